@@ -0,0 +1,171 @@
+package blockchain
+
+import (
+	"encoding/binary"
+	"errors"
+
+	"theta/common"
+	"theta/core"
+	"theta/crypto"
+	"theta/ledger/types"
+	"theta/rlp"
+)
+
+const accountTxIndexPrefix = "atx/"
+
+// AccountTxIndexCompleteKey returns the key which marks the database whose account tx index
+// has been maintained for all the blocks it holds, i.e. since the database was created.
+func AccountTxIndexCompleteKey() common.Bytes {
+	return common.Bytes("atxc")
+}
+
+// AccountTxCursorLength is the length of the cursor locating an entry of the account
+// tx index, i.e. the block height followed by the tx index in the block.
+const AccountTxCursorLength = 16
+
+// accountTxIndexPrefixKey constructs the DB key prefix of the account tx index entries
+// of the given address.
+func accountTxIndexPrefixKey(address common.Address) common.Bytes {
+	return append(common.Bytes(accountTxIndexPrefix), address[:]...)
+}
+
+// AccountTxCursor encodes the position of a tx in the chain. The index entries of an
+// address are sorted by their cursors.
+func AccountTxCursor(height uint64, index uint64) common.Bytes {
+	buf := make([]byte, AccountTxCursorLength)
+	binary.BigEndian.PutUint64(buf[:8], height)
+	binary.BigEndian.PutUint64(buf[8:], index)
+	return buf
+}
+
+// AccountTxIndexEntry records a finalized transaction in which an address is involved.
+type AccountTxIndexEntry struct {
+	TxHash      common.Hash       `json:"hash"`
+	BlockHash   common.Hash       `json:"block_hash"`
+	BlockHeight common.JSONUint64 `json:"block_height"`
+	Index       common.JSONUint64 `json:"index"`
+}
+
+// AddAccountTxsToIndex adds the transactions in the given finalized block to the index
+// of each address involved. It should be called after the tx receipts of the block are
+// saved, so that the addresses of the newly created contracts are indexed as well.
+func (ch *Chain) AddAccountTxsToIndex(block *core.ExtendedBlock) {
+	for idx, raw := range block.Txs {
+		tx, err := types.TxFromBytes(raw)
+		if err != nil {
+			logger.Errorf("Failed to decode tx %v in block %v: %v", idx, block.Hash().Hex(), err)
+			continue
+		}
+
+		txHash := crypto.Keccak256Hash(raw)
+		entry := AccountTxIndexEntry{
+			TxHash:      txHash,
+			BlockHash:   block.Hash(),
+			BlockHeight: common.JSONUint64(block.Height),
+			Index:       common.JSONUint64(idx),
+		}
+		cursor := AccountTxCursor(block.Height, uint64(idx))
+
+		addresses := getTxAddresses(tx)
+		if sctx, ok := tx.(*types.SmartContractTx); ok && sctx.To.Address == (common.Address{}) {
+			if receipt, found := ch.FindTxReceiptByHash(txHash); found {
+				addresses = append(addresses, receipt.ContractAddress)
+			}
+		}
+
+		for _, address := range addresses {
+			if address == (common.Address{}) {
+				continue
+			}
+			key := append(accountTxIndexPrefixKey(address), cursor...)
+			err := ch.store.Put(key, entry)
+			if err != nil {
+				logger.Panic(err)
+			}
+		}
+	}
+}
+
+// FindAccountTxs returns at most limit index entries of the given address in ascending
+// order, starting at the given cursor. It also returns the cursor of the entry following
+// the last one returned, or nil if there are no more entries.
+func (ch *Chain) FindAccountTxs(address common.Address, cursor common.Bytes, limit int) ([]*AccountTxIndexEntry, common.Bytes, error) {
+	if len(cursor) != 0 && len(cursor) != AccountTxCursorLength {
+		return nil, nil, errors.New("Invalid account tx cursor")
+	}
+
+	prefix := accountTxIndexPrefixKey(address)
+	ret := []*AccountTxIndexEntry{}
+	var next common.Bytes
+	var decodeErr error
+	err := ch.store.Traverse(prefix, cursor, func(key, value common.Bytes) bool {
+		if len(ret) >= limit {
+			next = key[len(prefix):]
+			return false
+		}
+		entry := &AccountTxIndexEntry{}
+		if decodeErr = rlp.DecodeBytes(value, entry); decodeErr != nil {
+			return false
+		}
+		ret = append(ret, entry)
+		return true
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if decodeErr != nil {
+		return nil, nil, decodeErr
+	}
+	return ret, next, nil
+}
+
+// getTxAddresses returns the addresses of the accounts involved in the given tx, i.e. the
+// inputs, outputs, sources, targets and holders.
+func getTxAddresses(tx types.Tx) []common.Address {
+	addresses := []common.Address{}
+	switch tx := tx.(type) {
+	case *types.CoinbaseTx:
+		addresses = append(addresses, tx.Proposer.Address)
+		for _, output := range tx.Outputs {
+			addresses = append(addresses, output.Address)
+		}
+	case *types.SlashTx:
+		addresses = append(addresses, tx.Proposer.Address, tx.SlashedAddress)
+	case *types.SendTx:
+		for _, input := range tx.Inputs {
+			addresses = append(addresses, input.Address)
+		}
+		for _, output := range tx.Outputs {
+			addresses = append(addresses, output.Address)
+		}
+	case *types.ReserveFundTx:
+		addresses = append(addresses, tx.Source.Address)
+	case *types.ReleaseFundTx:
+		addresses = append(addresses, tx.Source.Address)
+	case *types.ServicePaymentTx:
+		addresses = append(addresses, tx.Source.Address, tx.Target.Address)
+	case *types.SplitRuleTx:
+		addresses = append(addresses, tx.Initiator.Address)
+		for _, split := range tx.Splits {
+			addresses = append(addresses, split.Address)
+		}
+	case *types.SmartContractTx:
+		addresses = append(addresses, tx.From.Address, tx.To.Address)
+	case *types.DepositStakeTx:
+		addresses = append(addresses, tx.Source.Address, tx.Holder.Address)
+	case *types.WithdrawStakeTx:
+		addresses = append(addresses, tx.Source.Address, tx.Holder.Address)
+	case *types.DepositStakeTxV2:
+		addresses = append(addresses, tx.Source.Address, tx.Holder.Address)
+	case *types.EquivocationSlashTx:
+		addresses = append(addresses, tx.Proposer.Address)
+		if tx.Evidence != nil {
+			addresses = append(addresses, tx.Evidence.Offender)
+		}
+	case *types.ValidatorProfileTx:
+		addresses = append(addresses, tx.Holder.Address)
+	case *types.RedelegateStakeTx:
+		addresses = append(addresses, tx.Source.Address, tx.FromHolder.Address, tx.ToHolder.Address)
+	}
+	return addresses
+}
@@ -0,0 +1,161 @@
+package blockchain
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"theta/common"
+	"theta/core"
+	"theta/crypto"
+	"theta/ledger/types"
+)
+
+func TestAccountTxIndex(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	core.ResetTestBlocks()
+	chain := CreateTestChain()
+
+	alice := common.HexToAddress("0x0000000000000000000000000000000000000a01")
+	bob := common.HexToAddress("0x0000000000000000000000000000000000000b01")
+	carol := common.HexToAddress("0x0000000000000000000000000000000000000c01")
+	contract := common.HexToAddress("0x0000000000000000000000000000000000000d01")
+
+	tx1 := &types.SendTx{
+		Fee:     types.NewCoins(0, 1),
+		Inputs:  []types.TxInput{{Address: alice, Coins: types.NewCoins(0, 11)}},
+		Outputs: []types.TxOutput{{Address: bob, Coins: types.NewCoins(0, 10)}},
+	}
+	tx2 := &types.DepositStakeTx{
+		Fee:    types.NewCoins(0, 1),
+		Source: types.TxInput{Address: bob, Coins: types.NewCoins(100, 0)},
+		Holder: types.TxOutput{Address: carol},
+	}
+	tx3 := &types.SmartContractTx{From: types.TxInput{Address: alice}, GasLimit: 1, GasPrice: big.NewInt(1)}
+	raw1, err := types.TxToBytes(tx1)
+	require.Nil(err)
+	raw2, err := types.TxToBytes(tx2)
+	require.Nil(err)
+	raw3, err := types.TxToBytes(tx3)
+	require.Nil(err)
+
+	block1 := core.CreateTestBlock("b1", "a0")
+	block1.Height = 1
+	block1.Txs = []common.Bytes{raw1, raw2}
+	block2 := core.CreateTestBlock("b2", "b1")
+	block2.Height = 2
+	block2.Txs = []common.Bytes{raw3}
+
+	eb1, err := chain.AddBlock(block1)
+	require.Nil(err)
+	eb2, err := chain.AddBlock(block2)
+	require.Nil(err)
+
+	// The address of the deployed contract is only known from the receipt.
+	chain.AddTxReceipt(tx3, nil, nil, contract, 0, nil)
+	chain.AddAccountTxsToIndex(eb1)
+	chain.AddAccountTxsToIndex(eb2)
+
+	entries, next, err := chain.FindAccountTxs(alice, nil, 10)
+	require.Nil(err)
+	assert.Nil(next)
+	require.Equal(2, len(entries))
+	assert.Equal(crypto.Keccak256Hash(raw1), entries[0].TxHash)
+	assert.Equal(eb1.Hash(), entries[0].BlockHash)
+	assert.Equal(crypto.Keccak256Hash(raw3), entries[1].TxHash)
+	assert.Equal(common.JSONUint64(2), entries[1].BlockHeight)
+
+	entries, next, err = chain.FindAccountTxs(bob, nil, 1)
+	require.Nil(err)
+	require.Equal(1, len(entries))
+	assert.Equal(crypto.Keccak256Hash(raw1), entries[0].TxHash)
+	assert.Equal(AccountTxCursor(1, 1), next)
+
+	entries, next, err = chain.FindAccountTxs(bob, next, 1)
+	require.Nil(err)
+	assert.Nil(next)
+	require.Equal(1, len(entries))
+	assert.Equal(crypto.Keccak256Hash(raw2), entries[0].TxHash)
+	assert.Equal(common.JSONUint64(1), entries[0].Index)
+
+	entries, _, err = chain.FindAccountTxs(carol, nil, 10)
+	require.Nil(err)
+	assert.Equal(1, len(entries))
+
+	entries, _, err = chain.FindAccountTxs(contract, nil, 10)
+	require.Nil(err)
+	assert.Equal(1, len(entries))
+
+	entries, _, err = chain.FindAccountTxs(alice, AccountTxCursor(2, 0), 10)
+	require.Nil(err)
+	assert.Equal(1, len(entries))
+
+	_, _, err = chain.FindAccountTxs(alice, common.Bytes{0x1}, 10)
+	assert.NotNil(err)
+}
+
+func TestAccountTxIndexIndirectlyFinalized(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	core.ResetTestBlocks()
+	chain := CreateTestChain()
+
+	alice := common.HexToAddress("0x0000000000000000000000000000000000000a01")
+	bob := common.HexToAddress("0x0000000000000000000000000000000000000b01")
+
+	tx1 := &types.SendTx{
+		Fee:     types.NewCoins(0, 1),
+		Inputs:  []types.TxInput{{Address: alice, Coins: types.NewCoins(0, 11)}},
+		Outputs: []types.TxOutput{{Address: bob, Coins: types.NewCoins(0, 10)}},
+	}
+	tx2 := &types.SendTx{
+		Fee:     types.NewCoins(0, 1),
+		Inputs:  []types.TxInput{{Address: bob, Coins: types.NewCoins(0, 6)}},
+		Outputs: []types.TxOutput{{Address: alice, Coins: types.NewCoins(0, 5)}},
+	}
+	raw1, err := types.TxToBytes(tx1)
+	require.Nil(err)
+	raw2, err := types.TxToBytes(tx2)
+	require.Nil(err)
+
+	block1 := core.CreateTestBlock("b1", "a0")
+	block1.Height = 1
+	block1.Txs = []common.Bytes{raw1}
+	block2 := core.CreateTestBlock("b2", "b1")
+	block2.Height = 2
+	block2.Txs = []common.Bytes{raw2}
+
+	_, err = chain.AddBlock(block1)
+	require.Nil(err)
+	eb2, err := chain.AddBlock(block2)
+	require.Nil(err)
+
+	// Finalizing b2 finalizes b1 indirectly, and both are indexed
+	require.Nil(chain.FinalizePreviousBlocks(eb2.Hash()))
+	eb2, err = chain.FindBlock(eb2.Hash())
+	require.Nil(err)
+	finalizedBlocks := chain.FindFinalizedBlocksAbove(eb2, 0)
+	require.Equal(2, len(finalizedBlocks))
+	assert.Equal(core.BlockStatusIndirectlyFinalized, finalizedBlocks[0].Status)
+	assert.Equal(block1.Hash(), finalizedBlocks[0].Hash())
+	assert.Equal(core.BlockStatusDirectlyFinalized, finalizedBlocks[1].Status)
+	assert.Equal(eb2.Hash(), finalizedBlocks[1].Hash())
+	for _, block := range finalizedBlocks {
+		chain.AddAccountTxsToIndex(block)
+	}
+
+	entries, _, err := chain.FindAccountTxs(alice, nil, 10)
+	require.Nil(err)
+	require.Equal(2, len(entries))
+	assert.Equal(crypto.Keccak256Hash(raw1), entries[0].TxHash)
+	assert.Equal(common.JSONUint64(1), entries[0].BlockHeight)
+	assert.Equal(crypto.Keccak256Hash(raw2), entries[1].TxHash)
+
+	// The blocks at or below the last finalized height are not returned again
+	assert.Equal(1, len(chain.FindFinalizedBlocksAbove(eb2, 1)))
+	assert.Equal(0, len(chain.FindFinalizedBlocksAbove(eb2, 2)))
+}
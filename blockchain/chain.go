@@ -294,6 +294,22 @@ func (ch *Chain) FinalizePreviousBlocks(hash common.Hash) error {
 	return nil
 }
 
+// FindFinalizedBlocksAbove returns the given finalized block and its ancestors with heights greater
+// than the given height in ascending order of height, i.e. the blocks finalized along with the block
+// since the last finalized block at the given height.
+func (ch *Chain) FindFinalizedBlocksAbove(block *core.ExtendedBlock, height uint64) []*core.ExtendedBlock {
+	blocks := []*core.ExtendedBlock{}
+	for block.Height > height {
+		blocks = append([]*core.ExtendedBlock{block}, blocks...)
+		parent, err := ch.FindBlock(block.Parent)
+		if err != nil {
+			break
+		}
+		block = parent
+	}
+	return blocks
+}
+
 func (ch *Chain) IsOrphan(block *core.Block) bool {
 	_, err := ch.FindBlock(block.Parent)
 	return err != nil
@@ -0,0 +1,199 @@
+package blockchain
+
+import (
+	"encoding/binary"
+
+	"theta/common"
+	"theta/core"
+	"theta/crypto"
+	"theta/ledger/types"
+	"theta/store"
+)
+
+// blockBloomKey constructs the DB key for the log bloom of the finalized block at the given height.
+func blockBloomKey(height uint64) common.Bytes {
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, height)
+	return append(common.Bytes("bb/"), buf...)
+}
+
+// BlockBloomEntry records the bloom filter of all the logs emitted by a finalized block.
+type BlockBloomEntry struct {
+	BlockHash common.Hash
+	Bloom     core.Bloom
+}
+
+// AddBlockBloom computes the log bloom of the given block from the tx receipts and
+// persists it. It should be called when the block is finalized.
+func (ch *Chain) AddBlockBloom(block *core.ExtendedBlock) {
+	bloom := core.Bloom{}
+	for _, tx := range block.Txs {
+		receipt, found := ch.FindTxReceiptByHash(crypto.Keccak256Hash(tx))
+		if !found {
+			continue
+		}
+		logsBloom := types.LogsBloom(receipt.Logs)
+		for i := range bloom {
+			bloom[i] |= logsBloom[i]
+		}
+	}
+
+	entry := BlockBloomEntry{
+		BlockHash: block.Hash(),
+		Bloom:     bloom,
+	}
+	err := ch.store.Put(blockBloomKey(block.Height), entry)
+	if err != nil {
+		logger.Panic(err)
+	}
+}
+
+// FindBlockBloomByHeight looks up the log bloom of the finalized block at the given height.
+func (ch *Chain) FindBlockBloomByHeight(height uint64) (*BlockBloomEntry, bool) {
+	entry := &BlockBloomEntry{}
+	err := ch.store.Get(blockBloomKey(height), entry)
+	if err != nil {
+		if err != store.ErrKeyNotFound {
+			logger.Error(err)
+		}
+		return nil, false
+	}
+	return entry, true
+}
+
+// LogFilter specifies the logs to search for. A log matches if it is emitted by any of the
+// Addresses (or Addresses is empty), and for each position i, its i-th topic is one of
+// Topics[i] (or Topics[i] is empty).
+type LogFilter struct {
+	FromHeight uint64
+	ToHeight   uint64
+	Addresses  []common.Address
+	Topics     [][]common.Hash
+}
+
+// LogEntry is a log together with its position in the chain.
+type LogEntry struct {
+	*types.Log
+	BlockHash   common.Hash       `json:"block_hash"`
+	BlockHeight common.JSONUint64 `json:"block_height"`
+	TxHash      common.Hash       `json:"tx_hash"`
+	TxIndex     common.JSONUint64 `json:"tx_index"`
+	LogIndex    common.JSONUint64 `json:"log_index"`
+}
+
+// FilterLogs returns the logs of the finalized blocks in the given height range that
+// match the filter. Blocks whose bloom rules out a match are skipped without loading
+// their tx receipts.
+func (ch *Chain) FilterLogs(filter *LogFilter) []*LogEntry {
+	ret := []*LogEntry{}
+	for height := filter.FromHeight; height <= filter.ToHeight; height++ {
+		block := ch.findFinalizedBlockByHeight(height)
+		if block == nil {
+			continue
+		}
+
+		bloomEntry, found := ch.FindBlockBloomByHeight(height)
+		if found && bloomEntry.BlockHash == block.Hash() && !filter.matchBloom(bloomEntry.Bloom) {
+			continue
+		}
+
+		logIndex := uint64(0)
+		for txIndex, tx := range block.Txs {
+			txHash := crypto.Keccak256Hash(tx)
+			receipt, found := ch.FindTxReceiptByHash(txHash)
+			if !found {
+				continue
+			}
+			for _, log := range receipt.Logs {
+				if filter.Match(log) {
+					ret = append(ret, &LogEntry{
+						Log:         log,
+						BlockHash:   block.Hash(),
+						BlockHeight: common.JSONUint64(block.Height),
+						TxHash:      txHash,
+						TxIndex:     common.JSONUint64(txIndex),
+						LogIndex:    common.JSONUint64(logIndex),
+					})
+				}
+				logIndex++
+			}
+		}
+	}
+	return ret
+}
+
+// Match returns whether the given log satisfies the address and topic constraints.
+func (filter *LogFilter) Match(log *types.Log) bool {
+	if len(filter.Addresses) > 0 {
+		matched := false
+		for _, address := range filter.Addresses {
+			if log.Address == address {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	if len(filter.Topics) > len(log.Topics) {
+		return false
+	}
+	for i, alternatives := range filter.Topics {
+		if len(alternatives) == 0 {
+			continue
+		}
+		matched := false
+		for _, topic := range alternatives {
+			if log.Topics[i] == topic {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+// matchBloom returns false if the bloom guarantees that no log in the block matches.
+func (filter *LogFilter) matchBloom(bloom core.Bloom) bool {
+	if len(filter.Addresses) > 0 {
+		matched := false
+		for _, address := range filter.Addresses {
+			if core.BloomLookup(bloom, address) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	for _, alternatives := range filter.Topics {
+		if len(alternatives) == 0 {
+			continue
+		}
+		matched := false
+		for _, topic := range alternatives {
+			if core.BloomLookup(bloom, topic) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+func (ch *Chain) findFinalizedBlockByHeight(height uint64) *core.ExtendedBlock {
+	for _, block := range ch.FindBlocksByHeight(height) {
+		if block.Status.IsFinalized() {
+			return block
+		}
+	}
+	return nil
+}
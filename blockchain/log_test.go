@@ -0,0 +1,72 @@
+package blockchain
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"theta/common"
+	"theta/core"
+	"theta/ledger/types"
+)
+
+func TestFilterLogs(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	core.ResetTestBlocks()
+	chain := CreateTestChain()
+
+	contract1 := common.HexToAddress("0x0000000000000000000000000000000000000c01")
+	contract2 := common.HexToAddress("0x0000000000000000000000000000000000000c02")
+	transferTopic := common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+	approvalTopic := common.HexToHash("0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925")
+
+	tx1 := &types.SmartContractTx{To: types.TxOutput{Address: contract1}, GasLimit: 1, GasPrice: big.NewInt(1)}
+	tx2 := &types.SmartContractTx{To: types.TxOutput{Address: contract2}, GasLimit: 2, GasPrice: big.NewInt(1)}
+	raw1, err := types.TxToBytes(tx1)
+	require.Nil(err)
+	raw2, err := types.TxToBytes(tx2)
+	require.Nil(err)
+
+	block1 := core.CreateTestBlock("b1", "a0")
+	block1.Height = 1
+	block1.Txs = []common.Bytes{raw1}
+	block2 := core.CreateTestBlock("b2", "b1")
+	block2.Height = 2
+	block2.Txs = []common.Bytes{raw2}
+
+	eb1, err := chain.AddBlock(block1)
+	require.Nil(err)
+	eb2, err := chain.AddBlock(block2)
+	require.Nil(err)
+	require.Nil(chain.FinalizePreviousBlocks(eb2.Hash()))
+
+	chain.AddTxReceipt(tx1, []*types.Log{{Address: contract1, Topics: []common.Hash{transferTopic}}}, nil, common.Address{}, 0, nil)
+	chain.AddTxReceipt(tx2, []*types.Log{{Address: contract2, Topics: []common.Hash{approvalTopic}}}, nil, common.Address{}, 0, nil)
+	eb1, _ = chain.FindBlock(eb1.Hash())
+	eb2, _ = chain.FindBlock(eb2.Hash())
+	chain.AddBlockBloom(eb1)
+	chain.AddBlockBloom(eb2)
+
+	bloomEntry, found := chain.FindBlockBloomByHeight(1)
+	require.True(found)
+	assert.Equal(eb1.Hash(), bloomEntry.BlockHash)
+	assert.True(core.BloomLookup(bloomEntry.Bloom, contract1))
+	assert.True(core.BloomLookup(bloomEntry.Bloom, transferTopic))
+
+	logs := chain.FilterLogs(&LogFilter{FromHeight: 0, ToHeight: 2})
+	assert.Equal(2, len(logs))
+
+	logs = chain.FilterLogs(&LogFilter{FromHeight: 0, ToHeight: 2, Addresses: []common.Address{contract2}})
+	require.Equal(1, len(logs))
+	assert.Equal(eb2.Hash(), logs[0].BlockHash)
+	assert.Equal(common.JSONUint64(2), logs[0].BlockHeight)
+
+	logs = chain.FilterLogs(&LogFilter{FromHeight: 0, ToHeight: 2, Topics: [][]common.Hash{{approvalTopic, transferTopic}}})
+	assert.Equal(2, len(logs))
+
+	logs = chain.FilterLogs(&LogFilter{FromHeight: 0, ToHeight: 2, Addresses: []common.Address{contract1}, Topics: [][]common.Hash{{approvalTopic}}})
+	assert.Equal(0, len(logs))
+}
@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"theta/common"
+	"theta/consensus"
+	"theta/core"
+	"theta/store/database"
+	"theta/store/database/backend"
+	"theta/store/kvstore"
+	"theta/store/migrate"
+)
+
+var migrateFrom string
+var migrateTo string
+
+// dbCmd represents the db command
+var dbCmd = &cobra.Command{
+	Use:   "db",
+	Short: "Manage the Theta node database.",
+}
+
+// dbMigrateCmd represents the db migrate command
+var dbMigrateCmd = &cobra.Command{
+	Use:     "migrate",
+	Short:   "Copy the database to another storage backend.",
+	Long:    `Copy all the keys, reference counts and state tries to another storage backend, compare the key counts and the last finalized blocks of both databases, and verify the state of the last finalized block in the new database.`,
+	Example: `theta db migrate --from leveldb:$HOME/.theta/db --to badger:$HOME/.theta/db`,
+	Run:     runDBMigrate,
+}
+
+func init() {
+	dbMigrateCmd.Flags().StringVar(&migrateFrom, "from", "", "source database in the form of <backend>:<path>")
+	dbMigrateCmd.Flags().StringVar(&migrateTo, "to", "", "destination database in the form of <backend>:<path>")
+	dbMigrateCmd.MarkFlagRequired("from")
+	dbMigrateCmd.MarkFlagRequired("to")
+
+	dbCmd.AddCommand(dbMigrateCmd)
+	RootCmd.AddCommand(dbCmd)
+}
+
+func runDBMigrate(cmd *cobra.Command, args []string) {
+	src, err := openDatabase(migrateFrom)
+	if err != nil {
+		log.Fatalf("Failed to open the source database %v: %v", migrateFrom, err)
+	}
+	defer src.Close()
+
+	dst, err := openDatabase(migrateTo)
+	if err != nil {
+		log.Fatalf("Failed to open the destination database %v: %v", migrateTo, err)
+	}
+	defer dst.Close()
+
+	lastFinalizedBlock, err := findLastFinalizedBlock(src)
+	if err != nil {
+		log.Fatalf("Failed to find the last finalized block: %v", err)
+	}
+	stateHash := lastFinalizedBlock.StateHash
+
+	log.Infof("Migrating database from %v to %v", migrateFrom, migrateTo)
+	stats, err := migrate.CopyDatabase(src, dst)
+	if err != nil {
+		log.Fatalf("Failed to migrate database: %v", err)
+	}
+	log.Infof("Copied %v keys and %v references", stats.Keys, stats.References)
+
+	if err := migrate.VerifyCopy(src, dst, lastFinalizedBlock.Hash()); err != nil {
+		log.Fatalf("Failed to verify the copy of the database: %v", err)
+	}
+	log.Infof("Verified the keys and the blocks up to block %v", lastFinalizedBlock.Hash().Hex())
+
+	if err := migrate.VerifyState(dst, stateHash); err != nil {
+		log.Fatalf("Failed to verify state %v: %v", stateHash.Hex(), err)
+	}
+	log.Infof("Verified state %v", stateHash.Hex())
+}
+
+// openDatabase opens the database specified in the form of <backend>:<path>.
+func openDatabase(spec string) (database.Database, error) {
+	parts := strings.SplitN(spec, ":", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Database must be specified in the form of <backend>:<path>")
+	}
+	return backend.OpenDatabase(parts[0], parts[1],
+		viper.GetInt(common.CfgStorageLevelDBCacheSize),
+		viper.GetInt(common.CfgStorageLevelDBHandles))
+}
+
+// findLastFinalizedBlock returns the last finalized block recorded in the consensus state.
+func findLastFinalizedBlock(db database.Database) (*core.ExtendedBlock, error) {
+	store := kvstore.NewKVStore(db)
+
+	stub := &consensus.StateStub{}
+	if err := store.Get([]byte(consensus.DBStateStubKey), stub); err != nil {
+		return nil, err
+	}
+	block := &core.ExtendedBlock{}
+	if err := store.Get(stub.LastFinalizedBlock[:], block); err != nil {
+		return nil, err
+	}
+	return block, nil
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/spf13/viper"
 	"theta/cmd/thetacli/cmd/utils"
 	"theta/common"
+	"theta/common/metrics"
 	"theta/common/util"
 	"theta/core"
 	"theta/crypto"
@@ -51,21 +52,34 @@ func runStart(cmd *cobra.Command, args []string) {
 		log.Fatalf("Failed to load or create key: %v", err)
 	}
 
+	// The metrics are created along with the components, hence the collection must be
+	// enabled before the database is opened.
+	if viper.GetBool(common.CfgPrometheusEnabled) {
+		metrics.Enabled = true
+	}
+
 	// Open database
 	dbPath := viper.GetString(common.CfgDataPath)
 	if dbPath == "" {
 		dbPath = cfgPath
 	}
 
-	mainDBPath := path.Join(dbPath, "db", "main")
-	refDBPath := path.Join(dbPath, "db", "ref")
-	db, err := backend.NewLDBDatabase(mainDBPath, refDBPath,
+	dbBackend := viper.GetString(common.CfgStorageBackend)
+	dbDir := path.Join(dbPath, "db")
+	db, err := backend.OpenDatabase(dbBackend, dbDir,
 		viper.GetInt(common.CfgStorageLevelDBCacheSize),
 		viper.GetInt(common.CfgStorageLevelDBHandles))
 
 	if err != nil {
-		log.Fatalf("Failed to connect to the db. main: %v, ref: %v, err: %v",
-			mainDBPath, refDBPath, err)
+		log.Fatalf("Failed to connect to the db. backend: %v, path: %v, err: %v",
+			dbBackend, dbDir, err)
+	}
+
+	// Load the chain configs before the snapshot, since decoding the block headers depends on the fork heights
+	if chainConfigPath := viper.GetString(common.CfgGenesisChainConfigPath); len(chainConfigPath) != 0 {
+		if err := core.LoadChainConfigs(chainConfigPath); err != nil {
+			log.Fatalf("Failed to load the chain configs from %v, err: %v", chainConfigPath, err)
+		}
 	}
 
 	// load snapshot
@@ -110,6 +124,7 @@ func runStart(cmd *cobra.Command, args []string) {
 	root = &core.Block{BlockHeader: snapshotBlockHeader}
 
 	viper.Set(common.CfgGenesisChainID, root.ChainID)
+	log.Infof("Chain config: %v", core.GetChainConfig(root.ChainID))
 
 	// Parse seeds and filter out empty item.
 	f := func(c rune) bool {
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"theta/cmd/thetacli/cmd/utils"
+	"theta/common"
 	"theta/rpc"
 
 	"github.com/spf13/cobra"
@@ -27,7 +28,8 @@ func doAccountCmd(cmd *cobra.Command, args []string) {
 	client := rpcc.NewRPCClient(viper.GetString(utils.CfgRemoteRPCEndpoint))
 
 	res, err := client.Call("theta.GetAccount", rpc.GetAccountArgs{
-		Address: addressFlag, Preview: previewFlag})
+		Address: addressFlag, Preview: previewFlag,
+		BlockQueryArgs: rpc.BlockQueryArgs{Height: common.JSONUint64(heightFlag)}})
 	if err != nil {
 		utils.Error("Failed to get account details: %v\n", err)
 	}
@@ -44,5 +46,6 @@ func doAccountCmd(cmd *cobra.Command, args []string) {
 func init() {
 	accountCmd.Flags().StringVar(&addressFlag, "address", "", "Address of the account")
 	accountCmd.Flags().BoolVar(&previewFlag, "preview", false, "Preview account balance from the screened view")
+	accountCmd.Flags().Uint64Var(&heightFlag, "height", uint64(0), "Query the account at the given block height, the latest finalized state if omitted")
 	accountCmd.MarkFlagRequired("address")
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"theta/cmd/thetacli/cmd/utils"
+	"theta/common"
 	"theta/rpc"
 
 	rpcc "github.com/ybbus/jsonrpc"
@@ -26,7 +27,8 @@ func doSplitRuleCmd(cmd *cobra.Command, args []string) {
 	client := rpcc.NewRPCClient(viper.GetString(utils.CfgRemoteRPCEndpoint))
 
 	resourceID := resourceIDFlag
-	res, err := client.Call("theta.GetSplitRule", rpc.GetSplitRuleArgs{ResourceID: resourceID})
+	res, err := client.Call("theta.GetSplitRule", rpc.GetSplitRuleArgs{
+		ResourceID: resourceID, BlockQueryArgs: rpc.BlockQueryArgs{Height: common.JSONUint64(heightFlag)}})
 	if err != nil {
 		utils.Error("Failed to get split rule details: %v\n", err)
 	}
@@ -42,5 +44,6 @@ func doSplitRuleCmd(cmd *cobra.Command, args []string) {
 
 func init() {
 	splitRuleCmd.Flags().StringVar(&resourceIDFlag, "resource_id", "", "Resource ID of the contract")
+	splitRuleCmd.Flags().Uint64Var(&heightFlag, "height", uint64(0), "Query the split rule at the given block height, the latest state if omitted")
 	splitRuleCmd.MarkFlagRequired("resource_id")
 }
@@ -33,6 +33,11 @@ var (
 	sourceFlag                   string
 	holderFlag                   string
 	asyncFlag                    bool
+	commissionRateFlag           uint64
+	maxCommissionRateFlag        uint64
+	maxCommissionChangeRateFlag  uint64
+	fromHolderFlag               string
+	toHolderFlag                 string
 )
 
 // TxCmd represents the Tx command
@@ -50,4 +55,6 @@ func init() {
 	TxCmd.AddCommand(smartContractCmd)
 	TxCmd.AddCommand(depositStakeCmd)
 	TxCmd.AddCommand(withdrawStakeCmd)
+	TxCmd.AddCommand(validatorProfileCmd)
+	TxCmd.AddCommand(redelegateStakeCmd)
 }
@@ -0,0 +1,102 @@
+package tx
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"theta/cmd/thetacli/cmd/utils"
+	"theta/common"
+	"theta/ledger/types"
+	"theta/rpc"
+
+	rpcc "github.com/ybbus/jsonrpc"
+)
+
+// redelegateStakeCmd represents the redelegate stake command
+// Example:
+//		thetacli tx redelegate --chain="privatenet" --source=2E833968E5bB786Ae419c4d13189fB081Cc43bab --from_holder=2E833968E5bB786Ae419c4d13189fB081Cc43bab --to_holder=70f587259738cB626A1720Af7038B8DcDb6a42a0 --purpose=0 --seq=8
+var redelegateStakeCmd = &cobra.Command{
+	Use:     "redelegate",
+	Short:   "move stake from one validator or guardian to another",
+	Example: `thetacli tx redelegate --chain="privatenet" --source=2E833968E5bB786Ae419c4d13189fB081Cc43bab --from_holder=2E833968E5bB786Ae419c4d13189fB081Cc43bab --to_holder=70f587259738cB626A1720Af7038B8DcDb6a42a0 --purpose=0 --seq=8`,
+	Run:     doRedelegateStakeCmd,
+}
+
+func doRedelegateStakeCmd(cmd *cobra.Command, args []string) {
+	wallet, sourceAddress, err := walletUnlockWithPath(cmd, sourceFlag, pathFlag)
+	if err != nil {
+		return
+	}
+	defer wallet.Lock(sourceAddress)
+
+	fee, ok := types.ParseCoinAmount(feeFlag)
+	if !ok {
+		utils.Error("Failed to parse fee")
+	}
+
+	source := types.TxInput{
+		Address:  sourceAddress,
+		Sequence: uint64(seqFlag),
+	}
+	fromHolder := types.TxOutput{
+		Address: common.HexToAddress(fromHolderFlag),
+	}
+	toHolder := types.TxOutput{
+		Address: common.HexToAddress(toHolderFlag),
+	}
+
+	redelegateStakeTx := &types.RedelegateStakeTx{
+		Fee: types.Coins{
+			ThetaWei: new(big.Int).SetUint64(0),
+			TFuelWei: fee,
+		},
+		Source:     source,
+		FromHolder: fromHolder,
+		ToHolder:   toHolder,
+		Purpose:    purposeFlag,
+	}
+
+	sig, err := wallet.Sign(sourceAddress, redelegateStakeTx.SignBytes(chainIDFlag))
+	if err != nil {
+		utils.Error("Failed to sign transaction: %v\n", err)
+	}
+	redelegateStakeTx.SetSignature(sourceAddress, sig)
+
+	raw, err := types.TxToBytes(redelegateStakeTx)
+	if err != nil {
+		utils.Error("Failed to encode transaction: %v\n", err)
+	}
+	signedTx := hex.EncodeToString(raw)
+
+	client := rpcc.NewRPCClient(viper.GetString(utils.CfgRemoteRPCEndpoint))
+
+	res, err := client.Call("theta.BroadcastRawTransaction", rpc.BroadcastRawTransactionArgs{TxBytes: signedTx})
+	if err != nil {
+		utils.Error("Failed to broadcast transaction: %v\n", err)
+	}
+	if res.Error != nil {
+		utils.Error("Server returned error: %v\n", res.Error)
+	}
+	fmt.Printf("Successfully broadcasted transaction.\n")
+}
+
+func init() {
+	redelegateStakeCmd.Flags().StringVar(&chainIDFlag, "chain", "", "Chain ID")
+	redelegateStakeCmd.Flags().StringVar(&sourceFlag, "source", "", "Source of the stake")
+	redelegateStakeCmd.Flags().StringVar(&fromHolderFlag, "from_holder", "", "Current holder of the stake")
+	redelegateStakeCmd.Flags().StringVar(&toHolderFlag, "to_holder", "", "New holder of the stake")
+	redelegateStakeCmd.Flags().StringVar(&pathFlag, "path", "", "Wallet derivation path")
+	redelegateStakeCmd.Flags().StringVar(&feeFlag, "fee", fmt.Sprintf("%dwei", types.MinimumTransactionFeeTFuelWei), "Fee")
+	redelegateStakeCmd.Flags().Uint64Var(&seqFlag, "seq", 0, "Sequence number of the transaction")
+	redelegateStakeCmd.Flags().Uint8Var(&purposeFlag, "purpose", 0, "Purpose of staking")
+	redelegateStakeCmd.Flags().StringVar(&walletFlag, "wallet", "soft", "Wallet type (soft|nano)")
+
+	redelegateStakeCmd.MarkFlagRequired("chain")
+	redelegateStakeCmd.MarkFlagRequired("source")
+	redelegateStakeCmd.MarkFlagRequired("from_holder")
+	redelegateStakeCmd.MarkFlagRequired("to_holder")
+	redelegateStakeCmd.MarkFlagRequired("seq")
+}
@@ -81,6 +81,13 @@ func doSmartContractCmd(cmd *cobra.Command, args []string) {
 		Data:     data,
 	}
 
+	client := rpcc.NewRPCClient(viper.GetString(utils.CfgRemoteRPCEndpoint))
+
+	if smartContractTx.GasLimit == 0 {
+		smartContractTx.GasLimit = estimateGas(client, smartContractTx)
+		fmt.Printf("Using estimated gas limit: %v\n", smartContractTx.GasLimit)
+	}
+
 	sig, err := wallet.Sign(fromAddress, smartContractTx.SignBytes(chainIDFlag))
 	if err != nil {
 		utils.Error("Failed to sign transaction: %v\n", err)
@@ -93,8 +100,6 @@ func doSmartContractCmd(cmd *cobra.Command, args []string) {
 	}
 	signedTx := hex.EncodeToString(raw)
 
-	client := rpcc.NewRPCClient(viper.GetString(utils.CfgRemoteRPCEndpoint))
-
 	res, err := client.Call("theta.BroadcastRawTransaction", rpc.BroadcastRawTransactionArgs{TxBytes: signedTx})
 	if err != nil {
 		utils.Error("Failed to broadcast transaction: %v\n", err)
@@ -114,13 +119,41 @@ func doSmartContractCmd(cmd *cobra.Command, args []string) {
 	fmt.Printf("Successfully broadcasted transaction:\n%s\n", formatted)
 }
 
+// estimateGas queries the node for the minimal gas limit of the given transaction
+func estimateGas(client *rpcc.RPCClient, smartContractTx *types.SmartContractTx) uint64 {
+	raw, err := types.TxToBytes(smartContractTx)
+	if err != nil {
+		utils.Error("Failed to encode transaction: %v\n", err)
+	}
+
+	res, err := client.Call("theta.EstimateGas", rpc.EstimateGasArgs{SctxBytes: hex.EncodeToString(raw)})
+	if err != nil {
+		utils.Error("Failed to estimate gas: %v\n", err)
+	}
+	if res.Error != nil {
+		utils.Error("Server returned error: %v\n", res.Error)
+	}
+	result := &rpc.EstimateGasResult{}
+	err = res.GetObject(result)
+	if err != nil {
+		utils.Error("Failed to parse server response: %v\n", err)
+	}
+	if result.VmError != "" {
+		if result.RevertReason != "" {
+			utils.Error("Transaction would fail: %v, reason: %v\n", result.VmError, result.RevertReason)
+		}
+		utils.Error("Transaction would fail: %v\n", result.VmError)
+	}
+	return uint64(result.GasLimit)
+}
+
 func init() {
 	smartContractCmd.Flags().StringVar(&chainIDFlag, "chain", "", "Chain ID")
 	smartContractCmd.Flags().StringVar(&fromFlag, "from", "", "The caller address")
 	smartContractCmd.Flags().StringVar(&toFlag, "to", "", "The smart contract address")
 	smartContractCmd.Flags().StringVar(&valueFlag, "value", "0", "Value to be transferred")
 	smartContractCmd.Flags().StringVar(&gasPriceFlag, "gas_price", fmt.Sprintf("%dwei", types.MinimumGasPrice), "The gas price")
-	smartContractCmd.Flags().Uint64Var(&gasLimitFlag, "gas_limit", 0, "The gas limit")
+	smartContractCmd.Flags().Uint64Var(&gasLimitFlag, "gas_limit", 0, "The gas limit, estimated by the node if omitted")
 	smartContractCmd.Flags().StringVar(&dataFlag, "data", "", "The data for the smart contract")
 	smartContractCmd.Flags().Uint64Var(&seqFlag, "seq", 0, "Sequence number of the transaction")
 	smartContractCmd.Flags().StringVar(&walletFlag, "wallet", "soft", "Wallet type (soft|nano)")
@@ -128,6 +161,5 @@ func init() {
 	smartContractCmd.MarkFlagRequired("chain")
 	smartContractCmd.MarkFlagRequired("from")
 	smartContractCmd.MarkFlagRequired("gas_price")
-	smartContractCmd.MarkFlagRequired("gas_limit")
 	smartContractCmd.MarkFlagRequired("seq")
 }
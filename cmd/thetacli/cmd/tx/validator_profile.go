@@ -0,0 +1,94 @@
+package tx
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"theta/cmd/thetacli/cmd/utils"
+	"theta/ledger/types"
+	"theta/rpc"
+
+	rpcc "github.com/ybbus/jsonrpc"
+)
+
+// validatorProfileCmd represents the validator profile command
+// Example:
+//		thetacli tx validator_profile --chain="privatenet" --holder=2E833968E5bB786Ae419c4d13189fB081Cc43bab --commission_rate=500 --max_commission_rate=2000 --max_commission_change_rate=100 --seq=8
+var validatorProfileCmd = &cobra.Command{
+	Use:     "validator_profile",
+	Short:   "set the commission rates of a validator, in basis points",
+	Example: `thetacli tx validator_profile --chain="privatenet" --holder=2E833968E5bB786Ae419c4d13189fB081Cc43bab --commission_rate=500 --max_commission_rate=2000 --max_commission_change_rate=100 --seq=8`,
+	Run:     doValidatorProfileCmd,
+}
+
+func doValidatorProfileCmd(cmd *cobra.Command, args []string) {
+	wallet, holderAddress, err := walletUnlockWithPath(cmd, holderFlag, pathFlag)
+	if err != nil {
+		return
+	}
+	defer wallet.Lock(holderAddress)
+
+	fee, ok := types.ParseCoinAmount(feeFlag)
+	if !ok {
+		utils.Error("Failed to parse fee")
+	}
+
+	holder := types.TxInput{
+		Address:  holderAddress,
+		Sequence: uint64(seqFlag),
+	}
+
+	validatorProfileTx := &types.ValidatorProfileTx{
+		Fee: types.Coins{
+			ThetaWei: new(big.Int).SetUint64(0),
+			TFuelWei: fee,
+		},
+		Holder:                  holder,
+		CommissionRate:          commissionRateFlag,
+		MaxCommissionRate:       maxCommissionRateFlag,
+		MaxCommissionChangeRate: maxCommissionChangeRateFlag,
+	}
+
+	sig, err := wallet.Sign(holderAddress, validatorProfileTx.SignBytes(chainIDFlag))
+	if err != nil {
+		utils.Error("Failed to sign transaction: %v\n", err)
+	}
+	validatorProfileTx.SetSignature(holderAddress, sig)
+
+	raw, err := types.TxToBytes(validatorProfileTx)
+	if err != nil {
+		utils.Error("Failed to encode transaction: %v\n", err)
+	}
+	signedTx := hex.EncodeToString(raw)
+
+	client := rpcc.NewRPCClient(viper.GetString(utils.CfgRemoteRPCEndpoint))
+
+	res, err := client.Call("theta.BroadcastRawTransaction", rpc.BroadcastRawTransactionArgs{TxBytes: signedTx})
+	if err != nil {
+		utils.Error("Failed to broadcast transaction: %v\n", err)
+	}
+	if res.Error != nil {
+		utils.Error("Server returned error: %v\n", res.Error)
+	}
+	fmt.Printf("Successfully broadcasted transaction.\n")
+}
+
+func init() {
+	validatorProfileCmd.Flags().StringVar(&chainIDFlag, "chain", "", "Chain ID")
+	validatorProfileCmd.Flags().StringVar(&holderFlag, "holder", "", "Holder of the validator stakes")
+	validatorProfileCmd.Flags().StringVar(&pathFlag, "path", "", "Wallet derivation path")
+	validatorProfileCmd.Flags().StringVar(&feeFlag, "fee", fmt.Sprintf("%dwei", types.MinimumTransactionFeeTFuelWei), "Fee")
+	validatorProfileCmd.Flags().Uint64Var(&seqFlag, "seq", 0, "Sequence number of the transaction")
+	validatorProfileCmd.Flags().Uint64Var(&commissionRateFlag, "commission_rate", 0, "Commission rate in basis points")
+	validatorProfileCmd.Flags().Uint64Var(&maxCommissionRateFlag, "max_commission_rate", 0, "Max commission rate in basis points, only used when creating the profile")
+	validatorProfileCmd.Flags().Uint64Var(&maxCommissionChangeRateFlag, "max_commission_change_rate", 0, "Max commission rate change per update in basis points, only used when creating the profile")
+	validatorProfileCmd.Flags().StringVar(&walletFlag, "wallet", "soft", "Wallet type (soft|nano)")
+
+	validatorProfileCmd.MarkFlagRequired("chain")
+	validatorProfileCmd.MarkFlagRequired("holder")
+	validatorProfileCmd.MarkFlagRequired("seq")
+	validatorProfileCmd.MarkFlagRequired("commission_rate")
+}
@@ -21,6 +21,8 @@ const (
 	CfgGenesisHash = "genesis.hash"
 	// CfgGenesisChainID defines the chainID.
 	CfgGenesisChainID = "genesis.chainID"
+	// CfgGenesisChainConfigPath defines the path of the file with the chain configs keyed by chainID.
+	CfgGenesisChainConfigPath = "genesis.chainConfigPath"
 
 	// CfgConsensusMaxEpochLength defines the maxium length of an epoch.
 	CfgConsensusMaxEpochLength = "consensus.maxEpochLength"
@@ -39,10 +41,16 @@ const (
 	CfgStorageStatePruningRetainedBlocks = "storage.statePruningRetainedBlocks"
 	// CfgStorageStatePruningSkipCheckpoints indicates if the checkpoint state trie should be retained
 	CfgStorageStatePruningSkipCheckpoints = "storage.statePruningSkipCheckpoints"
+	// CfgStorageBackend indicates the database backend, e.g. leveldb, badger, mongodb, mgodb or aerospike
+	CfgStorageBackend = "storage.backend"
 	// CfgStorageLevelDBCacheSize indicates Level DB cache size
 	CfgStorageLevelDBCacheSize = "storage.levelDBCacheSize"
 	// CfgStorageLevelDBHandles indicates Level DB handle count
 	CfgStorageLevelDBHandles = "storage.levelDBHandles"
+	// CfgStorageIndexAccountTxs indicates whether the finalized transactions are indexed by the addresses involved
+	CfgStorageIndexAccountTxs = "storage.indexAccountTxs"
+	// CfgStorageArchiveMode indicates whether the node retains the full state history and all the optional indexes
+	CfgStorageArchiveMode = "storage.archiveMode"
 
 	// CfgSyncMessageQueueSize defines the capacity of Sync Manager message queue.
 	CfgSyncMessageQueueSize = "sync.messageQueueSize"
@@ -51,6 +59,25 @@ const (
 	// CfgSyncDownloadByHeader indicates whether should download blocks using header.
 	CfgSyncDownloadByHeader = "sync.downloadByHeader"
 
+	// CfgMempoolMaxNumTxs defines the maximum number of transactions in the mempool.
+	CfgMempoolMaxNumTxs = "mempool.maxNumTxs"
+	// CfgMempoolMaxTotalBytes defines the maximum total size in bytes of the transactions in the mempool.
+	CfgMempoolMaxTotalBytes = "mempool.maxTotalBytes"
+	// CfgMempoolMaxNumTxsPerAccount defines the maximum number of pending transactions of an account.
+	CfgMempoolMaxNumTxsPerAccount = "mempool.maxNumTxsPerAccount"
+	// CfgMempoolTxGroupLifetimeSecs defines how long the transactions of an account are kept in the
+	// mempool after the account sent its last transaction, when the mempool is full. 0 disables the eviction.
+	CfgMempoolTxGroupLifetimeSecs = "mempool.txGroupLifetimeSecs"
+	// CfgMempoolReplacementPriceBump defines the minimum gas price increase in percent for a transaction
+	// to replace the pending transaction with the same sequence.
+	CfgMempoolReplacementPriceBump = "mempool.replacementPriceBump"
+	// CfgMempoolJournalEnabled indicates whether the mempool persists the pending transactions in the database,
+	// so that they are reinserted after the node restarts.
+	CfgMempoolJournalEnabled = "mempool.journalEnabled"
+	// CfgMempoolTxStatusRetentionSecs defines how long the status of a transaction remains queryable after
+	// the transaction leaves the mempool.
+	CfgMempoolTxStatusRetentionSecs = "mempool.txStatusRetentionSecs"
+
 	// CfgP2POpt sets which P2P network to use: p2p, libp2p, or both.
 	CfgP2POpt = "p2p.opt"
 	// CfgP2PReuseStream sets whether to reuse libp2p stream
@@ -107,6 +134,35 @@ const (
 	CfgRPCMaxConnections = "rpc.maxConnections"
 	// CfgRPCTimeoutSecs set a timeout for RPC.
 	CfgRPCTimeoutSecs = "rpc.timeoutSecs"
+	// CfgRPCMaxSubscriptionsPerConnection limits the number of subscriptions a websocket connection can create.
+	CfgRPCMaxSubscriptionsPerConnection = "rpc.maxSubscriptionsPerConnection"
+	// CfgRPCSubscriptionQueueSize sets the number of pending notifications per websocket connection
+	// before the connection is dropped as a slow consumer.
+	CfgRPCSubscriptionQueueSize = "rpc.subscriptionQueueSize"
+
+	// CfgThriftEnabled sets whether to run the Thrift service inside the node.
+	CfgThriftEnabled = "thrift.enabled"
+	// CfgThriftAddress sets the binding address of Thrift service.
+	CfgThriftAddress = "thrift.address"
+	// CfgThriftPort sets the port of Thrift service.
+	CfgThriftPort = "thrift.port"
+	// CfgThriftProtocol sets the Thrift protocol, either "binary" or "compact".
+	CfgThriftProtocol = "thrift.protocol"
+	// CfgThriftServerType sets the Thrift server type. A "simple" server serves one connection
+	// at a time, while a "threaded" server serves each connection in its own goroutine.
+	CfgThriftServerType = "thrift.serverType"
+	// CfgThriftTimeoutSecs sets the read timeout of idle Thrift connections.
+	CfgThriftTimeoutSecs = "thrift.timeoutSecs"
+	// CfgThriftSigner sets how the Thrift service signs transactions. With "keystore" the transactions
+	// are signed with the unlocked keys of the keystore, with "none" only pre-signed transactions are accepted.
+	// Since the key management calls are not authenticated, "keystore" requires a loopback CfgThriftAddress.
+	CfgThriftSigner = "thrift.signer"
+	// CfgThriftKeystorePath sets the folder of the encrypted keys used by the "keystore" signer.
+	CfgThriftKeystorePath = "thrift.keystorePath"
+	// CfgThriftUnlockAddresses lists the keystore addresses to unlock when the Thrift service starts.
+	CfgThriftUnlockAddresses = "thrift.unlockAddresses"
+	// CfgThriftKeystorePassword sets the password of the keys listed in CfgThriftUnlockAddresses.
+	CfgThriftKeystorePassword = "thrift.keystorePassword"
 
 	// CfgLogLevels sets the log level.
 	CfgLogLevels = "log.levels"
@@ -120,6 +176,13 @@ const (
 	// Graphite Server to collet metrics
 	CfgMetricsServer = "metrics.server"
 
+	// CfgPrometheusEnabled sets whether to collect metrics and serve them in Prometheus format.
+	CfgPrometheusEnabled = "prometheus.enabled"
+	// CfgPrometheusAddress sets the binding address of the Prometheus metrics endpoint.
+	CfgPrometheusAddress = "prometheus.address"
+	// CfgPrometheusPort sets the port of the Prometheus metrics endpoint.
+	CfgPrometheusPort = "prometheus.port"
+
 	// CfgProfEnabled to enable profiling
 	CfgProfEnabled = "prof.enabled"
 
@@ -155,8 +218,19 @@ func init() {
 	viper.SetDefault(CfgStorageStatePruningInterval, 16)
 	viper.SetDefault(CfgStorageStatePruningRetainedBlocks, 2048)
 	viper.SetDefault(CfgStorageStatePruningSkipCheckpoints, true)
+	viper.SetDefault(CfgStorageBackend, "leveldb")
 	viper.SetDefault(CfgStorageLevelDBCacheSize, 256)
 	viper.SetDefault(CfgStorageLevelDBHandles, 16)
+	viper.SetDefault(CfgStorageIndexAccountTxs, false)
+	viper.SetDefault(CfgStorageArchiveMode, false)
+
+	viper.SetDefault(CfgMempoolMaxNumTxs, 50000)
+	viper.SetDefault(CfgMempoolMaxTotalBytes, 32*1024*1024)
+	viper.SetDefault(CfgMempoolMaxNumTxsPerAccount, 512)
+	viper.SetDefault(CfgMempoolTxGroupLifetimeSecs, 0)
+	viper.SetDefault(CfgMempoolReplacementPriceBump, 10)
+	viper.SetDefault(CfgMempoolJournalEnabled, false)
+	viper.SetDefault(CfgMempoolTxStatusRetentionSecs, 60)
 
 	viper.SetDefault(CfgRPCEnabled, false)
 	viper.SetDefault(CfgP2PMessageQueueSize, 512)
@@ -182,6 +256,19 @@ func init() {
 	viper.SetDefault(CfgRPCPort, "16888")
 	viper.SetDefault(CfgRPCMaxConnections, 200)
 	viper.SetDefault(CfgRPCTimeoutSecs, 60)
+	viper.SetDefault(CfgRPCMaxSubscriptionsPerConnection, 32)
+	viper.SetDefault(CfgRPCSubscriptionQueueSize, 256)
+
+	viper.SetDefault(CfgThriftEnabled, false)
+	viper.SetDefault(CfgThriftAddress, "127.0.0.1")
+	viper.SetDefault(CfgThriftPort, "18888")
+	viper.SetDefault(CfgThriftProtocol, "binary")
+	viper.SetDefault(CfgThriftServerType, "threaded")
+	viper.SetDefault(CfgThriftTimeoutSecs, 60)
+	viper.SetDefault(CfgThriftSigner, "none")
+	viper.SetDefault(CfgThriftKeystorePath, "")
+	viper.SetDefault(CfgThriftUnlockAddresses, []string{})
+	viper.SetDefault(CfgThriftKeystorePassword, "")
 
 	viper.SetDefault(CfgLogLevels, "*:debug")
 	viper.SetDefault(CfgLogPrintSelfID, false)
@@ -190,6 +277,10 @@ func init() {
 
 	viper.SetDefault(CfgMetricsServer, "guardian-metrics.thetatoken.org")
 
+	viper.SetDefault(CfgPrometheusEnabled, false)
+	viper.SetDefault(CfgPrometheusAddress, "0.0.0.0")
+	viper.SetDefault(CfgPrometheusPort, "16900")
+
 	viper.SetDefault(CfgProfEnabled, false)
 	viper.SetDefault(CfgForceGCEnabled, true)
 }
@@ -1,5 +1,8 @@
 package common
 
+// The fork heights below are the mainnet values. The ledger and consensus read the heights of the
+// running chain from core.ChainConfig, which defaults to these values.
+
 // HeightEnableValidatorReward specifies the minimal block height to enable the validtor TFUEL reward
 const HeightEnableValidatorReward uint64 = 4164982 // approximate time: 2pm January 14th, 2020 PST
 
@@ -15,6 +18,26 @@ const HeightEnableSmartContract uint64 = 8411427 // approximate time: 12pm Dec 1
 // HeightSampleStakingReward specifies the block heigth to enable sampling of staking reward
 const HeightSampleStakingReward uint64 = 9497418 // approximate time: 7pm Mar 10th, 2021 PST
 
+// HeightEnableEquivocationSlash specifies the minimal block height to enable slashing the validators which
+// double signed votes or block proposals. It is not scheduled yet.
+const HeightEnableEquivocationSlash uint64 = 1e15
+
+// HeightEnableGuardianUptime specifies the minimal block height to start recording the guardian participation
+// in the checkpoint votes. It is not scheduled yet.
+const HeightEnableGuardianUptime uint64 = 1e15
+
+// HeightEnableGuardianUptimePenalty specifies the minimal block height to withhold the staking reward of the
+// guardians with low uptime. It is not scheduled yet.
+const HeightEnableGuardianUptimePenalty uint64 = 1e15
+
+// HeightEnableValidatorCommission specifies the minimal block height to enable the validator commission on the
+// staking reward. It is not scheduled yet.
+const HeightEnableValidatorCommission uint64 = 1e15
+
+// HeightEnableStakeRedelegation specifies the minimal block height to enable the stake redelegation between
+// stake holders. It is not scheduled yet.
+const HeightEnableStakeRedelegation uint64 = 1e15
+
 // CheckpointInterval defines the interval between checkpoints.
 const CheckpointInterval = int64(100)
 
@@ -0,0 +1,110 @@
+package prometheus
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"theta/common/metrics"
+)
+
+var (
+	typeGaugeTpl           = "# TYPE %s gauge\n"
+	typeCounterTpl         = "# TYPE %s counter\n"
+	typeSummaryTpl         = "# TYPE %s summary\n"
+	keyValueTpl            = "%s %v\n\n"
+	keyQuantileTagValueTpl = "%s{quantile=\"%s\"} %v\n"
+)
+
+// quantiles are the percentiles reported for histograms and timers
+var quantiles = []float64{0.5, 0.75, 0.95, 0.99, 0.999, 0.9999}
+
+// collector renders metrics in the Prometheus text exposition format.
+type collector struct {
+	buff *bytes.Buffer
+}
+
+// newCollector creates a new Prometheus metric aggregator.
+func newCollector() *collector {
+	return &collector{
+		buff: &bytes.Buffer{},
+	}
+}
+
+func (c *collector) addCounter(name string, m metrics.Counter) {
+	c.writeGaugeCounter(name, m.Count())
+}
+
+func (c *collector) addGauge(name string, m metrics.Gauge) {
+	c.writeGaugeCounter(name, m.Value())
+}
+
+func (c *collector) addGaugeFloat64(name string, m metrics.GaugeFloat64) {
+	c.writeGaugeCounter(name, m.Value())
+}
+
+func (c *collector) addMeter(name string, m metrics.Meter) {
+	c.writeCounter(name, m.Count())
+}
+
+func (c *collector) addHistogram(name string, m metrics.Histogram) {
+	ps := m.Percentiles(quantiles)
+	c.writeSummary(name, m.Count(), m.Sum(), ps)
+}
+
+func (c *collector) addTimer(name string, m metrics.Timer) {
+	ps := m.Percentiles(quantiles)
+	c.writeSummary(name, m.Count(), m.Sum(), ps)
+}
+
+func (c *collector) addResettingTimer(name string, m metrics.ResettingTimer) {
+	values := m.Values()
+	if len(values) <= 0 {
+		return
+	}
+	var sum int64
+	for _, v := range values {
+		sum += v
+	}
+	ps := m.Percentiles(quantiles)
+	fps := make([]float64, len(ps))
+	for i, p := range ps {
+		fps[i] = float64(p)
+	}
+	c.writeSummary(name, int64(len(values)), sum, fps)
+}
+
+func (c *collector) writeGaugeCounter(name string, value interface{}) {
+	name = mutateKey(name)
+	c.buff.WriteString(fmt.Sprintf(typeGaugeTpl, name))
+	c.buff.WriteString(fmt.Sprintf(keyValueTpl, name, value))
+}
+
+func (c *collector) writeCounter(name string, value interface{}) {
+	name = mutateKey(name + "_total")
+	c.buff.WriteString(fmt.Sprintf(typeCounterTpl, name))
+	c.buff.WriteString(fmt.Sprintf(keyValueTpl, name, value))
+}
+
+func (c *collector) writeSummary(name string, count int64, sum int64, ps []float64) {
+	name = mutateKey(name)
+	c.buff.WriteString(fmt.Sprintf(typeSummaryTpl, name))
+	for i, q := range quantiles {
+		c.buff.WriteString(fmt.Sprintf(keyQuantileTagValueTpl, name, strconv.FormatFloat(q, 'f', -1, 64), ps[i]))
+	}
+	c.buff.WriteString(fmt.Sprintf("%s_sum %v\n", name, sum))
+	c.buff.WriteString(fmt.Sprintf(keyValueTpl, name+"_count", count))
+}
+
+// mutateKey converts a metric name of the registry, e.g. "mempool/size", to a valid
+// Prometheus metric name, e.g. "theta_mempool_size".
+func mutateKey(key string) string {
+	key = strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '_' {
+			return r
+		}
+		return '_'
+	}, key)
+	return namespace + "_" + key
+}
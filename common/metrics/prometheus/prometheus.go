@@ -0,0 +1,125 @@
+// Package prometheus exposes the metrics of a registry in the Prometheus text
+// exposition format.
+package prometheus
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"sort"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+	"theta/common/metrics"
+)
+
+var logger *log.Entry = log.WithFields(log.Fields{"prefix": "prometheus"})
+
+// namespace is prepended to the names of all the exported metrics
+const namespace = "theta"
+
+// Handler returns an HTTP handler which renders the metrics of the given registry
+// in the Prometheus text exposition format.
+func Handler(reg metrics.Registry) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		names := []string{}
+		reg.Each(func(name string, i interface{}) {
+			names = append(names, name)
+		})
+		sort.Strings(names)
+
+		c := newCollector()
+		for _, name := range names {
+			switch m := reg.Get(name).(type) {
+			case metrics.Counter:
+				c.addCounter(name, m.Snapshot())
+			case metrics.Gauge:
+				c.addGauge(name, m.Snapshot())
+			case metrics.GaugeFloat64:
+				c.addGaugeFloat64(name, m.Snapshot())
+			case metrics.Histogram:
+				c.addHistogram(name, m.Snapshot())
+			case metrics.Meter:
+				c.addMeter(name, m.Snapshot())
+			case metrics.Timer:
+				c.addTimer(name, m.Snapshot())
+			case metrics.ResettingTimer:
+				c.addResettingTimer(name, m.Snapshot())
+			}
+		}
+		w.Header().Add("Content-Type", "text/plain; version=0.0.4")
+		w.Header().Add("Content-Length", fmt.Sprint(c.buff.Len()))
+		w.Write(c.buff.Bytes())
+	})
+}
+
+// Server serves the metrics of a registry on the "/metrics" endpoint.
+type Server struct {
+	address string
+	server  *http.Server
+
+	// Life cycle
+	wg      *sync.WaitGroup
+	ctx     context.Context
+	cancel  context.CancelFunc
+	stopped bool
+}
+
+// NewServer creates a new instance of Server listening on the given address. The
+// metrics of the default registry are exported if reg is nil.
+func NewServer(address string, reg metrics.Registry) *Server {
+	if reg == nil {
+		reg = metrics.DefaultRegistry
+	}
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", Handler(reg))
+
+	return &Server{
+		address: address,
+		server:  &http.Server{Handler: mux},
+		wg:      &sync.WaitGroup{},
+	}
+}
+
+// Start creates the main goroutine.
+func (s *Server) Start(ctx context.Context) {
+	c, cancel := context.WithCancel(ctx)
+	s.ctx = c
+	s.cancel = cancel
+
+	s.wg.Add(1)
+	go s.mainLoop()
+}
+
+func (s *Server) mainLoop() {
+	defer s.wg.Done()
+
+	go s.serve()
+
+	<-s.ctx.Done()
+	s.stopped = true
+	s.server.Shutdown(context.Background())
+}
+
+func (s *Server) serve() {
+	l, err := net.Listen("tcp", s.address)
+	if err != nil {
+		logger.WithFields(log.Fields{"error": err}).Fatal("Failed to create listener")
+	} else {
+		logger.WithFields(log.Fields{"address": s.address}).Info("Prometheus metrics server started")
+	}
+	defer l.Close()
+
+	logger.Info(s.server.Serve(l))
+}
+
+// Stop notifies all goroutines to stop without blocking.
+func (s *Server) Stop() {
+	s.cancel()
+}
+
+// Wait blocks until all goroutines stop.
+func (s *Server) Wait() {
+	s.wg.Wait()
+}
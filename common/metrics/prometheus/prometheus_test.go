@@ -0,0 +1,57 @@
+package prometheus
+
+import (
+	"io/ioutil"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"theta/common/metrics"
+)
+
+func init() {
+	metrics.Enabled = true
+}
+
+func TestHandler(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	reg := metrics.NewRegistry()
+	metrics.NewRegisteredCounter("mempool/rejected/duplicate", reg).Inc(3)
+	metrics.NewRegisteredGauge("consensus/epoch", reg).Update(42)
+	metrics.NewRegisteredGaugeFloat64("p2p/peers/0xabc/rate", reg).Update(1.5)
+	metrics.NewRegisteredMeter("storage/leveldb/bytes", reg).Mark(7)
+	timer := metrics.NewRegisteredTimer("storage/leveldb/read", reg)
+	timer.Update(10 * time.Millisecond)
+	timer.Update(20 * time.Millisecond)
+
+	rec := httptest.NewRecorder()
+	Handler(reg).ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	body, err := ioutil.ReadAll(rec.Body)
+	require.Nil(err)
+	out := string(body)
+
+	assert.True(strings.HasPrefix(rec.Header().Get("Content-Type"), "text/plain"))
+	assert.Contains(out, "# TYPE theta_mempool_rejected_duplicate gauge\ntheta_mempool_rejected_duplicate 3\n")
+	assert.Contains(out, "theta_consensus_epoch 42\n")
+	assert.Contains(out, "theta_p2p_peers_0xabc_rate 1.5\n")
+	assert.Contains(out, "# TYPE theta_storage_leveldb_bytes_total counter\ntheta_storage_leveldb_bytes_total 7\n")
+	assert.Contains(out, "# TYPE theta_storage_leveldb_read summary\n")
+	assert.Contains(out, "theta_storage_leveldb_read{quantile=\"0.5\"} 1.5e+07\n")
+	assert.Contains(out, "theta_storage_leveldb_read_sum 30000000\n")
+	assert.Contains(out, "theta_storage_leveldb_read_count 2\n")
+
+	// The metrics are sorted by name.
+	assert.True(strings.Index(out, "theta_consensus_epoch") < strings.Index(out, "theta_mempool_rejected_duplicate"))
+}
+
+func TestMutateKey(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("theta_mempool_size", mutateKey("mempool/size"))
+	assert.Equal("theta_p2p_peers_16Uiu2_bytes_sent", mutateKey("p2p/peers/16Uiu2/bytes-sent"))
+}
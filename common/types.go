@@ -403,6 +403,9 @@ const (
 
 	// ChannelIDNATMapping indicates the channel for NAT Mapping messages between peers
 	ChannelIDNATMapping
+
+	// ChannelIDEvidence indicates the channel for the evidence of validator misbehaviors
+	ChannelIDEvidence
 )
 
 // P2POptEnum defines the p2p network
@@ -15,6 +15,7 @@ import (
 	"github.com/spf13/viper"
 	"theta/blockchain"
 	"theta/common"
+	"theta/common/metrics"
 	"theta/common/result"
 	"theta/common/util"
 	"theta/core"
@@ -35,12 +36,15 @@ type ConsensusEngine struct {
 	privateKey *crypto.PrivateKey
 
 	chain            *blockchain.Chain
+	chainConfig      *core.ChainConfig
 	dispatcher       *dispatcher.Dispatcher
 	validatorManager core.ValidatorManager
 	ledger           core.Ledger
 	guardian         *GuardianEngine
+	evidencePool     *EvidencePool
 
 	incoming        chan interface{}
+	validBlocks     chan *core.Block
 	finalizedBlocks chan *core.Block
 	hasSynced       bool
 
@@ -56,17 +60,25 @@ type ConsensusEngine struct {
 	guardianTimer *time.Ticker
 
 	state *State
+
+	epochGauge           metrics.Gauge // Gauge for the current epoch
+	finalizedHeightGauge metrics.Gauge // Gauge for the height of the last finalized block
+	proposalLatencyTimer metrics.Timer // Timer for measuring the time spent in creating proposals
+	voteLatencyTimer     metrics.Timer // Timer for measuring the time from receiving a block to voting
+	blockProcessTimer    metrics.Timer // Timer for measuring the time spent in processing blocks
 }
 
 // NewConsensusEngine creates a instance of ConsensusEngine.
 func NewConsensusEngine(privateKey *crypto.PrivateKey, db store.Store, chain *blockchain.Chain, dispatcher *dispatcher.Dispatcher, validatorManager core.ValidatorManager) *ConsensusEngine {
 	e := &ConsensusEngine{
-		chain:      chain,
-		dispatcher: dispatcher,
+		chain:       chain,
+		chainConfig: core.GetChainConfig(chain.ChainID),
+		dispatcher:  dispatcher,
 
 		privateKey: privateKey,
 
 		incoming:        make(chan interface{}, viper.GetInt(common.CfgConsensusMessageQueueSize)),
+		validBlocks:     make(chan *core.Block, viper.GetInt(common.CfgConsensusMessageQueueSize)),
 		finalizedBlocks: make(chan *core.Block, viper.GetInt(common.CfgConsensusMessageQueueSize)),
 
 		wg: &sync.WaitGroup{},
@@ -75,6 +87,12 @@ func NewConsensusEngine(privateKey *crypto.PrivateKey, db store.Store, chain *bl
 		state: NewState(db, chain),
 
 		validatorManager: validatorManager,
+
+		epochGauge:           metrics.GetOrRegisterGauge("consensus/epoch", nil),
+		finalizedHeightGauge: metrics.GetOrRegisterGauge("consensus/height/finalized", nil),
+		proposalLatencyTimer: metrics.GetOrRegisterTimer("consensus/proposal/latency", nil),
+		voteLatencyTimer:     metrics.GetOrRegisterTimer("consensus/vote/latency", nil),
+		blockProcessTimer:    metrics.GetOrRegisterTimer("consensus/block/process", nil),
 	}
 
 	logger = util.GetLoggerForModule("consensus")
@@ -85,6 +103,7 @@ func NewConsensusEngine(privateKey *crypto.PrivateKey, db store.Store, chain *bl
 		e.logger.Panic(err)
 	}
 	e.guardian = NewGuardianEngine(e, blsKey)
+	e.evidencePool = NewEvidencePool(chain, e.chainConfig)
 
 	e.logger.WithFields(log.Fields{"state": e.state}).Info("Starting state")
 
@@ -115,6 +134,11 @@ func (e *ConsensusEngine) Chain() *blockchain.Chain {
 	return e.chain
 }
 
+// ChainConfig returns the config of the chain.
+func (e *ConsensusEngine) ChainConfig() *core.ChainConfig {
+	return e.chainConfig
+}
+
 // GetEpoch returns the current epoch
 func (e *ConsensusEngine) GetEpoch() uint64 {
 	return e.state.GetEpoch()
@@ -155,8 +179,8 @@ func (e *ConsensusEngine) Start(ctx context.Context) {
 
 func (e *ConsensusEngine) autoRewind(lastCC *core.ExtendedBlock) *core.ExtendedBlock {
 	// check hardcoded block hashes to determine if need to auto rewind
-	heights := make([]uint64, 0, len(core.HardcodeBlockHashes))
-	for k := range core.HardcodeBlockHashes {
+	heights := make([]uint64, 0, len(e.chainConfig.HardcodeBlockHashes))
+	for k := range e.chainConfig.HardcodeBlockHashes {
 		heights = append(heights, k)
 	}
 	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
@@ -190,7 +214,7 @@ func (e *ConsensusEngine) autoRewind(lastCC *core.ExtendedBlock) *core.ExtendedB
 				}).Fatal("Can't find finalized block at height")
 			}
 
-			if finalizedBlock.Hash().Hex() == core.HardcodeBlockHashes[heights[idx]] {
+			if finalizedBlock.Hash().Hex() == e.chainConfig.HardcodeBlockHashes[heights[idx]] {
 				break
 			}
 
@@ -294,6 +318,8 @@ func (e *ConsensusEngine) enterEpoch() {
 		e.proposalTimer.Stop()
 	}
 	e.proposalTimer = time.NewTimer(time.Duration(viper.GetInt(common.CfgConsensusMinProposalWait)) * time.Second)
+
+	e.epochGauge.Update(int64(e.GetEpoch()))
 }
 
 // GetChannelIDs implements the p2p.MessageHandler interface.
@@ -325,6 +351,9 @@ func (e *ConsensusEngine) processMessage(msg interface{}) (endEpoch bool) {
 	case *core.AggregatedVotes:
 		e.logger.WithFields(log.Fields{"guardian vote": m}).Debug("Received guardian vote")
 		e.handleGuardianVote(m)
+	case *core.DoubleSignEvidence:
+		e.logger.WithFields(log.Fields{"evidence": m}).Debug("Received double sign evidence")
+		e.handleEvidence(m)
 	default:
 		// Should not happen.
 		log.Errorf("Unknown message type: %v", m)
@@ -476,7 +505,7 @@ func (e *ConsensusEngine) validateBlock(block *core.Block, parent *core.Extended
 
 	// Validate Guardian Votes.
 	// We allow checkpoint blocs to have nil guardian votes.
-	if block.GuardianVotes != nil && block.Height >= common.HeightEnableTheta2 && common.IsCheckPointHeight(block.Height) {
+	if block.GuardianVotes != nil && block.Height >= e.chainConfig.HeightEnableTheta2 && common.IsCheckPointHeight(block.Height) {
 		// Voted block must exist.
 		lastCheckpoint, err := e.chain.FindBlock(block.GuardianVotes.Block)
 		if err != nil {
@@ -553,7 +582,11 @@ func (e *ConsensusEngine) handleBlock(block *core.Block) {
 		}).Fatal("Failed to find block")
 	}
 
-	if hex, ok := core.HardcodeBlockHashes[eb.Height]; ok {
+	if evidence := e.evidencePool.AddProposal(block.BlockHeader); evidence != nil {
+		e.broadcastEvidence(evidence)
+	}
+
+	if hex, ok := e.chainConfig.HardcodeBlockHashes[eb.Height]; ok {
 		e.handleHardcodeBlock(common.HexToHash(hex))
 	} else {
 		e.handleNormalBlock(eb)
@@ -691,11 +724,18 @@ func (e *ConsensusEngine) handleNormalBlock(eb *core.ExtendedBlock) {
 
 	e.chain.MarkBlockValid(block.Hash())
 
+	select {
+	case e.validBlocks <- block:
+	default:
+		e.logger.Debugf("Failed to notify valid block, height=%v", block.Height)
+	}
+
 	// Skip voting for block older than current best known epoch.
 	// Allow block with one epoch behind since votes are processed first and might advance epoch
 	// before block is processed.
 	if localEpoch := e.GetEpoch(); block.Epoch == localEpoch-1 || block.Epoch == localEpoch {
 		e.vote()
+		e.voteLatencyTimer.UpdateSince(start)
 	} else {
 		e.logger.WithFields(log.Fields{
 			"block.Epoch": block.Epoch,
@@ -707,6 +747,7 @@ func (e *ConsensusEngine) handleNormalBlock(eb *core.ExtendedBlock) {
 	// Check and process CC.
 	e.checkCC(block.Hash())
 
+	e.blockProcessTimer.UpdateSince(start)
 	e.logger.WithFields(log.Fields{
 		"block.Epoch":       block.Epoch,
 		"block.Hash":        block.Hash().Hex(),
@@ -818,6 +859,11 @@ func (e *ConsensusEngine) handleVote(vote core.Vote) (endEpoch bool) {
 		return
 	}
 
+	// Check for conflicting votes.
+	if evidence := e.evidencePool.AddVote(vote); evidence != nil {
+		e.broadcastEvidence(evidence)
+	}
+
 	// Save vote.
 	err := e.state.AddVote(&vote)
 	if err != nil {
@@ -969,11 +1015,41 @@ func (e *ConsensusEngine) broadcastGuardianVote(vote *core.AggregatedVotes) {
 	e.dispatcher.SendData([]string{}, voteMsg)
 }
 
+func (e *ConsensusEngine) handleEvidence(evidence *core.DoubleSignEvidence) {
+	if e.evidencePool.AddEvidence(evidence) {
+		e.broadcastEvidence(evidence)
+	}
+}
+
+func (e *ConsensusEngine) broadcastEvidence(evidence *core.DoubleSignEvidence) {
+	payload, err := rlp.EncodeToBytes(evidence)
+	if err != nil {
+		e.logger.WithFields(log.Fields{"evidence": evidence}).Error("Failed to encode evidence")
+		return
+	}
+	evidenceMsg := dispatcher.DataResponse{
+		ChannelID: common.ChannelIDEvidence,
+		Payload:   payload,
+	}
+	e.dispatcher.SendData([]string{}, evidenceMsg)
+}
+
+// GetPendingEvidence returns the double sign evidence to be included in the proposed blocks.
+func (e *ConsensusEngine) GetPendingEvidence() []*core.DoubleSignEvidence {
+	return e.evidencePool.GetPendingEvidence()
+}
+
 // GetSummary returns a summary of consensus state.
 func (e *ConsensusEngine) GetSummary() *StateStub {
 	return e.state.GetSummary()
 }
 
+// ValidBlocks returns a channel that will be published with blocks that have been validated
+// and applied to the ledger, before they are finalized.
+func (e *ConsensusEngine) ValidBlocks() chan *core.Block {
+	return e.validBlocks
+}
+
 // FinalizedBlocks returns a channel that will be published with finalized blocks by the engine.
 func (e *ConsensusEngine) FinalizedBlocks() chan *core.Block {
 	return e.finalizedBlocks
@@ -1020,8 +1096,11 @@ func (e *ConsensusEngine) finalizeBlock(block *core.ExtendedBlock) error {
 
 	e.logger.WithFields(log.Fields{"block.Hash": block.Hash().Hex(), "block.Height": block.Height}).Info("Finalizing block")
 
+	lastFinalizedHeight := e.state.GetLastFinalizedBlock().Height
 	e.state.SetLastFinalizedBlock(block)
 	e.ledger.FinalizeState(block.Height, block.StateHash)
+	e.finalizedHeightGauge.Update(int64(block.Height))
+	e.evidencePool.Prune(block.Height)
 
 	e.checkSyncStatus()
 
@@ -1034,6 +1113,16 @@ func (e *ConsensusEngine) finalizeBlock(block *core.ExtendedBlock) error {
 	// duplicate TX in fork.
 	e.chain.AddTxsToIndex(block, true)
 
+	// Persist the log blooms for log queries, and index the account txs. The ancestors finalized
+	// indirectly along with the block are included, so that no finalized block is skipped.
+	indexAccountTxs := viper.GetBool(common.CfgStorageIndexAccountTxs)
+	for _, finalizedBlock := range e.chain.FindFinalizedBlocksAbove(block, lastFinalizedHeight) {
+		e.chain.AddBlockBloom(finalizedBlock)
+		if indexAccountTxs {
+			e.chain.AddAccountTxsToIndex(finalizedBlock)
+		}
+	}
+
 	// Guardians to vote for checkpoint blocks.
 	if common.IsCheckPointHeight(block.Height) {
 		e.guardian.StartNewBlock(block.Hash())
@@ -1111,7 +1200,7 @@ func (e *ConsensusEngine) createProposal() (core.Proposal, error) {
 	block.HCC.Votes = e.chain.FindVotesByHash(block.HCC.BlockHash).UniqueVoter().FilterByValidators(hccValidators)
 
 	// Add guardian votes.
-	if block.Height >= common.HeightEnableTheta2 && common.IsCheckPointHeight(block.Height) {
+	if block.Height >= e.chainConfig.HeightEnableTheta2 && common.IsCheckPointHeight(block.Height) {
 		block.GuardianVotes = e.guardian.GetBestVote()
 	}
 
@@ -1165,7 +1254,9 @@ func (e *ConsensusEngine) propose() {
 		proposal = lastProposal
 		e.logger.WithFields(log.Fields{"proposal": proposal}).Info("Repeating proposal")
 	} else {
+		start := time.Now()
 		proposal, err = e.createProposal()
+		e.proposalLatencyTimer.UpdateSince(start)
 		if err != nil {
 			e.logger.WithFields(log.Fields{"error": err}).Error("Failed to create proposal")
 			return
@@ -0,0 +1,202 @@
+package consensus
+
+import (
+	"sort"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+	"theta/blockchain"
+	"theta/common"
+	"theta/common/util"
+	"theta/core"
+)
+
+const (
+	// Number of blocks below the last finalized block for which the signed votes and proposals are
+	// kept for double sign detection.
+	evidenceRecordRetention uint64 = 1000
+
+	// Maximum number of pending evidence kept in the pool.
+	maxNumPendingEvidence = 100
+)
+
+type signerHeight struct {
+	signer common.Address
+	height uint64
+}
+
+type signerHeightEpoch struct {
+	signer common.Address
+	height uint64
+	epoch  uint64
+}
+
+type signedVote struct {
+	vote   core.Vote
+	header *core.BlockHeader
+}
+
+// EvidencePool detects validators that signed conflicting votes or block proposals, and keeps
+// the evidence until it is too old to be used for slashing.
+type EvidencePool struct {
+	logger *log.Entry
+
+	chain       *blockchain.Chain
+	chainConfig *core.ChainConfig
+
+	mu        *sync.Mutex
+	votes     map[signerHeight]signedVote              // First vote seen from a voter at a height
+	proposals map[signerHeightEpoch]*core.BlockHeader  // First proposal seen from a proposer at a height in an epoch
+	pending   map[common.Hash]*core.DoubleSignEvidence // Evidence hash to evidence
+
+	finalizedHeight uint64
+}
+
+// NewEvidencePool creates a new instance of EvidencePool.
+func NewEvidencePool(chain *blockchain.Chain, chainConfig *core.ChainConfig) *EvidencePool {
+	return &EvidencePool{
+		logger:      util.GetLoggerForModule("evidence"),
+		chain:       chain,
+		chainConfig: chainConfig,
+
+		mu:        &sync.Mutex{},
+		votes:     make(map[signerHeight]signedVote),
+		proposals: make(map[signerHeightEpoch]*core.BlockHeader),
+		pending:   make(map[common.Hash]*core.DoubleSignEvidence),
+	}
+}
+
+// AddVote records a validated vote. It returns the new evidence if the voter has voted for a
+// different block at the same height, otherwise nil. Votes for blocks not in the local chain yet
+// are skipped, since their heights can not be verified.
+func (p *EvidencePool) AddVote(vote core.Vote) *core.DoubleSignEvidence {
+	block, err := p.chain.FindBlock(vote.Block)
+	if err != nil {
+		return nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key := signerHeight{signer: vote.ID, height: block.Height}
+	existing, ok := p.votes[key]
+	if !ok {
+		p.votes[key] = signedVote{vote: vote, header: block.BlockHeader}
+		return nil
+	}
+	if existing.vote.Block == vote.Block {
+		return nil
+	}
+
+	evidence := core.NewConflictingVotesEvidence(existing.vote, existing.header, vote, block.BlockHeader)
+	if !p.addEvidenceUnsafe(evidence) {
+		return nil
+	}
+	return evidence
+}
+
+// AddProposal records a block header. It returns the new evidence if the proposer has proposed a
+// different block at the same height in the same epoch, otherwise nil.
+func (p *EvidencePool) AddProposal(header *core.BlockHeader) *core.DoubleSignEvidence {
+	if header == nil {
+		return nil
+	}
+	if res := header.Validate(p.chain.ChainID); res.IsError() {
+		return nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	key := signerHeightEpoch{signer: header.Proposer, height: header.Height, epoch: header.Epoch}
+	existing, ok := p.proposals[key]
+	if !ok {
+		p.proposals[key] = header
+		return nil
+	}
+	if existing.Hash() == header.Hash() {
+		return nil
+	}
+
+	evidence := core.NewConflictingProposalsEvidence(existing, header)
+	if !p.addEvidenceUnsafe(evidence) {
+		return nil
+	}
+	return evidence
+}
+
+// AddEvidence validates and adds evidence received from peers. It returns true if the evidence is
+// valid and new.
+func (p *EvidencePool) AddEvidence(evidence *core.DoubleSignEvidence) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.addEvidenceUnsafe(evidence)
+}
+
+func (p *EvidencePool) addEvidenceUnsafe(evidence *core.DoubleSignEvidence) bool {
+	hash := evidence.Hash()
+	if _, ok := p.pending[hash]; ok {
+		return false
+	}
+	if len(p.pending) >= maxNumPendingEvidence {
+		p.logger.WithFields(log.Fields{"evidence": evidence}).Warn("Evidence pool is full, dropping evidence")
+		return false
+	}
+	if res := evidence.Validate(p.chain.ChainID); res.IsError() {
+		p.logger.WithFields(log.Fields{
+			"evidence": evidence,
+			"error":    res.Message,
+		}).Warn("Ignoring invalid evidence")
+		return false
+	}
+	if evidence.Height()+p.chainConfig.MaxEvidenceAge() <= p.finalizedHeight {
+		return false
+	}
+
+	p.pending[hash] = evidence
+	p.logger.WithFields(log.Fields{"evidence": evidence}).Warn("Double sign detected")
+	return true
+}
+
+// GetPendingEvidence returns the pending evidence sorted by height.
+func (p *EvidencePool) GetPendingEvidence() []*core.DoubleSignEvidence {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	ret := make([]*core.DoubleSignEvidence, 0, len(p.pending))
+	for _, evidence := range p.pending {
+		ret = append(ret, evidence)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		if ret[i].Height() != ret[j].Height() {
+			return ret[i].Height() < ret[j].Height()
+		}
+		return ret[i].Hash().Hex() < ret[j].Hash().Hex()
+	})
+	return ret
+}
+
+// Prune removes the records too old for double sign detection, and the evidence too old
+// for slashing.
+func (p *EvidencePool) Prune(finalizedHeight uint64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.finalizedHeight = finalizedHeight
+	for key := range p.votes {
+		if key.height+evidenceRecordRetention < finalizedHeight {
+			delete(p.votes, key)
+		}
+	}
+	for key := range p.proposals {
+		if key.height+evidenceRecordRetention < finalizedHeight {
+			delete(p.proposals, key)
+		}
+	}
+	for hash, evidence := range p.pending {
+		if evidence.Height()+p.chainConfig.MaxEvidenceAge() <= finalizedHeight {
+			delete(p.pending, hash)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+package consensus
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"theta/blockchain"
+	"theta/common"
+	"theta/core"
+	"theta/crypto"
+)
+
+func createEvidenceTestPool(maxEvidenceAge uint64) *EvidencePool {
+	chain := blockchain.CreateTestChain()
+	chainConfig := core.MainnetChainConfig()
+	chainConfig.ChainID = chain.ChainID
+	chainConfig.ReturnLockingPeriod = maxEvidenceAge
+	return NewEvidencePool(chain, chainConfig)
+}
+
+func createEvidenceTestBlock(signer *crypto.PrivateKey, height uint64, epoch uint64, stateHash string) *core.Block {
+	block := core.NewBlock()
+	block.ChainID = "testchain"
+	block.Height = height
+	block.Epoch = epoch
+	block.Parent = common.HexToHash("0x1")
+	block.HCC.BlockHash = common.HexToHash("0x1")
+	block.StateHash = common.HexToHash(stateHash)
+	block.Proposer = signer.PublicKey().Address()
+	block.Timestamp = big.NewInt(1)
+	block.Signature, _ = signer.Sign(block.SignBytes())
+	block.UpdateHash()
+	return block
+}
+
+func createEvidenceTestVote(signer *crypto.PrivateKey, block *core.Block) core.Vote {
+	vote := core.Vote{
+		Block:  block.Hash(),
+		Height: block.Height,
+		Epoch:  block.Epoch,
+		ID:     signer.PublicKey().Address(),
+	}
+	vote.Sign(signer)
+	return vote
+}
+
+func TestEvidencePoolConflictingVotes(t *testing.T) {
+	require := require.New(t)
+
+	pool := createEvidenceTestPool(1000)
+	proposer, _, _ := crypto.GenerateKeyPair()
+	voter, _, _ := crypto.GenerateKeyPair()
+
+	blockA := createEvidenceTestBlock(proposer, 10, 5, "0xa")
+	blockB := createEvidenceTestBlock(proposer, 10, 6, "0xb")
+	blockC := createEvidenceTestBlock(proposer, 11, 7, "0xc")
+	blockD := createEvidenceTestBlock(proposer, 10, 8, "0xd")
+	for _, block := range []*core.Block{blockA, blockB, blockC} {
+		_, err := pool.chain.AddBlock(block)
+		require.Nil(err)
+	}
+
+	// Votes for blocks not in the chain are skipped
+	require.Nil(pool.AddVote(createEvidenceTestVote(voter, blockD)))
+	require.Equal(0, len(pool.votes))
+
+	voteA := createEvidenceTestVote(voter, blockA)
+	require.Nil(pool.AddVote(voteA))
+	require.Nil(pool.AddVote(voteA))
+
+	// A vote at a different height is not conflicting
+	require.Nil(pool.AddVote(createEvidenceTestVote(voter, blockC)))
+
+	evidence := pool.AddVote(createEvidenceTestVote(voter, blockB))
+	require.NotNil(evidence)
+	require.Equal(core.EvidenceConflictingVotes, evidence.Type)
+	require.Equal(voter.PublicKey().Address(), evidence.Offender)
+	require.Equal(uint64(10), evidence.Height())
+	require.True(evidence.Validate("testchain").IsOK())
+
+	// The same double sign is only reported once
+	require.Nil(pool.AddVote(createEvidenceTestVote(voter, blockB)))
+	require.Equal(1, len(pool.GetPendingEvidence()))
+}
+
+func TestEvidencePoolConflictingProposals(t *testing.T) {
+	require := require.New(t)
+
+	pool := createEvidenceTestPool(1000)
+	proposer, _, _ := crypto.GenerateKeyPair()
+
+	headerA := createEvidenceTestBlock(proposer, 10, 5, "0xa").BlockHeader
+	headerB := createEvidenceTestBlock(proposer, 10, 5, "0xb").BlockHeader
+	headerC := createEvidenceTestBlock(proposer, 10, 6, "0xc").BlockHeader
+
+	require.Nil(pool.AddProposal(nil))
+	require.Nil(pool.AddProposal(headerA))
+	require.Nil(pool.AddProposal(headerA))
+
+	// A proposal in a different epoch is not conflicting
+	require.Nil(pool.AddProposal(headerC))
+
+	// Unsigned proposals are ignored
+	unsigned := createEvidenceTestBlock(proposer, 10, 5, "0xe").BlockHeader
+	unsigned.Signature = nil
+	unsigned.UpdateHash()
+	require.Nil(pool.AddProposal(unsigned))
+
+	evidence := pool.AddProposal(headerB)
+	require.NotNil(evidence)
+	require.Equal(core.EvidenceConflictingProposals, evidence.Type)
+	require.Equal(proposer.PublicKey().Address(), evidence.Offender)
+	require.Nil(pool.AddProposal(headerB))
+	require.Equal(1, len(pool.GetPendingEvidence()))
+}
+
+func TestEvidencePoolAddEvidence(t *testing.T) {
+	require := require.New(t)
+
+	pool := createEvidenceTestPool(100)
+	proposer, _, _ := crypto.GenerateKeyPair()
+
+	createEvidence := func(height uint64) *core.DoubleSignEvidence {
+		headerA := createEvidenceTestBlock(proposer, height, 1, "0xa").BlockHeader
+		headerB := createEvidenceTestBlock(proposer, height, 1, "0xb").BlockHeader
+		return core.NewConflictingProposalsEvidence(headerA, headerB)
+	}
+
+	// Invalid evidence is rejected
+	invalid := createEvidence(200)
+	invalid.Offender = common.HexToAddress("0x1")
+	require.False(pool.AddEvidence(invalid))
+
+	// Evidence too old for slashing is rejected
+	pool.Prune(300)
+	require.False(pool.AddEvidence(createEvidence(200)))
+	require.True(pool.AddEvidence(createEvidence(201)))
+	require.False(pool.AddEvidence(createEvidence(201)))
+
+	// The pending evidence is capped, and sorted by height
+	for height := uint64(400); len(pool.pending) < maxNumPendingEvidence; height-- {
+		require.True(pool.AddEvidence(createEvidence(height)))
+	}
+	require.False(pool.AddEvidence(createEvidence(500)))
+
+	pending := pool.GetPendingEvidence()
+	require.Equal(maxNumPendingEvidence, len(pending))
+	for i := 1; i < len(pending); i++ {
+		require.True(pending[i-1].Height() <= pending[i].Height())
+	}
+}
+
+func TestEvidencePoolPrune(t *testing.T) {
+	require := require.New(t)
+
+	pool := createEvidenceTestPool(100)
+	proposer, _, _ := crypto.GenerateKeyPair()
+	voter, _, _ := crypto.GenerateKeyPair()
+
+	blockA := createEvidenceTestBlock(proposer, 10, 1, "0xa")
+	blockB := createEvidenceTestBlock(proposer, 10, 1, "0xb")
+	blockC := createEvidenceTestBlock(proposer, 1050, 2, "0xc")
+	for _, block := range []*core.Block{blockA, blockB, blockC} {
+		_, err := pool.chain.AddBlock(block)
+		require.Nil(err)
+	}
+
+	require.Nil(pool.AddVote(createEvidenceTestVote(voter, blockA)))
+	require.Nil(pool.AddVote(createEvidenceTestVote(voter, blockC)))
+	require.Nil(pool.AddProposal(blockA.BlockHeader))
+	require.NotNil(pool.AddProposal(blockB.BlockHeader))
+
+	// The evidence expires before the records
+	pool.Prune(109)
+	require.Equal(1, len(pool.pending))
+	pool.Prune(110)
+	require.Equal(0, len(pool.pending))
+	require.Equal(2, len(pool.votes))
+	require.Equal(1, len(pool.proposals))
+
+	// The records below the retention window are removed
+	pool.Prune(10 + evidenceRecordRetention + 1)
+	require.Equal(1, len(pool.votes))
+	require.Equal(0, len(pool.proposals))
+
+	// A conflicting vote for a pruned record is no longer detected
+	require.Nil(pool.AddVote(createEvidenceTestVote(voter, blockB)))
+}
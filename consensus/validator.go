@@ -9,8 +9,6 @@ import (
 	"theta/core"
 )
 
-const MaxValidatorCount int = 31
-
 //
 // -------------------------------- FixedValidatorManager ----------------------------------
 //
@@ -139,8 +137,7 @@ func (m *RotatingValidatorManager) GetNextValidatorSet(blockHash common.Hash) *c
 // -------------------------------- Utilities ----------------------------------
 //
 
-func SelectTopStakeHoldersAsValidators(vcp *core.ValidatorCandidatePool) *core.ValidatorSet {
-	maxNumValidators := MaxValidatorCount
+func SelectTopStakeHoldersAsValidators(vcp *core.ValidatorCandidatePool, maxNumValidators int) *core.ValidatorSet {
 	topStakeHolders := vcp.GetTopStakeHolders(maxNumValidators)
 
 	valSet := core.NewValidatorSet()
@@ -166,7 +163,7 @@ func selectTopStakeHoldersAsValidatorsForBlock(consensus core.ConsensusEngine, b
 		log.Panic("Failed to retrieve the validator candidate pool")
 	}
 
-	return SelectTopStakeHoldersAsValidators(vcp)
+	return SelectTopStakeHoldersAsValidators(vcp, consensus.ChainConfig().MaxValidatorCount)
 }
 
 // Generate a random uint64 in [0, max)
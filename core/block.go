@@ -145,7 +145,7 @@ func (h *BlockHeader) EncodeRLP(w io.Writer) error {
 	if h == nil {
 		return rlp.Encode(w, &BlockHeader{})
 	}
-	if h.Height < common.HeightEnableTheta2 {
+	if h.Height < GetChainConfig(h.ChainID).HeightEnableTheta2 {
 		return rlp.Encode(w, []interface{}{
 			h.ChainID,
 			h.Epoch,
@@ -251,7 +251,7 @@ func (h *BlockHeader) DecodeRLP(stream *rlp.Stream) error {
 	}
 
 	// Theta2.0 fork
-	if h.Height >= common.HeightEnableTheta2 {
+	if h.Height >= GetChainConfig(h.ChainID).HeightEnableTheta2 {
 		raw, err := stream.Raw()
 		if err != nil {
 			return err
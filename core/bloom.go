@@ -63,6 +63,14 @@ func (b *Bloom) Add(d *big.Int) {
 	b.SetBytes(bin.Bytes())
 }
 
+// AddBytes adds d to the filter. Unlike Add, the leading zero bytes of d are kept,
+// so that it can be used with BloomLookup for fixed size values like addresses and hashes.
+func (b *Bloom) AddBytes(d []byte) {
+	bin := new(big.Int).SetBytes(b[:])
+	bin.Or(bin, bloom9(d))
+	b.SetBytes(bin.Bytes())
+}
+
 // Big converts b to a big integer.
 func (b Bloom) Big() *big.Int {
 	return new(big.Int).SetBytes(b[:])
@@ -0,0 +1,210 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"sync"
+
+	"theta/common"
+)
+
+const (
+	// MaxValidatorCount is the maximal number of validators selected from the validator candidate pool on the mainnet
+	MaxValidatorCount int = 31
+
+	// MinimumGasPrice is the minimum gas price for a smart contract transaction on the mainnet
+	MinimumGasPrice uint64 = 1e8
+
+	// MaximumTxGasLimit is the maximum gas limit for a smart contract transaction on the mainnet
+	MaximumTxGasLimit uint64 = 10e6
+
+	// MinimumTransactionFeeTFuelWei specifies the minimum fee for a regular transaction on the mainnet
+	MinimumTransactionFeeTFuelWei uint64 = 1e12
+)
+
+//
+// ------- ChainConfig ------- //
+//
+
+// ChainConfig carries the chain specific parameters, i.e. the fork heights, the hardcoded block hashes,
+// the validator set size, the stake locking period and the gas/fee limits. The mainnet values are used
+// for the chains without a registered config, so private nets can override them without patching the source.
+type ChainConfig struct {
+	ChainID string `json:"chain_id"`
+
+	HeightEnableValidatorReward       uint64 `json:"height_enable_validator_reward"`
+	HeightEnableTheta2                uint64 `json:"height_enable_theta2"`
+	HeightLowerGNStakeThresholdTo1000 uint64 `json:"height_lower_gn_stake_threshold_to_1000"`
+	HeightEnableSmartContract         uint64 `json:"height_enable_smart_contract"`
+	HeightSampleStakingReward         uint64 `json:"height_sample_staking_reward"`
+	HeightEnableEquivocationSlash     uint64 `json:"height_enable_equivocation_slash"`
+	HeightEnableGuardianUptime        uint64 `json:"height_enable_guardian_uptime"`
+	HeightEnableGuardianUptimePenalty uint64 `json:"height_enable_guardian_uptime_penalty"`
+	HeightEnableValidatorCommission   uint64 `json:"height_enable_validator_commission"`
+	HeightEnableStakeRedelegation     uint64 `json:"height_enable_stake_redelegation"`
+
+	HardcodeBlockHashes map[uint64]string `json:"hardcode_block_hashes"`
+
+	MaxValidatorCount   int    `json:"max_validator_count"`
+	ReturnLockingPeriod uint64 `json:"return_locking_period"`
+
+	MinimumGasPrice               uint64 `json:"minimum_gas_price"`
+	MaximumTxGasLimit             uint64 `json:"maximum_tx_gas_limit"`
+	MinimumTransactionFeeTFuelWei uint64 `json:"minimum_transaction_fee_tfuel_wei"`
+}
+
+// MainnetChainConfig returns a new instance of the mainnet ChainConfig.
+func MainnetChainConfig() *ChainConfig {
+	hardcodeBlockHashes := make(map[uint64]string, len(HardcodeBlockHashes))
+	for height, hash := range HardcodeBlockHashes {
+		hardcodeBlockHashes[height] = hash
+	}
+
+	return &ChainConfig{
+		ChainID: MainnetChainID,
+
+		HeightEnableValidatorReward:       common.HeightEnableValidatorReward,
+		HeightEnableTheta2:                common.HeightEnableTheta2,
+		HeightLowerGNStakeThresholdTo1000: common.HeightLowerGNStakeThresholdTo1000,
+		HeightEnableSmartContract:         common.HeightEnableSmartContract,
+		HeightSampleStakingReward:         common.HeightSampleStakingReward,
+		HeightEnableEquivocationSlash:     common.HeightEnableEquivocationSlash,
+		HeightEnableGuardianUptime:        common.HeightEnableGuardianUptime,
+		HeightEnableGuardianUptimePenalty: common.HeightEnableGuardianUptimePenalty,
+		HeightEnableValidatorCommission:   common.HeightEnableValidatorCommission,
+		HeightEnableStakeRedelegation:     common.HeightEnableStakeRedelegation,
+
+		HardcodeBlockHashes: hardcodeBlockHashes,
+
+		MaxValidatorCount:   MaxValidatorCount,
+		ReturnLockingPeriod: ReturnLockingPeriod,
+
+		MinimumGasPrice:               MinimumGasPrice,
+		MaximumTxGasLimit:             MaximumTxGasLimit,
+		MinimumTransactionFeeTFuelWei: MinimumTransactionFeeTFuelWei,
+	}
+}
+
+// Validate checks the parameters of the config are usable.
+func (c *ChainConfig) Validate() error {
+	if c.ChainID == "" {
+		return errors.New("Chain ID is empty")
+	}
+	if c.MaxValidatorCount <= 0 {
+		return fmt.Errorf("Invalid max validator count: %v", c.MaxValidatorCount)
+	}
+	if c.ReturnLockingPeriod == 0 {
+		return errors.New("Return locking period should be positive")
+	}
+	if c.MaximumTxGasLimit == 0 {
+		return errors.New("Maximum tx gas limit should be positive")
+	}
+	if c.HeightEnableGuardianUptimePenalty < c.HeightEnableGuardianUptime {
+		return errors.New("Guardian uptime penalty can not be enabled before the guardian uptime")
+	}
+	return nil
+}
+
+// MaxEvidenceAge returns the number of blocks after which a double sign can no longer be slashed. It
+// equals the stake return locking period, so a stake withdrawn right after a double sign is still
+// in the validator candidate pool when the evidence is committed.
+func (c *ChainConfig) MaxEvidenceAge() uint64 {
+	return c.ReturnLockingPeriod
+}
+
+// RedelegationCooldownPeriod returns the number of blocks a redelegated stake is tracked for. During
+// the period, the stake can not be redelegated again, and it is still slashed for the double signs
+// the original holder committed before the redelegation. It equals the max evidence age, so the
+// evidence of any double sign before the redelegation expires before the tracking ends.
+func (c *ChainConfig) RedelegationCooldownPeriod() uint64 {
+	return c.MaxEvidenceAge()
+}
+
+// MinGuardianStakeDeposit returns the minimal guardian stake deposit at the given height.
+func (c *ChainConfig) MinGuardianStakeDeposit(blockHeight uint64) *big.Int {
+	if blockHeight >= c.HeightLowerGNStakeThresholdTo1000 {
+		return MinGuardianStakeDeposit1000
+	}
+	return MinGuardianStakeDeposit
+}
+
+func (c *ChainConfig) String() string {
+	return fmt.Sprintf("ChainConfig{chainID: %v, heightEnableValidatorReward: %v, heightEnableTheta2: %v, heightEnableSmartContract: %v, maxValidatorCount: %v, returnLockingPeriod: %v}",
+		c.ChainID, c.HeightEnableValidatorReward, c.HeightEnableTheta2, c.HeightEnableSmartContract, c.MaxValidatorCount, c.ReturnLockingPeriod)
+}
+
+//
+// ------- ChainConfig Registry ------- //
+//
+
+var (
+	chainConfigs      = make(map[string]*ChainConfig)
+	chainConfigsMutex = &sync.RWMutex{}
+
+	mainnetChainConfig = MainnetChainConfig()
+)
+
+// RegisterChainConfig registers the config for its chain ID, replacing the existing one if any.
+func RegisterChainConfig(config *ChainConfig) error {
+	if err := config.Validate(); err != nil {
+		return err
+	}
+
+	chainConfigsMutex.Lock()
+	defer chainConfigsMutex.Unlock()
+
+	chainConfigs[config.ChainID] = config
+	return nil
+}
+
+// GetChainConfig returns the config registered for the chain ID, or the mainnet config if the
+// chain does not have a registered config.
+func GetChainConfig(chainID string) *ChainConfig {
+	chainConfigsMutex.RLock()
+	defer chainConfigsMutex.RUnlock()
+
+	if config, ok := chainConfigs[chainID]; ok {
+		return config
+	}
+	return mainnetChainConfig
+}
+
+// LoadChainConfigs reads the JSON file at the given path and registers the configs in it. The file
+// maps chain IDs to configs. The parameters not specified in a config take the mainnet values, except
+// for the hardcoded block hashes, which are never inherited from the mainnet.
+func LoadChainConfigs(filePath string) error {
+	raw, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return err
+	}
+
+	entries := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(raw, &entries); err != nil {
+		return fmt.Errorf("Failed to parse chain configs: %v", err)
+	}
+
+	for chainID, entry := range entries {
+		config := MainnetChainConfig()
+		config.ChainID = ""
+		config.HardcodeBlockHashes = nil
+		if err := json.Unmarshal(entry, config); err != nil {
+			return fmt.Errorf("Failed to parse the config of chain %v: %v", chainID, err)
+		}
+		if config.ChainID == "" {
+			config.ChainID = chainID
+		}
+		if config.ChainID != chainID {
+			return fmt.Errorf("Chain ID mismatch: config for %v has chain ID %v", chainID, config.ChainID)
+		}
+		if config.HardcodeBlockHashes == nil {
+			config.HardcodeBlockHashes = make(map[uint64]string)
+		}
+		if err := RegisterChainConfig(config); err != nil {
+			return fmt.Errorf("Invalid config for chain %v: %v", chainID, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package core
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"theta/common"
+)
+
+func TestMainnetChainConfig(t *testing.T) {
+	require := require.New(t)
+
+	config := GetChainConfig(MainnetChainID)
+	require.Nil(config.Validate())
+	require.Equal(common.HeightEnableTheta2, config.HeightEnableTheta2)
+	require.Equal(common.HeightEnableSmartContract, config.HeightEnableSmartContract)
+	require.Equal(MaxValidatorCount, config.MaxValidatorCount)
+	require.Equal(ReturnLockingPeriod, config.MaxEvidenceAge())
+	require.Equal(MinGuardianStakeDeposit, config.MinGuardianStakeDeposit(common.HeightLowerGNStakeThresholdTo1000-1))
+	require.Equal(MinGuardianStakeDeposit1000, config.MinGuardianStakeDeposit(common.HeightLowerGNStakeThresholdTo1000))
+
+	// Chains without a registered config use the mainnet config
+	require.Equal(config, GetChainConfig("unregistered_chain"))
+}
+
+func TestLoadChainConfigs(t *testing.T) {
+	require := require.New(t)
+
+	dir, err := ioutil.TempDir("", "chain_config_test")
+	require.Nil(err)
+	defer os.RemoveAll(dir)
+
+	filePath := path.Join(dir, "chain_config.json")
+	err = ioutil.WriteFile(filePath, []byte(`{
+		"test_privatenet": {
+			"height_enable_theta2": 10,
+			"height_enable_smart_contract": 20,
+			"max_validator_count": 4,
+			"return_locking_period": 100,
+			"minimum_transaction_fee_tfuel_wei": 1000,
+			"hardcode_block_hashes": {"30": "0x1234"}
+		}
+	}`), 0644)
+	require.Nil(err)
+	require.Nil(LoadChainConfigs(filePath))
+
+	config := GetChainConfig("test_privatenet")
+	require.Equal("test_privatenet", config.ChainID)
+	require.Equal(uint64(10), config.HeightEnableTheta2)
+	require.Equal(uint64(20), config.HeightEnableSmartContract)
+	require.Equal(4, config.MaxValidatorCount)
+	require.Equal(uint64(100), config.MaxEvidenceAge())
+	require.Equal(uint64(1000), config.MinimumTransactionFeeTFuelWei)
+	require.Equal(map[uint64]string{30: "0x1234"}, config.HardcodeBlockHashes)
+
+	// The parameters not specified take the mainnet values
+	require.Equal(common.HeightEnableValidatorReward, config.HeightEnableValidatorReward)
+	require.Equal(MinimumGasPrice, config.MinimumGasPrice)
+	require.Equal(MaximumTxGasLimit, config.MaximumTxGasLimit)
+
+	// Invalid configs are rejected
+	err = ioutil.WriteFile(filePath, []byte(`{"test_invalid": {"max_validator_count": 0}}`), 0644)
+	require.Nil(err)
+	require.NotNil(LoadChainConfigs(filePath))
+	require.Equal(MainnetChainID, GetChainConfig("test_invalid").ChainID)
+
+	err = ioutil.WriteFile(filePath, []byte(`{"test_mismatch": {"chain_id": "other"}}`), 0644)
+	require.Nil(err)
+	require.NotNil(LoadChainConfigs(filePath))
+}
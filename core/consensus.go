@@ -15,6 +15,8 @@ type ConsensusEngine interface {
 	AddMessage(msg interface{})
 	FinalizedBlocks() chan *Block
 	GetLastFinalizedBlock() *ExtendedBlock
+	GetPendingEvidence() []*DoubleSignEvidence
+	ChainConfig() *ChainConfig
 }
 
 // ValidatorManager is the component for managing validator related logic for consensus engine.
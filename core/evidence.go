@@ -0,0 +1,145 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+
+	"theta/common"
+	"theta/common/result"
+	"theta/crypto"
+	"theta/rlp"
+)
+
+const (
+	// EvidenceConflictingVotes indicates a validator voted for two different blocks at the same height
+	EvidenceConflictingVotes uint8 = 0
+	// EvidenceConflictingProposals indicates a proposer proposed two different blocks at the same
+	// height in the same epoch
+	EvidenceConflictingProposals uint8 = 1
+
+	// DoubleSignSlashPercentage is the percentage of the stakes of a validator forfeited for double signing
+	DoubleSignSlashPercentage int64 = 5
+)
+
+//
+// ------- DoubleSignEvidence ------- //
+//
+
+// DoubleSignEvidence proves that a validator signed two conflicting votes or block proposals.
+// Vote signatures do not cover the vote height, so the headers of both blocks are included to
+// prove that the two blocks are at the same height.
+type DoubleSignEvidence struct {
+	Type     uint8
+	Offender common.Address
+	HeaderA  *BlockHeader
+	HeaderB  *BlockHeader
+	VoteA    *Vote `rlp:"nil"` // Only set for conflicting votes
+	VoteB    *Vote `rlp:"nil"` // Only set for conflicting votes
+}
+
+// NewConflictingVotesEvidence creates the evidence of two conflicting votes. The two votes are
+// sorted by block hash, so the same pair of votes always produces the same evidence.
+func NewConflictingVotesEvidence(voteA Vote, headerA *BlockHeader, voteB Vote, headerB *BlockHeader) *DoubleSignEvidence {
+	if bytes.Compare(headerA.Hash().Bytes(), headerB.Hash().Bytes()) > 0 {
+		voteA, voteB = voteB, voteA
+		headerA, headerB = headerB, headerA
+	}
+	return &DoubleSignEvidence{
+		Type:     EvidenceConflictingVotes,
+		Offender: voteA.ID,
+		HeaderA:  headerA,
+		HeaderB:  headerB,
+		VoteA:    &voteA,
+		VoteB:    &voteB,
+	}
+}
+
+// NewConflictingProposalsEvidence creates the evidence of two conflicting block proposals.
+func NewConflictingProposalsEvidence(headerA *BlockHeader, headerB *BlockHeader) *DoubleSignEvidence {
+	if bytes.Compare(headerA.Hash().Bytes(), headerB.Hash().Bytes()) > 0 {
+		headerA, headerB = headerB, headerA
+	}
+	return &DoubleSignEvidence{
+		Type:     EvidenceConflictingProposals,
+		Offender: headerA.Proposer,
+		HeaderA:  headerA,
+		HeaderB:  headerB,
+	}
+}
+
+// Height returns the height at which the offender double signed.
+func (e *DoubleSignEvidence) Height() uint64 {
+	if e.HeaderA == nil {
+		return 0
+	}
+	return e.HeaderA.Height
+}
+
+// Hash calculates the hash of the evidence.
+func (e *DoubleSignEvidence) Hash() common.Hash {
+	raw, _ := rlp.EncodeToBytes(e)
+	return crypto.Keccak256Hash(raw)
+}
+
+// Validate checks the evidence proves a double sign by the offender on the given chain.
+func (e *DoubleSignEvidence) Validate(chainID string) result.Result {
+	if e.Offender.IsEmpty() {
+		return result.Error("Offender is not specified")
+	}
+	if e.HeaderA == nil || e.HeaderB == nil {
+		return result.Error("Block header is missing")
+	}
+	if e.HeaderA.ChainID != chainID || e.HeaderB.ChainID != chainID {
+		return result.Error("ChainID mismatch")
+	}
+	if bytes.Compare(e.HeaderA.Hash().Bytes(), e.HeaderB.Hash().Bytes()) >= 0 {
+		return result.Error("Block headers are not distinct or not sorted by hash")
+	}
+	if e.HeaderA.Height != e.HeaderB.Height {
+		return result.Error("Block heights mismatch: %v vs %v", e.HeaderA.Height, e.HeaderB.Height)
+	}
+
+	switch e.Type {
+	case EvidenceConflictingVotes:
+		if e.VoteA == nil || e.VoteB == nil {
+			return result.Error("Vote is missing")
+		}
+		if e.VoteA.ID != e.Offender || e.VoteB.ID != e.Offender {
+			return result.Error("Votes are not from the offender")
+		}
+		if e.VoteA.Block != e.HeaderA.Hash() || e.VoteB.Block != e.HeaderB.Hash() {
+			return result.Error("Votes do not match the block headers")
+		}
+		if res := e.VoteA.Validate(); res.IsError() {
+			return res
+		}
+		if res := e.VoteB.Validate(); res.IsError() {
+			return res
+		}
+	case EvidenceConflictingProposals:
+		if e.VoteA != nil || e.VoteB != nil {
+			return result.Error("Unexpected votes in proposal evidence")
+		}
+		if e.HeaderA.Proposer != e.Offender || e.HeaderB.Proposer != e.Offender {
+			return result.Error("Blocks are not proposed by the offender")
+		}
+		if e.HeaderA.Epoch != e.HeaderB.Epoch {
+			return result.Error("Block epochs mismatch: %v vs %v", e.HeaderA.Epoch, e.HeaderB.Epoch)
+		}
+		if res := e.HeaderA.Validate(chainID); res.IsError() {
+			return res
+		}
+		if res := e.HeaderB.Validate(chainID); res.IsError() {
+			return res
+		}
+	default:
+		return result.Error("Unknown evidence type: %v", e.Type)
+	}
+
+	return result.OK
+}
+
+func (e *DoubleSignEvidence) String() string {
+	return fmt.Sprintf("DoubleSignEvidence{type: %v, offender: %v, height: %v, blockA: %v, blockB: %v}",
+		e.Type, e.Offender.Hex(), e.Height(), e.HeaderA.Hash().Hex(), e.HeaderB.Hash().Hex())
+}
@@ -0,0 +1,123 @@
+package core
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"theta/common"
+	"theta/crypto"
+	"theta/rlp"
+)
+
+func createSignedTestHeader(signer *crypto.PrivateKey, height uint64, epoch uint64, stateHash string) *BlockHeader {
+	block := NewBlock()
+	block.ChainID = "testchain"
+	block.Height = height
+	block.Epoch = epoch
+	block.Parent = common.HexToHash("0x1")
+	block.HCC.BlockHash = common.HexToHash("0x1")
+	block.StateHash = common.HexToHash(stateHash)
+	block.Proposer = signer.PublicKey().Address()
+	block.Timestamp = big.NewInt(1)
+	block.Signature, _ = signer.Sign(block.SignBytes())
+	return block.BlockHeader
+}
+
+func createSignedTestVote(signer *crypto.PrivateKey, header *BlockHeader, epoch uint64) Vote {
+	vote := Vote{
+		Block:  header.Hash(),
+		Height: header.Height,
+		Epoch:  epoch,
+		ID:     signer.PublicKey().Address(),
+	}
+	vote.Sign(signer)
+	return vote
+}
+
+func TestConflictingVotesEvidence(t *testing.T) {
+	assert := assert.New(t)
+
+	proposer, _, _ := crypto.GenerateKeyPair()
+	voter, _, _ := crypto.GenerateKeyPair()
+	other, _, _ := crypto.GenerateKeyPair()
+
+	headerA := createSignedTestHeader(proposer, 10, 5, "0xa")
+	headerB := createSignedTestHeader(proposer, 10, 6, "0xb")
+	headerC := createSignedTestHeader(proposer, 11, 7, "0xc")
+	voteA := createSignedTestVote(voter, headerA, 5)
+	voteB := createSignedTestVote(voter, headerB, 6)
+
+	evidence := NewConflictingVotesEvidence(voteA, headerA, voteB, headerB)
+	assert.True(evidence.Validate("testchain").IsOK())
+	assert.False(evidence.Validate("otherchain").IsOK())
+	assert.Equal(voter.PublicKey().Address(), evidence.Offender)
+	assert.Equal(uint64(10), evidence.Height())
+
+	// The evidence does not depend on the order of the votes
+	assert.Equal(evidence.Hash(), NewConflictingVotesEvidence(voteB, headerB, voteA, headerA).Hash())
+
+	// RLP round trip
+	raw, err := rlp.EncodeToBytes(evidence)
+	assert.Nil(err)
+	decoded := &DoubleSignEvidence{}
+	assert.Nil(rlp.DecodeBytes(raw, decoded))
+	assert.True(decoded.Validate("testchain").IsOK())
+	assert.Equal(evidence.Hash(), decoded.Hash())
+
+	// Votes for blocks at different heights are not conflicting
+	voteC := createSignedTestVote(voter, headerC, 7)
+	assert.False(NewConflictingVotesEvidence(voteA, headerA, voteC, headerC).Validate("testchain").IsOK())
+
+	// Repeated votes for the same block are not conflicting
+	voteA2 := createSignedTestVote(voter, headerA, 6)
+	assert.False(NewConflictingVotesEvidence(voteA, headerA, voteA2, headerA).Validate("testchain").IsOK())
+
+	// Votes from different voters are not conflicting
+	voteB2 := createSignedTestVote(other, headerB, 6)
+	assert.False(NewConflictingVotesEvidence(voteA, headerA, voteB2, headerB).Validate("testchain").IsOK())
+
+	// The header must match the vote
+	assert.False(NewConflictingVotesEvidence(voteA, headerA, voteB, headerC).Validate("testchain").IsOK())
+
+	// Forged signature
+	forged := NewConflictingVotesEvidence(voteA, headerA, voteB, headerB)
+	forged.VoteB.Signature = forged.VoteA.Signature
+	assert.False(forged.Validate("testchain").IsOK())
+}
+
+func TestConflictingProposalsEvidence(t *testing.T) {
+	assert := assert.New(t)
+
+	proposer, _, _ := crypto.GenerateKeyPair()
+	other, _, _ := crypto.GenerateKeyPair()
+
+	headerA := createSignedTestHeader(proposer, 10, 5, "0xa")
+	headerB := createSignedTestHeader(proposer, 10, 5, "0xb")
+
+	evidence := NewConflictingProposalsEvidence(headerA, headerB)
+	assert.True(evidence.Validate("testchain").IsOK())
+	assert.Equal(proposer.PublicKey().Address(), evidence.Offender)
+	assert.Equal(evidence.Hash(), NewConflictingProposalsEvidence(headerB, headerA).Hash())
+
+	raw, err := rlp.EncodeToBytes(evidence)
+	assert.Nil(err)
+	decoded := &DoubleSignEvidence{}
+	assert.Nil(rlp.DecodeBytes(raw, decoded))
+	assert.Nil(decoded.VoteA)
+	assert.True(decoded.Validate("testchain").IsOK())
+
+	// Proposals in different epochs are not conflicting
+	headerC := createSignedTestHeader(proposer, 10, 6, "0xc")
+	assert.False(NewConflictingProposalsEvidence(headerA, headerC).Validate("testchain").IsOK())
+
+	// Proposals from different proposers are not conflicting
+	headerD := createSignedTestHeader(other, 10, 5, "0xd")
+	assert.False(NewConflictingProposalsEvidence(headerA, headerD).Validate("testchain").IsOK())
+
+	// Unsigned proposal
+	headerE := createSignedTestHeader(proposer, 10, 5, "0xe")
+	headerE.Signature = nil
+	headerE.UpdateHash()
+	assert.False(NewConflictingProposalsEvidence(headerA, headerE).Validate("testchain").IsOK())
+}
@@ -287,11 +287,7 @@ func (gcp *GuardianCandidatePool) Hash() common.Hash {
 	return crypto.Keccak256Hash(raw)
 }
 
-func (gcp *GuardianCandidatePool) DepositStake(source common.Address, holder common.Address, amount *big.Int, pubkey *bls.PublicKey, blockHeight uint64) (err error) {
-	minGuardianStake := MinGuardianStakeDeposit
-	if blockHeight >= common.HeightLowerGNStakeThresholdTo1000 {
-		minGuardianStake = MinGuardianStakeDeposit1000
-	}
+func (gcp *GuardianCandidatePool) DepositStake(source common.Address, holder common.Address, amount *big.Int, pubkey *bls.PublicKey, minGuardianStake *big.Int) (err error) {
 	if amount.Cmp(minGuardianStake) < 0 {
 		return fmt.Errorf("Insufficient stake: %v", amount)
 	}
@@ -318,12 +314,12 @@ func (gcp *GuardianCandidatePool) DepositStake(source common.Address, holder com
 	return nil
 }
 
-func (gcp *GuardianCandidatePool) WithdrawStake(source common.Address, holder common.Address, currentHeight uint64) error {
+func (gcp *GuardianCandidatePool) WithdrawStake(source common.Address, holder common.Address, currentHeight uint64, returnLockingPeriod uint64) error {
 	matchedHolderFound := false
 	for _, g := range gcp.SortedGuardians {
 		if g.Holder == holder {
 			matchedHolderFound = true
-			err := g.withdrawStake(source, currentHeight)
+			err := g.withdrawStake(source, currentHeight, returnLockingPeriod)
 			if err != nil {
 				return err
 			}
@@ -337,6 +333,46 @@ func (gcp *GuardianCandidatePool) WithdrawStake(source common.Address, holder co
 	return nil
 }
 
+// RedelegateStake moves the stake of the source from one guardian to another, and returns the
+// amount moved. The destination guardian must already be in the pool, since a new guardian needs
+// its BLS key registered with a stake deposit.
+func (gcp *GuardianCandidatePool) RedelegateStake(source common.Address, fromHolder common.Address, toHolder common.Address) (*big.Int, error) {
+	if fromHolder == toHolder {
+		return nil, fmt.Errorf("Cannot redelegate stake to the same holder: %v", toHolder)
+	}
+
+	var fromGuardian, toGuardian *Guardian
+	for _, g := range gcp.SortedGuardians {
+		if g.Holder == fromHolder {
+			fromGuardian = g
+		} else if g.Holder == toHolder {
+			toGuardian = g
+		}
+	}
+	if fromGuardian == nil {
+		return nil, fmt.Errorf("No matched stake holder address found: %v", fromHolder)
+	}
+	if toGuardian == nil {
+		return nil, fmt.Errorf("No matched stake holder address found: %v", toHolder)
+	}
+	if err := toGuardian.checkDeposit(source); err != nil {
+		return nil, err
+	}
+
+	stake, err := fromGuardian.removeStake(source)
+	if err != nil {
+		return nil, err
+	}
+	if len(fromGuardian.Stakes) == 0 {
+		gcp.Remove(fromHolder)
+	}
+	if err := toGuardian.depositStake(source, stake.Amount); err != nil {
+		return nil, err // should not happen, already checked
+	}
+
+	return stake.Amount, nil
+}
+
 func (gcp *GuardianCandidatePool) ReturnStakes(currentHeight uint64) []*Stake {
 	returnedStakes := []*Stake{}
 
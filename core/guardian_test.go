@@ -2,6 +2,7 @@ package core
 
 import (
 	"bytes"
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -117,6 +118,34 @@ func TestGuardianPool(t *testing.T) {
 	require.Equal(3, pool.WithStake().Index(nextPub))
 }
 
+func TestGuardianPoolRedelegateStake(t *testing.T) {
+	require := require.New(t)
+
+	pool, _ := createTestGuardianPool(3)
+	g0, g1 := pool.SortedGuardians[0], pool.SortedGuardians[1]
+	source := g0.Holder
+
+	// Should not redelegate to a guardian not in the pool.
+	_, err := pool.RedelegateStake(source, g0.Holder, common.HexToAddress("0xf01"))
+	require.NotNil(err)
+	// Should not redelegate to the same guardian.
+	_, err = pool.RedelegateStake(source, g0.Holder, g0.Holder)
+	require.NotNil(err)
+
+	amount, err := pool.RedelegateStake(source, g0.Holder, g1.Holder)
+	require.Nil(err)
+	require.Equal(MinGuardianStakeDeposit, amount)
+	require.Equal(2, pool.Len(), "Guardian without stake should be removed")
+	require.False(pool.Contains(source))
+	require.Equal(new(big.Int).Mul(MinGuardianStakeDeposit, big.NewInt(2)), g1.TotalStake())
+	require.True(isSorted(pool))
+
+	// Should not redelegate the stake with withdrawal pending.
+	require.Nil(pool.WithdrawStake(source, g1.Holder, 100, ReturnLockingPeriod))
+	_, err = pool.RedelegateStake(source, g1.Holder, pool.SortedGuardians[1].Holder)
+	require.NotNil(err)
+}
+
 func TestAggregateVote(t *testing.T) {
 	pool, sks := createTestGuardianPool(10)
 
@@ -0,0 +1,89 @@
+package core
+
+import (
+	"fmt"
+
+	"theta/common"
+)
+
+const (
+	// GuardianUptimeWindow is the number of most recent checkpoints covered by the uptime record of a guardian
+	GuardianUptimeWindow = 100
+
+	// MinGuardianUptimePercentage is the minimal percentage of the checkpoints in the uptime window a guardian
+	// needs to have voted on to receive the staking reward, once the uptime penalty is enabled
+	MinGuardianUptimePercentage = 50
+
+	// MinGuardianUptimeSamples is the minimal number of recorded checkpoints before the uptime penalty applies
+	// to a guardian, so a newly joined guardian is not penalized based on only a few checkpoints
+	MinGuardianUptimeSamples = 10
+)
+
+//
+// ------- GuardianUptime ------- //
+//
+
+// GuardianUptime records whether a guardian participated in the aggregated votes of the most recent
+// checkpoints, oldest first.
+type GuardianUptime struct {
+	Holder         common.Address
+	Participation  []bool
+	LastCheckpoint uint64 // Height of the last recorded checkpoint
+}
+
+// NewGuardianUptime creates a new instance of GuardianUptime.
+func NewGuardianUptime(holder common.Address) *GuardianUptime {
+	return &GuardianUptime{
+		Holder:        holder,
+		Participation: []bool{},
+	}
+}
+
+// Record records the participation of the guardian in the votes of the checkpoint at the given
+// height. It returns false if the checkpoint is not newer than the last recorded one.
+func (gu *GuardianUptime) Record(checkpointHeight uint64, participated bool) bool {
+	if len(gu.Participation) > 0 && checkpointHeight <= gu.LastCheckpoint {
+		return false
+	}
+
+	gu.Participation = append(gu.Participation, participated)
+	if len(gu.Participation) > GuardianUptimeWindow {
+		gu.Participation = gu.Participation[len(gu.Participation)-GuardianUptimeWindow:]
+	}
+	gu.LastCheckpoint = checkpointHeight
+	return true
+}
+
+// NumParticipated returns the number of recorded checkpoints the guardian participated in.
+func (gu *GuardianUptime) NumParticipated() int {
+	count := 0
+	for _, participated := range gu.Participation {
+		if participated {
+			count++
+		}
+	}
+	return count
+}
+
+// UptimePercentage returns the percentage of the recorded checkpoints the guardian participated in.
+// A guardian without any record is considered fully up.
+func (gu *GuardianUptime) UptimePercentage() int {
+	if len(gu.Participation) == 0 {
+		return 100
+	}
+	return gu.NumParticipated() * 100 / len(gu.Participation)
+}
+
+// IsBelowThreshold returns whether the guardian has enough records and its uptime is below
+// the minimal uptime required for the staking reward.
+func (gu *GuardianUptime) IsBelowThreshold() bool {
+	if len(gu.Participation) < MinGuardianUptimeSamples {
+		return false
+	}
+	return gu.UptimePercentage() < MinGuardianUptimePercentage
+}
+
+func (gu *GuardianUptime) String() string {
+	return fmt.Sprintf("GuardianUptime{holder: %v, participated: %v/%v, lastCheckpoint: %v}",
+		gu.Holder.Hex(), gu.NumParticipated(), len(gu.Participation), gu.LastCheckpoint)
+}
@@ -0,0 +1,63 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"theta/common"
+	"theta/rlp"
+)
+
+func TestGuardianUptimeRecord(t *testing.T) {
+	assert := assert.New(t)
+
+	holder := common.HexToAddress("0x2E833968E5bB786Ae419c4d13189fB081Cc43bab")
+	gu := NewGuardianUptime(holder)
+	assert.Equal(100, gu.UptimePercentage())
+	assert.False(gu.IsBelowThreshold())
+
+	assert.True(gu.Record(101, true))
+	assert.False(gu.Record(101, false)) // same checkpoint
+	assert.False(gu.Record(1, false))   // older checkpoint
+	assert.True(gu.Record(201, false))
+	assert.Equal(1, gu.NumParticipated())
+	assert.Equal(50, gu.UptimePercentage())
+	assert.Equal(uint64(201), gu.LastCheckpoint)
+
+	// Not enough samples yet
+	assert.False(gu.IsBelowThreshold())
+
+	// The window only keeps the most recent checkpoints
+	for i := 0; i < GuardianUptimeWindow; i++ {
+		assert.True(gu.Record(uint64(301+100*i), i%4 == 0))
+	}
+	assert.Equal(GuardianUptimeWindow, len(gu.Participation))
+	assert.Equal(GuardianUptimeWindow/4, gu.NumParticipated())
+	assert.Equal(25, gu.UptimePercentage())
+	assert.True(gu.IsBelowThreshold())
+
+	for i := 0; i < GuardianUptimeWindow; i++ {
+		gu.Record(gu.LastCheckpoint+100, true)
+	}
+	assert.Equal(100, gu.UptimePercentage())
+	assert.False(gu.IsBelowThreshold())
+}
+
+func TestGuardianUptimeRLP(t *testing.T) {
+	assert := assert.New(t)
+
+	gu := NewGuardianUptime(common.HexToAddress("0x2E833968E5bB786Ae419c4d13189fB081Cc43bab"))
+	gu.Record(101, true)
+	gu.Record(201, false)
+	gu.Record(301, true)
+
+	raw, err := rlp.EncodeToBytes(gu)
+	assert.Nil(err)
+
+	decoded := &GuardianUptime{}
+	err = rlp.DecodeBytes(raw, decoded)
+	assert.Nil(err)
+	assert.Equal(gu.Holder, decoded.Holder)
+	assert.Equal(gu.Participation, decoded.Participation)
+	assert.Equal(gu.LastCheckpoint, decoded.LastCheckpoint)
+}
@@ -31,6 +31,9 @@ type Ledger interface {
 	GetCurrentBlock() *Block
 	ScreenTxUnsafe(rawTx common.Bytes) result.Result
 	ScreenTx(rawTx common.Bytes) (priority *TxInfo, res result.Result)
+	ScreenReplacementTx(rawTx common.Bytes, precedingRawTxs []common.Bytes) (txInfo *TxInfo, res result.Result)
+	RescreenTxs(rawTxs []common.Bytes) []result.Result
+	GetTxInfo(rawTx common.Bytes) (txInfo *TxInfo, res result.Result)
 	ProposeBlockTxs(block *Block) (stateRootHash common.Hash, blockRawTxs []common.Bytes, res result.Result)
 	ApplyBlockTxs(block *Block) result.Result
 	ApplyBlockTxsForChainCorrection(block *Block) (common.Hash, result.Result)
@@ -0,0 +1,83 @@
+package core
+
+import (
+	"fmt"
+	"math/big"
+
+	"theta/common"
+)
+
+//
+// ------- Redelegation ------- //
+//
+
+// Redelegation records a stake moved from one holder to another.
+type Redelegation struct {
+	Source     common.Address
+	FromHolder common.Address
+	ToHolder   common.Address
+	Purpose    uint8
+	Amount     *big.Int
+	Height     uint64 // Height of the block that contains the redelegation
+}
+
+// InCooldown returns whether the redelegation is still tracked at the given height. The cooldown
+// period is given by ChainConfig.RedelegationCooldownPeriod.
+func (r *Redelegation) InCooldown(currentHeight uint64, cooldownPeriod uint64) bool {
+	return currentHeight < r.Height+cooldownPeriod
+}
+
+func (r *Redelegation) String() string {
+	return fmt.Sprintf("{Source: %v, FromHolder: %v, ToHolder: %v, Purpose: %v, Amount: %v, Height: %v}",
+		r.Source, r.FromHolder, r.ToHolder, r.Purpose, r.Amount, r.Height)
+}
+
+//
+// ------- RedelegationList ------- //
+//
+
+// RedelegationList keeps the redelegations in the cooldown period, in the order they happened.
+type RedelegationList struct {
+	Redelegations []*Redelegation
+}
+
+// Append adds a redelegation to the list.
+func (rl *RedelegationList) Append(r *Redelegation) {
+	rl.Redelegations = append(rl.Redelegations, r)
+}
+
+// IsLocked returns whether the stake of the source delegated to the holder has been redelegated
+// to the holder recently, and thus can not be redelegated again.
+func (rl *RedelegationList) IsLocked(source common.Address, holder common.Address, purpose uint8, currentHeight uint64, cooldownPeriod uint64) bool {
+	for _, r := range rl.Redelegations {
+		if r.Source == source && r.ToHolder == holder && r.Purpose == purpose && r.InCooldown(currentHeight, cooldownPeriod) {
+			return true
+		}
+	}
+	return false
+}
+
+// RedelegatedFrom returns the redelegations away from the holder at or after the given height.
+func (rl *RedelegationList) RedelegatedFrom(holder common.Address, purpose uint8, height uint64) []*Redelegation {
+	ret := []*Redelegation{}
+	for _, r := range rl.Redelegations {
+		if r.FromHolder == holder && r.Purpose == purpose && r.Height >= height {
+			ret = append(ret, r)
+		}
+	}
+	return ret
+}
+
+// Prune removes the redelegations whose cooldown period has ended, and returns true if any
+// redelegation is removed.
+func (rl *RedelegationList) Prune(currentHeight uint64, cooldownPeriod uint64) bool {
+	remaining := []*Redelegation{}
+	for _, r := range rl.Redelegations {
+		if r.InCooldown(currentHeight, cooldownPeriod) {
+			remaining = append(remaining, r)
+		}
+	}
+	pruned := len(remaining) != len(rl.Redelegations)
+	rl.Redelegations = remaining
+	return pruned
+}
@@ -0,0 +1,46 @@
+package core
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"theta/common"
+)
+
+func TestRedelegationList(t *testing.T) {
+	assert := assert.New(t)
+
+	source1 := common.HexToAddress("0x111")
+	source2 := common.HexToAddress("0x222")
+	holder1 := common.HexToAddress("0xf01")
+	holder2 := common.HexToAddress("0xf02")
+	holder3 := common.HexToAddress("0xf03")
+
+	cooldown := MainnetChainConfig().RedelegationCooldownPeriod()
+
+	rl := &RedelegationList{}
+	rl.Append(&Redelegation{Source: source1, FromHolder: holder1, ToHolder: holder2, Purpose: StakeForValidator, Amount: big.NewInt(100), Height: 100})
+	rl.Append(&Redelegation{Source: source2, FromHolder: holder1, ToHolder: holder3, Purpose: StakeForValidator, Amount: big.NewInt(200), Height: 200})
+	rl.Append(&Redelegation{Source: source1, FromHolder: holder3, ToHolder: holder1, Purpose: StakeForGuardian, Amount: big.NewInt(300), Height: 300})
+
+	// Chained redelegations are locked during the cooldown period
+	assert.True(rl.IsLocked(source1, holder2, StakeForValidator, 101, cooldown))
+	assert.True(rl.IsLocked(source1, holder2, StakeForValidator, 100+cooldown-1, cooldown))
+	assert.False(rl.IsLocked(source1, holder2, StakeForValidator, 100+cooldown, cooldown))
+	assert.False(rl.IsLocked(source1, holder2, StakeForGuardian, 101, cooldown))
+	assert.False(rl.IsLocked(source2, holder2, StakeForValidator, 101, cooldown))
+	assert.False(rl.IsLocked(source1, holder1, StakeForValidator, 101, cooldown))
+
+	redelegations := rl.RedelegatedFrom(holder1, StakeForValidator, 100)
+	assert.Equal(2, len(redelegations))
+	redelegations = rl.RedelegatedFrom(holder1, StakeForValidator, 150)
+	assert.Equal(1, len(redelegations))
+	assert.Equal(source2, redelegations[0].Source)
+	assert.Equal(0, len(rl.RedelegatedFrom(holder3, StakeForValidator, 0)))
+
+	assert.False(rl.Prune(100+cooldown-1, cooldown))
+	assert.True(rl.Prune(200+cooldown, cooldown))
+	assert.Equal(1, len(rl.Redelegations))
+	assert.Equal(uint64(300), rl.Redelegations[0].Height)
+}
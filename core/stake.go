@@ -136,14 +136,14 @@ func (sh *StakeHolder) depositStake(source common.Address, amount *big.Int) erro
 	return nil
 }
 
-func (sh *StakeHolder) withdrawStake(source common.Address, currentHeight uint64) error {
+func (sh *StakeHolder) withdrawStake(source common.Address, currentHeight uint64, returnLockingPeriod uint64) error {
 	for _, stake := range sh.Stakes {
 		if stake.Source == source {
 			if stake.Withdrawn {
 				return fmt.Errorf("Already withdrawn, cannot withdraw again for source: %v", source)
 			}
 			stake.Withdrawn = true
-			stake.ReturnHeight = currentHeight + ReturnLockingPeriod
+			stake.ReturnHeight = currentHeight + returnLockingPeriod
 			return nil
 		}
 	}
@@ -169,6 +169,60 @@ func (sh *StakeHolder) returnStake(source common.Address, currentHeight uint64)
 	return nil, fmt.Errorf("Cannot return, no matched stake source address found: %v", source)
 }
 
+// slashStakes forfeits the given percentage of each stake, including the withdrawn stakes that
+// are still locked, and returns the total amount forfeited
+func (sh *StakeHolder) slashStakes(percentage int64) *big.Int {
+	totalSlashed := new(big.Int).SetUint64(0)
+	for _, stake := range sh.Stakes {
+		slashed := new(big.Int).Mul(stake.Amount, big.NewInt(percentage))
+		slashed.Div(slashed, big.NewInt(100))
+		stake.Amount = new(big.Int).Sub(stake.Amount, slashed)
+		totalSlashed.Add(totalSlashed, slashed)
+	}
+	return totalSlashed
+}
+
+// slashStake forfeits up to the given amount of the stake from the source, and returns the amount forfeited
+func (sh *StakeHolder) slashStake(source common.Address, amount *big.Int) *big.Int {
+	for _, stake := range sh.Stakes {
+		if stake.Source == source {
+			slashed := new(big.Int).Set(amount)
+			if slashed.Cmp(stake.Amount) > 0 {
+				slashed.Set(stake.Amount)
+			}
+			stake.Amount = new(big.Int).Sub(stake.Amount, slashed)
+			return slashed
+		}
+	}
+	return new(big.Int).SetUint64(0)
+}
+
+// checkDeposit returns an error if the source can not deposit more stake to the holder
+func (sh *StakeHolder) checkDeposit(source common.Address) error {
+	for _, stake := range sh.Stakes {
+		if stake.Source == source && stake.Withdrawn {
+			return fmt.Errorf("Cannot deposit during the withdrawal locking period for: %v", source)
+		}
+	}
+	return nil
+}
+
+// removeStake removes the stake of the source from the holder. A withdrawn stake can not be
+// removed, since it is waiting to be returned to the source.
+func (sh *StakeHolder) removeStake(source common.Address) (*Stake, error) {
+	for idx, stake := range sh.Stakes {
+		if stake.Source == source {
+			if stake.Withdrawn {
+				return nil, fmt.Errorf("Cannot move stake during the withdrawal locking period for: %v", source)
+			}
+			sh.Stakes = append(sh.Stakes[:idx], sh.Stakes[idx+1:]...)
+			return stake, nil
+		}
+	}
+
+	return nil, fmt.Errorf("No matched stake source address found: %v", source)
+}
+
 func (sh *StakeHolder) String() string {
 	return fmt.Sprintf("{holder: %v, stakes :%v}", sh.Holder, sh.Stakes)
 }
@@ -80,8 +80,8 @@ func TestStakeWithdraw(t *testing.T) {
 	assert.Nil(stakeHolder.depositStake(sourceAddr2, stake2Amount1))
 	assert.True(stakeHolder.TotalStake().Cmp(new(big.Int).SetUint64(9000)) == 0)
 
-	assert.Nil(stakeHolder.withdrawStake(sourceAddr1, currentHeight))
-	assert.NotNil(stakeHolder.withdrawStake(sourceAddr1, currentHeight)) // cannot withdraw twice
+	assert.Nil(stakeHolder.withdrawStake(sourceAddr1, currentHeight, ReturnLockingPeriod))
+	assert.NotNil(stakeHolder.withdrawStake(sourceAddr1, currentHeight, ReturnLockingPeriod)) // cannot withdraw twice
 	assert.True(stakeHolder.TotalStake().Cmp(new(big.Int).SetUint64(8000)) == 0)
 
 	assert.NotNil(stakeHolder.depositStake(sourceAddr1, stake1Amount2)) // sourceAddr1 cannot deposit more stake since it is is in the withdrawal locking period
@@ -90,7 +90,7 @@ func TestStakeWithdraw(t *testing.T) {
 	assert.Nil(stakeHolder.depositStake(sourceAddr3, stake3Amount3))
 	assert.True(stakeHolder.TotalStake().Cmp(new(big.Int).SetUint64(9600)) == 0)
 
-	assert.NotNil(stakeHolder.withdrawStake(sourceAddr4, currentHeight)) // sourceAddr4 never deposited, should not be able to withdraw
+	assert.NotNil(stakeHolder.withdrawStake(sourceAddr4, currentHeight, ReturnLockingPeriod)) // sourceAddr4 never deposited, should not be able to withdraw
 }
 
 func TestStakeReturn(t *testing.T) {
@@ -114,7 +114,7 @@ func TestStakeReturn(t *testing.T) {
 	stakeHolder.depositStake(sourceAddr2, stake2Amount1)
 	assert.True(stakeHolder.TotalStake().Cmp(new(big.Int).SetUint64(13000)) == 0)
 
-	assert.Nil(stakeHolder.withdrawStake(sourceAddr1, initHeight))
+	assert.Nil(stakeHolder.withdrawStake(sourceAddr1, initHeight, ReturnLockingPeriod))
 	assert.True(stakeHolder.TotalStake().Cmp(new(big.Int).SetUint64(8000)) == 0)
 	assert.Equal(2, len(stakeHolder.Stakes))
 
@@ -225,12 +225,12 @@ func (vcp *ValidatorCandidatePool) DepositStake(source common.Address, holder co
 	return nil
 }
 
-func (vcp *ValidatorCandidatePool) WithdrawStake(source common.Address, holder common.Address, currentHeight uint64) error {
+func (vcp *ValidatorCandidatePool) WithdrawStake(source common.Address, holder common.Address, currentHeight uint64, returnLockingPeriod uint64) error {
 	matchedHolderFound := false
 	for _, candidate := range vcp.SortedCandidates {
 		if candidate.Holder == holder {
 			matchedHolderFound = true
-			err := candidate.withdrawStake(source, currentHeight)
+			err := candidate.withdrawStake(source, currentHeight, returnLockingPeriod)
 			if err != nil {
 				return err
 			}
@@ -247,6 +247,81 @@ func (vcp *ValidatorCandidatePool) WithdrawStake(source common.Address, holder c
 	return nil
 }
 
+// SlashStakes forfeits the given percentage of the stakes delegated to the holder, and returns the
+// total amount forfeited. The forfeited stakes are burned.
+func (vcp *ValidatorCandidatePool) SlashStakes(holder common.Address, percentage int64) (*big.Int, error) {
+	if percentage < 0 || percentage > 100 {
+		return nil, fmt.Errorf("Invalid slash percentage: %v", percentage)
+	}
+
+	candidate := vcp.FindStakeDelegate(holder)
+	if candidate == nil {
+		return nil, fmt.Errorf("No matched stake holder address found: %v", holder)
+	}
+	slashed := candidate.slashStakes(percentage)
+
+	vcp.sortCandidates()
+
+	return slashed, nil
+}
+
+// SlashStake forfeits up to the given amount of the stake the source delegated to the holder, and
+// returns the amount forfeited. The forfeited stake is burned.
+func (vcp *ValidatorCandidatePool) SlashStake(source common.Address, holder common.Address, amount *big.Int) (*big.Int, error) {
+	candidate := vcp.FindStakeDelegate(holder)
+	if candidate == nil {
+		return nil, fmt.Errorf("No matched stake holder address found: %v", holder)
+	}
+	slashed := candidate.slashStake(source, amount)
+
+	vcp.sortCandidates()
+
+	return slashed, nil
+}
+
+// RedelegateStake moves the stake of the source from one holder to another, and returns the
+// amount moved.
+func (vcp *ValidatorCandidatePool) RedelegateStake(source common.Address, fromHolder common.Address, toHolder common.Address) (*big.Int, error) {
+	if fromHolder == toHolder {
+		return nil, fmt.Errorf("Cannot redelegate stake to the same holder: %v", toHolder)
+	}
+
+	fromCandidate := vcp.FindStakeDelegate(fromHolder)
+	if fromCandidate == nil {
+		return nil, fmt.Errorf("No matched stake holder address found: %v", fromHolder)
+	}
+	toCandidate := vcp.FindStakeDelegate(toHolder)
+	if toCandidate != nil {
+		if err := toCandidate.checkDeposit(source); err != nil {
+			return nil, err
+		}
+	}
+
+	stake, err := fromCandidate.removeStake(source)
+	if err != nil {
+		return nil, err
+	}
+	if len(fromCandidate.Stakes) == 0 {
+		for idx, candidate := range vcp.SortedCandidates {
+			if candidate == fromCandidate {
+				vcp.SortedCandidates = append(vcp.SortedCandidates[:idx], vcp.SortedCandidates[idx+1:]...)
+				break
+			}
+		}
+	}
+
+	if toCandidate == nil {
+		toCandidate = newStakeHolder(toHolder, []*Stake{newStake(source, stake.Amount)})
+		vcp.SortedCandidates = append(vcp.SortedCandidates, toCandidate)
+	} else if err := toCandidate.depositStake(source, stake.Amount); err != nil {
+		return nil, err // should not happen, already checked
+	}
+
+	vcp.sortCandidates()
+
+	return stake.Amount, nil
+}
+
 func (vcp *ValidatorCandidatePool) ReturnStakes(currentHeight uint64) []*Stake {
 	returnedStakes := []*Stake{}
 
@@ -0,0 +1,100 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"theta/common"
+)
+
+const (
+	// CommissionRateBase is the denominator of the commission rates, i.e. the rates are in basis points
+	CommissionRateBase uint64 = 10000
+
+	// CommissionRateUpdateInterval is the minimal number of blocks between two commission rate updates
+	// of a validator, about one day
+	CommissionRateUpdateInterval uint64 = 14400
+)
+
+//
+// ------- ValidatorProfile ------- //
+//
+
+// ValidatorProfile specifies the commission a validator charges on the block rewards of the stakes
+// delegated to it by other sources. All the rates are in basis points. The max commission rate and
+// the max change rate can not be changed once the profile is created.
+type ValidatorProfile struct {
+	Holder                  common.Address
+	CommissionRate          uint64 // Current commission rate
+	MaxCommissionRate       uint64 // Upper bound of the commission rate
+	MaxCommissionChangeRate uint64 // Maximal change of the commission rate in a single update
+	LastUpdateHeight        uint64 // Height of the last commission rate update
+}
+
+// NewValidatorProfile creates a new instance of ValidatorProfile.
+func NewValidatorProfile(holder common.Address, rate, maxRate, maxChangeRate, height uint64) (*ValidatorProfile, error) {
+	vp := &ValidatorProfile{
+		Holder:                  holder,
+		CommissionRate:          rate,
+		MaxCommissionRate:       maxRate,
+		MaxCommissionChangeRate: maxChangeRate,
+		LastUpdateHeight:        height,
+	}
+	if err := vp.Validate(); err != nil {
+		return nil, err
+	}
+	return vp, nil
+}
+
+// Validate checks the rates of the profile are consistent.
+func (vp *ValidatorProfile) Validate() error {
+	if vp.MaxCommissionRate > CommissionRateBase {
+		return fmt.Errorf("Max commission rate %v exceeds %v", vp.MaxCommissionRate, CommissionRateBase)
+	}
+	if vp.CommissionRate > vp.MaxCommissionRate {
+		return fmt.Errorf("Commission rate %v exceeds the max commission rate %v", vp.CommissionRate, vp.MaxCommissionRate)
+	}
+	if vp.MaxCommissionChangeRate > vp.MaxCommissionRate {
+		return fmt.Errorf("Max commission change rate %v exceeds the max commission rate %v",
+			vp.MaxCommissionChangeRate, vp.MaxCommissionRate)
+	}
+	return nil
+}
+
+// UpdateCommissionRate changes the commission rate at the given height, within the limits of the profile.
+func (vp *ValidatorProfile) UpdateCommissionRate(rate uint64, height uint64) error {
+	if rate == vp.CommissionRate {
+		return nil
+	}
+	if height < vp.LastUpdateHeight+CommissionRateUpdateInterval {
+		return fmt.Errorf("Commission rate can not be updated until height %v", vp.LastUpdateHeight+CommissionRateUpdateInterval)
+	}
+	if rate > vp.MaxCommissionRate {
+		return fmt.Errorf("Commission rate %v exceeds the max commission rate %v", rate, vp.MaxCommissionRate)
+	}
+	var change uint64
+	if rate > vp.CommissionRate {
+		change = rate - vp.CommissionRate
+	} else {
+		change = vp.CommissionRate - rate
+	}
+	if change > vp.MaxCommissionChangeRate {
+		return errors.New("Commission rate change exceeds the max commission change rate")
+	}
+
+	vp.CommissionRate = rate
+	vp.LastUpdateHeight = height
+	return nil
+}
+
+// Commission calculates the commission the validator charges on the given reward.
+func (vp *ValidatorProfile) Commission(reward *big.Int) *big.Int {
+	commission := new(big.Int).Mul(reward, new(big.Int).SetUint64(vp.CommissionRate))
+	return commission.Div(commission, new(big.Int).SetUint64(CommissionRateBase))
+}
+
+func (vp *ValidatorProfile) String() string {
+	return fmt.Sprintf("ValidatorProfile{holder: %v, commissionRate: %v, maxCommissionRate: %v, maxCommissionChangeRate: %v, lastUpdateHeight: %v}",
+		vp.Holder.Hex(), vp.CommissionRate, vp.MaxCommissionRate, vp.MaxCommissionChangeRate, vp.LastUpdateHeight)
+}
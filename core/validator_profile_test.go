@@ -0,0 +1,76 @@
+package core
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"theta/common"
+)
+
+func TestValidatorProfileValidate(t *testing.T) {
+	assert := assert.New(t)
+
+	holder := common.HexToAddress("0x2E833968E5bB786Ae419c4d13189fB081Cc43bab")
+
+	vp, err := NewValidatorProfile(holder, 500, 2000, 100, 10)
+	assert.Nil(err)
+	assert.Equal(uint64(500), vp.CommissionRate)
+	assert.Equal(uint64(10), vp.LastUpdateHeight)
+
+	_, err = NewValidatorProfile(holder, 500, CommissionRateBase+1, 100, 10)
+	assert.NotNil(err) // max rate exceeds 100%
+
+	_, err = NewValidatorProfile(holder, 2500, 2000, 100, 10)
+	assert.NotNil(err) // rate exceeds the max rate
+
+	_, err = NewValidatorProfile(holder, 500, 2000, 2500, 10)
+	assert.NotNil(err) // max change rate exceeds the max rate
+}
+
+func TestValidatorProfileUpdateCommissionRate(t *testing.T) {
+	assert := assert.New(t)
+
+	holder := common.HexToAddress("0x2E833968E5bB786Ae419c4d13189fB081Cc43bab")
+	vp, err := NewValidatorProfile(holder, 500, 2000, 100, 10)
+	assert.Nil(err)
+
+	// Too early
+	err = vp.UpdateCommissionRate(550, 10+CommissionRateUpdateInterval-1)
+	assert.NotNil(err)
+
+	// Change exceeds the max change rate
+	height := 10 + CommissionRateUpdateInterval
+	err = vp.UpdateCommissionRate(700, height)
+	assert.NotNil(err)
+	err = vp.UpdateCommissionRate(300, height)
+	assert.NotNil(err)
+
+	err = vp.UpdateCommissionRate(600, height)
+	assert.Nil(err)
+	assert.Equal(uint64(600), vp.CommissionRate)
+	assert.Equal(height, vp.LastUpdateHeight)
+
+	// Rate exceeds the max rate
+	vp.CommissionRate = 1950
+	err = vp.UpdateCommissionRate(2050, height+CommissionRateUpdateInterval)
+	assert.NotNil(err)
+
+	err = vp.UpdateCommissionRate(1900, height+CommissionRateUpdateInterval)
+	assert.Nil(err)
+	assert.Equal(uint64(1900), vp.CommissionRate)
+}
+
+func TestValidatorProfileCommission(t *testing.T) {
+	assert := assert.New(t)
+
+	holder := common.HexToAddress("0x2E833968E5bB786Ae419c4d13189fB081Cc43bab")
+	vp, err := NewValidatorProfile(holder, 500, 2000, 100, 10)
+	assert.Nil(err)
+
+	assert.Equal(big.NewInt(50), vp.Commission(big.NewInt(1000)))
+	assert.Equal(0, vp.Commission(big.NewInt(19)).Sign())
+
+	vp.CommissionRate = 0
+	assert.Equal(0, vp.Commission(big.NewInt(1000)).Sign())
+}
@@ -167,11 +167,11 @@ func TestValidatorCandidatePool(t *testing.T) {
 	log.Infof("")
 
 	height1 := uint64(100000)
-	assert.NotNil(vcp.WithdrawStake(sourceAddr4, holderAddr6, height1)) // no one deposited to holderAddr6 yet
-	assert.NotNil(vcp.WithdrawStake(sourceAddr4, holderAddr1, height1)) // sourceAddr4 never deposited to holderAddr1, should fail
-	assert.Nil(vcp.WithdrawStake(sourceAddr1, holderAddr2, height1))
-	assert.Nil(vcp.WithdrawStake(sourceAddr2, holderAddr2, height1))
-	assert.NotNil(vcp.WithdrawStake(sourceAddr2, holderAddr2, height1)) // sourceAddr2 cannot withdraw twice from holderAddr2
+	assert.NotNil(vcp.WithdrawStake(sourceAddr4, holderAddr6, height1, ReturnLockingPeriod)) // no one deposited to holderAddr6 yet
+	assert.NotNil(vcp.WithdrawStake(sourceAddr4, holderAddr1, height1, ReturnLockingPeriod)) // sourceAddr4 never deposited to holderAddr1, should fail
+	assert.Nil(vcp.WithdrawStake(sourceAddr1, holderAddr2, height1, ReturnLockingPeriod))
+	assert.Nil(vcp.WithdrawStake(sourceAddr2, holderAddr2, height1, ReturnLockingPeriod))
+	assert.NotNil(vcp.WithdrawStake(sourceAddr2, holderAddr2, height1, ReturnLockingPeriod)) // sourceAddr2 cannot withdraw twice from holderAddr2
 
 	assert.True(len(vcp.SortedCandidates) == 4)
 	checkAndPrintAllSortedCandidates(t, assert, vcp)
@@ -183,8 +183,8 @@ func TestValidatorCandidatePool(t *testing.T) {
 	log.Infof("--------------------------------------------------------")
 	log.Infof("")
 
-	assert.NotNil(vcp.WithdrawStake(sourceAddr1, holderAddr2, height1)) // sourceAddr1 cannot withdraw twice from holderAddr2
-	assert.Nil(vcp.WithdrawStake(sourceAddr3, holderAddr2, height1))
+	assert.NotNil(vcp.WithdrawStake(sourceAddr1, holderAddr2, height1, ReturnLockingPeriod)) // sourceAddr1 cannot withdraw twice from holderAddr2
+	assert.Nil(vcp.WithdrawStake(sourceAddr3, holderAddr2, height1, ReturnLockingPeriod))
 	assert.True(len(vcp.SortedCandidates) == 4) // holderAddr1's stake not returned yet, it should still be in the candidate list
 	assert.True(vcp.SortedCandidates[3].Holder == holderAddr2)
 	assert.True(vcp.SortedCandidates[3].TotalStake().Cmp(Zero) == 0) // All stakes are withdrawn
@@ -218,8 +218,8 @@ func TestValidatorCandidatePool(t *testing.T) {
 
 	height2 := height1 + 500
 
-	assert.NotNil(vcp.WithdrawStake(sourceAddr5, holderAddr6, height2)) // sourceAddr5 never deposited to holderAddr6, so cannot withraw from holderAddr6
-	assert.Nil(vcp.WithdrawStake(sourceAddr6, holderAddr6, height2))
+	assert.NotNil(vcp.WithdrawStake(sourceAddr5, holderAddr6, height2, ReturnLockingPeriod)) // sourceAddr5 never deposited to holderAddr6, so cannot withraw from holderAddr6
+	assert.Nil(vcp.WithdrawStake(sourceAddr6, holderAddr6, height2, ReturnLockingPeriod))
 	assert.NotNil(vcp.DepositStake(sourceAddr6, holderAddr6, stake6Amount2)) // cannot deposit during the withdrawal locking period
 	assert.True(len(vcp.SortedCandidates) == 6)                              // holderAddr6's stake not returned yet, should it should still be in the candidate list
 	assert.True(vcp.SortedCandidates[5].Holder == holderAddr6)
@@ -275,8 +275,8 @@ func TestValidatorCandidatePool(t *testing.T) {
 	log.Infof("--------------------------------------------------------")
 	log.Infof("")
 
-	assert.Nil(vcp.WithdrawStake(sourceAddr1, holderAddr1, height6))
-	assert.Nil(vcp.WithdrawStake(sourceAddr2, holderAddr1, height6))
+	assert.Nil(vcp.WithdrawStake(sourceAddr1, holderAddr1, height6, ReturnLockingPeriod))
+	assert.Nil(vcp.WithdrawStake(sourceAddr2, holderAddr1, height6, ReturnLockingPeriod))
 	assert.NotNil(vcp.DepositStake(sourceAddr2, holderAddr1, stake2Amount2)) // cannot deposit during the withdrawal locking period
 	assert.True(len(vcp.SortedCandidates) == 4)
 	assert.True(len(vcp.SortedCandidates[3].Stakes) == 3)
@@ -302,6 +302,109 @@ func TestValidatorCandidatePool(t *testing.T) {
 	checkAndPrintTopCandidates(t, assert, vcp, 3)
 }
 
+func TestValidatorCandidatePoolSlashStakes(t *testing.T) {
+	assert := assert.New(t)
+
+	sourceAddr1 := common.HexToAddress("0x111")
+	stake1Amount := new(big.Int).Mul(new(big.Int).SetUint64(1000), MinValidatorStakeDeposit)
+	sourceAddr2 := common.HexToAddress("0x222")
+	stake2Amount := new(big.Int).Mul(new(big.Int).SetUint64(3000), MinValidatorStakeDeposit)
+	sourceAddr3 := common.HexToAddress("0x333")
+	stake3Amount := new(big.Int).Mul(new(big.Int).SetUint64(3500), MinValidatorStakeDeposit)
+
+	holderAddr1 := common.HexToAddress("0xf01")
+	holderAddr2 := common.HexToAddress("0xf02")
+
+	vcp := &ValidatorCandidatePool{}
+	assert.Nil(vcp.DepositStake(sourceAddr1, holderAddr1, stake1Amount))
+	assert.Nil(vcp.DepositStake(sourceAddr2, holderAddr1, stake2Amount))
+	assert.Nil(vcp.DepositStake(sourceAddr3, holderAddr2, stake3Amount))
+	assert.Nil(vcp.WithdrawStake(sourceAddr2, holderAddr1, 100, ReturnLockingPeriod))
+	assert.Equal(holderAddr2, vcp.SortedCandidates[0].Holder)
+
+	_, err := vcp.SlashStakes(common.HexToAddress("0xf03"), 10)
+	assert.NotNil(err) // unknown holder
+	_, err = vcp.SlashStakes(holderAddr1, 101)
+	assert.NotNil(err) // invalid percentage
+
+	// The withdrawn stake is still locked, so it is slashed as well
+	slashed, err := vcp.SlashStakes(holderAddr2, 50)
+	assert.Nil(err)
+	assert.True(slashed.Cmp(new(big.Int).Div(stake3Amount, big.NewInt(2))) == 0)
+	slashed, err = vcp.SlashStakes(holderAddr1, 50)
+	assert.Nil(err)
+	assert.True(slashed.Cmp(new(big.Int).Div(new(big.Int).Add(stake1Amount, stake2Amount), big.NewInt(2))) == 0)
+
+	holder1 := vcp.FindStakeDelegate(holderAddr1)
+	assert.True(holder1.TotalStake().Cmp(new(big.Int).Div(stake1Amount, big.NewInt(2))) == 0)
+	assert.True(holder1.Stakes[1].Withdrawn)
+	assert.True(holder1.Stakes[1].Amount.Cmp(new(big.Int).Div(stake2Amount, big.NewInt(2))) == 0)
+
+	// Candidates are sorted again after slashing
+	assert.Equal(holderAddr2, vcp.SortedCandidates[0].Holder)
+	assert.Equal(holderAddr1, vcp.SortedCandidates[1].Holder)
+}
+
+func TestValidatorCandidatePoolRedelegateStake(t *testing.T) {
+	assert := assert.New(t)
+
+	sourceAddr1 := common.HexToAddress("0x111")
+	stake1Amount := new(big.Int).Mul(new(big.Int).SetUint64(1000), MinValidatorStakeDeposit)
+	sourceAddr2 := common.HexToAddress("0x222")
+	stake2Amount := new(big.Int).Mul(new(big.Int).SetUint64(3000), MinValidatorStakeDeposit)
+
+	holderAddr1 := common.HexToAddress("0xf01")
+	holderAddr2 := common.HexToAddress("0xf02")
+	holderAddr3 := common.HexToAddress("0xf03")
+
+	vcp := &ValidatorCandidatePool{}
+	assert.Nil(vcp.DepositStake(sourceAddr1, holderAddr1, stake1Amount))
+	assert.Nil(vcp.DepositStake(sourceAddr2, holderAddr1, stake2Amount))
+	assert.Nil(vcp.DepositStake(sourceAddr1, holderAddr2, stake1Amount))
+
+	_, err := vcp.RedelegateStake(sourceAddr1, holderAddr1, holderAddr1)
+	assert.NotNil(err) // same holder
+	_, err = vcp.RedelegateStake(sourceAddr1, holderAddr3, holderAddr1)
+	assert.NotNil(err) // unknown holder
+	_, err = vcp.RedelegateStake(sourceAddr2, holderAddr2, holderAddr1)
+	assert.NotNil(err) // unknown source
+
+	// Merge into the existing stake of the source
+	amount, err := vcp.RedelegateStake(sourceAddr1, holderAddr1, holderAddr2)
+	assert.Nil(err)
+	assert.True(amount.Cmp(stake1Amount) == 0)
+	assert.True(vcp.FindStakeDelegate(holderAddr1).TotalStake().Cmp(stake2Amount) == 0)
+	assert.True(vcp.FindStakeDelegate(holderAddr2).TotalStake().Cmp(new(big.Int).Mul(stake1Amount, big.NewInt(2))) == 0)
+	assert.Equal(holderAddr1, vcp.SortedCandidates[0].Holder)
+
+	// Redelegate to a new holder, the empty holder is removed
+	amount, err = vcp.RedelegateStake(sourceAddr2, holderAddr1, holderAddr3)
+	assert.Nil(err)
+	assert.True(amount.Cmp(stake2Amount) == 0)
+	assert.Nil(vcp.FindStakeDelegate(holderAddr1))
+	assert.Equal(2, len(vcp.SortedCandidates))
+	assert.Equal(holderAddr3, vcp.SortedCandidates[0].Holder)
+
+	// Withdrawn stakes can not be redelegated, nor receive redelegated stakes
+	assert.Nil(vcp.WithdrawStake(sourceAddr1, holderAddr2, 100, ReturnLockingPeriod))
+	_, err = vcp.RedelegateStake(sourceAddr1, holderAddr2, holderAddr3)
+	assert.NotNil(err)
+	assert.Nil(vcp.DepositStake(sourceAddr1, holderAddr3, stake1Amount))
+	_, err = vcp.RedelegateStake(sourceAddr1, holderAddr3, holderAddr2)
+	assert.NotNil(err)
+	assert.True(vcp.FindStakeDelegate(holderAddr3).TotalStake().Cmp(new(big.Int).Add(stake1Amount, stake2Amount)) == 0)
+
+	// Slash part of a single stake
+	slashed, err := vcp.SlashStake(sourceAddr2, holderAddr3, big.NewInt(100))
+	assert.Nil(err)
+	assert.Equal(int64(100), slashed.Int64())
+	slashed, err = vcp.SlashStake(sourceAddr2, holderAddr3, new(big.Int).Mul(stake2Amount, big.NewInt(2)))
+	assert.Nil(err)
+	assert.True(slashed.Cmp(new(big.Int).Sub(stake2Amount, big.NewInt(100))) == 0)
+	_, err = vcp.SlashStake(sourceAddr2, holderAddr1, big.NewInt(100))
+	assert.NotNil(err)
+}
+
 func TestValidatorSetUniqueSortedOrder(t *testing.T) {
 	assert := assert.New(t)
 
@@ -248,12 +248,12 @@ func adjustByOutputs(view *state.StoreView, accounts map[string]*types.Account,
 	}
 }
 
-func sanityCheckForGasPrice(gasPrice *big.Int) bool {
+func sanityCheckForGasPrice(gasPrice *big.Int, minGasPrice uint64) bool {
 	if gasPrice == nil {
 		return false
 	}
 
-	minimumGasPrice := new(big.Int).SetUint64(types.MinimumGasPrice)
+	minimumGasPrice := new(big.Int).SetUint64(minGasPrice)
 	if gasPrice.Cmp(minimumGasPrice) < 0 {
 		return false
 	}
@@ -261,9 +261,9 @@ func sanityCheckForGasPrice(gasPrice *big.Int) bool {
 	return true
 }
 
-func sanityCheckForFee(fee types.Coins) bool {
+func sanityCheckForFee(fee types.Coins, minFee uint64) bool {
 	fee = fee.NoNil()
-	minimumFee := new(big.Int).SetUint64(types.MinimumTransactionFeeTFuelWei)
+	minimumFee := new(big.Int).SetUint64(minFee)
 	return fee.ThetaWei.Cmp(types.Zero) == 0 && fee.TFuelWei.Cmp(minimumFee) >= 0
 }
 
@@ -33,39 +33,48 @@ type Executor struct {
 	consensus core.ConsensusEngine
 	valMgr    core.ValidatorManager
 
+	chainConfig *core.ChainConfig
+
 	coinbaseTxExec *CoinbaseTxExecutor
 	// slashTxExec          *SlashTxExecutor
-	sendTxExec           *SendTxExecutor
-	reserveFundTxExec    *ReserveFundTxExecutor
-	releaseFundTxExec    *ReleaseFundTxExecutor
-	servicePaymentTxExec *ServicePaymentTxExecutor
-	splitRuleTxExec      *SplitRuleTxExecutor
-	smartContractTxExec  *SmartContractTxExecutor
-	depositStakeTxExec   *DepositStakeExecutor
-	withdrawStakeTxExec  *WithdrawStakeExecutor
+	sendTxExec              *SendTxExecutor
+	reserveFundTxExec       *ReserveFundTxExecutor
+	releaseFundTxExec       *ReleaseFundTxExecutor
+	servicePaymentTxExec    *ServicePaymentTxExecutor
+	splitRuleTxExec         *SplitRuleTxExecutor
+	smartContractTxExec     *SmartContractTxExecutor
+	depositStakeTxExec      *DepositStakeExecutor
+	withdrawStakeTxExec     *WithdrawStakeExecutor
+	equivocationSlashTxExec *EquivocationSlashTxExecutor
+	validatorProfileTxExec  *ValidatorProfileTxExecutor
+	redelegateStakeTxExec   *RedelegateStakeExecutor
 
 	skipSanityCheck bool
 }
 
 // NewExecutor creates a new instance of Executor
-func NewExecutor(db database.Database, chain *blockchain.Chain, state *st.LedgerState, consensus core.ConsensusEngine, valMgr core.ValidatorManager) *Executor {
+func NewExecutor(db database.Database, chain *blockchain.Chain, state *st.LedgerState, consensus core.ConsensusEngine, valMgr core.ValidatorManager, chainConfig *core.ChainConfig) *Executor {
 	executor := &Executor{
 		db:             db,
 		chain:          chain,
 		state:          state,
 		consensus:      consensus,
 		valMgr:         valMgr,
-		coinbaseTxExec: NewCoinbaseTxExecutor(db, chain, state, consensus, valMgr),
+		chainConfig:    chainConfig,
+		coinbaseTxExec: NewCoinbaseTxExecutor(db, chain, state, consensus, valMgr, chainConfig),
 		// slashTxExec:          NewSlashTxExecutor(consensus, valMgr),
-		sendTxExec:           NewSendTxExecutor(),
-		reserveFundTxExec:    NewReserveFundTxExecutor(state),
-		releaseFundTxExec:    NewReleaseFundTxExecutor(state),
-		servicePaymentTxExec: NewServicePaymentTxExecutor(state),
-		splitRuleTxExec:      NewSplitRuleTxExecutor(state),
-		smartContractTxExec:  NewSmartContractTxExecutor(chain, state),
-		depositStakeTxExec:   NewDepositStakeExecutor(),
-		withdrawStakeTxExec:  NewWithdrawStakeExecutor(state),
-		skipSanityCheck:      false,
+		sendTxExec:              NewSendTxExecutor(chainConfig),
+		reserveFundTxExec:       NewReserveFundTxExecutor(state, chainConfig),
+		releaseFundTxExec:       NewReleaseFundTxExecutor(state, chainConfig),
+		servicePaymentTxExec:    NewServicePaymentTxExecutor(state, chainConfig),
+		splitRuleTxExec:         NewSplitRuleTxExecutor(state, chainConfig),
+		smartContractTxExec:     NewSmartContractTxExecutor(chain, state, chainConfig),
+		depositStakeTxExec:      NewDepositStakeExecutor(chainConfig),
+		withdrawStakeTxExec:     NewWithdrawStakeExecutor(state, chainConfig),
+		equivocationSlashTxExec: NewEquivocationSlashTxExecutor(chain, consensus, valMgr, chainConfig),
+		validatorProfileTxExec:  NewValidatorProfileTxExecutor(chainConfig),
+		redelegateStakeTxExec:   NewRedelegateStakeExecutor(chainConfig),
+		skipSanityCheck:         false,
 	}
 
 	return executor
@@ -92,6 +101,12 @@ func (exec *Executor) ScreenTx(tx types.Tx) (common.Hash, result.Result) {
 	return exec.processTx(tx, core.ScreenedView)
 }
 
+// ScreenTxWithView checks the validity of the given transaction against the given view, and
+// applies the transaction to the view if it is valid.
+func (exec *Executor) ScreenTxWithView(view *st.StoreView, tx types.Tx) (common.Hash, result.Result) {
+	return exec.processTxWithView(exec.state.GetChainID(), view, tx)
+}
+
 // GetTxInfo extracts tx information used by mempool to sort Txs.
 func (exec *Executor) GetTxInfo(tx types.Tx) (*core.TxInfo, result.Result) {
 	txExecutor := exec.getTxExecutor(tx)
@@ -116,6 +131,10 @@ func (exec *Executor) processTx(tx types.Tx, viewSel core.ViewSelector) (common.
 		view = exec.state.Screened()
 	}
 
+	return exec.processTxWithView(chainID, view, tx)
+}
+
+func (exec *Executor) processTxWithView(chainID string, view *st.StoreView, tx types.Tx) (common.Hash, result.Result) {
 	sanityCheckResult := exec.sanityCheck(chainID, view, tx)
 	if sanityCheckResult.IsError() {
 		return common.Hash{}, sanityCheckResult
@@ -171,7 +190,7 @@ func (exec *Executor) isTxTypeSupported(view *st.StoreView, tx types.Tx) bool {
 
 	switch tx.(type) {
 	case *types.SmartContractTx:
-		if blockHeight < common.HeightEnableSmartContract {
+		if blockHeight < exec.chainConfig.HeightEnableSmartContract {
 			return false
 		}
 	default:
@@ -206,6 +225,12 @@ func (exec *Executor) getTxExecutor(tx types.Tx) TxExecutor {
 		txExecutor = exec.withdrawStakeTxExec
 	case *types.DepositStakeTxV2:
 		txExecutor = exec.depositStakeTxExec
+	case *types.EquivocationSlashTx:
+		txExecutor = exec.equivocationSlashTxExec
+	case *types.ValidatorProfileTx:
+		txExecutor = exec.validatorProfileTxExec
+	case *types.RedelegateStakeTx:
+		txExecutor = exec.redelegateStakeTxExec
 	default:
 		txExecutor = nil
 	}
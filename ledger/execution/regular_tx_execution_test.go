@@ -7,8 +7,10 @@ import (
 
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"theta/blockchain"
 	"theta/common"
 	"theta/common/result"
+	"theta/core"
 	"theta/ledger/types"
 )
 
@@ -1167,7 +1169,7 @@ func TestSplitPaymentInternalMethod(t *testing.T) {
 		EndBlockHeight:   uint64(99999),
 	}
 
-	exec := NewServicePaymentTxExecutor(et.state())
+	exec := NewServicePaymentTxExecutor(et.state(), et.executor.chainConfig)
 	fullAmount := types.NewCoins(0, 10000)
 
 	// carol is the target account
@@ -2251,3 +2253,321 @@ func TestSplitRuleZeroDuration(t *testing.T) {
 	retrievedSplitRule2ndTime := et.state().Delivered().GetSplitRule(resourceID)
 	assert.Nil(retrievedSplitRule2ndTime) // Should be expired and got deleted
 }
+
+func TestEquivocationSlashTxRedelegatedStake(t *testing.T) {
+	assert := assert.New(t)
+	et := NewExecTest()
+
+	offender := types.MakeAcc("offender")
+	validator := types.MakeAcc("validator")
+	source1 := types.MakeAcc("source1")
+	source2 := types.MakeAcc("source2")
+	source3 := types.MakeAcc("source3")
+
+	view := et.state().Delivered()
+	vcp := &core.ValidatorCandidatePool{}
+	stake := new(big.Int).Mul(core.MinValidatorStakeDeposit, big.NewInt(2))
+	assert.Nil(vcp.DepositStake(source1.Address, offender.Address, stake))
+	assert.Nil(vcp.DepositStake(source2.Address, offender.Address, stake))
+	assert.Nil(vcp.DepositStake(source3.Address, validator.Address, stake))
+
+	// Source2 redelegates its stake away from the offender after the double sign
+	evidenceHeight := uint64(1)
+	_, err := vcp.RedelegateStake(source2.Address, offender.Address, validator.Address)
+	assert.Nil(err)
+	view.UpdateValidatorCandidatePool(vcp)
+	rl := &core.RedelegationList{}
+	rl.Append(&core.Redelegation{
+		Source:     source2.Address,
+		FromHolder: offender.Address,
+		ToHolder:   validator.Address,
+		Purpose:    core.StakeForValidator,
+		Amount:     stake,
+		Height:     evidenceHeight + 1,
+	})
+	view.UpdateRedelegationList(rl)
+
+	createHeader := func(stateHash string) *core.BlockHeader {
+		header := &core.BlockHeader{
+			ChainID:   et.chainID,
+			Height:    evidenceHeight,
+			Epoch:     1,
+			Parent:    common.HexToHash("0x1"),
+			StateHash: common.HexToHash(stateHash),
+			Proposer:  offender.Address,
+			Timestamp: big.NewInt(1),
+		}
+		header.HCC.BlockHash = common.HexToHash("0x1")
+		header.Signature = offender.Sign(header.SignBytes())
+		return header
+	}
+	tx := &types.EquivocationSlashTx{
+		Proposer: types.TxInput{Address: et.accProposer.Address},
+		Evidence: core.NewConflictingProposalsEvidence(createHeader("0xa"), createHeader("0xb")),
+	}
+	tx.Proposer.Signature = et.accProposer.Sign(tx.SignBytes(et.chainID))
+
+	// Equivocation slashing is not active on the mainnet config yet
+	res := et.executor.getTxExecutor(tx).sanityCheck(et.chainID, view, tx)
+	assert.True(res.IsError())
+	assert.Equal("Feature equivocation slash is not active yet", res.Message)
+
+	_, res = et.executor.getTxExecutor(tx).process(et.chainID, view, tx)
+	assert.True(res.IsOK(), res.Message)
+
+	slashed := func(amount *big.Int) *big.Int {
+		forfeited := new(big.Int).Mul(amount, big.NewInt(core.DoubleSignSlashPercentage))
+		forfeited.Div(forfeited, big.NewInt(100))
+		return new(big.Int).Sub(amount, forfeited)
+	}
+	stakeAmount := func(holder, source common.Address) *big.Int {
+		candidate := view.GetValidatorCandidatePool().FindStakeDelegate(holder)
+		for _, s := range candidate.Stakes {
+			if s.Source == source {
+				return s.Amount
+			}
+		}
+		return nil
+	}
+
+	// The stakes delegated to the offender and the stake redelegated away from it are slashed,
+	// while the other stakes of the new holder are not
+	assert.Equal(slashed(stake), stakeAmount(offender.Address, source1.Address))
+	assert.Equal(slashed(stake), stakeAmount(validator.Address, source2.Address))
+	assert.Equal(stake, stakeAmount(validator.Address, source3.Address))
+	assert.True(view.DoubleSignSlashed(offender.Address, evidenceHeight))
+	assert.Equal([]uint64{view.Height() + 1}, view.GetStakeTransactionHeightList().Heights)
+}
+
+type evidenceTestLedger struct {
+	core.Ledger
+	currentBlock *core.Block
+}
+
+func (l *evidenceTestLedger) GetCurrentBlock() *core.Block { return l.currentBlock }
+
+type evidenceTestConsensusEngine struct {
+	*TestConsensusEngine
+	ledger core.Ledger
+}
+
+func (tce *evidenceTestConsensusEngine) GetLedger() core.Ledger { return tce.ledger }
+
+type evidenceTestValidatorManager struct {
+	TestValidatorManager
+	nextValSets map[common.Hash]*core.ValidatorSet
+}
+
+func (tvm *evidenceTestValidatorManager) GetNextValidatorSet(blockHash common.Hash) *core.ValidatorSet {
+	return tvm.nextValSets[blockHash]
+}
+
+func TestEquivocationSlashTxOffenderValidatorAtEvidenceHeight(t *testing.T) {
+	assert := assert.New(t)
+	et := NewExecTest()
+
+	offender := types.MakeAcc("offender")
+	view := et.state().Delivered()
+	vcp := &core.ValidatorCandidatePool{}
+	assert.Nil(vcp.DepositStake(offender.Address, offender.Address, core.MinValidatorStakeDeposit))
+	view.UpdateValidatorCandidatePool(vcp)
+
+	// The block at the evidence height is the ancestor of the current block
+	chain := blockchain.CreateTestChainByBlocks([]string{
+		"a1", "a0",
+		"a2", "a1",
+	})
+	a0 := core.CreateTestBlock("a0", "")
+	a1 := core.CreateTestBlock("a1", "a0")
+	a2 := core.CreateTestBlock("a2", "a1")
+	evidenceHeight := a1.Height
+	assert.True(evidenceHeight < view.Height()+1)
+
+	proposer := core.NewValidator(et.accProposer.Address.Hex(), core.MinValidatorStakeDeposit)
+	currentValSet := core.NewValidatorSet()
+	currentValSet.AddValidator(proposer)
+	evidenceValSet := core.NewValidatorSet()
+	evidenceValSet.AddValidator(proposer)
+	evidenceValSet.AddValidator(core.NewValidator(offender.Address.Hex(), core.MinValidatorStakeDeposit))
+	valMgr := &evidenceTestValidatorManager{
+		nextValSets: map[common.Hash]*core.ValidatorSet{
+			a1.Hash(): currentValSet,
+			a0.Hash(): evidenceValSet,
+		},
+	}
+	consensus := &evidenceTestConsensusEngine{
+		TestConsensusEngine: NewTestConsensusEngine("localseed"),
+		ledger:              &evidenceTestLedger{currentBlock: a2},
+	}
+	chainConfig := core.MainnetChainConfig()
+	chainConfig.HeightEnableEquivocationSlash = 0
+	exec := NewEquivocationSlashTxExecutor(chain, consensus, valMgr, chainConfig)
+
+	createHeader := func(stateHash string) *core.BlockHeader {
+		header := &core.BlockHeader{
+			ChainID:   et.chainID,
+			Height:    evidenceHeight,
+			Epoch:     1,
+			Parent:    common.HexToHash("0x1"),
+			StateHash: common.HexToHash(stateHash),
+			Proposer:  offender.Address,
+			Timestamp: big.NewInt(1),
+		}
+		header.HCC.BlockHash = common.HexToHash("0x1")
+		header.Signature = offender.Sign(header.SignBytes())
+		return header
+	}
+	tx := &types.EquivocationSlashTx{
+		Proposer: types.TxInput{Address: et.accProposer.Address},
+		Evidence: core.NewConflictingProposalsEvidence(createHeader("0xa"), createHeader("0xb")),
+	}
+	tx.Proposer.Signature = et.accProposer.Sign(tx.SignBytes(et.chainID))
+
+	// The offender is not a validator of the current block, but was one at the evidence height
+	res := exec.sanityCheck(et.chainID, view, tx)
+	assert.True(res.IsOK(), res.Message)
+
+	// An offender with stake which was not a validator at the evidence height can not be slashed
+	valMgr.nextValSets[a0.Hash()] = currentValSet
+	res = exec.sanityCheck(et.chainID, view, tx)
+	assert.True(res.IsError())
+	assert.Equal(fmt.Sprintf("Validator %v was not a validator at height %v", offender.Address.Hex(), evidenceHeight), res.Message)
+}
+
+func TestValidatorProfileTx(t *testing.T) {
+	assert := assert.New(t)
+	et := NewExecTest()
+
+	validator := types.MakeAcc("validator")
+	et.acc2State(validator)
+	vcp := &core.ValidatorCandidatePool{}
+	assert.Nil(vcp.DepositStake(validator.Address, validator.Address, core.MinValidatorStakeDeposit))
+	et.state().Delivered().UpdateValidatorCandidatePool(vcp)
+	et.fastforwardTo(core.CommissionRateUpdateInterval)
+
+	chainConfig := *core.GetChainConfig(et.chainID)
+	chainConfig.HeightEnableValidatorCommission = 0
+	exec := NewValidatorProfileTxExecutor(&chainConfig)
+
+	sequence := uint64(0)
+	createTx := func(rate, maxRate, maxChangeRate uint64) *types.ValidatorProfileTx {
+		tx := &types.ValidatorProfileTx{
+			Fee:                     types.NewCoins(0, int64(chainConfig.MinimumTransactionFeeTFuelWei)),
+			Holder:                  types.TxInput{Address: validator.Address, Sequence: sequence + 1},
+			CommissionRate:          rate,
+			MaxCommissionRate:       maxRate,
+			MaxCommissionChangeRate: maxChangeRate,
+		}
+		tx.Holder.Signature = validator.Sign(tx.SignBytes(et.chainID))
+		return tx
+	}
+	execTx := func(tx *types.ValidatorProfileTx) result.Result {
+		view := et.state().Delivered()
+		if res := exec.sanityCheck(et.chainID, view, tx); res.IsError() {
+			return res
+		}
+		_, res := exec.process(et.chainID, view, tx)
+		if res.IsOK() {
+			sequence++
+		}
+		return res
+	}
+
+	// A validator without a profile charges no commission, so the created profile can not
+	// jump to the max commission rate
+	res := execTx(createTx(2000, 2000, 500))
+	assert.True(res.IsError())
+	assert.Nil(et.state().Delivered().GetValidatorProfile(validator.Address))
+
+	// The profile is created within the max change rate
+	res = execTx(createTx(500, 2000, 500))
+	assert.True(res.IsOK(), res.Message)
+	profile := et.state().Delivered().GetValidatorProfile(validator.Address)
+	assert.NotNil(profile)
+	assert.Equal(uint64(500), profile.CommissionRate)
+	assert.Equal(uint64(2000), profile.MaxCommissionRate)
+	assert.Equal(uint64(500), profile.MaxCommissionChangeRate)
+	assert.Equal(core.CommissionRateUpdateInterval+1, profile.LastUpdateHeight)
+
+	// The rate can not be changed again within the update interval
+	et.fastforwardBy(100)
+	res = execTx(createTx(1000, 2000, 500))
+	assert.True(res.IsError())
+
+	// The max rates can not be changed
+	et.fastforwardBy(core.CommissionRateUpdateInterval)
+	res = execTx(createTx(1000, 3000, 500))
+	assert.True(res.IsError())
+
+	// The rate can not change more than the max change rate
+	res = execTx(createTx(1100, 2000, 500))
+	assert.True(res.IsError())
+
+	res = execTx(createTx(1000, 2000, 500))
+	assert.True(res.IsOK(), res.Message)
+	assert.Equal(uint64(1000), et.state().Delivered().GetValidatorProfile(validator.Address).CommissionRate)
+
+	// Only the stake holders can create a profile
+	other := types.MakeAcc("other")
+	et.acc2State(other)
+	tx := createTx(100, 2000, 500)
+	tx.Holder = types.TxInput{Address: other.Address, Sequence: 1}
+	tx.Holder.Signature = other.Sign(tx.SignBytes(et.chainID))
+	res = execTx(tx)
+	assert.True(res.IsError())
+}
+
+func TestGrantValidatorCommission(t *testing.T) {
+	assert := assert.New(t)
+	et := NewExecTest()
+
+	validator := types.MakeAcc("validator")
+	val2 := types.MakeAcc("val2")
+	source := types.MakeAcc("source")
+
+	view := et.state().Delivered()
+	vcp := &core.ValidatorCandidatePool{}
+	stake := core.MinValidatorStakeDeposit
+	assert.Nil(vcp.DepositStake(validator.Address, validator.Address, stake))
+	assert.Nil(vcp.DepositStake(source.Address, validator.Address, stake))
+	assert.Nil(vcp.DepositStake(val2.Address, val2.Address, stake))
+	assert.Nil(vcp.DepositStake(source.Address, val2.Address, stake))
+	view.UpdateValidatorCandidatePool(vcp)
+
+	profile, err := core.NewValidatorProfile(validator.Address, 1000, 2000, 500, 1)
+	assert.Nil(err)
+	view.SetValidatorProfile(profile)
+
+	validatorSet := core.NewValidatorSet()
+	validatorSet.AddValidator(core.NewValidator(validator.Address.Hex(), stake))
+	validatorSet.AddValidator(core.NewValidator(val2.Address.Hex(), stake))
+	stakeSourceMap := map[common.Address]*big.Int{
+		validator.Address: stake,
+		val2.Address:      stake,
+		source.Address:    new(big.Int).Mul(stake, big.NewInt(2)),
+	}
+	createRewards := func() map[string]types.Coins {
+		return map[string]types.Coins{
+			string(validator.Address[:]): types.NewCoins(0, 1000),
+			string(val2.Address[:]):      types.NewCoins(0, 1000),
+			string(source.Address[:]):    types.NewCoins(0, 2000),
+		}
+	}
+	blockHeight := view.Height() + 1
+
+	// No commission is charged before the feature is enabled
+	chainConfig := *core.GetChainConfig(et.chainID)
+	chainConfig.HeightEnableValidatorCommission = blockHeight + 1
+	accountReward := createRewards()
+	grantValidatorCommission(&chainConfig, view, validatorSet, stakeSourceMap, &accountReward, blockHeight)
+	assert.Equal(createRewards(), accountReward)
+
+	// Half of the reward of the source is earned by the stake delegated to the validator, and 10%
+	// of it goes to the validator. The validator without a profile charges no commission, and no
+	// commission is charged on the own stake of the validator.
+	chainConfig.HeightEnableValidatorCommission = blockHeight
+	grantValidatorCommission(&chainConfig, view, validatorSet, stakeSourceMap, &accountReward, blockHeight)
+	assert.Equal(big.NewInt(1100), accountReward[string(validator.Address[:])].TFuelWei)
+	assert.Equal(big.NewInt(1000), accountReward[string(val2.Address[:])].TFuelWei)
+	assert.Equal(big.NewInt(1900), accountReward[string(source.Address[:])].TFuelWei)
+}
@@ -6,11 +6,11 @@ import (
 	"fmt"
 	"math/big"
 	"testing"
-	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"theta/common"
+	"theta/core"
 	"theta/ledger/types"
 	"theta/ledger/vm"
 )
@@ -82,7 +82,7 @@ func TestSimpleSmartContractDeploymentAndExecution(t *testing.T) {
 // contract TestCustomToken {
 //     using SafeMath for uint;
 //     mapping (address => uint) balances;
-//     address public constant ADMIN = 0x4d4ce78b09F8A06C0d3063a315dC9c011F6e876E;
+//     address public constant ADMIN = 0xdB58A9e59eF9Fb7AF7EB369b088C5a973972FE37;
 //
 //     function mint() public {
 //         require(msg.sender == ADMIN);
@@ -115,7 +115,7 @@ func TestCustomTokenSmartContract(t *testing.T) {
 	user2PrivAcc := &privAccounts[3]
 
 	adminAddr := adminPrivAcc.Address
-	assert.Equal(common.HexToAddress("0x4d4ce78b09F8A06C0d3063a315dC9c011F6e876E"), adminAddr)
+	assert.Equal(common.HexToAddress("0xdB58A9e59eF9Fb7AF7EB369b088C5a973972FE37"), adminAddr)
 	deployerAddr := deployerPrivAcc.Address
 	user1Addr := user1PrivAcc.Address
 	user2Addr := user2PrivAcc.Address
@@ -271,10 +271,10 @@ func deploySmartContract(et *execTest, deployerPrivAcc *types.PrivAccount,
 	deploySCTx.From.Signature = deployerPrivAcc.Sign(signBytes)
 
 	// Dry run to get the smart contract address when it is actually deployed
-	parentBlock := &core.Block {
-		BlockHeader: &core.BlockHeader {
-			Height: 1,
-			Timestamp: 1601599331,
+	parentBlock := &core.Block{
+		BlockHeader: &core.BlockHeader{
+			Height:    1,
+			Timestamp: big.NewInt(1601599331),
 		},
 	}
 	stateCopy, err := et.state().Delivered().Copy()
@@ -337,13 +337,13 @@ func callSmartContract(et *execTest, contractAddr common.Address, callerPrivAcc
 	stateCopy, err := et.state().Delivered().Copy()
 	assert.Nil(err)
 
-	parentBlock := &core.Block {
-		BlockHeader: &core.BlockHeader {
-			Height: 1,
-			Timestamp: 1601599331,
+	parentBlock := &core.Block{
+		BlockHeader: &core.BlockHeader{
+			Height:    1,
+			Timestamp: big.NewInt(1601599331),
 		},
 	}
-	vmRet, execContractAddr, gasUsed, vmErr := vm.Execute(callSCTX, stateCopy)
+	vmRet, execContractAddr, gasUsed, vmErr := vm.Execute(parentBlock, callSCTX, stateCopy)
 	assert.Equal(contractAddr, execContractAddr)
 	log.Infof("[Call      ] gas used: %v", gasUsed)
 
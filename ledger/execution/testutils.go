@@ -37,6 +37,10 @@ func (tce *TestConsensusEngine) GetLedger() core.Ledger            { return nil
 func (tce *TestConsensusEngine) GetLastFinalizedBlock() *core.ExtendedBlock {
 	return &core.ExtendedBlock{}
 }
+func (tce *TestConsensusEngine) GetPendingEvidence() []*core.DoubleSignEvidence { return nil }
+func (tce *TestConsensusEngine) ChainConfig() *core.ChainConfig {
+	return core.MainnetChainConfig()
+}
 
 func NewTestConsensusEngine(seed string) *TestConsensusEngine {
 	privKey, _, _ := crypto.TEST_GenerateKeyPairWithSeed(seed)
@@ -123,7 +127,7 @@ func (et *execTest) reset() {
 	valMgr := NewTestValidatorManager(propser, valSet)
 
 	chain := blockchain.CreateTestChain()
-	executor := NewExecutor(db, chain, ledgerState, consensus, valMgr)
+	executor := NewExecutor(db, chain, ledgerState, consensus, valMgr, core.GetChainConfig(chainID))
 
 	et.chainID = chainID
 	et.executor = executor
@@ -28,21 +28,23 @@ var _ TxExecutor = (*CoinbaseTxExecutor)(nil)
 
 // CoinbaseTxExecutor implements the TxExecutor interface
 type CoinbaseTxExecutor struct {
-	db        database.Database
-	chain     *blockchain.Chain
-	state     *st.LedgerState
-	consensus core.ConsensusEngine
-	valMgr    core.ValidatorManager
+	db          database.Database
+	chain       *blockchain.Chain
+	state       *st.LedgerState
+	consensus   core.ConsensusEngine
+	valMgr      core.ValidatorManager
+	chainConfig *core.ChainConfig
 }
 
 // NewCoinbaseTxExecutor creates a new instance of CoinbaseTxExecutor
-func NewCoinbaseTxExecutor(db database.Database, chain *blockchain.Chain, state *st.LedgerState, consensus core.ConsensusEngine, valMgr core.ValidatorManager) *CoinbaseTxExecutor {
+func NewCoinbaseTxExecutor(db database.Database, chain *blockchain.Chain, state *st.LedgerState, consensus core.ConsensusEngine, valMgr core.ValidatorManager, chainConfig *core.ChainConfig) *CoinbaseTxExecutor {
 	return &CoinbaseTxExecutor{
-		db:        db,
-		chain:     chain,
-		state:     state,
-		consensus: consensus,
-		valMgr:    valMgr,
+		db:          db,
+		chain:       chain,
+		state:       state,
+		consensus:   consensus,
+		valMgr:      valMgr,
+		chainConfig: chainConfig,
 	}
 }
 
@@ -94,8 +96,8 @@ func (exec *CoinbaseTxExecutor) sanityCheck(chainID string, view *st.StoreView,
 	var expectedRewards map[string]types.Coins
 	guardianVotes := exec.consensus.GetLedger().GetCurrentBlock().GuardianVotes
 
-	if tx.BlockHeight < common.HeightEnableTheta2 || guardianVotes == nil {
-		expectedRewards = CalculateReward(exec.consensus.GetLedger(), view, validatorSet, nil, nil)
+	if tx.BlockHeight < exec.chainConfig.HeightEnableTheta2 || guardianVotes == nil {
+		expectedRewards = CalculateReward(exec.chainConfig, exec.consensus.GetLedger(), view, validatorSet, nil, nil)
 	} else {
 		guradianVoteBlock, err := exec.chain.FindBlock(guardianVotes.Block)
 		if err != nil {
@@ -103,7 +105,7 @@ func (exec *CoinbaseTxExecutor) sanityCheck(chainID string, view *st.StoreView,
 		}
 		storeView := st.NewStoreView(guradianVoteBlock.Height, guradianVoteBlock.StateHash, exec.db)
 		guardianCandidatePool := storeView.GetGuardianCandidatePool()
-		expectedRewards = CalculateReward(exec.consensus.GetLedger(), view, validatorSet, guardianVotes, guardianCandidatePool)
+		expectedRewards = CalculateReward(exec.chainConfig, exec.consensus.GetLedger(), view, validatorSet, guardianVotes, guardianCandidatePool)
 	}
 
 	if len(expectedRewards) != len(tx.Outputs) {
@@ -147,17 +149,17 @@ func (exec *CoinbaseTxExecutor) process(chainID string, view *st.StoreView, tran
 }
 
 // CalculateReward calculates the block reward for each account
-func CalculateReward(ledger core.Ledger, view *st.StoreView, validatorSet *core.ValidatorSet, guardianVotes *core.AggregatedVotes, guardianPool *core.GuardianCandidatePool) map[string]types.Coins {
+func CalculateReward(chainConfig *core.ChainConfig, ledger core.Ledger, view *st.StoreView, validatorSet *core.ValidatorSet, guardianVotes *core.AggregatedVotes, guardianPool *core.GuardianCandidatePool) map[string]types.Coins {
 	accountReward := map[string]types.Coins{}
 	blockHeight := view.Height() + 1 // view points to the parent block
-	if blockHeight < common.HeightEnableValidatorReward {
+	if blockHeight < chainConfig.HeightEnableValidatorReward {
 		grantValidatorsWithZeroReward(validatorSet, &accountReward)
-	} else if blockHeight < common.HeightEnableTheta2 || guardianVotes == nil || guardianPool == nil {
-		grantValidatorReward(ledger, view, validatorSet, &accountReward, blockHeight)
-	} else if blockHeight < common.HeightSampleStakingReward {
-		grantStakerReward(ledger, view, validatorSet, guardianVotes, guardianPool, &accountReward, blockHeight)
+	} else if blockHeight < chainConfig.HeightEnableTheta2 || guardianVotes == nil || guardianPool == nil {
+		grantValidatorReward(chainConfig, ledger, view, validatorSet, &accountReward, blockHeight)
+	} else if blockHeight < chainConfig.HeightSampleStakingReward {
+		grantStakerReward(chainConfig, ledger, view, validatorSet, guardianVotes, guardianPool, &accountReward, blockHeight)
 	} else {
-		grantStakerRewardRandomized(ledger, view, validatorSet, guardianVotes, guardianPool, &accountReward, blockHeight)
+		grantStakerRewardRandomized(chainConfig, ledger, view, validatorSet, guardianVotes, guardianPool, &accountReward, blockHeight)
 	}
 
 	return accountReward
@@ -171,7 +173,7 @@ func grantValidatorsWithZeroReward(validatorSet *core.ValidatorSet, accountRewar
 	}
 }
 
-func grantValidatorReward(ledger core.Ledger, view *st.StoreView, validatorSet *core.ValidatorSet, accountReward *map[string]types.Coins, blockHeight uint64) {
+func grantValidatorReward(chainConfig *core.ChainConfig, ledger core.Ledger, view *st.StoreView, validatorSet *core.ValidatorSet, accountReward *map[string]types.Coins, blockHeight uint64) {
 	if !common.IsCheckPointHeight(blockHeight) {
 		return
 	}
@@ -227,9 +229,24 @@ func grantValidatorReward(ledger core.Ledger, view *st.StoreView, validatorSet *
 
 		logger.Infof("Block reward for staker %v : %v", hex.EncodeToString(stakeSourceAddr[:]), reward)
 	}
+
+	grantValidatorCommission(chainConfig, view, validatorSet, stakeSourceMap, accountReward, blockHeight)
+}
+
+// hasInsufficientUptime returns whether the staking reward of the guardian should be withheld since
+// it participated in too few of the recent checkpoint votes
+func hasInsufficientUptime(chainConfig *core.ChainConfig, view *st.StoreView, guardian common.Address, blockHeight uint64) bool {
+	if blockHeight < chainConfig.HeightEnableGuardianUptimePenalty {
+		return false
+	}
+	uptime := view.GetGuardianUptime(guardian)
+	if uptime == nil {
+		return false
+	}
+	return uptime.IsBelowThreshold()
 }
 
-func grantStakerReward(ledger core.Ledger, view *st.StoreView, validatorSet *core.ValidatorSet, guardianVotes *core.AggregatedVotes,
+func grantStakerReward(chainConfig *core.ChainConfig, ledger core.Ledger, view *st.StoreView, validatorSet *core.ValidatorSet, guardianVotes *core.AggregatedVotes,
 	guardianPool *core.GuardianCandidatePool, accountReward *map[string]types.Coins, blockHeight uint64) {
 	if !common.IsCheckPointHeight(blockHeight) {
 		return
@@ -281,6 +298,10 @@ func grantStakerReward(ledger core.Ledger, view *st.StoreView, validatorSet *cor
 		if guardianVotes.Multiplies[i] == 0 {
 			continue
 		}
+		if hasInsufficientUptime(chainConfig, view, g.Holder, blockHeight) {
+			logger.Infof("Withhold the staking reward of guardian %v due to low uptime", g.Holder.Hex())
+			continue
+		}
 		stakes := g.Stakes
 		for _, stake := range stakes {
 			if stake.Withdrawn {
@@ -316,9 +337,11 @@ func grantStakerReward(ledger core.Ledger, view *st.StoreView, validatorSet *cor
 
 		logger.Infof("Block reward for staker %v : %v", hex.EncodeToString(stakeSourceAddr[:]), reward)
 	}
+
+	grantValidatorCommission(chainConfig, view, validatorSet, stakeSourceMap, accountReward, blockHeight)
 }
 
-func grantStakerRewardRandomized(ledger core.Ledger, view *st.StoreView, validatorSet *core.ValidatorSet, guardianVotes *core.AggregatedVotes,
+func grantStakerRewardRandomized(chainConfig *core.ChainConfig, ledger core.Ledger, view *st.StoreView, validatorSet *core.ValidatorSet, guardianVotes *core.AggregatedVotes,
 	guardianPool *core.GuardianCandidatePool, accountReward *map[string]types.Coins, blockHeight uint64) {
 	if !common.IsCheckPointHeight(blockHeight) {
 		return
@@ -370,6 +393,10 @@ func grantStakerRewardRandomized(ledger core.Ledger, view *st.StoreView, validat
 		if guardianVotes.Multiplies[i] == 0 {
 			continue
 		}
+		if hasInsufficientUptime(chainConfig, view, g.Holder, blockHeight) {
+			logger.Infof("Withhold the staking reward of guardian %v due to low uptime", g.Holder.Hex())
+			continue
+		}
 		stakes := g.Stakes
 		for _, stake := range stakes {
 			if stake.Withdrawn {
@@ -442,6 +469,84 @@ func grantStakerRewardRandomized(ledger core.Ledger, view *st.StoreView, validat
 			logger.Infof("Block reward for staker %v : %v", hex.EncodeToString(stakeSourceAddr[:]), reward)
 		}
 	}
+
+	grantValidatorCommission(chainConfig, view, validatorSet, stakeSourceMap, accountReward, blockHeight)
+}
+
+// grantValidatorCommission moves the commission on the reward of the stakes delegated to a validator
+// from the stake sources to the validator. The reward of a source is attributed to its stakes pro rata,
+// and the commission is charged on the part earned by the stakes delegated to the validator.
+func grantValidatorCommission(chainConfig *core.ChainConfig, view *st.StoreView, validatorSet *core.ValidatorSet, stakeSourceMap map[common.Address]*big.Int,
+	accountReward *map[string]types.Coins, blockHeight uint64) {
+	if blockHeight < chainConfig.HeightEnableValidatorCommission {
+		return
+	}
+
+	// The commissions are calculated on the rewards before any commission is charged
+	sourceRewardMap := map[common.Address]*big.Int{}
+	for stakeSource := range stakeSourceMap {
+		if reward, exists := (*accountReward)[string(stakeSource[:])]; exists {
+			sourceRewardMap[stakeSource] = reward.NoNil().TFuelWei
+		}
+	}
+
+	// Reload the VCP, since the stake amounts in stakeSourceMap might be shared with the pool of the caller
+	vcp := view.GetValidatorCandidatePool()
+	for _, v := range validatorSet.Validators() {
+		validatorAddr := v.Address
+		profile := view.GetValidatorProfile(validatorAddr)
+		if profile == nil || profile.CommissionRate == 0 {
+			continue
+		}
+		stakeDelegate := vcp.FindStakeDelegate(validatorAddr)
+		if stakeDelegate == nil {
+			continue
+		}
+
+		totalCommission := big.NewInt(0)
+		for _, stake := range stakeDelegate.Stakes {
+			if stake.Withdrawn || stake.Source == validatorAddr {
+				continue
+			}
+			sourceReward, exists := sourceRewardMap[stake.Source]
+			if !exists || sourceReward.Sign() == 0 {
+				continue
+			}
+			sourceStake := stakeSourceMap[stake.Source]
+			if sourceStake == nil || sourceStake.Sign() == 0 {
+				continue
+			}
+
+			stakeReward := new(big.Int).Mul(sourceReward, stake.Amount)
+			stakeReward.Div(stakeReward, sourceStake)
+			commission := profile.Commission(stakeReward)
+			if commission.Sign() == 0 {
+				continue
+			}
+
+			sourceKey := string(stake.Source[:])
+			(*accountReward)[sourceKey] = (*accountReward)[sourceKey].Minus(types.Coins{
+				ThetaWei: big.NewInt(0),
+				TFuelWei: commission,
+			})
+			totalCommission.Add(totalCommission, commission)
+		}
+
+		if totalCommission.Sign() == 0 {
+			continue
+		}
+		validatorKey := string(validatorAddr[:])
+		reward, exists := (*accountReward)[validatorKey]
+		if !exists {
+			reward = types.NewCoins(0, 0)
+		}
+		(*accountReward)[validatorKey] = reward.Plus(types.Coins{
+			ThetaWei: big.NewInt(0),
+			TFuelWei: totalCommission,
+		})
+
+		logger.Infof("Commission for validator %v : %v", hex.EncodeToString(validatorAddr[:]), totalCommission)
+	}
 }
 
 func (exec *CoinbaseTxExecutor) getTxInfo(transaction types.Tx) *core.TxInfo {
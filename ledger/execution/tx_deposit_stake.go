@@ -17,17 +17,20 @@ var _ TxExecutor = (*DepositStakeExecutor)(nil)
 
 // DepositStakeExecutor implements the TxExecutor interface
 type DepositStakeExecutor struct {
+	chainConfig *core.ChainConfig
 }
 
 // NewDepositStakeExecutor creates a new instance of DepositStakeExecutor
-func NewDepositStakeExecutor() *DepositStakeExecutor {
-	return &DepositStakeExecutor{}
+func NewDepositStakeExecutor(chainConfig *core.ChainConfig) *DepositStakeExecutor {
+	return &DepositStakeExecutor{
+		chainConfig: chainConfig,
+	}
 }
 
 func (exec *DepositStakeExecutor) sanityCheck(chainID string, view *st.StoreView, transaction types.Tx) result.Result {
 	// Feature block height check
 	blockHeight := view.Height() + 1 // the view points to the parent of the current block
-	if _, ok := transaction.(*types.DepositStakeTxV2); ok && blockHeight < common.HeightEnableTheta2 {
+	if _, ok := transaction.(*types.DepositStakeTxV2); ok && blockHeight < exec.chainConfig.HeightEnableTheta2 {
 		return result.Error("Feature guardian is not active yet")
 	}
 
@@ -50,9 +53,9 @@ func (exec *DepositStakeExecutor) sanityCheck(chainID string, view *st.StoreView
 		return res
 	}
 
-	if !sanityCheckForFee(tx.Fee) {
+	if !sanityCheckForFee(tx.Fee, exec.chainConfig.MinimumTransactionFeeTFuelWei) {
 		return result.Error("Insufficient fee. Transaction fee needs to be at least %v TFuelWei",
-			types.MinimumTransactionFeeTFuelWei).WithErrorCode(result.CodeInvalidFee)
+			exec.chainConfig.MinimumTransactionFeeTFuelWei).WithErrorCode(result.CodeInvalidFee)
 	}
 
 	if !(tx.Purpose == core.StakeForValidator || tx.Purpose == core.StakeForGuardian) {
@@ -78,10 +81,7 @@ func (exec *DepositStakeExecutor) sanityCheck(chainID string, view *st.StoreView
 	}
 
 	if tx.Purpose == core.StakeForGuardian {
-		minGuardianStake := core.MinGuardianStakeDeposit
-		if blockHeight >= common.HeightLowerGNStakeThresholdTo1000 {
-			minGuardianStake = core.MinGuardianStakeDeposit1000
-		}
+		minGuardianStake := exec.chainConfig.MinGuardianStakeDeposit(blockHeight)
 		if stake.ThetaWei.Cmp(minGuardianStake) < 0 {
 			return result.Error("Insufficient amount of stake, at least %v ThetaWei is required for each guardian deposit", minGuardianStake).
 				WithErrorCode(result.CodeInsufficientStake)
@@ -154,7 +154,7 @@ func (exec *DepositStakeExecutor) process(chainID string, view *st.StoreView, tr
 			}
 		}
 
-		err := gcp.DepositStake(sourceAddress, holderAddress, stakeAmount, tx.BlsPubkey, blockHeight)
+		err := gcp.DepositStake(sourceAddress, holderAddress, stakeAmount, tx.BlsPubkey, exec.chainConfig.MinGuardianStakeDeposit(blockHeight))
 		if err != nil {
 			return common.Hash{}, result.Error("Failed to deposit stake, err: %v", err)
 		}
@@ -0,0 +1,197 @@
+package execution
+
+import (
+	"math/big"
+
+	"theta/blockchain"
+	"theta/common"
+	"theta/common/result"
+	"theta/core"
+	st "theta/ledger/state"
+	"theta/ledger/types"
+)
+
+var _ TxExecutor = (*EquivocationSlashTxExecutor)(nil)
+
+// ------------------------------- EquivocationSlash Transaction -----------------------------------
+
+// EquivocationSlashTxExecutor implements the TxExecutor interface
+type EquivocationSlashTxExecutor struct {
+	chain       *blockchain.Chain
+	consensus   core.ConsensusEngine
+	valMgr      core.ValidatorManager
+	chainConfig *core.ChainConfig
+}
+
+// NewEquivocationSlashTxExecutor creates a new instance of EquivocationSlashTxExecutor
+func NewEquivocationSlashTxExecutor(chain *blockchain.Chain, consensus core.ConsensusEngine, valMgr core.ValidatorManager, chainConfig *core.ChainConfig) *EquivocationSlashTxExecutor {
+	return &EquivocationSlashTxExecutor{
+		chain:       chain,
+		consensus:   consensus,
+		valMgr:      valMgr,
+		chainConfig: chainConfig,
+	}
+}
+
+func (exec *EquivocationSlashTxExecutor) sanityCheck(chainID string, view *st.StoreView, transaction types.Tx) result.Result {
+	// Feature block height check
+	blockHeight := view.Height() + 1 // the view points to the parent of the current block
+	if blockHeight < exec.chainConfig.HeightEnableEquivocationSlash {
+		return result.Error("Feature equivocation slash is not active yet")
+	}
+
+	tx := transaction.(*types.EquivocationSlashTx)
+
+	validatorSet := getValidatorSet(exec.consensus.GetLedger(), exec.valMgr)
+	validatorAddresses := getValidatorAddresses(validatorSet)
+
+	// Validate proposer, basic
+	res := tx.Proposer.ValidateBasic()
+	if res.IsError() {
+		return res
+	}
+
+	// verify the proposer is one of the validators
+	res = isAValidator(tx.Proposer.Address, validatorAddresses)
+	if res.IsError() {
+		return res
+	}
+
+	proposerAccount, res := getOrMakeInput(view, tx.Proposer)
+	if res.IsError() {
+		return res
+	}
+
+	// verify the proposer's signature
+	signBytes := tx.SignBytes(chainID)
+	if !tx.Proposer.Signature.Verify(signBytes, proposerAccount.Address) {
+		return result.Error("SignBytes: %X", signBytes)
+	}
+
+	evidence := tx.Evidence
+	if evidence == nil {
+		return result.Error("Evidence is missing")
+	}
+	res = evidence.Validate(chainID)
+	if res.IsError() {
+		return result.Error("Invalid evidence: %v", res.Message)
+	}
+
+	evidenceHeight := evidence.Height()
+	if evidenceHeight >= blockHeight {
+		return result.Error("Evidence height %v is not below the block height %v", evidenceHeight, blockHeight)
+	}
+	if blockHeight-evidenceHeight > exec.chainConfig.MaxEvidenceAge() {
+		return result.Error("Evidence at height %v is too old", evidenceHeight)
+	}
+
+	if view.DoubleSignSlashed(evidence.Offender, evidenceHeight) {
+		return result.Error("Validator %v has already been slashed for double signing at height %v",
+			evidence.Offender.Hex(), evidenceHeight)
+	}
+
+	// The offender must have been a validator of the block it double signed
+	evidenceValidatorSet, res := exec.getValidatorSetAt(evidenceHeight)
+	if res.IsError() {
+		return res
+	}
+	if isAValidator(evidence.Offender, getValidatorAddresses(evidenceValidatorSet)).IsError() {
+		return result.Error("Validator %v was not a validator at height %v", evidence.Offender.Hex(), evidenceHeight)
+	}
+
+	vcp := view.GetValidatorCandidatePool()
+	if vcp == nil || vcp.FindStakeDelegate(evidence.Offender) == nil {
+		return result.Error("Validator %v has no stake to slash", evidence.Offender.Hex())
+	}
+
+	return result.OK
+}
+
+// getValidatorSetAt returns the validator set of the block at the given height on the branch of
+// the current block. The conflicting blocks of an evidence may not be on this branch, so the
+// validator set is looked up from the ancestor of the current block instead of their parents.
+func (exec *EquivocationSlashTxExecutor) getValidatorSetAt(height uint64) (*core.ValidatorSet, result.Result) {
+	block := exec.consensus.GetLedger().GetCurrentBlock()
+	if block == nil {
+		return nil, result.Error("Current block is missing")
+	}
+	for block.Height > height {
+		parent, err := exec.chain.FindBlock(block.Parent)
+		if err != nil {
+			return nil, result.Error("Failed to find block %v: %v", block.Parent.Hex(), err)
+		}
+		block = parent.Block
+	}
+	return exec.valMgr.GetNextValidatorSet(block.Parent), result.OK
+}
+
+// NOTE: EquivocationSlashTxExecutor.process() burns the forfeited stakes instead of transferring
+//       them to the proposer, so the proposer gains no extra benefit from reporting the double sign
+func (exec *EquivocationSlashTxExecutor) process(chainID string, view *st.StoreView, transaction types.Tx) (common.Hash, result.Result) {
+	tx := transaction.(*types.EquivocationSlashTx)
+	evidence := tx.Evidence
+
+	vcp := view.GetValidatorCandidatePool()
+	if vcp == nil {
+		return common.Hash{}, result.Error("Validator candidate pool does not exist")
+	}
+	slashed, err := vcp.SlashStakes(evidence.Offender, core.DoubleSignSlashPercentage)
+	if err != nil {
+		return common.Hash{}, result.Error("Failed to slash stakes, err: %v", err)
+	}
+	slashed.Add(slashed, exec.slashRedelegatedStakes(view, vcp, evidence))
+	view.UpdateValidatorCandidatePool(vcp)
+	view.SetDoubleSignSlashed(evidence.Offender, evidence.Height())
+
+	// The validator stakes changed, update the stake transaction height list
+	hl := view.GetStakeTransactionHeightList()
+	if hl == nil {
+		hl = &types.HeightList{}
+	}
+	blockHeight := view.Height() + 1 // the view points to the parent of the current block
+	hl.Append(blockHeight)
+	view.UpdateStakeTransactionHeightList(hl)
+
+	logger.Infof("Slashed %v ThetaWei from validator %v for double signing at height %v",
+		slashed, evidence.Offender.Hex(), evidence.Height())
+
+	txHash := types.TxID(chainID, tx)
+	return txHash, result.OK
+}
+
+// slashRedelegatedStakes slashes the stakes redelegated away from the offender at or after the double sign,
+// so the offender's delegators can not escape the slashing by redelegating after the double sign
+func (exec *EquivocationSlashTxExecutor) slashRedelegatedStakes(view *st.StoreView, vcp *core.ValidatorCandidatePool,
+	evidence *core.DoubleSignEvidence) *big.Int {
+	totalSlashed := new(big.Int).SetUint64(0)
+	rl := view.GetRedelegationList()
+	if rl == nil {
+		return totalSlashed
+	}
+
+	for _, r := range rl.RedelegatedFrom(evidence.Offender, core.StakeForValidator, evidence.Height()) {
+		amount := new(big.Int).Mul(r.Amount, big.NewInt(core.DoubleSignSlashPercentage))
+		amount.Div(amount, big.NewInt(100))
+		slashed, err := vcp.SlashStake(r.Source, r.ToHolder, amount)
+		if err != nil {
+			// The stake has been returned to the source, should not happen within the cooldown period
+			logger.Warnf("Failed to slash the stake redelegated from %v to %v: %v", r.FromHolder.Hex(), r.ToHolder.Hex(), err)
+			continue
+		}
+		totalSlashed.Add(totalSlashed, slashed)
+	}
+	return totalSlashed
+}
+
+func (exec *EquivocationSlashTxExecutor) getTxInfo(transaction types.Tx) *core.TxInfo {
+	tx := transaction.(*types.EquivocationSlashTx)
+	return &core.TxInfo{
+		Address:           tx.Proposer.Address,
+		Sequence:          tx.Proposer.Sequence,
+		EffectiveGasPrice: exec.calculateEffectiveGasPrice(transaction),
+	}
+}
+
+func (exec *EquivocationSlashTxExecutor) calculateEffectiveGasPrice(transaction types.Tx) *big.Int {
+	return new(big.Int).SetUint64(0)
+}
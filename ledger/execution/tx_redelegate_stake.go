@@ -0,0 +1,186 @@
+package execution
+
+import (
+	"fmt"
+	"math/big"
+
+	"theta/common"
+	"theta/common/result"
+	"theta/core"
+	st "theta/ledger/state"
+	"theta/ledger/types"
+)
+
+var _ TxExecutor = (*RedelegateStakeExecutor)(nil)
+
+// ------------------------------- RedelegateStake Transaction -----------------------------------
+
+// RedelegateStakeExecutor implements the TxExecutor interface
+type RedelegateStakeExecutor struct {
+	chainConfig *core.ChainConfig
+}
+
+// NewRedelegateStakeExecutor creates a new instance of RedelegateStakeExecutor
+func NewRedelegateStakeExecutor(chainConfig *core.ChainConfig) *RedelegateStakeExecutor {
+	return &RedelegateStakeExecutor{
+		chainConfig: chainConfig,
+	}
+}
+
+func (exec *RedelegateStakeExecutor) sanityCheck(chainID string, view *st.StoreView, transaction types.Tx) result.Result {
+	// Feature block height check
+	blockHeight := view.Height() + 1 // the view points to the parent of the current block
+	if blockHeight < exec.chainConfig.HeightEnableStakeRedelegation {
+		return result.Error("Feature stake redelegation is not active yet")
+	}
+
+	tx := transaction.(*types.RedelegateStakeTx)
+
+	res := tx.Source.ValidateBasic()
+	if res.IsError() {
+		return res
+	}
+
+	sourceAccount, success := getInput(view, tx.Source)
+	if success.IsError() {
+		return result.Error("Failed to get the source account: %v", tx.Source.Address)
+	}
+
+	signBytes := tx.SignBytes(chainID)
+	res = validateInputAdvanced(sourceAccount, signBytes, tx.Source)
+	if res.IsError() {
+		logger.Debugf(fmt.Sprintf("validateSourceAdvanced failed on %v: %v", tx.Source.Address.Hex(), res))
+		return res
+	}
+
+	if !sanityCheckForFee(tx.Fee, exec.chainConfig.MinimumTransactionFeeTFuelWei) {
+		return result.Error("Insufficient fee. Transaction fee needs to be at least %v TFuelWei",
+			exec.chainConfig.MinimumTransactionFeeTFuelWei).WithErrorCode(result.CodeInvalidFee)
+	}
+
+	if !(tx.Purpose == core.StakeForValidator || tx.Purpose == core.StakeForGuardian) {
+		return result.Error("Invalid stake purpose!").
+			WithErrorCode(result.CodeInvalidStakePurpose)
+	}
+
+	if !tx.Source.Coins.IsZero() {
+		return result.Error("Source should not send coins for the stake redelegation").
+			WithErrorCode(result.CodeInvalidStake)
+	}
+
+	// A stake redelegated recently can not be redelegated again, otherwise a chain of redelegations
+	// could move the stake out of reach of the slashing of the original holder
+	rl := view.GetRedelegationList()
+	if rl != nil && rl.IsLocked(tx.Source.Address, tx.FromHolder.Address, tx.Purpose, blockHeight, exec.chainConfig.RedelegationCooldownPeriod()) {
+		return result.Error("The stake was redelegated to %v recently, it can not be redelegated again until the cooldown period ends",
+			tx.FromHolder.Address.Hex())
+	}
+
+	// Dry run the redelegation, the updated pools are not saved
+	var err error
+	if tx.Purpose == core.StakeForValidator {
+		vcp := view.GetValidatorCandidatePool()
+		_, err = vcp.RedelegateStake(tx.Source.Address, tx.FromHolder.Address, tx.ToHolder.Address)
+	} else {
+		gcp := view.GetGuardianCandidatePool()
+		_, err = gcp.RedelegateStake(tx.Source.Address, tx.FromHolder.Address, tx.ToHolder.Address)
+	}
+	if err != nil {
+		return result.Error("Invalid stake redelegation: %v", err)
+	}
+
+	if !sourceAccount.Balance.IsGTE(tx.Fee) {
+		logger.Infof(fmt.Sprintf("RedelegateStake: Source did not have enough balance %v", tx.Source.Address.Hex()))
+		return result.Error("RedelegateStake: Source balance is %v, but required minimal balance is %v",
+			sourceAccount.Balance, tx.Fee)
+	}
+
+	return result.OK
+}
+
+// NOTE: RedelegateStakeExecutor.process() records the redelegation, so the moved stake can still be
+//       slashed for the double signs the original holder committed before the redelegation
+func (exec *RedelegateStakeExecutor) process(chainID string, view *st.StoreView, transaction types.Tx) (common.Hash, result.Result) {
+	blockHeight := view.Height() + 1 // the view points to the parent of the current block
+	tx := transaction.(*types.RedelegateStakeTx)
+
+	sourceAccount, success := getInput(view, tx.Source)
+	if success.IsError() {
+		return common.Hash{}, result.Error("Failed to get the source account")
+	}
+
+	if !chargeFee(sourceAccount, tx.Fee) {
+		return common.Hash{}, result.Error("Failed to charge transaction fee")
+	}
+
+	sourceAddress := tx.Source.Address
+	fromHolderAddress := tx.FromHolder.Address
+	toHolderAddress := tx.ToHolder.Address
+
+	var amount *big.Int
+	var err error
+	if tx.Purpose == core.StakeForValidator {
+		vcp := view.GetValidatorCandidatePool()
+		amount, err = vcp.RedelegateStake(sourceAddress, fromHolderAddress, toHolderAddress)
+		if err != nil {
+			return common.Hash{}, result.Error("Failed to redelegate stake, err: %v", err)
+		}
+		view.UpdateValidatorCandidatePool(vcp)
+	} else if tx.Purpose == core.StakeForGuardian {
+		gcp := view.GetGuardianCandidatePool()
+		amount, err = gcp.RedelegateStake(sourceAddress, fromHolderAddress, toHolderAddress)
+		if err != nil {
+			return common.Hash{}, result.Error("Failed to redelegate stake, err: %v", err)
+		}
+		view.UpdateGuardianCandidatePool(gcp)
+	} else {
+		return common.Hash{}, result.Error("Invalid staking purpose").WithErrorCode(result.CodeInvalidStakePurpose)
+	}
+
+	rl := view.GetRedelegationList()
+	if rl == nil {
+		rl = &core.RedelegationList{}
+	}
+	rl.Append(&core.Redelegation{
+		Source:     sourceAddress,
+		FromHolder: fromHolderAddress,
+		ToHolder:   toHolderAddress,
+		Purpose:    tx.Purpose,
+		Amount:     amount,
+		Height:     blockHeight,
+	})
+	view.UpdateRedelegationList(rl)
+
+	// Only update stake transaction height list for validator stake tx.
+	if tx.Purpose == core.StakeForValidator {
+		hl := view.GetStakeTransactionHeightList()
+		if hl == nil {
+			hl = &types.HeightList{}
+		}
+		hl.Append(blockHeight)
+		view.UpdateStakeTransactionHeightList(hl)
+	}
+
+	sourceAccount.Sequence++
+	view.SetAccount(sourceAddress, sourceAccount)
+
+	txHash := types.TxID(chainID, tx)
+	return txHash, result.OK
+}
+
+func (exec *RedelegateStakeExecutor) getTxInfo(transaction types.Tx) *core.TxInfo {
+	tx := transaction.(*types.RedelegateStakeTx)
+	return &core.TxInfo{
+		Address:           tx.Source.Address,
+		Sequence:          tx.Source.Sequence,
+		EffectiveGasPrice: exec.calculateEffectiveGasPrice(transaction),
+	}
+}
+
+func (exec *RedelegateStakeExecutor) calculateEffectiveGasPrice(transaction types.Tx) *big.Int {
+	tx := transaction.(*types.RedelegateStakeTx)
+	fee := tx.Fee
+	gas := new(big.Int).SetUint64(types.GasRedelegateStakeTx)
+	effectiveGasPrice := new(big.Int).Div(fee.TFuelWei, gas)
+	return effectiveGasPrice
+}
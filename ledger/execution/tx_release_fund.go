@@ -17,13 +17,15 @@ var _ TxExecutor = (*ReleaseFundTxExecutor)(nil)
 
 // ReleaseFundTxExecutor implements the TxExecutor interface
 type ReleaseFundTxExecutor struct {
-	state *st.LedgerState
+	state       *st.LedgerState
+	chainConfig *core.ChainConfig
 }
 
 // NewReleaseFundTxExecutor creates a new instance of ReleaseFundTxExecutor
-func NewReleaseFundTxExecutor(state *st.LedgerState) *ReleaseFundTxExecutor {
+func NewReleaseFundTxExecutor(state *st.LedgerState, chainConfig *core.ChainConfig) *ReleaseFundTxExecutor {
 	return &ReleaseFundTxExecutor{
-		state: state,
+		state:       state,
+		chainConfig: chainConfig,
 	}
 }
 
@@ -50,9 +52,9 @@ func (exec *ReleaseFundTxExecutor) sanityCheck(chainID string, view *st.StoreVie
 		return res
 	}
 
-	if !sanityCheckForFee(tx.Fee) {
+	if !sanityCheckForFee(tx.Fee, exec.chainConfig.MinimumTransactionFeeTFuelWei) {
 		return result.Error("Insufficient fee. Transaction fee needs to be at least %v TFuelWei",
-			types.MinimumTransactionFeeTFuelWei).WithErrorCode(result.CodeInvalidFee)
+			exec.chainConfig.MinimumTransactionFeeTFuelWei).WithErrorCode(result.CodeInvalidFee)
 	}
 
 	minimalBalance := tx.Fee
@@ -17,13 +17,15 @@ var _ TxExecutor = (*ReserveFundTxExecutor)(nil)
 
 // ReserveFundTxExecutor implements the TxExecutor interface
 type ReserveFundTxExecutor struct {
-	state *st.LedgerState
+	state       *st.LedgerState
+	chainConfig *core.ChainConfig
 }
 
 // NewReserveFundTxExecutor creates a new instance of ReserveFundTxExecutor
-func NewReserveFundTxExecutor(state *st.LedgerState) *ReserveFundTxExecutor {
+func NewReserveFundTxExecutor(state *st.LedgerState, chainConfig *core.ChainConfig) *ReserveFundTxExecutor {
 	return &ReserveFundTxExecutor{
-		state: state,
+		state:       state,
+		chainConfig: chainConfig,
 	}
 }
 
@@ -62,9 +64,9 @@ func (exec *ReserveFundTxExecutor) sanityCheck(chainID string, view *st.StoreVie
 			WithErrorCode(result.CodeInvalidFundToReserve)
 	}
 
-	if !sanityCheckForFee(tx.Fee) {
+	if !sanityCheckForFee(tx.Fee, exec.chainConfig.MinimumTransactionFeeTFuelWei) {
 		return result.Error("Insufficient fee. Transaction fee needs to be at least %v TFuelWei",
-			types.MinimumTransactionFeeTFuelWei).WithErrorCode(result.CodeInvalidFee)
+			exec.chainConfig.MinimumTransactionFeeTFuelWei).WithErrorCode(result.CodeInvalidFee)
 	}
 
 	fund := tx.Source.Coins
@@ -17,11 +17,14 @@ var _ TxExecutor = (*SendTxExecutor)(nil)
 
 // SendTxExecutor implements the TxExecutor interface
 type SendTxExecutor struct {
+	chainConfig *core.ChainConfig
 }
 
 // NewSendTxExecutor creates a new instance of SendTxExecutor
-func NewSendTxExecutor() *SendTxExecutor {
-	return &SendTxExecutor{}
+func NewSendTxExecutor(chainConfig *core.ChainConfig) *SendTxExecutor {
+	return &SendTxExecutor{
+		chainConfig: chainConfig,
+	}
 }
 
 func (exec *SendTxExecutor) sanityCheck(chainID string, view *st.StoreView, transaction types.Tx) result.Result {
@@ -60,7 +63,7 @@ func (exec *SendTxExecutor) sanityCheck(chainID string, view *st.StoreView, tran
 	}
 
 	blockHeight := view.Height() + 1
-	if blockHeight >= common.HeightEnableSmartContract {
+	if blockHeight >= exec.chainConfig.HeightEnableSmartContract {
 		for _, outAcc := range accounts {
 			if outAcc.IsASmartContract() {
 				return result.Error(
@@ -76,9 +79,9 @@ func (exec *SendTxExecutor) sanityCheck(chainID string, view *st.StoreView, tran
 		return res
 	}
 
-	if !sanityCheckForFee(tx.Fee) {
+	if !sanityCheckForFee(tx.Fee, exec.chainConfig.MinimumTransactionFeeTFuelWei) {
 		return result.Error("Insufficient fee. Transaction fee needs to be at least %v TFuelWei",
-			types.MinimumTransactionFeeTFuelWei).WithErrorCode(result.CodeInvalidFee)
+			exec.chainConfig.MinimumTransactionFeeTFuelWei).WithErrorCode(result.CodeInvalidFee)
 	}
 
 	outTotal := sumOutputs(tx.Outputs)
@@ -17,13 +17,15 @@ var _ TxExecutor = (*ServicePaymentTxExecutor)(nil)
 
 // ServicePaymentTxExecutor implements the TxExecutor interface
 type ServicePaymentTxExecutor struct {
-	state *st.LedgerState
+	state       *st.LedgerState
+	chainConfig *core.ChainConfig
 }
 
 // NewServicePaymentTxExecutor creates a new instance of ServicePaymentTxExecutor
-func NewServicePaymentTxExecutor(state *st.LedgerState) *ServicePaymentTxExecutor {
+func NewServicePaymentTxExecutor(state *st.LedgerState, chainConfig *core.ChainConfig) *ServicePaymentTxExecutor {
 	return &ServicePaymentTxExecutor{
-		state: state,
+		state:       state,
+		chainConfig: chainConfig,
 	}
 }
 
@@ -77,9 +79,9 @@ func (exec *ServicePaymentTxExecutor) sanityCheck(chainID string, view *st.Store
 		return result.Error(errMsg)
 	}
 
-	if !sanityCheckForFee(tx.Fee) {
+	if !sanityCheckForFee(tx.Fee, exec.chainConfig.MinimumTransactionFeeTFuelWei) {
 		return result.Error("Insufficient fee. Transaction fee needs to be at least %v TFuelWei",
-			types.MinimumTransactionFeeTFuelWei).WithErrorCode(result.CodeInvalidFee)
+			exec.chainConfig.MinimumTransactionFeeTFuelWei).WithErrorCode(result.CodeInvalidFee)
 	}
 
 	transferAmount := tx.Source.Coins
@@ -19,15 +19,17 @@ var _ TxExecutor = (*SmartContractTxExecutor)(nil)
 
 // SmartContractTxExecutor implements the TxExecutor interface
 type SmartContractTxExecutor struct {
-	state *st.LedgerState
-	chain *blockchain.Chain
+	state       *st.LedgerState
+	chain       *blockchain.Chain
+	chainConfig *core.ChainConfig
 }
 
 // NewSmartContractTxExecutor creates a new instance of SmartContractTxExecutor
-func NewSmartContractTxExecutor(chain *blockchain.Chain, state *st.LedgerState) *SmartContractTxExecutor {
+func NewSmartContractTxExecutor(chain *blockchain.Chain, state *st.LedgerState, chainConfig *core.ChainConfig) *SmartContractTxExecutor {
 	return &SmartContractTxExecutor{
-		state: state,
-		chain: chain,
+		state:       state,
+		chain:       chain,
+		chainConfig: chainConfig,
 	}
 }
 
@@ -60,13 +62,13 @@ func (exec *SmartContractTxExecutor) sanityCheck(chainID string, view *st.StoreV
 			WithErrorCode(result.CodeInvalidValueToTransfer)
 	}
 
-	if !sanityCheckForGasPrice(tx.GasPrice) {
-		return result.Error("Insufficient gas price. Gas price needs to be at least %v TFuelWei", types.MinimumGasPrice).
+	if !sanityCheckForGasPrice(tx.GasPrice, exec.chainConfig.MinimumGasPrice) {
+		return result.Error("Insufficient gas price. Gas price needs to be at least %v TFuelWei", exec.chainConfig.MinimumGasPrice).
 			WithErrorCode(result.CodeInvalidGasPrice)
 	}
 
-	if tx.GasLimit > types.MaximumTxGasLimit {
-		return result.Error("Invalid gas limit. Gas limit needs to be at most %v", types.MaximumTxGasLimit).
+	if tx.GasLimit > exec.chainConfig.MaximumTxGasLimit {
+		return result.Error("Invalid gas limit. Gas limit needs to be at most %v", exec.chainConfig.MaximumTxGasLimit).
 			WithErrorCode(result.CodeInvalidGasLimit)
 	}
 
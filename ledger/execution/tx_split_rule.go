@@ -17,13 +17,15 @@ var _ TxExecutor = (*SplitRuleTxExecutor)(nil)
 
 // SplitRuleTxExecutor implements the TxExecutor interface
 type SplitRuleTxExecutor struct {
-	state *st.LedgerState
+	state       *st.LedgerState
+	chainConfig *core.ChainConfig
 }
 
 // NewSplitRuleTxExecutor creates a new instance of SplitRuleTxExecutor
-func NewSplitRuleTxExecutor(state *st.LedgerState) *SplitRuleTxExecutor {
+func NewSplitRuleTxExecutor(state *st.LedgerState, chainConfig *core.ChainConfig) *SplitRuleTxExecutor {
 	return &SplitRuleTxExecutor{
-		state: state,
+		state:       state,
+		chainConfig: chainConfig,
 	}
 }
 
@@ -48,9 +50,9 @@ func (exec *SplitRuleTxExecutor) sanityCheck(chainID string, view *st.StoreView,
 		return res
 	}
 
-	if !sanityCheckForFee(tx.Fee) {
+	if !sanityCheckForFee(tx.Fee, exec.chainConfig.MinimumTransactionFeeTFuelWei) {
 		return result.Error("Insufficient fee. Transaction fee needs to be at least %v TFuelWei",
-			types.MinimumTransactionFeeTFuelWei).WithErrorCode(result.CodeInvalidFee)
+			exec.chainConfig.MinimumTransactionFeeTFuelWei).WithErrorCode(result.CodeInvalidFee)
 	}
 
 	minimalBalance := tx.Fee
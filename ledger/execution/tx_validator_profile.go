@@ -0,0 +1,148 @@
+package execution
+
+import (
+	"fmt"
+	"math/big"
+
+	"theta/common"
+	"theta/common/result"
+	"theta/core"
+	st "theta/ledger/state"
+	"theta/ledger/types"
+)
+
+var _ TxExecutor = (*ValidatorProfileTxExecutor)(nil)
+
+// ------------------------------- ValidatorProfile Transaction -----------------------------------
+
+// ValidatorProfileTxExecutor implements the TxExecutor interface
+type ValidatorProfileTxExecutor struct {
+	chainConfig *core.ChainConfig
+}
+
+// NewValidatorProfileTxExecutor creates a new instance of ValidatorProfileTxExecutor
+func NewValidatorProfileTxExecutor(chainConfig *core.ChainConfig) *ValidatorProfileTxExecutor {
+	return &ValidatorProfileTxExecutor{
+		chainConfig: chainConfig,
+	}
+}
+
+func (exec *ValidatorProfileTxExecutor) sanityCheck(chainID string, view *st.StoreView, transaction types.Tx) result.Result {
+	// Feature block height check
+	blockHeight := view.Height() + 1 // the view points to the parent of the current block
+	if blockHeight < exec.chainConfig.HeightEnableValidatorCommission {
+		return result.Error("Feature validator commission is not active yet")
+	}
+
+	tx := transaction.(*types.ValidatorProfileTx)
+
+	res := tx.Holder.ValidateBasic()
+	if res.IsError() {
+		return res
+	}
+
+	holderAccount, success := getInput(view, tx.Holder)
+	if success.IsError() {
+		return result.Error("Failed to get the holder account: %v", tx.Holder.Address)
+	}
+
+	signBytes := tx.SignBytes(chainID)
+	res = validateInputAdvanced(holderAccount, signBytes, tx.Holder)
+	if res.IsError() {
+		logger.Debugf(fmt.Sprintf("validateSourceAdvanced failed on %v: %v", tx.Holder.Address.Hex(), res))
+		return res
+	}
+
+	if !sanityCheckForFee(tx.Fee, exec.chainConfig.MinimumTransactionFeeTFuelWei) {
+		return result.Error("Insufficient fee. Transaction fee needs to be at least %v TFuelWei",
+			exec.chainConfig.MinimumTransactionFeeTFuelWei).WithErrorCode(result.CodeInvalidFee)
+	}
+
+	if !tx.Holder.Coins.IsZero() {
+		return result.Error("Holder should not send coins for the validator profile update")
+	}
+
+	vcp := view.GetValidatorCandidatePool()
+	if vcp == nil || vcp.FindStakeDelegate(tx.Holder.Address) == nil {
+		return result.Error("%v is not a validator candidate", tx.Holder.Address.Hex())
+	}
+
+	if _, err := exec.updatedProfile(view, tx, blockHeight); err != nil {
+		return result.Error("Invalid validator profile: %v", err)
+	}
+
+	if !holderAccount.Balance.IsGTE(tx.Fee) {
+		return result.Error("ValidatorProfile: Holder balance is %v, but required minimal balance is %v",
+			holderAccount.Balance, tx.Fee)
+	}
+
+	return result.OK
+}
+
+func (exec *ValidatorProfileTxExecutor) process(chainID string, view *st.StoreView, transaction types.Tx) (common.Hash, result.Result) {
+	blockHeight := view.Height() + 1 // the view points to the parent of the current block
+	tx := transaction.(*types.ValidatorProfileTx)
+
+	holderAccount, success := getInput(view, tx.Holder)
+	if success.IsError() {
+		return common.Hash{}, result.Error("Failed to get the holder account")
+	}
+
+	if !chargeFee(holderAccount, tx.Fee) {
+		return common.Hash{}, result.Error("Failed to charge transaction fee")
+	}
+
+	profile, err := exec.updatedProfile(view, tx, blockHeight)
+	if err != nil {
+		return common.Hash{}, result.Error("Failed to update the validator profile, err: %v", err)
+	}
+	view.SetValidatorProfile(profile)
+
+	holderAccount.Sequence++
+	view.SetAccount(tx.Holder.Address, holderAccount)
+
+	txHash := types.TxID(chainID, tx)
+	return txHash, result.OK
+}
+
+// updatedProfile returns the validator profile after applying the transaction. The max commission rate and
+// the max change rate are only taken from the transaction when the profile is created. A validator without
+// a profile charges no commission, so the creation is subject to the same limits as an update from rate 0.
+func (exec *ValidatorProfileTxExecutor) updatedProfile(view *st.StoreView, tx *types.ValidatorProfileTx, blockHeight uint64) (*core.ValidatorProfile, error) {
+	profile := view.GetValidatorProfile(tx.Holder.Address)
+	if profile == nil {
+		profile, err := core.NewValidatorProfile(tx.Holder.Address, 0,
+			tx.MaxCommissionRate, tx.MaxCommissionChangeRate, 0)
+		if err != nil {
+			return nil, err
+		}
+		if err := profile.UpdateCommissionRate(tx.CommissionRate, blockHeight); err != nil {
+			return nil, err
+		}
+		return profile, nil
+	}
+	if tx.MaxCommissionRate != profile.MaxCommissionRate || tx.MaxCommissionChangeRate != profile.MaxCommissionChangeRate {
+		return nil, fmt.Errorf("Max commission rate and max commission change rate can not be changed")
+	}
+	if err := profile.UpdateCommissionRate(tx.CommissionRate, blockHeight); err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
+
+func (exec *ValidatorProfileTxExecutor) getTxInfo(transaction types.Tx) *core.TxInfo {
+	tx := transaction.(*types.ValidatorProfileTx)
+	return &core.TxInfo{
+		Address:           tx.Holder.Address,
+		Sequence:          tx.Holder.Sequence,
+		EffectiveGasPrice: exec.calculateEffectiveGasPrice(transaction),
+	}
+}
+
+func (exec *ValidatorProfileTxExecutor) calculateEffectiveGasPrice(transaction types.Tx) *big.Int {
+	tx := transaction.(*types.ValidatorProfileTx)
+	fee := tx.Fee
+	gas := new(big.Int).SetUint64(types.GasValidatorProfileTx)
+	effectiveGasPrice := new(big.Int).Div(fee.TFuelWei, gas)
+	return effectiveGasPrice
+}
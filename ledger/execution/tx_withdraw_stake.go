@@ -17,13 +17,15 @@ var _ TxExecutor = (*WithdrawStakeExecutor)(nil)
 
 // WithdrawStakeExecutor implements the TxExecutor interface
 type WithdrawStakeExecutor struct {
-	state *st.LedgerState
+	state       *st.LedgerState
+	chainConfig *core.ChainConfig
 }
 
 // NewWithdrawStakeExecutor creates a new instance of WithdrawStakeExecutor
-func NewWithdrawStakeExecutor(state *st.LedgerState) *WithdrawStakeExecutor {
+func NewWithdrawStakeExecutor(state *st.LedgerState, chainConfig *core.ChainConfig) *WithdrawStakeExecutor {
 	return &WithdrawStakeExecutor{
-		state: state,
+		state:       state,
+		chainConfig: chainConfig,
 	}
 }
 
@@ -47,9 +49,9 @@ func (exec *WithdrawStakeExecutor) sanityCheck(chainID string, view *st.StoreVie
 		return res
 	}
 
-	if !sanityCheckForFee(tx.Fee) {
+	if !sanityCheckForFee(tx.Fee, exec.chainConfig.MinimumTransactionFeeTFuelWei) {
 		return result.Error("Insufficient fee. Transaction fee needs to be at least %v TFuelWei",
-			types.MinimumTransactionFeeTFuelWei).WithErrorCode(result.CodeInvalidFee)
+			exec.chainConfig.MinimumTransactionFeeTFuelWei).WithErrorCode(result.CodeInvalidFee)
 	}
 
 	if !(tx.Purpose == core.StakeForValidator || tx.Purpose == core.StakeForGuardian) {
@@ -88,7 +90,7 @@ func (exec *WithdrawStakeExecutor) process(chainID string, view *st.StoreView, t
 	if tx.Purpose == core.StakeForValidator {
 		vcp := view.GetValidatorCandidatePool()
 		currentHeight := exec.state.Height()
-		err := vcp.WithdrawStake(sourceAddress, holderAddress, currentHeight)
+		err := vcp.WithdrawStake(sourceAddress, holderAddress, currentHeight, exec.chainConfig.ReturnLockingPeriod)
 		if err != nil {
 			return common.Hash{}, result.Error("Failed to withdraw stake, err: %v", err)
 		}
@@ -96,7 +98,7 @@ func (exec *WithdrawStakeExecutor) process(chainID string, view *st.StoreView, t
 	} else if tx.Purpose == core.StakeForGuardian {
 		gcp := view.GetGuardianCandidatePool()
 		currentHeight := exec.state.Height()
-		err := gcp.WithdrawStake(sourceAddress, holderAddress, currentHeight)
+		err := gcp.WithdrawStake(sourceAddress, holderAddress, currentHeight, exec.chainConfig.ReturnLockingPeriod)
 		if err != nil {
 			return common.Hash{}, result.Error("Failed to withdraw stake, err: %v", err)
 		}
@@ -22,8 +22,10 @@ import (
 	"theta/ledger/state"
 	st "theta/ledger/state"
 	"theta/ledger/types"
+	"theta/ledger/vm"
 	mp "theta/mempool"
 	"theta/store/database"
+	"theta/store/database/backend"
 )
 
 var logger *log.Entry = log.WithFields(log.Fields{"prefix": "ledger"})
@@ -39,6 +41,7 @@ type Ledger struct {
 	consensus    core.ConsensusEngine
 	valMgr       core.ValidatorManager
 	mempool      *mp.Mempool
+	chainConfig  *core.ChainConfig
 	currentBlock *core.Block
 
 	mu       *sync.RWMutex // Lock for accessing ledger state.
@@ -49,16 +52,18 @@ type Ledger struct {
 // NewLedger creates an instance of Ledger
 func NewLedger(chainID string, db database.Database, chain *blockchain.Chain, consensus core.ConsensusEngine, valMgr core.ValidatorManager, mempool *mp.Mempool) *Ledger {
 	state := st.NewLedgerState(chainID, db)
-	executor := exec.NewExecutor(db, chain, state, consensus, valMgr)
+	chainConfig := core.GetChainConfig(chainID)
+	executor := exec.NewExecutor(db, chain, state, consensus, valMgr, chainConfig)
 	ledger := &Ledger{
-		db:        db,
-		chain:     chain,
-		consensus: consensus,
-		valMgr:    valMgr,
-		mempool:   mempool,
-		mu:        &sync.RWMutex{},
-		state:     state,
-		executor:  executor,
+		db:          db,
+		chain:       chain,
+		consensus:   consensus,
+		valMgr:      valMgr,
+		mempool:     mempool,
+		chainConfig: chainConfig,
+		mu:          &sync.RWMutex{},
+		state:       state,
+		executor:    executor,
 	}
 	return ledger
 }
@@ -68,6 +73,11 @@ func (ledger *Ledger) State() *st.LedgerState {
 	return ledger.state
 }
 
+// ChainConfig returns the config of the chain
+func (ledger *Ledger) ChainConfig() *core.ChainConfig {
+	return ledger.chainConfig
+}
+
 // GetCurrentBlock returns the block currently being processed
 func (ledger *Ledger) GetCurrentBlock() *core.Block {
 	return ledger.currentBlock
@@ -216,6 +226,81 @@ func (ledger *Ledger) ScreenTx(rawTx common.Bytes) (txInfo *core.TxInfo, res res
 	return txInfo, res
 }
 
+// ScreenReplacementTx screens the given transaction, which replaces a pending transaction of the
+// same account, without changing the screened state. The preceding pending transactions of the
+// account are applied to a copy of the delivered state in the given order, and the replacement is
+// then checked against the resulting account, including its signature, sequence, fee and balance.
+func (ledger *Ledger) ScreenReplacementTx(rawTx common.Bytes, precedingRawTxs []common.Bytes) (txInfo *core.TxInfo, res result.Result) {
+	var tx types.Tx
+	tx, err := types.TxFromBytes(rawTx)
+	if err != nil {
+		return nil, result.Error("Error decoding tx: %v", err)
+	}
+
+	if ledger.shouldSkipCheckTx(tx) {
+		return nil, result.Error("Unauthorized transaction, should skip").
+			WithErrorCode(result.CodeUnauthorizedTx)
+	}
+
+	ledger.mu.RLock()
+	defer ledger.mu.RUnlock()
+
+	view, err := ledger.state.Delivered().Copy()
+	if err != nil {
+		return nil, result.Error("Failed to copy the delivered view: %v", err)
+	}
+	for _, precedingRawTx := range precedingRawTxs {
+		precedingTx, err := types.TxFromBytes(precedingRawTx)
+		if err != nil {
+			return nil, result.Error("Error decoding tx: %v", err)
+		}
+		if _, res = ledger.executor.ScreenTxWithView(view, precedingTx); res.IsError() {
+			return nil, result.Error("Preceding transaction is invalid: %v", res.Message)
+		}
+	}
+
+	_, res = ledger.executor.ScreenTxWithView(view, tx)
+	if res.IsError() {
+		return nil, res
+	}
+
+	return ledger.executor.GetTxInfo(tx)
+}
+
+// RescreenTxs discards the screened state, and screens the given transactions again in the given
+// order starting from the delivered state, the same way as after a block is applied. It is used by
+// the mempool when pending transactions are replaced or evicted, since their changes can not be
+// removed from the screened state otherwise.
+func (ledger *Ledger) RescreenTxs(rawTxs []common.Bytes) []result.Result {
+	ledger.mu.Lock()
+	defer ledger.mu.Unlock()
+
+	ledger.state.ResetScreened()
+
+	results := make([]result.Result, len(rawTxs))
+	for i, rawTx := range rawTxs {
+		results[i] = ledger.ScreenTxUnsafe(rawTx)
+	}
+	return results
+}
+
+// GetTxInfo extracts the information used by the mempool to sort the given transaction without
+// screening it.
+func (ledger *Ledger) GetTxInfo(rawTx common.Bytes) (txInfo *core.TxInfo, res result.Result) {
+	var tx types.Tx
+	tx, err := types.TxFromBytes(rawTx)
+	if err != nil {
+		return nil, result.Error("Error decoding tx: %v", err)
+	}
+
+	if ledger.shouldSkipCheckTx(tx) {
+		return nil, result.Error("Unauthorized transaction, should skip").
+			WithErrorCode(result.CodeUnauthorizedTx)
+	}
+
+	return ledger.executor.GetTxInfo(tx)
+}
+
 // ProposeBlockTxs collects and executes a list of transactions, which will be used to assemble the next blockl
 // It also clears these transactions from the mempool.
 func (ledger *Ledger) ProposeBlockTxs(block *core.Block) (stateRootHash common.Hash, blockRawTxs []common.Bytes, res result.Result) {
@@ -305,6 +390,10 @@ func (ledger *Ledger) ApplyBlockTxs(block *core.Block) result.Result {
 			hasValidatorUpdate = true
 		} else if _, ok := tx.(*types.WithdrawStakeTx); ok {
 			hasValidatorUpdate = true
+		} else if _, ok := tx.(*types.EquivocationSlashTx); ok {
+			hasValidatorUpdate = true
+		} else if _, ok := tx.(*types.RedelegateStakeTx); ok {
+			hasValidatorUpdate = true
 		}
 		_, res := ledger.executor.ExecuteTx(tx)
 		if res.IsError() {
@@ -386,6 +475,10 @@ func (ledger *Ledger) ApplyBlockTxsForChainCorrection(block *core.Block) (common
 			hasValidatorUpdate = true
 		} else if _, ok := tx.(*types.WithdrawStakeTx); ok {
 			hasValidatorUpdate = true
+		} else if _, ok := tx.(*types.EquivocationSlashTx); ok {
+			hasValidatorUpdate = true
+		} else if _, ok := tx.(*types.RedelegateStakeTx); ok {
+			hasValidatorUpdate = true
 		}
 		_, res := ledger.executor.ExecuteTx(tx)
 		if res.IsError() {
@@ -402,8 +495,66 @@ func (ledger *Ledger) ApplyBlockTxsForChainCorrection(block *core.Block) (common
 	return view.Hash(), result.OKWith(result.Info{"hasValidatorUpdate": hasValidatorUpdate})
 }
 
+// TraceTx re-executes the transactions of the given committed block on top of the state of its
+// parent block, and traces the EVM execution of the smart contract transaction at txIndex. The
+// replay runs on a temporary state and does not modify the ledger state or the tx receipts.
+func (ledger *Ledger) TraceTx(block *core.Block, txIndex int, tracer vm.Tracer) (evmRet common.Bytes,
+	gasUsed uint64, evmErr error, res result.Result) {
+	if txIndex < 0 || txIndex >= len(block.Txs) {
+		return nil, 0, nil, result.Error("Invalid tx index: %v", txIndex)
+	}
+
+	extParentBlock, err := ledger.chain.FindBlock(block.Parent)
+	if extParentBlock == nil || err != nil {
+		return nil, 0, nil, result.Error("Failed to find the parent block: %v, err: %v", block.Parent.Hex(), err)
+	}
+	parentBlock := extParentBlock.Block
+
+	chainID := ledger.state.GetChainID()
+	ledgerState := st.NewLedgerState(chainID, ledger.db)
+	res = ledgerState.ResetState(parentBlock)
+	if res.IsError() {
+		return nil, 0, nil, result.Error("State of block %v is not available, it might have been pruned",
+			parentBlock.Height)
+	}
+
+	// Tx receipts of the replayed transactions go to a throwaway chain
+	receiptChain := blockchain.NewChain(chainID, kvstore.NewKVStore(backend.NewMemDatabase()), parentBlock)
+	executor := exec.NewExecutor(ledger.db, receiptChain, ledgerState, ledger.consensus, ledger.valMgr, ledger.chainConfig)
+	executor.SetSkipSanityCheck(true) // the transactions have already been committed
+
+	for i := 0; i < txIndex; i++ {
+		tx, err := types.TxFromBytes(block.Txs[i])
+		if err != nil {
+			return nil, 0, nil, result.Error("Failed to parse transaction: %v", hex.EncodeToString(block.Txs[i]))
+		}
+		if _, res := executor.ExecuteTx(tx); res.IsError() {
+			return nil, 0, nil, res
+		}
+	}
+
+	tx, err := types.TxFromBytes(block.Txs[txIndex])
+	if err != nil {
+		return nil, 0, nil, result.Error("Failed to parse transaction: %v", hex.EncodeToString(block.Txs[txIndex]))
+	}
+	sctx, ok := tx.(*types.SmartContractTx)
+	if !ok {
+		return nil, 0, nil, result.Error("Transaction is not a smart contract transaction")
+	}
+
+	view := ledgerState.Delivered()
+	view.ResetLogs()
+	evmRet, _, gasUsed, evmErr = vm.ExecuteWithTracer(parentBlock, sctx, view, tracer)
+
+	return evmRet, gasUsed, evmErr, result.OK
+}
+
 // PruneState attempts to prune the state up to the targetEndHeight
 func (ledger *Ledger) PruneState(targetEndHeight uint64) error {
+	if viper.GetBool(common.CfgStorageArchiveMode) {
+		return fmt.Errorf("State pruning is disabled in archive mode")
+	}
+
 	var processedHeight uint64
 	db := ledger.State().DB()
 	kvStore := kvstore.NewKVStore(db)
@@ -570,6 +721,8 @@ func (ledger *Ledger) shouldSkipCheckTx(tx types.Tx) bool {
 		return true
 	case *types.SlashTx:
 		return true
+	case *types.EquivocationSlashTx:
+		return true
 	default:
 		return false
 	}
@@ -580,6 +733,8 @@ func (ledger *Ledger) shouldSkipCheckTx(tx types.Tx) bool {
 func (ledger *Ledger) handleDelayedStateUpdates(view *st.StoreView) {
 	ledger.handleValidatorStakeReturn(view)
 	ledger.handleGuardianStakeReturn(view)
+	ledger.handleGuardianUptimeUpdate(view)
+	ledger.handleRedelegationCooldown(view)
 }
 
 func (ledger *Ledger) handleValidatorStakeReturn(view *st.StoreView) {
@@ -640,6 +795,55 @@ func (ledger *Ledger) handleGuardianStakeReturn(view *st.StoreView) {
 	view.UpdateGuardianCandidatePool(gcp)
 }
 
+// handleRedelegationCooldown removes the stake redelegations whose cooldown period has ended
+func (ledger *Ledger) handleRedelegationCooldown(view *st.StoreView) {
+	rl := view.GetRedelegationList()
+	if rl == nil {
+		return
+	}
+
+	currentHeight := view.Height()
+	if rl.Prune(currentHeight, ledger.chainConfig.RedelegationCooldownPeriod()) {
+		view.UpdateRedelegationList(rl)
+	}
+}
+
+// handleGuardianUptimeUpdate records which guardians participated in the aggregated guardian votes
+// carried by a checkpoint block. The participation is derived from the multiplies of the votes,
+// which follow the order of the guardians with stake in the GCP at the voted block.
+func (ledger *Ledger) handleGuardianUptimeUpdate(view *st.StoreView) {
+	block := ledger.currentBlock
+	if block == nil || block.Height < ledger.chainConfig.HeightEnableGuardianUptime || !common.IsCheckPointHeight(block.Height) {
+		return
+	}
+	guardianVotes := block.GuardianVotes
+	if guardianVotes == nil {
+		return
+	}
+
+	guardianVoteBlock, err := ledger.chain.FindBlock(guardianVotes.Block)
+	if err != nil {
+		logger.Panic(err)
+	}
+	storeView := st.NewStoreView(guardianVoteBlock.Height, guardianVoteBlock.StateHash, ledger.db)
+	guardianPool := storeView.GetGuardianCandidatePool().WithStake()
+	if guardianPool.Len() != len(guardianVotes.Multiplies) {
+		logger.Warnf("Guardian pool size mismatch: %v vs %v, skip the uptime update", guardianPool.Len(), len(guardianVotes.Multiplies))
+		return
+	}
+
+	for i, g := range guardianPool.SortedGuardians {
+		uptime := view.GetGuardianUptime(g.Holder)
+		if uptime == nil {
+			uptime = core.NewGuardianUptime(g.Holder)
+		}
+		if !uptime.Record(guardianVoteBlock.Height, guardianVotes.Multiplies[i] > 0) {
+			continue
+		}
+		view.SetGuardianUptime(uptime)
+	}
+}
+
 // addSpecialTransactions adds special transactions (e.g. coinbase transaction, slash transaction) to the block
 func (ledger *Ledger) addSpecialTransactions(block *core.Block, view *st.StoreView, rawTxs *[]common.Bytes) {
 	if block == nil {
@@ -656,6 +860,7 @@ func (ledger *Ledger) addSpecialTransactions(block *core.Block, view *st.StoreVi
 
 	ledger.addCoinbaseTx(view, &proposer, validatorSet, rawTxs)
 	//ledger.addSlashTxs(view, &proposer, &validators, rawTxs)
+	ledger.addEquivocationSlashTxs(view, &proposer, rawTxs)
 }
 
 // addCoinbaseTx adds a Coinbase transaction
@@ -670,7 +875,7 @@ func (ledger *Ledger) addCoinbaseTx(view *st.StoreView, proposer *core.Validator
 	ch := ledger.GetCurrentBlock().Height
 	guardianVotes := ledger.GetCurrentBlock().GuardianVotes
 
-	if guardianVotes != nil && ch >= common.HeightEnableTheta2 && common.IsCheckPointHeight(ch) {
+	if guardianVotes != nil && ch >= ledger.chainConfig.HeightEnableTheta2 && common.IsCheckPointHeight(ch) {
 		guradianVoteBlock, err := ledger.chain.FindBlock(guardianVotes.Block)
 		if err != nil {
 			logger.Panic(err)
@@ -678,9 +883,9 @@ func (ledger *Ledger) addCoinbaseTx(view *st.StoreView, proposer *core.Validator
 		storeView := st.NewStoreView(guradianVoteBlock.Height, guradianVoteBlock.StateHash, ledger.db)
 		guardianCandidatePool := storeView.GetGuardianCandidatePool()
 
-		accountRewardMap = exec.CalculateReward(ledger, view, validatorSet, guardianVotes, guardianCandidatePool)
+		accountRewardMap = exec.CalculateReward(ledger.chainConfig, ledger, view, validatorSet, guardianVotes, guardianCandidatePool)
 	} else {
-		accountRewardMap = exec.CalculateReward(ledger, view, validatorSet, nil, nil)
+		accountRewardMap = exec.CalculateReward(ledger.chainConfig, ledger, view, validatorSet, nil, nil)
 	}
 
 	coinbaseTxOutputs := []types.TxOutput{}
@@ -749,6 +954,53 @@ func (ledger *Ledger) addSlashTxs(view *st.StoreView, proposer *core.Validator,
 	view.ClearSlashIntents()
 }
 
+// addEquivocationSlashTxs adds the transactions that slash the validators which double signed,
+// based on the evidence collected by the consensus engine
+func (ledger *Ledger) addEquivocationSlashTxs(view *st.StoreView, proposer *core.Validator, rawTxs *[]common.Bytes) {
+	proposerAddress := proposer.Address
+	proposerTxIn := types.TxInput{
+		Address: proposerAddress,
+	}
+
+	blockHeight := view.Height() + 1 // the view points to the parent of the current block
+	if blockHeight < ledger.chainConfig.HeightEnableEquivocationSlash {
+		return
+	}
+
+	included := make(map[string]bool)
+	for _, evidence := range ledger.consensus.GetPendingEvidence() {
+		height := evidence.Height()
+		if height >= blockHeight || blockHeight-height > ledger.chainConfig.MaxEvidenceAge() {
+			continue
+		}
+		key := fmt.Sprintf("%v/%v", evidence.Offender.Hex(), height)
+		if included[key] || view.DoubleSignSlashed(evidence.Offender, height) {
+			continue
+		}
+		included[key] = true
+
+		slashTx := &types.EquivocationSlashTx{
+			Proposer: proposerTxIn,
+			Evidence: evidence,
+		}
+
+		signature, err := ledger.signTransaction(slashTx)
+		if err != nil {
+			logger.Errorf("Failed to add equivocation slash transaction: %v", err)
+			continue
+		}
+		slashTx.SetSignature(proposerAddress, signature)
+		slashTxBytes, err := types.TxToBytes(slashTx)
+		if err != nil {
+			logger.Errorf("Failed to add equivocation slash transaction: %v", err)
+			continue
+		}
+
+		*rawTxs = append(*rawTxs, slashTxBytes)
+		logger.Debugf("Adding equivocation slash transction: tx: %v, bytes: %v", slashTx, hex.EncodeToString(slashTxBytes))
+	}
+}
+
 // signTransaction signs the given transaction
 func (ledger *Ledger) signTransaction(tx types.Tx) (*crypto.Signature, error) {
 	chainID := ledger.state.GetChainID()
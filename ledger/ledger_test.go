@@ -44,6 +44,62 @@ func TestLedgerScreenTx(t *testing.T) {
 	assert.Equal(result.CodeUnauthorizedTx, res.Code, res.Message)
 }
 
+func TestLedgerScreenReplacementTx(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	chainID, ledger, _ := newTestLedger()
+	numInAccs := 2
+	accOut, accIns := prepareInitLedgerState(ledger, numInAccs)
+
+	sendTxBytes := newRawSendTx(chainID, 1, true, accOut, accIns[0], false)
+	_, res := ledger.ScreenTx(sendTxBytes)
+	assert.True(res.IsOK(), res.Message)
+	nextTxBytes := newRawSendTx(chainID, 2, true, accOut, accIns[0], false)
+	_, res = ledger.ScreenTx(nextTxBytes)
+	assert.True(res.IsOK(), res.Message)
+	otherTxBytes := newRawSendTx(chainID, 1, true, accOut, accIns[1], false)
+	_, res = ledger.ScreenTx(otherTxBytes)
+	assert.True(res.IsOK(), res.Message)
+
+	// The screened state already includes the txs with sequence 1 and 2
+	replacementTxBytes := newRawSendTx(chainID, 2, true, accOut, accIns[0], true)
+	_, res = ledger.ScreenTx(replacementTxBytes)
+	assert.Equal(result.CodeInvalidSequence, res.Code, res.Message)
+
+	// The replacement is screened against the account with the preceding txs applied
+	txInfo, res := ledger.ScreenReplacementTx(replacementTxBytes, []common.Bytes{sendTxBytes})
+	assert.True(res.IsOK(), res.Message)
+	assert.Equal(accIns[0].Address, txInfo.Address)
+	assert.Equal(uint64(2), txInfo.Sequence)
+
+	_, res = ledger.ScreenReplacementTx(replacementTxBytes, []common.Bytes{})
+	assert.Equal(result.CodeInvalidSequence, res.Code, res.Message)
+
+	// A replacement signed by another account is rejected
+	tx, err := types.TxFromBytes(replacementTxBytes)
+	require.Nil(err)
+	forgedTx := tx.(*types.SendTx)
+	forgedTx.Inputs[0].Signature = accIns[1].Sign(forgedTx.SignBytes(chainID))
+	forgedTxBytes, err := types.TxToBytes(forgedTx)
+	require.Nil(err)
+	_, res = ledger.ScreenReplacementTx(forgedTxBytes, []common.Bytes{sendTxBytes})
+	assert.True(res.IsError())
+
+	// The screened state is not changed by the replacement screening
+	_, res = ledger.ScreenTx(newRawSendTx(chainID, 3, true, accOut, accIns[0], false))
+	assert.True(res.IsOK(), res.Message)
+
+	// Rescreening rebuilds the screened state from the delivered state
+	results := ledger.RescreenTxs([]common.Bytes{sendTxBytes, replacementTxBytes, nextTxBytes})
+	assert.Equal(3, len(results))
+	assert.True(results[0].IsOK(), results[0].Message)
+	assert.True(results[1].IsOK(), results[1].Message)
+	assert.Equal(result.CodeInvalidSequence, results[2].Code, results[2].Message)
+	_, res = ledger.ScreenTx(otherTxBytes)
+	assert.True(res.IsOK(), res.Message)
+}
+
 func TestLedgerProposerBlockTxs(t *testing.T) {
 	assert := assert.New(t)
 
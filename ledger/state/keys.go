@@ -1,6 +1,10 @@
 package state
 
-import "theta/common"
+import (
+	"encoding/binary"
+
+	"theta/common"
+)
 
 //
 // ------------------------- Ledger State Keys -------------------------
@@ -48,7 +52,36 @@ func StakeTransactionHeightListKey() common.Bytes {
 	return common.Bytes("ls/sthl")
 }
 
+// RedelegationListKey returns the state key for the stake redelegations in the cooldown period
+func RedelegationListKey() common.Bytes {
+	return common.Bytes("ls/rdl")
+}
+
+// DoubleSignSlashKey constructs the state key which marks the validator has been slashed for
+// double signing at the given height
+func DoubleSignSlashKey(offender common.Address, height uint64) common.Bytes {
+	heightBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(heightBytes, height)
+	key := append(common.Bytes("ls/dss/"), offender[:]...)
+	return append(key, heightBytes...)
+}
+
+// GuardianUptimeKey constructs the state key for the uptime record of the given guardian
+func GuardianUptimeKey(holder common.Address) common.Bytes {
+	return append(common.Bytes("ls/gu/"), holder[:]...)
+}
+
+// ValidatorProfileKey constructs the state key for the commission profile of the given validator
+func ValidatorProfileKey(holder common.Address) common.Bytes {
+	return append(common.Bytes("ls/vp/"), holder[:]...)
+}
+
 // StatePruningProgressKey returns the key for the state pruning progress
 func StatePruningProgressKey() common.Bytes {
 	return common.Bytes("ls/spp")
 }
+
+// ArchiveModeKey returns the key which marks the database as the storage of an archive node
+func ArchiveModeKey() common.Bytes {
+	return common.Bytes("ls/arch")
+}
@@ -0,0 +1,169 @@
+package state
+
+import (
+	"fmt"
+
+	"theta/common"
+	"theta/common/hexutil"
+	"theta/core"
+	"theta/crypto"
+	"theta/ledger/types"
+	"theta/rlp"
+	"theta/store/trie"
+)
+
+//
+// AccountProof contains the merkle proof of an account against a state root, and the merkle
+// proofs of its storage slots against the storage root of the account
+//
+type AccountProof struct {
+	Address       common.Address  `json:"address"`
+	Account       *types.Account  `json:"account"` // nil if the account does not exist
+	AccountProof  []hexutil.Bytes `json:"account_proof"`
+	StorageProofs []StorageProof  `json:"storage_proofs"`
+}
+
+// StorageProof contains the merkle proof of a storage slot
+type StorageProof struct {
+	Key   common.Hash     `json:"key"`
+	Value common.Hash     `json:"value"`
+	Proof []hexutil.Bytes `json:"proof"`
+}
+
+// GetProof returns the merkle proofs of the account and the given storage slots of the account.
+func (sv *StoreView) GetProof(addr common.Address, storageKeys []common.Hash) (*AccountProof, error) {
+	account := sv.GetAccount(addr)
+	proof := &AccountProof{
+		Address:       addr,
+		Account:       account,
+		StorageProofs: []StorageProof{},
+	}
+
+	accountProof := &proofNodeList{}
+	if err := sv.store.Prove(AccountKey(addr), accountProof); err != nil {
+		return nil, err
+	}
+	proof.AccountProof = accountProof.nodes
+
+	for _, key := range storageKeys {
+		storageProof := StorageProof{
+			Key:   key,
+			Proof: []hexutil.Bytes{},
+		}
+		if account != nil && !isEmptyRoot(account.Root) {
+			storage := sv.getAccountStorage(account)
+			if storage == nil {
+				return nil, fmt.Errorf("Failed to load the storage of account %v", addr.Hex())
+			}
+			nodes := &proofNodeList{}
+			if err := storage.Prove(key[:], nodes); err != nil {
+				return nil, err
+			}
+			storageProof.Proof = nodes.nodes
+			storageProof.Value = sv.GetState(addr, key)
+		}
+		proof.StorageProofs = append(proof.StorageProofs, storageProof)
+	}
+
+	return proof, nil
+}
+
+// VerifyAccountProof verifies the account proof against the given state root, and the storage proofs
+// against the storage root of the account. It returns the account proven by the account proof, which
+// is nil if the proof shows the account does not exist. It only depends on the proof itself, so light
+// clients can use it offline with a state root taken from a trusted block header.
+func VerifyAccountProof(stateRoot common.Hash, proof *AccountProof) (*types.Account, error) {
+	if proof == nil {
+		return nil, fmt.Errorf("Proof is empty")
+	}
+
+	accBytes, err := verifyProof(stateRoot, AccountKey(proof.Address), proof.AccountProof)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid account proof: %v", err)
+	}
+
+	var account *types.Account
+	storageRoot := core.EmptyRootHash
+	if len(accBytes) > 0 {
+		account = &types.Account{}
+		if err := types.FromBytes(accBytes, account); err != nil {
+			return nil, fmt.Errorf("Failed to decode the proven account: %v", err)
+		}
+		if !isEmptyRoot(account.Root) {
+			storageRoot = account.Root
+		}
+	}
+
+	for _, storageProof := range proof.StorageProofs {
+		enc, err := verifyProof(storageRoot, storageProof.Key[:], storageProof.Proof)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid storage proof for key %v: %v", storageProof.Key.Hex(), err)
+		}
+		value := common.Hash{}
+		if len(enc) > 0 {
+			_, content, _, err := rlp.Split(enc)
+			if err != nil {
+				return nil, fmt.Errorf("Failed to decode the proven value of key %v: %v", storageProof.Key.Hex(), err)
+			}
+			value = common.BytesToHash(content)
+		}
+		if value != storageProof.Value {
+			return nil, fmt.Errorf("Storage value mismatch for key %v, proven: %v, claimed: %v",
+				storageProof.Key.Hex(), value.Hex(), storageProof.Value.Hex())
+		}
+	}
+
+	return account, nil
+}
+
+// verifyProof returns the value of the key proven by the proof nodes, or nil if the proof shows
+// the key is absent.
+func verifyProof(root common.Hash, key []byte, nodes []hexutil.Bytes) ([]byte, error) {
+	if isEmptyRoot(root) {
+		if len(nodes) > 0 {
+			return nil, fmt.Errorf("Non-empty proof for an empty trie")
+		}
+		return nil, nil
+	}
+	value, _, err := trie.VerifyProof(root, key, newProofNodeSet(nodes))
+	return value, err
+}
+
+func isEmptyRoot(root common.Hash) bool {
+	return root == common.Hash{} || root == core.EmptyRootHash
+}
+
+// proofNodeList collects the proof nodes in the order they are written by the trie.
+type proofNodeList struct {
+	nodes []hexutil.Bytes
+}
+
+func (l *proofNodeList) Put(key []byte, value []byte) error {
+	l.nodes = append(l.nodes, common.CopyBytes(value))
+	return nil
+}
+
+var _ trie.DatabaseReader = (proofNodeSet)(nil)
+
+// proofNodeSet indexes the proof nodes by their hashes for the trie proof verification.
+type proofNodeSet map[common.Hash][]byte
+
+func newProofNodeSet(nodes []hexutil.Bytes) proofNodeSet {
+	set := make(proofNodeSet)
+	for _, node := range nodes {
+		set[crypto.Keccak256Hash(node)] = node
+	}
+	return set
+}
+
+func (s proofNodeSet) Get(key []byte) ([]byte, error) {
+	if node, ok := s[common.BytesToHash(key)]; ok {
+		return node, nil
+	}
+	return nil, fmt.Errorf("proof node %v not found", hexutil.Encode(key))
+}
+
+func (s proofNodeSet) Has(key []byte) (bool, error) {
+	_, ok := s[common.BytesToHash(key)]
+	return ok, nil
+}
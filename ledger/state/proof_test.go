@@ -0,0 +1,80 @@
+package state
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"theta/common"
+	"theta/ledger/types"
+	"theta/store/database/backend"
+)
+
+func TestAccountProof(t *testing.T) {
+	assert := assert.New(t)
+
+	sv := NewStoreView(uint64(1), common.Hash{}, backend.NewMemDatabase())
+
+	// Empty state
+	addr := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	key1 := common.BytesToHash([]byte{0x1})
+	key2 := common.BytesToHash([]byte{0x2})
+	proof, err := sv.GetProof(addr, []common.Hash{key1})
+	assert.Nil(err)
+	account, err := VerifyAccountProof(sv.Hash(), proof)
+	assert.Nil(err)
+	assert.Nil(account)
+
+	for i := 0; i < 20; i++ {
+		acc := types.MakeAccWithInitBalance(string(rune('a'+i)), types.NewCoins(100, int64(1000+i)))
+		sv.SetAccount(acc.Address, &acc.Account)
+	}
+	sv.SetAccount(addr, types.NewAccount(addr))
+	sv.SetState(addr, key1, common.BytesToHash([]byte{0xa}))
+	for i := 0; i < 20; i++ {
+		sv.SetState(addr, common.BytesToHash([]byte{0x10, byte(i)}), common.BytesToHash([]byte{byte(i + 1)}))
+	}
+	root := sv.Save()
+
+	// Existing account with an existing and a missing storage slot
+	proof, err = sv.GetProof(addr, []common.Hash{key1, key2})
+	assert.Nil(err)
+	assert.NotNil(proof.Account)
+	assert.Equal(2, len(proof.StorageProofs))
+	assert.Equal(common.BytesToHash([]byte{0xa}), proof.StorageProofs[0].Value)
+	assert.Equal(common.Hash{}, proof.StorageProofs[1].Value)
+
+	// The proof must survive a JSON round trip, e.g. to an offline light client
+	proofJSON, err := json.Marshal(proof)
+	assert.Nil(err)
+	decodedProof := &AccountProof{}
+	assert.Nil(json.Unmarshal(proofJSON, decodedProof))
+
+	account, err = VerifyAccountProof(root, decodedProof)
+	assert.Nil(err)
+	assert.NotNil(account)
+	assert.Equal(sv.GetAccount(addr).Root, account.Root)
+
+	// Tampered storage value
+	decodedProof.StorageProofs[0].Value = common.BytesToHash([]byte{0xb})
+	_, err = VerifyAccountProof(root, decodedProof)
+	assert.NotNil(err)
+
+	// Wrong state root
+	_, err = VerifyAccountProof(common.BytesToHash([]byte{0x1}), proof)
+	assert.NotNil(err)
+
+	// Missing account
+	missingAddr := common.HexToAddress("0x00000000000000000000000000000000000000bb")
+	proof, err = sv.GetProof(missingAddr, []common.Hash{key1})
+	assert.Nil(err)
+	assert.Nil(proof.Account)
+	account, err = VerifyAccountProof(root, proof)
+	assert.Nil(err)
+	assert.Nil(account)
+
+	// A claimed storage value for a missing account is rejected
+	proof.StorageProofs[0].Value = common.BytesToHash([]byte{0xa})
+	_, err = VerifyAccountProof(root, proof)
+	assert.NotNil(err)
+}
@@ -121,6 +121,15 @@ func (s *LedgerState) Screened() *StoreView {
 	return s.screened
 }
 
+// ResetScreened discards the changes made in the screened view by copying the delivered view.
+func (s *LedgerState) ResetScreened() {
+	var err error
+	s.screened, err = s.delivered.Copy()
+	if err != nil {
+		log.Panicf("ResetScreened: failed to copy to the screened view: %v", err)
+	}
+}
+
 // Finalized creates a fresh clone of delivered view to be used for checking transactions.
 func (s *LedgerState) Finalized() *StoreView {
 	return s.finalized
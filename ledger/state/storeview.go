@@ -364,6 +364,93 @@ func (sv *StoreView) UpdateStakeTransactionHeightList(hl *types.HeightList) {
 	sv.Set(StakeTransactionHeightListKey(), hlBytes)
 }
 
+// GetRedelegationList gets the stake redelegations in the cooldown period
+func (sv *StoreView) GetRedelegationList() *core.RedelegationList {
+	data := sv.Get(RedelegationListKey())
+	if data == nil || len(data) == 0 {
+		return nil
+	}
+
+	rl := &core.RedelegationList{}
+	err := types.FromBytes(data, rl)
+	if err != nil {
+		log.Panicf("Error reading redelegation list %X, error: %v",
+			data, err.Error())
+	}
+	return rl
+}
+
+// UpdateRedelegationList updates the stake redelegations in the cooldown period
+func (sv *StoreView) UpdateRedelegationList(rl *core.RedelegationList) {
+	rlBytes, err := types.ToBytes(rl)
+	if err != nil {
+		log.Panicf("Error writing redelegation list %v, error: %v",
+			rl, err.Error())
+	}
+	sv.Set(RedelegationListKey(), rlBytes)
+}
+
+// DoubleSignSlashed returns whether the validator has been slashed for double signing at the given height
+func (sv *StoreView) DoubleSignSlashed(offender common.Address, height uint64) bool {
+	data := sv.Get(DoubleSignSlashKey(offender, height))
+	return len(data) > 0
+}
+
+// SetDoubleSignSlashed marks the validator has been slashed for double signing at the given height
+func (sv *StoreView) SetDoubleSignSlashed(offender common.Address, height uint64) {
+	sv.Set(DoubleSignSlashKey(offender, height), common.Bytes{0x1})
+}
+
+// GetGuardianUptime gets the uptime record of the given guardian, returns nil if not found
+func (sv *StoreView) GetGuardianUptime(holder common.Address) *core.GuardianUptime {
+	data := sv.Get(GuardianUptimeKey(holder))
+	if data == nil || len(data) == 0 {
+		return nil
+	}
+	gu := &core.GuardianUptime{}
+	err := types.FromBytes(data, gu)
+	if err != nil {
+		log.Panicf("Error reading guardian uptime %X, error: %v",
+			data, err.Error())
+	}
+	return gu
+}
+
+// SetGuardianUptime sets the uptime record of the given guardian
+func (sv *StoreView) SetGuardianUptime(gu *core.GuardianUptime) {
+	guBytes, err := types.ToBytes(gu)
+	if err != nil {
+		log.Panicf("Error writing guardian uptime %v, error: %v",
+			gu, err.Error())
+	}
+	sv.Set(GuardianUptimeKey(gu.Holder), guBytes)
+}
+
+// GetValidatorProfile gets the commission profile of the given validator, returns nil if not found
+func (sv *StoreView) GetValidatorProfile(holder common.Address) *core.ValidatorProfile {
+	data := sv.Get(ValidatorProfileKey(holder))
+	if data == nil || len(data) == 0 {
+		return nil
+	}
+	vp := &core.ValidatorProfile{}
+	err := types.FromBytes(data, vp)
+	if err != nil {
+		log.Panicf("Error reading validator profile %X, error: %v",
+			data, err.Error())
+	}
+	return vp
+}
+
+// SetValidatorProfile sets the commission profile of the given validator
+func (sv *StoreView) SetValidatorProfile(vp *core.ValidatorProfile) {
+	vpBytes, err := types.ToBytes(vp)
+	if err != nil {
+		log.Panicf("Error writing validator profile %v, error: %v",
+			vp, err.Error())
+	}
+	sv.Set(ValidatorProfileKey(vp.Holder), vpBytes)
+}
+
 func (sv *StoreView) GetStore() *treestore.TreeStore {
 	return sv.store
 }
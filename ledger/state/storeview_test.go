@@ -308,10 +308,10 @@ func TestGetAndUpdateValidatorCandidatePool(t *testing.T) {
 	log.Infof("")
 
 	height := uint64(99999)
-	assert.Nil(vcp.WithdrawStake(sourceAddr1, holderAddr2, height))
-	assert.Nil(vcp.WithdrawStake(sourceAddr2, holderAddr1, height))
-	assert.Nil(vcp.WithdrawStake(sourceAddr3, holderAddr4, height))
-	assert.Nil(vcp.WithdrawStake(sourceAddr4, holderAddr4, height))
+	assert.Nil(vcp.WithdrawStake(sourceAddr1, holderAddr2, height, core.ReturnLockingPeriod))
+	assert.Nil(vcp.WithdrawStake(sourceAddr2, holderAddr1, height, core.ReturnLockingPeriod))
+	assert.Nil(vcp.WithdrawStake(sourceAddr3, holderAddr4, height, core.ReturnLockingPeriod))
+	assert.Nil(vcp.WithdrawStake(sourceAddr4, holderAddr4, height, core.ReturnLockingPeriod))
 
 	sv.UpdateValidatorCandidatePool(vcp)
 	vcp2 := sv.GetValidatorCandidatePool()
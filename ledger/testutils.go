@@ -63,15 +63,17 @@ func newExecSim(chainID string, db database.Database, snapshot mockSnapshot, val
 	//ledgerState.ResetState(initHeight, snapshot.block.StateHash)
 	ledgerState.ResetState(snapshot.block)
 
-	executor := exec.NewExecutor(db, chain, ledgerState, consensus, valMgr)
+	chainConfig := core.GetChainConfig(chainID)
+	executor := exec.NewExecutor(db, chain, ledgerState, consensus, valMgr, chainConfig)
 
 	ledger := &Ledger{
-		consensus: consensus,
-		valMgr:    valMgr,
-		mempool:   mempool,
-		mu:        &sync.RWMutex{},
-		state:     ledgerState,
-		executor:  executor,
+		consensus:   consensus,
+		valMgr:      valMgr,
+		mempool:     mempool,
+		chainConfig: chainConfig,
+		mu:          &sync.RWMutex{},
+		state:       ledgerState,
+		executor:    executor,
 	}
 	consensus.SetLedger(ledger)
 
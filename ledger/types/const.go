@@ -1,5 +1,7 @@
 package types
 
+import "theta/core"
+
 const (
 	// DenomThetaWei is the basic unit of theta, 1 Theta = 10^18 ThetaWei
 	DenomThetaWei string = "ThetaWei"
@@ -7,15 +9,17 @@ const (
 	// DenomTFuelWei is the basic unit of theta, 1 Theta = 10^18 ThetaWei
 	DenomTFuelWei string = "TFuelWei"
 
-	// MinimumGasPrice is the minimum gas price for a smart contract transaction
-	MinimumGasPrice uint64 = 1e8
+	// MinimumGasPrice is the minimum gas price for a smart contract transaction on the mainnet,
+	// the ledger uses the value in the chain config
+	MinimumGasPrice uint64 = core.MinimumGasPrice
 
-	// MaximumTxGasLimit is the maximum gas limit for a smart contract transaction
-	//MaximumTxGasLimit uint64 = 2e6
-	MaximumTxGasLimit uint64 = 10e6
+	// MaximumTxGasLimit is the maximum gas limit for a smart contract transaction on the mainnet,
+	// the ledger uses the value in the chain config
+	MaximumTxGasLimit uint64 = core.MaximumTxGasLimit
 
-	// MinimumTransactionFeeTFuelWei specifies the minimum fee for a regular transaction
-	MinimumTransactionFeeTFuelWei uint64 = 1e12
+	// MinimumTransactionFeeTFuelWei specifies the minimum fee for a regular transaction on the mainnet,
+	// the ledger uses the value in the chain config
+	MinimumTransactionFeeTFuelWei uint64 = core.MinimumTransactionFeeTFuelWei
 
 	// MaxAccountsAffectedPerTx specifies the max number of accounts one transaction is allowed to modify to avoid spamming
 	MaxAccountsAffectedPerTx = 512
@@ -20,6 +20,7 @@ import (
 	"io"
 
 	"theta/common"
+	"theta/core"
 	"theta/rlp"
 )
 
@@ -57,3 +58,15 @@ func (l *Log) DecodeRLP(s *rlp.Stream) error {
 	}
 	return err
 }
+
+// LogsBloom returns the bloom filter of the addresses and topics of the given logs.
+func LogsBloom(logs []*Log) core.Bloom {
+	bloom := core.Bloom{}
+	for _, log := range logs {
+		bloom.AddBytes(log.Address.Bytes())
+		for _, topic := range log.Topics {
+			bloom.AddBytes(topic.Bytes())
+		}
+	}
+	return bloom
+}
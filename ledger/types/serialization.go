@@ -36,6 +36,9 @@ const (
 	TxDepositStake
 	TxWithdrawStake
 	TxDepositStakeV2
+	TxEquivocationSlash
+	TxValidatorProfile
+	TxRedelegateStake
 )
 
 func Fuzz(data []byte) int {
@@ -109,6 +112,18 @@ func TxFromBytes(raw []byte) (Tx, error) {
 		data := &DepositStakeTxV2{}
 		err = s.Decode(data)
 		return data, err
+	} else if txType == TxEquivocationSlash {
+		data := &EquivocationSlashTx{}
+		err = s.Decode(data)
+		return data, err
+	} else if txType == TxValidatorProfile {
+		data := &ValidatorProfileTx{}
+		err = s.Decode(data)
+		return data, err
+	} else if txType == TxRedelegateStake {
+		data := &RedelegateStakeTx{}
+		err = s.Decode(data)
+		return data, err
 	} else {
 		return nil, fmt.Errorf("Unknown TX type: %v", txType)
 	}
@@ -140,6 +155,12 @@ func TxToBytes(t Tx) ([]byte, error) {
 		txType = TxWithdrawStake
 	case *DepositStakeTxV2:
 		txType = TxDepositStakeV2
+	case *EquivocationSlashTx:
+		txType = TxEquivocationSlash
+	case *ValidatorProfileTx:
+		txType = TxValidatorProfile
+	case *RedelegateStakeTx:
+		txType = TxRedelegateStake
 	default:
 		return nil, errors.New("Unsupported message type")
 	}
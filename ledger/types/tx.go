@@ -29,6 +29,9 @@ Transaction Types:
  - DepositStakeTx       Deposit stake to a target address (e.g. a validator)
  - WithdrawStakeTx      Withdraw stake from a target address (e.g. a validator)
  - SmartContractTx      Execute smart contract
+ - EquivocationSlashTx  Transaction for slashing a validator that double signed
+ - ValidatorProfileTx   Set the commission rates of a validator
+ - RedelegateStakeTx    Move stake from one holder to another (e.g. between validators)
 */
 
 // Gas of regular transactions
@@ -41,6 +44,8 @@ const (
 	GasUpdateValidatorsTx uint64 = 10000
 	GasDepositStakeTx     uint64 = 10000
 	GasWidthdrawStakeTx   uint64 = 10000
+	GasValidatorProfileTx uint64 = 10000
+	GasRedelegateStakeTx  uint64 = 10000
 )
 
 type Tx interface {
@@ -333,6 +338,40 @@ func (tx *SlashTx) String() string {
 
 //-----------------------------------------------------------------------------
 
+type EquivocationSlashTx struct {
+	Proposer TxInput                  `json:"proposer"`
+	Evidence *core.DoubleSignEvidence `json:"evidence"`
+}
+
+func (_ *EquivocationSlashTx) AssertIsTx() {}
+
+func (tx *EquivocationSlashTx) SignBytes(chainID string) []byte {
+	signBytes := encodeToBytes(chainID)
+	sig := tx.Proposer.Signature
+	tx.Proposer.Signature = nil
+	txBytes, _ := TxToBytes(tx)
+	signBytes = append(signBytes, txBytes...)
+	signBytes = addPrefixForSignBytes(signBytes)
+
+	tx.Proposer.Signature = sig
+	return signBytes
+}
+
+func (tx *EquivocationSlashTx) SetSignature(addr common.Address, sig *crypto.Signature) bool {
+	if tx.Proposer.Address == addr {
+		tx.Proposer.Signature = sig
+		return true
+	}
+	return false
+}
+
+func (tx *EquivocationSlashTx) String() string {
+	return fmt.Sprintf("EquivocationSlashTx{%v->%v, evidence: %v}",
+		tx.Proposer.Address.Hex(), tx.Evidence.Offender.Hex(), tx.Evidence)
+}
+
+//-----------------------------------------------------------------------------
+
 type SendTx struct {
 	Fee     Coins      `json:"fee"` // Fee
 	Inputs  []TxInput  `json:"inputs"`
@@ -919,6 +958,85 @@ func (tx *WithdrawStakeTx) String() string {
 		tx.Source.Address, tx.Holder.Address, tx.Source.Coins.ThetaWei, tx.Purpose)
 }
 
+//-----------------------------------------------------------------------------
+
+// ValidatorProfileTx creates or updates the commission profile of a validator. The rates are in
+// basis points. MaxCommissionRate and MaxCommissionChangeRate only take effect when the profile
+// is created, and can not be changed afterwards.
+type ValidatorProfileTx struct {
+	Fee                     Coins   `json:"fee"`    // Fee
+	Holder                  TxInput `json:"holder"` // validator stake holder account
+	CommissionRate          uint64  `json:"commission_rate"`
+	MaxCommissionRate       uint64  `json:"max_commission_rate"`
+	MaxCommissionChangeRate uint64  `json:"max_commission_change_rate"`
+}
+
+func (_ *ValidatorProfileTx) AssertIsTx() {}
+
+func (tx *ValidatorProfileTx) SignBytes(chainID string) []byte {
+	signBytes := encodeToBytes(chainID)
+	sig := tx.Holder.Signature
+	tx.Holder.Signature = nil
+	txBytes, _ := TxToBytes(tx)
+	signBytes = append(signBytes, txBytes...)
+	signBytes = addPrefixForSignBytes(signBytes)
+
+	tx.Holder.Signature = sig
+	return signBytes
+}
+
+func (tx *ValidatorProfileTx) SetSignature(addr common.Address, sig *crypto.Signature) bool {
+	if tx.Holder.Address == addr {
+		tx.Holder.Signature = sig
+		return true
+	}
+	return false
+}
+
+func (tx *ValidatorProfileTx) String() string {
+	return fmt.Sprintf("ValidatorProfileTx{%v, commission rate: %v, max rate: %v, max change rate: %v}",
+		tx.Holder.Address, tx.CommissionRate, tx.MaxCommissionRate, tx.MaxCommissionChangeRate)
+}
+
+//-----------------------------------------------------------------------------
+
+// RedelegateStakeTx moves the stake of the source from one holder to another without going
+// through the return locking period.
+type RedelegateStakeTx struct {
+	Fee        Coins    `json:"fee"`         // Fee
+	Source     TxInput  `json:"source"`      // source staker account
+	FromHolder TxOutput `json:"from_holder"` // current stake holder account
+	ToHolder   TxOutput `json:"to_holder"`   // new stake holder account
+	Purpose    uint8    `json:"purpose"`     // purpose e.g. stake for validator/guardian
+}
+
+func (_ *RedelegateStakeTx) AssertIsTx() {}
+
+func (tx *RedelegateStakeTx) SignBytes(chainID string) []byte {
+	signBytes := encodeToBytes(chainID)
+	sig := tx.Source.Signature
+	tx.Source.Signature = nil
+	txBytes, _ := TxToBytes(tx)
+	signBytes = append(signBytes, txBytes...)
+	signBytes = addPrefixForSignBytes(signBytes)
+
+	tx.Source.Signature = sig
+	return signBytes
+}
+
+func (tx *RedelegateStakeTx) SetSignature(addr common.Address, sig *crypto.Signature) bool {
+	if tx.Source.Address == addr {
+		tx.Source.Signature = sig
+		return true
+	}
+	return false
+}
+
+func (tx *RedelegateStakeTx) String() string {
+	return fmt.Sprintf("RedelegateStakeTx{%v: %v -> %v, purpose: %v}",
+		tx.Source.Address, tx.FromHolder.Address, tx.ToHolder.Address, tx.Purpose)
+}
+
 // --------------- Utils --------------- //
 
 type EthereumTxWrapper struct {
@@ -947,3 +1065,71 @@ func addPrefixForSignBytes(signBytes common.Bytes) common.Bytes {
 	}
 	return signBytes
 }
+
+// ethereumSignedTx is the RLP layout of a signed legacy Ethereum transaction
+type ethereumSignedTx struct {
+	AccountNonce uint64
+	Price        *big.Int
+	GasLimit     uint64
+	Recipient    *common.Address `rlp:"nil"`
+	Amount       *big.Int
+	Payload      []byte
+	V            *big.Int
+	R            *big.Int
+	S            *big.Int
+}
+
+// SmartContractTxFromEthereumTx extracts the SmartContractTx from a signed Ethereum
+// transaction whose payload is the Theta sign bytes (see addPrefixForSignBytes). This
+// is what Ethereum wallets produce when asked to sign a Theta smart contract transaction.
+func SmartContractTxFromEthereumTx(chainID string, rawEthTx common.Bytes) (*SmartContractTx, error) {
+	var ethTx ethereumSignedTx
+	if err := rlp.DecodeBytes(rawEthTx, &ethTx); err != nil {
+		return nil, fmt.Errorf("Failed to decode Ethereum transaction: %v", err)
+	}
+
+	if ethTx.AccountNonce != 0 || ethTx.GasLimit != 0 ||
+		(ethTx.Price != nil && ethTx.Price.Sign() != 0) ||
+		(ethTx.Amount != nil && ethTx.Amount.Sign() != 0) ||
+		ethTx.Recipient == nil || *ethTx.Recipient != (common.Address{}) {
+		return nil, fmt.Errorf("Ethereum transaction is not a wrapped Theta transaction")
+	}
+
+	encodedChainID, txBytes, err := rlp.SplitString(ethTx.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to decode chain ID: %v", err)
+	}
+	if string(encodedChainID) != chainID {
+		return nil, fmt.Errorf("Chain ID mismatch, expected: %v, actual: %v", chainID, string(encodedChainID))
+	}
+
+	tx, err := TxFromBytes(txBytes)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to parse wrapped transaction: %v", err)
+	}
+	sctx, ok := tx.(*SmartContractTx)
+	if !ok {
+		return nil, fmt.Errorf("Wrapped transaction is not a SmartContractTx")
+	}
+
+	if ethTx.V == nil || ethTx.R == nil || ethTx.S == nil {
+		return nil, fmt.Errorf("Ethereum transaction is not signed")
+	}
+	if ethTx.V.Cmp(big.NewInt(27)) != 0 && ethTx.V.Cmp(big.NewInt(28)) != 0 {
+		return nil, fmt.Errorf("Unsupported signature V value: %v", ethTx.V)
+	}
+	if ethTx.R.BitLen() > 256 || ethTx.S.BitLen() > 256 {
+		return nil, fmt.Errorf("Invalid signature")
+	}
+	sigBytes := make([]byte, 65)
+	ethTx.R.FillBytes(sigBytes[0:32])
+	ethTx.S.FillBytes(sigBytes[32:64])
+	sigBytes[64] = byte(ethTx.V.Uint64() - 27)
+	sig, err := crypto.SignatureFromBytes(sigBytes)
+	if err != nil {
+		return nil, err
+	}
+	sctx.From.Signature = sig
+
+	return sctx, nil
+}
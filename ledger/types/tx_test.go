@@ -787,3 +787,44 @@ func TestSmartContractTxJSON(t *testing.T) {
 	assert.Equal(uint64(math.MaxUint64), d.GasLimit)
 	assert.Equal(0, gasPrice.Cmp(d.GasPrice))
 }
+
+func TestSmartContractTxFromEthereumTx(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	chainID := "test_chain_id"
+	fromPrivAcc := PrivAccountFromSecret("alice")
+
+	sctx := &SmartContractTx{
+		From:     NewTxInput(fromPrivAcc.Address, NewCoins(0, 0), 1),
+		To:       TxOutput{Address: getTestAddress("contract")},
+		GasLimit: 100000,
+		GasPrice: big.NewInt(1e8),
+		Data:     common.Hex2Bytes("a9059cbb"),
+	}
+	sig := fromPrivAcc.Sign(sctx.SignBytes(chainID))
+	sigBytes := sig.ToBytes()
+
+	txBytes, err := TxToBytes(sctx)
+	require.Nil(err)
+	payload := append(encodeToBytes(chainID), txBytes...)
+	rawEthTx, err := rlp.EncodeToBytes(ethereumSignedTx{
+		Price:     big.NewInt(0),
+		Recipient: &common.Address{},
+		Amount:    big.NewInt(0),
+		Payload:   payload,
+		V:         big.NewInt(int64(sigBytes[64]) + 27),
+		R:         new(big.Int).SetBytes(sigBytes[0:32]),
+		S:         new(big.Int).SetBytes(sigBytes[32:64]),
+	})
+	require.Nil(err)
+
+	unwrapped, err := SmartContractTxFromEthereumTx(chainID, rawEthTx)
+	require.Nil(err)
+	assert.Equal(sctx.From.Address, unwrapped.From.Address)
+	assert.Equal(sctx.Data, unwrapped.Data)
+	assert.True(unwrapped.From.Signature.Verify(unwrapped.SignBytes(chainID), fromPrivAcc.Address))
+
+	_, err = SmartContractTxFromEthereumTx("other_chain_id", rawEthTx)
+	assert.NotNil(err)
+}
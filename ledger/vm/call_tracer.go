@@ -0,0 +1,127 @@
+package vm
+
+import (
+	"math/big"
+	"time"
+
+	"theta/common"
+	"theta/common/hexutil"
+)
+
+// CallFrame describes a single call or contract creation captured by the CallTracer.
+type CallFrame struct {
+	Type    string            `json:"type"`
+	From    common.Address    `json:"from"`
+	To      common.Address    `json:"to"`
+	Value   *common.JSONBig   `json:"value,omitempty"`
+	Gas     common.JSONUint64 `json:"gas"`
+	GasUsed common.JSONUint64 `json:"gas_used"`
+	Input   hexutil.Bytes     `json:"input"`
+	Output  hexutil.Bytes     `json:"output,omitempty"`
+	Error   string            `json:"error,omitempty"`
+	Calls   []*CallFrame      `json:"calls,omitempty"`
+
+	// RevertReason is left for the caller to decode from the output of reverted calls
+	RevertReason string `json:"revert_reason,omitempty"`
+
+	err error
+}
+
+// Err returns the VM error of the call, if any.
+func (f *CallFrame) Err() error {
+	return f.err
+}
+
+// CallTracer is an EVM tracer which records the tree of calls and contract creations
+// made during the execution. It implements the Tracer interface.
+type CallTracer struct {
+	root  *CallFrame
+	stack []*CallFrame
+}
+
+// NewCallTracer returns a new call tracer
+func NewCallTracer() *CallTracer {
+	return &CallTracer{}
+}
+
+// CaptureStart implements the Tracer interface to record the top level call.
+func (t *CallTracer) CaptureStart(from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) error {
+	typ := CALL
+	if create {
+		typ = CREATE
+	}
+	t.root = newCallFrame(typ, from, to, input, gas, value)
+	t.stack = []*CallFrame{t.root}
+	return nil
+}
+
+// CaptureState implements the Tracer interface. The call tracer does not record
+// individual execution steps.
+func (t *CallTracer) CaptureState(env *EVM, pc uint64, op OpCode, gas, cost uint64, memory *Memory, stack *Stack, contract *Contract, depth int, err error) error {
+	return nil
+}
+
+// CaptureFault implements the Tracer interface.
+func (t *CallTracer) CaptureFault(env *EVM, pc uint64, op OpCode, gas, cost uint64, memory *Memory, stack *Stack, contract *Contract, depth int, err error) error {
+	return nil
+}
+
+// CaptureEnd implements the Tracer interface to finalize the top level call.
+func (t *CallTracer) CaptureEnd(output []byte, gasUsed uint64, d time.Duration, err error) error {
+	if t.root == nil {
+		return nil
+	}
+	t.root.finalize(output, gasUsed, err)
+	t.stack = nil
+	return nil
+}
+
+// CaptureEnter implements the Tracer interface to open a nested call frame.
+func (t *CallTracer) CaptureEnter(typ OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	if len(t.stack) == 0 {
+		return
+	}
+	frame := newCallFrame(typ, from, to, input, gas, value)
+	parent := t.stack[len(t.stack)-1]
+	parent.Calls = append(parent.Calls, frame)
+	t.stack = append(t.stack, frame)
+}
+
+// CaptureExit implements the Tracer interface to close the innermost call frame.
+func (t *CallTracer) CaptureExit(output []byte, gasUsed uint64, err error) {
+	// The top level frame is closed by CaptureEnd
+	if len(t.stack) <= 1 {
+		return
+	}
+	frame := t.stack[len(t.stack)-1]
+	t.stack = t.stack[:len(t.stack)-1]
+	frame.finalize(output, gasUsed, err)
+}
+
+// Result returns the root of the captured call tree, or nil if nothing was executed.
+func (t *CallTracer) Result() *CallFrame {
+	return t.root
+}
+
+func newCallFrame(typ OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) *CallFrame {
+	frame := &CallFrame{
+		Type:  typ.String(),
+		From:  from,
+		To:    to,
+		Gas:   common.JSONUint64(gas),
+		Input: common.CopyBytes(input),
+	}
+	if value != nil {
+		frame.Value = (*common.JSONBig)(new(big.Int).Set(value))
+	}
+	return frame
+}
+
+func (f *CallFrame) finalize(output []byte, gasUsed uint64, err error) {
+	f.GasUsed = common.JSONUint64(gasUsed)
+	f.Output = common.CopyBytes(output)
+	if err != nil {
+		f.err = err
+		f.Error = err.Error()
+	}
+}
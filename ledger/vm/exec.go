@@ -14,6 +14,22 @@ import (
 
 // Execute executes the given smart contract
 func Execute(parentBlock *core.Block, tx *types.SmartContractTx, storeView *state.StoreView) (evmRet common.Bytes,
+	contractAddr common.Address, gasUsed uint64, evmErr error) {
+	return execute(parentBlock, tx, storeView, Config{})
+}
+
+// ExecuteWithTracer executes the given smart contract and reports the execution
+// steps and the nested calls to the tracer
+func ExecuteWithTracer(parentBlock *core.Block, tx *types.SmartContractTx, storeView *state.StoreView, tracer Tracer) (evmRet common.Bytes,
+	contractAddr common.Address, gasUsed uint64, evmErr error) {
+	config := Config{
+		Debug:  true,
+		Tracer: tracer,
+	}
+	return execute(parentBlock, tx, storeView, config)
+}
+
+func execute(parentBlock *core.Block, tx *types.SmartContractTx, storeView *state.StoreView, config Config) (evmRet common.Bytes,
 	contractAddr common.Address, gasUsed uint64, evmErr error) {
 	context := Context{
 		CanTransfer: CanTransfer,
@@ -25,11 +41,10 @@ func Execute(parentBlock *core.Block, tx *types.SmartContractTx, storeView *stat
 		Time:        parentBlock.Timestamp,
 		Difficulty:  new(big.Int).SetInt64(0),
 	}
-	chainIDBigInt := mapChainID(parentBlock.ChainID)
+	chainIDBigInt := MapChainID(parentBlock.ChainID)
 	chainConfig := &params.ChainConfig{
 		ChainID: chainIDBigInt,
 	}
-	config := Config{}
 	evm := NewEVM(context, storeView, chainConfig, config)
 
 	value := tx.From.Coins.TFuelWei
@@ -41,7 +56,7 @@ func Execute(parentBlock *core.Block, tx *types.SmartContractTx, storeView *stat
 	contractAddr = tx.To.Address
 	createContract := (contractAddr == common.Address{})
 
-	if gasLimit > types.MaximumTxGasLimit {
+	if gasLimit > core.GetChainConfig(parentBlock.ChainID).MaximumTxGasLimit {
 		return common.Bytes{}, common.Address{}, 0, ErrInvalidGasLimit
 	}
 
@@ -105,9 +120,9 @@ func calculateIntrinsicGas(data []byte, createContract bool) (uint64, error) {
 	return gas, nil
 }
 
-// To be compatible with Ethereum, mapChainID() returns 1 for "mainnet", 3 for "testnet_sapphire", and 4 for "testnet_amber"
+// To be compatible with Ethereum, MapChainID() returns 1 for "mainnet", 3 for "testnet_sapphire", and 4 for "testnet_amber"
 // Reference: https://github.com/ethereum/go-ethereum/blob/43cd31ea9f57e26f8f67aa8bd03bbb0a50814465/params/config.go#L55
-func mapChainID(chainIDStr string) *big.Int {
+func MapChainID(chainIDStr string) *big.Int {
 	if chainIDStr == "mainnet" { // correspond to the Ethereum mainnet
 		return big.NewInt(1)
 	} else if chainIDStr == "testnet_sapphire" { // correspond to Ropsten
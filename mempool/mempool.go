@@ -5,19 +5,23 @@ import (
 	"encoding/hex"
 	"errors"
 	"math/big"
+	"sort"
 	"sync"
 	"time"
 
 	log "github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
 
 	"theta/common"
 	"theta/common/clist"
 	"theta/common/math"
+	"theta/common/metrics"
 	"theta/common/pqueue"
 	"theta/common/result"
 	"theta/consensus"
 	"theta/core"
 	dp "theta/dispatcher"
+	"theta/store/database"
 )
 
 var logger *log.Entry = log.WithFields(log.Fields{"prefix": "mempool"})
@@ -30,6 +34,28 @@ func (m MempoolError) Error() string {
 
 const DuplicateTxError = MempoolError("Transaction already seen")
 
+const MempoolFullError = MempoolError("Mempool is full")
+
+const AccountTxQuotaError = MempoolError("Too many pending transactions from the account")
+
+const ReplacementUnderpricedError = MempoolError("Replacement transaction underpriced")
+
+// insertedTxsQueueSize is the capacity of the channel that publishes newly inserted transactions
+const insertedTxsQueueSize = 1024
+
+// rejectionReasons maps the screening error codes to the reasons reported by the rejection
+// metrics. Transactions rejected with other codes are reported as "other".
+var rejectionReasons = map[result.ErrorCode]string{
+	result.CodeInvalidSignature: "invalid_signature",
+	result.CodeInvalidSequence:  "invalid_sequence",
+	result.CodeInsufficientFund: "insufficient_fund",
+	result.CodeInvalidFee:       "invalid_fee",
+	result.CodeInvalidGasPrice:  "invalid_gas",
+	result.CodeInvalidGasLimit:  "invalid_gas",
+	result.CodeFeeLimitTooHigh:  "invalid_gas",
+	result.CodeUnauthorizedTx:   "unauthorized",
+}
+
 //
 // mempoolTransaction implements the pqueue.Element interface
 //
@@ -66,9 +92,11 @@ func createMempoolTransaction(rawTransaction common.Bytes, txInfo *core.TxInfo)
 // their lowest sequence transaction.
 //
 type mempoolTransactionGroup struct {
-	address common.Address
-	txs     *pqueue.PriorityQueue
-	index   int
+	address     common.Address
+	txs         *pqueue.PriorityQueue
+	index       int
+	numBytes    int       // total size of the transactions in the group
+	lastUpdated time.Time // time when the last transaction was added to the group
 }
 
 var _ pqueue.Element = (*mempoolTransactionGroup)(nil)
@@ -91,17 +119,110 @@ func (mtg *mempoolTransactionGroup) GetIndex() int {
 func (mtg *mempoolTransactionGroup) AddTx(rawTx common.Bytes, txInfo *core.TxInfo) {
 	mpx := createMempoolTransaction(rawTx, txInfo)
 	mtg.txs.Push(mpx)
+	mtg.numBytes += len(rawTx)
+	mtg.lastUpdated = time.Now()
 }
 
 func (mtg *mempoolTransactionGroup) PopTx() (common.Bytes, *core.TxInfo) {
 	mptx := mtg.txs.Pop().(*mempoolTransaction)
+	mtg.numBytes -= len(mptx.rawTransaction)
 	return mptx.rawTransaction, mptx.txInfo
 }
 
+// PopLastTx removes the transaction with the highest sequence from the group. Evicting the
+// last transaction keeps the remaining ones executable.
+func (mtg *mempoolTransactionGroup) PopLastTx() common.Bytes {
+	var last *mempoolTransaction
+	for _, elem := range *mtg.txs.ElementList() {
+		mptx := elem.(*mempoolTransaction)
+		if last == nil || mptx.Priority().Cmp(last.Priority()) < 0 {
+			last = mptx
+		}
+	}
+	if last == nil {
+		return nil
+	}
+	mtg.txs.Remove(last.GetIndex())
+	mtg.numBytes -= len(last.rawTransaction)
+	return last.rawTransaction
+}
+
+// PopAllTxs removes all the transactions from the group.
+func (mtg *mempoolTransactionGroup) PopAllTxs() []common.Bytes {
+	rawTxs := []common.Bytes{}
+	for !mtg.IsEmpty() {
+		rawTx, _ := mtg.PopTx()
+		rawTxs = append(rawTxs, rawTx)
+	}
+	return rawTxs
+}
+
+// FindTx returns the transaction with the given sequence, or nil if the group does not have one.
+func (mtg *mempoolTransactionGroup) FindTx(sequence uint64) *mempoolTransaction {
+	for _, elem := range *mtg.txs.ElementList() {
+		mptx := elem.(*mempoolTransaction)
+		if mptx.txInfo.Sequence == sequence {
+			return mptx
+		}
+	}
+	return nil
+}
+
+// ReplaceTx replaces the content of the given transaction. The new transaction must have the
+// same sequence, so the position of the transaction in the group remains valid.
+func (mtg *mempoolTransactionGroup) ReplaceTx(mptx *mempoolTransaction, rawTx common.Bytes, txInfo *core.TxInfo) {
+	mtg.numBytes += len(rawTx) - len(mptx.rawTransaction)
+	mptx.rawTransaction = rawTx
+	mptx.txInfo = txInfo
+	mtg.lastUpdated = time.Now()
+}
+
+// SortedTxs returns the raw transactions of the group in the ascending order of sequence.
+func (mtg *mempoolTransactionGroup) SortedTxs() []common.Bytes {
+	mptxs := mtg.sortedMempoolTxs()
+	rawTxs := make([]common.Bytes, len(mptxs))
+	for i, mptx := range mptxs {
+		rawTxs[i] = mptx.rawTransaction
+	}
+	return rawTxs
+}
+
+// PrecedingTxs returns the raw transactions of the group with lower sequences than the given
+// sequence in the ascending order of sequence.
+func (mtg *mempoolTransactionGroup) PrecedingTxs(sequence uint64) []common.Bytes {
+	rawTxs := []common.Bytes{}
+	for _, mptx := range mtg.sortedMempoolTxs() {
+		if mptx.txInfo.Sequence >= sequence {
+			break
+		}
+		rawTxs = append(rawTxs, mptx.rawTransaction)
+	}
+	return rawTxs
+}
+
+func (mtg *mempoolTransactionGroup) sortedMempoolTxs() []*mempoolTransaction {
+	mptxs := []*mempoolTransaction{}
+	for _, elem := range *mtg.txs.ElementList() {
+		mptxs = append(mptxs, elem.(*mempoolTransaction))
+	}
+	sort.Slice(mptxs, func(i, j int) bool {
+		return mptxs[i].txInfo.Sequence < mptxs[j].txInfo.Sequence
+	})
+	return mptxs
+}
+
+func (mtg *mempoolTransactionGroup) NumTxs() int {
+	return mtg.txs.NumElements()
+}
+
 func (mtg *mempoolTransactionGroup) IsEmpty() bool {
 	return mtg.txs.IsEmpty()
 }
 
+func (mtg *mempoolTransactionGroup) HasExpired(lifetime time.Duration) bool {
+	return time.Since(mtg.lastUpdated) > lifetime
+}
+
 // RemoveTxs removes matching Txs from transaction group. Returns number of Txs removed.
 func (mtg *mempoolTransactionGroup) RemoveTxs(committedRawTxMap map[string]bool) (numRemoved int) {
 	elementList := mtg.txs.ElementList()
@@ -116,6 +237,7 @@ func (mtg *mempoolTransactionGroup) RemoveTxs(committedRawTxMap map[string]bool)
 	}
 	for _, elem := range elemsTobeRemoved {
 		mtg.txs.Remove(elem.GetIndex())
+		mtg.numBytes -= len(elem.(*mempoolTransaction).rawTransaction)
 		numRemoved++
 	}
 	return
@@ -137,7 +259,7 @@ func createMempoolTransactionGroup(rawTx common.Bytes, txInfo *core.TxInfo) *mem
 type Mempool struct {
 	mutex *sync.Mutex
 
-	consensus  *consensus.ConsensusEngine
+	consensus  syncStatus
 	ledger     core.Ledger
 	dispatcher *dp.Dispatcher
 
@@ -146,6 +268,19 @@ type Mempool struct {
 	txBookeepper     transactionBookkeeper
 	addressToTxGroup map[common.Address]*mempoolTransactionGroup
 	size             int
+	insertedTxs      chan common.Bytes
+
+	numCandidateTxs      int           // number of transactions in the candidate pool
+	numCandidateBytes    int           // total size of the transactions in the candidate pool
+	maxNumTxs            int           // maximum number of transactions in the candidate pool
+	maxNumBytes          int           // maximum total size of the transactions in the candidate pool
+	maxNumTxsPerAccount  int           // maximum number of pending transactions of an account
+	txGroupLifetime      time.Duration // lifetime of the transaction group of an inactive account
+	replacementPriceBump int64         // minimum gas price increase in percent to replace a pending transaction
+
+	journal *txJournal // persists the candidate transactions across restarts, nil if disabled
+
+	sizeGauge metrics.Gauge // Gauge for the number of transactions in the mempool
 
 	// Life cycle
 	wg      *sync.WaitGroup
@@ -155,8 +290,21 @@ type Mempool struct {
 	stopped bool
 }
 
+// syncStatus reports whether the node has caught up with the chain. The incoming transactions
+// are only screened after the node has synced.
+type syncStatus interface {
+	HasSynced() bool
+}
+
+var _ syncStatus = (*consensus.ConsensusEngine)(nil)
+
 // CreateMempool creates an instance of Mempool
 func CreateMempool(dispatcher *dp.Dispatcher, engine *consensus.ConsensusEngine) *Mempool {
+	txGroupLifetime := time.Duration(viper.GetInt(common.CfgMempoolTxGroupLifetimeSecs)) * time.Second
+	txStatusRetention := time.Duration(viper.GetInt(common.CfgMempoolTxStatusRetentionSecs)) * time.Second
+	if txStatusRetention <= 0 {
+		txStatusRetention = maxTxLife
+	}
 	return &Mempool{
 		mutex:            &sync.Mutex{},
 		consensus:        engine,
@@ -164,8 +312,16 @@ func CreateMempool(dispatcher *dp.Dispatcher, engine *consensus.ConsensusEngine)
 		newTxs:           clist.New(),
 		candidateTxs:     pqueue.CreatePriorityQueue(),
 		addressToTxGroup: make(map[common.Address]*mempoolTransactionGroup),
-		txBookeepper:     createTransactionBookkeeper(defaultMaxNumTxs),
-		wg:               &sync.WaitGroup{},
+		// Keep the records after the transactions expire so that their statuses can be queried.
+		txBookeepper:         createTransactionBookkeeper(defaultMaxNumTxs, txGroupLifetime+txStatusRetention),
+		insertedTxs:          make(chan common.Bytes, insertedTxsQueueSize),
+		maxNumTxs:            viper.GetInt(common.CfgMempoolMaxNumTxs),
+		maxNumBytes:          viper.GetInt(common.CfgMempoolMaxTotalBytes),
+		maxNumTxsPerAccount:  viper.GetInt(common.CfgMempoolMaxNumTxsPerAccount),
+		txGroupLifetime:      txGroupLifetime,
+		replacementPriceBump: viper.GetInt64(common.CfgMempoolReplacementPriceBump),
+		sizeGauge:            metrics.GetOrRegisterGauge("mempool/size", nil),
+		wg:                   &sync.WaitGroup{},
 	}
 }
 
@@ -174,6 +330,12 @@ func (mp *Mempool) SetLedger(ledger core.Ledger) {
 	mp.ledger = ledger
 }
 
+// SetJournal enables the journal which persists the candidate transactions in the given
+// database. The journaled transactions are screened and reinserted when the mempool starts.
+func (mp *Mempool) SetJournal(db database.Database) {
+	mp.journal = newTxJournal(db)
+}
+
 // InsertTransaction inserts the incoming transaction to mempool (submitted by the clients or relayed from peers)
 func (mp *Mempool) InsertTransaction(rawTx common.Bytes) error {
 	mp.mutex.Lock()
@@ -182,6 +344,7 @@ func (mp *Mempool) InsertTransaction(rawTx common.Bytes) error {
 	if mp.txBookeepper.hasSeen(rawTx) {
 		logger.Debugf("Transaction already seen: %v, hash: 0x%v",
 			hex.EncodeToString(rawTx), getTransactionHash(rawTx))
+		mp.recordRejection("duplicate")
 		return DuplicateTxError
 	}
 
@@ -190,29 +353,25 @@ func (mp *Mempool) InsertTransaction(rawTx common.Bytes) error {
 
 	// Delay tx verification when in fast sync
 	if mp.consensus.HasSynced() {
+		// A transaction with the same sequence as a pending transaction of the account
+		// replaces the pending one if it pays a sufficiently higher gas price
+		if txInfo, res := mp.ledger.GetTxInfo(rawTx); res.IsOK() {
+			if txGroup, ok := mp.addressToTxGroup[txInfo.Address]; ok {
+				if pendingTx := txGroup.FindTx(txInfo.Sequence); pendingTx != nil {
+					return mp.replaceTransaction(txGroup, pendingTx, rawTx, txInfo)
+				}
+			}
+		}
+
 		txInfo, checkTxRes = mp.ledger.ScreenTx(rawTx)
 		if !checkTxRes.IsOK() {
 			logger.Debugf("Transaction screening failed, tx: %v, error: %v", hex.EncodeToString(rawTx), checkTxRes.Message)
-			return errors.New(checkTxRes.Message)
+			return mp.rejectTx(rawTx, rejectionReason(checkTxRes.Code), errors.New(checkTxRes.Message))
 		}
 
-		// only record the transactions that passed the screening. This is because that
-		// an invalid transaction could becoume valid later on. For example, assume expected
-		// sequence for an account is 6. The account accidentally submits txA (seq = 7), got rejected.
-		// He then submit txB(seq = 6), and then txA(seq = 7) again. For the second submission, txA
-		// should not be rejected even though it has been submitted earlier.
-		mp.txBookeepper.record(rawTx)
-
-		txGroup, ok := mp.addressToTxGroup[txInfo.Address]
-		if ok {
-			txGroup.AddTx(rawTx, txInfo)
-			mp.candidateTxs.Remove(txGroup.index) // Need to re-insert txGroup into queue since its priority could change.
-		} else {
-			txGroup = createMempoolTransactionGroup(rawTx, txInfo)
-			mp.addressToTxGroup[txInfo.Address] = txGroup
+		if err := mp.addCandidateTx(rawTx, txInfo); err != nil {
+			return err
 		}
-		mp.candidateTxs.Push(txGroup)
-		logger.Debugf("rawTx: %v, txInfo: %v", hex.EncodeToString(rawTx), txInfo)
 	} else {
 		// Record tx during sync for gossiping purpose
 		mp.txBookeepper.record(rawTx)
@@ -224,18 +383,322 @@ func (mp *Mempool) InsertTransaction(rawTx common.Bytes) error {
 
 	mp.newTxs.PushBack(rawTx)
 	mp.size++
+	mp.sizeGauge.Update(int64(mp.size))
 	return nil
 }
 
+// addCandidateTx adds a screened transaction to the candidate pool, evicting the transactions
+// with lower fees if the pool is full.
+func (mp *Mempool) addCandidateTx(rawTx common.Bytes, txInfo *core.TxInfo) error {
+	txGroup, ok := mp.addressToTxGroup[txInfo.Address]
+	if ok && txGroup.NumTxs() >= mp.maxNumTxsPerAccount {
+		logger.Debugf("Too many pending transactions from %v, tx: %v", txInfo.Address.Hex(), hex.EncodeToString(rawTx))
+		return mp.rejectTx(rawTx, "account_quota", AccountTxQuotaError)
+	}
+	numEvicted, err := mp.makeRoom(rawTx, txInfo)
+	if numEvicted > 0 {
+		// The screened state still reflects the evicted transactions
+		defer mp.rescreenCandidateTxs()
+	}
+	if err != nil {
+		logger.Debugf("Mempool is full, tx: %v", hex.EncodeToString(rawTx))
+		return mp.rejectTx(rawTx, "full", err)
+	}
+
+	// only record the transactions that passed the screening. This is because that
+	// an invalid transaction could becoume valid later on. For example, assume expected
+	// sequence for an account is 6. The account accidentally submits txA (seq = 7), got rejected.
+	// He then submit txB(seq = 6), and then txA(seq = 7) again. For the second submission, txA
+	// should not be rejected even though it has been submitted earlier.
+	mp.txBookeepper.record(rawTx)
+
+	if ok {
+		txGroup.AddTx(rawTx, txInfo)
+		mp.candidateTxs.Remove(txGroup.index) // Need to re-insert txGroup into queue since its priority could change.
+	} else {
+		txGroup = createMempoolTransactionGroup(rawTx, txInfo)
+		mp.addressToTxGroup[txInfo.Address] = txGroup
+	}
+	mp.candidateTxs.Push(txGroup)
+	mp.numCandidateTxs++
+	mp.numCandidateBytes += len(rawTx)
+	logger.Debugf("rawTx: %v, txInfo: %v", hex.EncodeToString(rawTx), txInfo)
+
+	select {
+	case mp.insertedTxs <- rawTx:
+	default:
+		logger.Debugf("Failed to notify inserted tx, tx.hash: 0x%v", getTransactionHash(rawTx))
+	}
+
+	if mp.journal != nil {
+		mp.journal.insert(rawTx)
+	}
+	return nil
+}
+
+// replaceTransaction replaces the pending transaction of a transaction group with the given
+// transaction. The replacement is fully screened against the account before the pending transaction
+// prior to changing the group, so an invalid replacement leaves the group and the screened state
+// untouched. Since the screened state still reflects the replaced transaction, all the candidate
+// transactions are screened again after the replacement. The rejected replacements are kept in the
+// seen cache, so that resubmitting them does not trigger the screening again.
+func (mp *Mempool) replaceTransaction(txGroup *mempoolTransactionGroup, pendingTx *mempoolTransaction,
+	rawTx common.Bytes, txInfo *core.TxInfo) error {
+	pendingRawTx, pendingTxInfo := pendingTx.rawTransaction, pendingTx.txInfo
+
+	minGasPrice := new(big.Int).Mul(pendingTxInfo.EffectiveGasPrice, big.NewInt(100+mp.replacementPriceBump))
+	minGasPrice.Div(minGasPrice, big.NewInt(100))
+	if txInfo.EffectiveGasPrice.Cmp(minGasPrice) < 0 || txInfo.EffectiveGasPrice.Cmp(pendingTxInfo.EffectiveGasPrice) <= 0 {
+		logger.Debugf("Replacement transaction underpriced, tx: %v", hex.EncodeToString(rawTx))
+		return mp.rejectReplacement(rawTx, "underpriced", ReplacementUnderpricedError)
+	}
+	if mp.numCandidateBytes+len(rawTx)-len(pendingRawTx) > mp.maxNumBytes {
+		logger.Debugf("Mempool is full, tx: %v", hex.EncodeToString(rawTx))
+		return mp.rejectTx(rawTx, "full", MempoolFullError)
+	}
+
+	txInfo, res := mp.ledger.ScreenReplacementTx(rawTx, txGroup.PrecedingTxs(pendingTxInfo.Sequence))
+	if !res.IsOK() {
+		logger.Debugf("Transaction screening failed, tx: %v, error: %v", hex.EncodeToString(rawTx), res.Message)
+		return mp.rejectReplacement(rawTx, rejectionReason(res.Code), errors.New(res.Message))
+	}
+
+	mp.replaceTx(txGroup, pendingTx, rawTx, txInfo)
+	mp.txBookeepper.record(rawTx)
+	mp.txBookeepper.markReplaced(pendingRawTx, rawTx)
+	if mp.journal != nil {
+		mp.journal.remove([]common.Bytes{pendingRawTx})
+		mp.journal.insert(rawTx)
+	}
+
+	// The later transactions of the account might not be affordable any more
+	mp.rescreenCandidateTxs()
+
+	select {
+	case mp.insertedTxs <- rawTx:
+	default:
+		logger.Debugf("Failed to notify inserted tx, tx.hash: 0x%v", getTransactionHash(rawTx))
+	}
+
+	logger.Infof("Replace tx, tx.hash: 0x%v, replaced tx.hash: 0x%v", getTransactionHash(rawTx), getTransactionHash(pendingRawTx))
+
+	mp.newTxs.PushBack(rawTx)
+	return nil
+}
+
+// replaceTx replaces the content of a transaction in the candidate pool, and updates the
+// position of its transaction group since the priority of the group could change.
+func (mp *Mempool) replaceTx(txGroup *mempoolTransactionGroup, mptx *mempoolTransaction, rawTx common.Bytes, txInfo *core.TxInfo) {
+	mp.numCandidateBytes += len(rawTx) - len(mptx.rawTransaction)
+	txGroup.ReplaceTx(mptx, rawTx, txInfo)
+	mp.candidateTxs.Remove(txGroup.GetIndex())
+	mp.candidateTxs.Push(txGroup)
+}
+
+// rescreenCandidateTxs discards the screened state, screens all the candidate transactions again
+// starting from the delivered state, and removes the ones that are no longer valid. It is needed
+// after pending transactions are replaced or evicted, since the screened state still reflects them.
+func (mp *Mempool) rescreenCandidateTxs() {
+	rawTxs := []common.Bytes{}
+	for _, elem := range *mp.candidateTxs.ElementList() {
+		rawTxs = append(rawTxs, elem.(*mempoolTransactionGroup).SortedTxs()...)
+	}
+
+	invalidTxs := []common.Bytes{}
+	for i, res := range mp.ledger.RescreenTxs(rawTxs) {
+		if !res.IsOK() {
+			invalidTxs = append(invalidTxs, rawTxs[i])
+			mp.txBookeepper.markAbandoned(rawTxs[i], res.Message)
+		}
+	}
+	if len(invalidTxs) == 0 {
+		return
+	}
+	mp.removeTxs(invalidTxs)
+	mp.sizeGauge.Update(int64(mp.size))
+}
+
+// makeRoom evicts the transactions until the candidate pool has room for the given transaction,
+// and returns the number of evicted transactions. The expired transaction groups are evicted first,
+// followed by the transactions with the lowest fees. The transactions of the same account are never
+// evicted, and MempoolFullError is returned if none of the other transactions has a lower fee.
+func (mp *Mempool) makeRoom(rawTx common.Bytes, txInfo *core.TxInfo) (numEvicted int, err error) {
+	if len(rawTx) > mp.maxNumBytes {
+		return 0, MempoolFullError
+	}
+
+	isFull := func() bool {
+		return mp.numCandidateTxs+1 > mp.maxNumTxs || mp.numCandidateBytes+len(rawTx) > mp.maxNumBytes
+	}
+	if isFull() {
+		numEvicted += mp.evictExpiredTxGroups(txInfo.Address)
+	}
+
+	for isFull() {
+		var lowest *mempoolTransactionGroup
+		for _, elem := range *mp.candidateTxs.ElementList() {
+			txGroup := elem.(*mempoolTransactionGroup)
+			if txGroup.address == txInfo.Address {
+				continue
+			}
+			if lowest == nil || txGroup.Priority().Cmp(lowest.Priority()) < 0 {
+				lowest = txGroup
+			}
+		}
+		if lowest == nil || lowest.Priority().Cmp(txInfo.EffectiveGasPrice) >= 0 {
+			return numEvicted, MempoolFullError
+		}
+
+		evictedTx := lowest.PopLastTx()
+		numEvicted++
+		mp.txBookeepper.markEvicted(evictedTx, TxEvictionReasonLowFee)
+		if mp.journal != nil {
+			mp.journal.remove([]common.Bytes{evictedTx})
+		}
+		mp.numCandidateTxs--
+		mp.numCandidateBytes -= len(evictedTx)
+		mp.size--
+		if lowest.IsEmpty() {
+			mp.candidateTxs.Remove(lowest.GetIndex())
+			delete(mp.addressToTxGroup, lowest.address)
+		}
+
+		logger.Debugf("Evicted tx with low fee, tx.hash: 0x%v", getTransactionHash(evictedTx))
+	}
+	return numEvicted, nil
+}
+
+// evictExpiredTxGroups evicts the transactions of the accounts other than the given one that did
+// not send any new transaction within the transaction group lifetime, and returns the number of
+// evicted transactions. It is only called when the candidate pool is full, and a zero lifetime
+// disables it.
+func (mp *Mempool) evictExpiredTxGroups(exclude common.Address) (numEvicted int) {
+	if mp.txGroupLifetime <= 0 {
+		return 0
+	}
+
+	expiredTxGroups := []*mempoolTransactionGroup{}
+	for _, elem := range *mp.candidateTxs.ElementList() {
+		txGroup := elem.(*mempoolTransactionGroup)
+		if txGroup.address != exclude && txGroup.HasExpired(mp.txGroupLifetime) {
+			expiredTxGroups = append(expiredTxGroups, txGroup)
+		}
+	}
+
+	// Note after each removal, the indices of the elems in the priority queue
+	// could change. So we need txGroup.GetIndex() to return the updated index
+	for _, txGroup := range expiredTxGroups {
+		mp.candidateTxs.Remove(txGroup.GetIndex())
+		delete(mp.addressToTxGroup, txGroup.address)
+
+		numBytes := txGroup.numBytes
+		evictedTxs := txGroup.PopAllTxs()
+		for _, evictedTx := range evictedTxs {
+			mp.txBookeepper.markEvicted(evictedTx, TxEvictionReasonExpired)
+		}
+		if mp.journal != nil {
+			mp.journal.remove(evictedTxs)
+		}
+		mp.numCandidateTxs -= len(evictedTxs)
+		mp.numCandidateBytes -= numBytes
+		mp.size -= len(evictedTxs)
+		numEvicted += len(evictedTxs)
+
+		logger.Debugf("Evicted %v expired txs from %v", len(evictedTxs), txGroup.address.Hex())
+	}
+	return numEvicted
+}
+
+// recordRejection counts a rejected transaction under the given reason.
+func (mp *Mempool) recordRejection(reason string) {
+	metrics.GetOrRegisterCounter("mempool/rejected/"+reason, nil).Inc(1)
+}
+
+// rejectTx counts a rejected transaction under the given reason, and records the error so
+// that it can be queried by the transaction hash.
+func (mp *Mempool) rejectTx(rawTx common.Bytes, reason string, err error) error {
+	mp.recordRejection(reason)
+	mp.txBookeepper.recordRejected(rawTx, err.Error())
+	return err
+}
+
+// rejectReplacement rejects a replacement transaction like rejectTx, and keeps the transaction in
+// the seen cache, so that the same replacement is rejected as a duplicate until its record expires.
+func (mp *Mempool) rejectReplacement(rawTx common.Bytes, reason string, err error) error {
+	mp.recordRejection(reason)
+	mp.txBookeepper.recordRejectedAsSeen(rawTx, err.Error())
+	return err
+}
+
+// rejectionReason returns the reason reported by the rejection metrics for the given
+// screening error code.
+func rejectionReason(code result.ErrorCode) string {
+	if reason, ok := rejectionReasons[code]; ok {
+		return reason
+	}
+	return "other"
+}
+
 // Start needs to be called when the Mempool starts
 func (mp *Mempool) Start(ctx context.Context) error {
 	c, cancel := context.WithCancel(ctx)
 	mp.ctx = c
 	mp.cancel = cancel
 
+	if mp.journal != nil {
+		mp.restoreJournal()
+	}
+
 	return nil
 }
 
+// restoreJournal screens the journaled transactions against the current ledger state and
+// inserts the valid ones into the candidate pool. The invalid ones are removed from the journal.
+func (mp *Mempool) restoreJournal() {
+	mp.mutex.Lock()
+	defer mp.mutex.Unlock()
+
+	rawTxs, err := mp.journal.load()
+	if err != nil {
+		logger.Errorf("Failed to load the tx journal: %v", err)
+		return
+	}
+
+	// Screen the transactions of each account in the ascending order of sequence
+	sequences := make(map[string]uint64)
+	for _, rawTx := range rawTxs {
+		if txInfo, res := mp.ledger.GetTxInfo(rawTx); res.IsOK() {
+			sequences[string(rawTx)] = txInfo.Sequence
+		}
+	}
+	sort.SliceStable(rawTxs, func(i, j int) bool {
+		return sequences[string(rawTxs[i])] < sequences[string(rawTxs[j])]
+	})
+
+	numRestored := 0
+	invalidTxs := []common.Bytes{}
+	for _, rawTx := range rawTxs {
+		txInfo, checkTxRes := mp.ledger.ScreenTx(rawTx)
+		if !checkTxRes.IsOK() {
+			logger.Debugf("Journaled transaction screening failed, tx: %v, error: %v", hex.EncodeToString(rawTx), checkTxRes.Message)
+			invalidTxs = append(invalidTxs, rawTx)
+			continue
+		}
+		if err := mp.addCandidateTx(rawTx, txInfo); err != nil {
+			invalidTxs = append(invalidTxs, rawTx)
+			continue
+		}
+
+		mp.newTxs.PushBack(rawTx)
+		mp.size++
+		numRestored++
+	}
+	mp.journal.remove(invalidTxs)
+	mp.sizeGauge.Update(int64(mp.size))
+
+	logger.Infof("Restored %v txs from the journal, dropped %v invalid txs", numRestored, len(invalidTxs))
+}
+
 // Stop needs to be called when the Mempool stops
 func (mp *Mempool) Stop() {
 	mp.cancel()
@@ -292,6 +755,8 @@ func (mp *Mempool) ReapUnsafe(maxNumTxs int) []common.Bytes {
 		}
 		txGroup := mp.candidateTxs.Pop().(*mempoolTransactionGroup)
 		rawTx, txInfo := txGroup.PopTx()
+		mp.numCandidateTxs--
+		mp.numCandidateBytes -= len(rawTx)
 
 		// Check for outdated txs
 		txHash := getTransactionHash(rawTx)
@@ -312,6 +777,7 @@ func (mp *Mempool) ReapUnsafe(maxNumTxs int) []common.Bytes {
 	}
 
 	mp.size -= len(txs)
+	mp.sizeGauge.Update(int64(mp.size))
 
 	return txs
 }
@@ -357,7 +823,7 @@ func (mp *Mempool) UpdateUnsafe(committedRawTxs []common.Bytes) {
 			checkTxRes := mp.ledger.ScreenTxUnsafe(mempoolTx.rawTransaction)
 			if !checkTxRes.IsOK() {
 				invalidTxs = append(invalidTxs, mempoolTx.rawTransaction)
-				mp.txBookeepper.markAbandoned(mempoolTx.rawTransaction)
+				mp.txBookeepper.markAbandoned(mempoolTx.rawTransaction, checkTxRes.Message)
 			}
 		}
 	}
@@ -366,11 +832,16 @@ func (mp *Mempool) UpdateUnsafe(committedRawTxs []common.Bytes) {
 	start = time.Now()
 	mp.removeTxs(invalidTxs)
 	removeInvalidTxTime := time.Since(start)
+	mp.sizeGauge.Update(int64(mp.size))
 
 	logger.Debugf("UpdateUnsafe: %d tx screened in %v, removeCommittedTxTime = %v, removed %d obsolete Txs in %v: %v,", count, screenTxTime, removeCommittedTxTime, len(invalidTxs), removeInvalidTxTime, invalidTxs)
 }
 
 func (mp *Mempool) removeTxs(committedRawTxs []common.Bytes) {
+	if mp.journal != nil {
+		mp.journal.remove(committedRawTxs)
+	}
+
 	committedRawTxMap := make(map[string]bool)
 	for _, rawtx := range committedRawTxs {
 		committedRawTxMap[string(rawtx)] = true
@@ -380,8 +851,11 @@ func (mp *Mempool) removeTxs(committedRawTxs []common.Bytes) {
 	elemsTobeRemoved := []pqueue.Element{}
 	for _, elem := range *elementList {
 		txGroup := elem.(*mempoolTransactionGroup)
+		numBytes := txGroup.numBytes
 		numRemoved := txGroup.RemoveTxs(committedRawTxMap)
 		mp.size -= numRemoved
+		mp.numCandidateTxs -= numRemoved
+		mp.numCandidateBytes -= numBytes - txGroup.numBytes
 		if txGroup.IsEmpty() {
 			delete(mp.addressToTxGroup, txGroup.address)
 			elemsTobeRemoved = append(elemsTobeRemoved, txGroup)
@@ -395,8 +869,23 @@ func (mp *Mempool) removeTxs(committedRawTxs []common.Bytes) {
 	}
 }
 
-func (mp *Mempool) GetTransactionStatus(hash string) (TxStatus, bool) {
-	return mp.txBookeepper.getStatus(hash)
+// InsertedTxs returns a channel that will be published with the transactions that passed
+// the screening and were added to the candidate pool.
+func (mp *Mempool) InsertedTxs() chan common.Bytes {
+	return mp.insertedTxs
+}
+
+// GetTransactionStatus returns the status of the transaction with the given hash, the reason
+// if the transaction has been evicted, and whether the transaction is known.
+func (mp *Mempool) GetTransactionStatus(hash string) (TxStatus, TxEvictionReason, bool) {
+	return mp.txBookeepper.getStatusWithReason(hash)
+}
+
+// GetTransactionRecord returns the record of the transaction with the given hash, and whether
+// the transaction is known. The records are retained for a while after the transactions leave
+// the mempool.
+func (mp *Mempool) GetTransactionRecord(hash string) (TxRecord, bool) {
+	return mp.txBookeepper.getRecord(hash)
 }
 
 // GetCandidateTransactions returns all the currently candidate transactions
@@ -426,11 +915,18 @@ func (mp *Mempool) Flush() {
 	defer mp.mutex.Unlock()
 
 	mp.txBookeepper.reset()
+	if mp.journal != nil {
+		mp.journal.reset()
+	}
 
 	for !mp.candidateTxs.IsEmpty() {
 		mp.candidateTxs.Pop()
 	}
+	mp.addressToTxGroup = make(map[common.Address]*mempoolTransactionGroup)
 	mp.size = 0
+	mp.numCandidateTxs = 0
+	mp.numCandidateBytes = 0
+	mp.sizeGauge.Update(0)
 }
 
 // BroadcastTx broadcast given raw transaction to the network
@@ -2,14 +2,17 @@ package mempool
 
 import (
 	"context"
+	"fmt"
 	"math/big"
 	"strconv"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"theta/common"
 	"theta/common/result"
 	"theta/core"
@@ -391,6 +394,127 @@ func TestMempoolTransactionGossip(t *testing.T) {
 	}
 }
 
+func TestMempoolReplaceTransaction(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	p2psimnet := p2psim.NewSimnetWithHandler(nil)
+	mempool, _ := newTestMempool("peer0", p2psimnet)
+	ledger := newScreeningTestLedger(map[string]int64{"A1": 1000, "B1": 1000})
+	mempool.SetLedger(ledger)
+
+	txA1 := createScreeningTestRawTx("A1", 1, 100)
+	txA2 := createScreeningTestRawTx("A1", 2, 100)
+	txA3 := createScreeningTestRawTx("A1", 3, 100)
+	txB1 := createScreeningTestRawTx("B1", 1, 100)
+	for _, tx := range []common.Bytes{txA1, txA2, txA3, txB1} {
+		require.Nil(mempool.InsertTransaction(tx))
+	}
+	assert.Equal(4, mempool.Size())
+
+	// A replacement has to pay at least 10% more than the pending transaction
+	underpriced := createScreeningTestRawTx("A1", 2, 109)
+	assert.Equal(ReplacementUnderpricedError, mempool.InsertTransaction(underpriced))
+	assert.Equal(DuplicateTxError, mempool.InsertTransaction(underpriced))
+	record, ok := mempool.GetTransactionRecord(getTransactionHash(underpriced))
+	assert.True(ok)
+	assert.Equal(TxStatusRejected, record.Status)
+	assert.Equal(0, ledger.numRescreens)
+
+	// A forged replacement and a replacement the account can not afford are rejected without
+	// touching the pending transactions or the screened state
+	forged := createScreeningTestRawTx("A1", 2, 500)
+	ledger.forged[string(forged)] = true
+	unaffordable := createScreeningTestRawTx("A1", 2, 1000)
+	for _, tx := range []common.Bytes{forged, unaffordable} {
+		assert.NotNil(mempool.InsertTransaction(tx))
+		assert.Equal(DuplicateTxError, mempool.InsertTransaction(tx))
+	}
+	status, _, _ := mempool.GetTransactionStatus(getTransactionHash(txA2))
+	assert.Equal(TxStatusPending, status)
+	assert.Equal(4, mempool.Size())
+	assert.Equal([]common.Bytes{txA1, txA2, txA3}, mempool.addressToTxGroup[common.HexToAddress("A1")].SortedTxs())
+	assert.Equal(0, ledger.numRescreens)
+	assert.Equal(int64(300), ledger.screened[common.HexToAddress("A1")].spent)
+	assert.Equal(uint64(3), ledger.screened[common.HexToAddress("A1")].sequence)
+
+	// The replacement succeeds, and the later transactions of the account that are no longer
+	// affordable are removed
+	replacement := createScreeningTestRawTx("A1", 2, 850)
+	require.Nil(mempool.InsertTransaction(replacement))
+	status, _, _ = mempool.GetTransactionStatus(getTransactionHash(txA2))
+	assert.Equal(TxStatusReplaced, status)
+	record, _ = mempool.GetTransactionRecord(getTransactionHash(txA2))
+	assert.Equal(getTransactionHash(replacement), record.ReplacedBy)
+	status, _, _ = mempool.GetTransactionStatus(getTransactionHash(txA3))
+	assert.Equal(TxStatusAbandoned, status)
+	assert.Equal([]common.Bytes{txA1, replacement}, mempool.addressToTxGroup[common.HexToAddress("A1")].SortedTxs())
+	assert.Equal(3, mempool.Size())
+
+	// The screened state is rebuilt from the delivered state
+	assert.Equal(1, ledger.numRescreens)
+	assert.Equal(int64(950), ledger.screened[common.HexToAddress("A1")].spent)
+	assert.Equal(uint64(2), ledger.screened[common.HexToAddress("A1")].sequence)
+	assert.Equal(int64(100), ledger.screened[common.HexToAddress("B1")].spent)
+}
+
+func TestMempoolEvictionResetsScreenedState(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	p2psimnet := p2psim.NewSimnetWithHandler(nil)
+	mempool, _ := newTestMempool("peer0", p2psimnet)
+	ledger := newScreeningTestLedger(map[string]int64{"A1": 1000, "B1": 1000, "C1": 1000, "D1": 1000})
+	mempool.SetLedger(ledger)
+	mempool.maxNumTxs = 3
+
+	txA1 := createScreeningTestRawTx("A1", 1, 100)
+	txA2 := createScreeningTestRawTx("A1", 2, 100)
+	txB1 := createScreeningTestRawTx("B1", 1, 200)
+	for _, tx := range []common.Bytes{txA1, txA2, txB1} {
+		require.Nil(mempool.InsertTransaction(tx))
+	}
+	assert.Equal(int64(200), ledger.screened[common.HexToAddress("A1")].spent)
+
+	// Evicting the last transaction of A1 discards its screened state changes, while the
+	// inserted transaction stays in the screened state
+	txC1 := createScreeningTestRawTx("C1", 1, 300)
+	require.Nil(mempool.InsertTransaction(txC1))
+	status, reason, _ := mempool.GetTransactionStatus(getTransactionHash(txA2))
+	assert.Equal(TxStatusEvicted, status)
+	assert.Equal(TxEvictionReasonLowFee, reason)
+	assert.Equal(3, mempool.Size())
+	assert.Equal(1, ledger.numRescreens)
+	assert.Equal(int64(100), ledger.screened[common.HexToAddress("A1")].spent)
+	assert.Equal(uint64(1), ledger.screened[common.HexToAddress("A1")].sequence)
+	assert.Equal(int64(200), ledger.screened[common.HexToAddress("B1")].spent)
+	assert.Equal(int64(300), ledger.screened[common.HexToAddress("C1")].spent)
+
+	// The sequence of the evicted transaction can be used again
+	txA2Again := createScreeningTestRawTx("A1", 2, 150)
+	mempool.maxNumTxs = 4
+	require.Nil(mempool.InsertTransaction(txA2Again))
+	assert.Equal(int64(250), ledger.screened[common.HexToAddress("A1")].spent)
+
+	// The expired transaction groups are kept while the mempool has room
+	ledger.screened = make(map[common.Address]*screeningTestAccount)
+	mempool.txGroupLifetime = time.Millisecond
+	time.Sleep(10 * time.Millisecond)
+	mempool.UpdateUnsafe([]common.Bytes{})
+	assert.Equal(4, mempool.Size())
+
+	// The expired transaction groups are evicted first when the mempool is full
+	txD1 := createScreeningTestRawTx("D1", 1, 50)
+	require.Nil(mempool.InsertTransaction(txD1))
+	status, reason, _ = mempool.GetTransactionStatus(getTransactionHash(txC1))
+	assert.Equal(TxStatusEvicted, status)
+	assert.Equal(TxEvictionReasonExpired, reason)
+	assert.Equal(1, mempool.Size())
+	assert.Equal(2, ledger.numRescreens)
+	assert.Equal(1, len(ledger.screened))
+	assert.Equal(int64(50), ledger.screened[common.HexToAddress("D1")].spent)
+}
+
 // --------------- Test Utilities --------------- //
 
 func newTestMempool(peerID string, simnet *p2psim.Simnet) (*Mempool, context.Context) {
@@ -398,7 +522,8 @@ func newTestMempool(peerID string, simnet *p2psim.Simnet) (*Mempool, context.Con
 
 	messenger := simnet.AddEndpoint(peerID)
 	dispatcher := dp.NewDispatcher(messenger, nil)
-	mempool := CreateMempool(dispatcher)
+	mempool := CreateMempool(dispatcher, nil)
+	mempool.consensus = testSyncStatus{}
 	mempool.SetLedger(newTestLedger())
 	txMsgHandler := CreateMempoolMessageHandler(mempool)
 	messenger.RegisterMessageHandler(txMsgHandler)
@@ -406,6 +531,125 @@ func newTestMempool(peerID string, simnet *p2psim.Simnet) (*Mempool, context.Con
 	return mempool, ctx
 }
 
+// screeningTestLedger screens the transactions created by createScreeningTestRawTx. A
+// transaction passes the screening if it is not forged, its sequence is the next one of the
+// account, and the account can afford the gas price on top of its other screened transactions.
+type screeningTestLedger struct {
+	*TestLedger
+
+	balances     map[common.Address]int64
+	delivered    map[common.Address]screeningTestAccount
+	screened     map[common.Address]*screeningTestAccount
+	forged       map[string]bool
+	numRescreens int
+}
+
+type screeningTestAccount struct {
+	sequence uint64
+	spent    int64
+}
+
+func newScreeningTestLedger(balances map[string]int64) *screeningTestLedger {
+	tl := &screeningTestLedger{
+		TestLedger: newTestLedger().(*TestLedger),
+		balances:   make(map[common.Address]int64),
+		delivered:  make(map[common.Address]screeningTestAccount),
+		screened:   make(map[common.Address]*screeningTestAccount),
+		forged:     make(map[string]bool),
+	}
+	for address, balance := range balances {
+		tl.balances[common.HexToAddress(address)] = balance
+	}
+	return tl
+}
+
+func (tl *screeningTestLedger) ScreenTxUnsafe(rawTx common.Bytes) result.Result {
+	_, res := tl.ScreenTx(rawTx)
+	return res
+}
+
+func (tl *screeningTestLedger) ScreenTx(rawTx common.Bytes) (*core.TxInfo, result.Result) {
+	return tl.screenTx(tl.screened, rawTx)
+}
+
+func (tl *screeningTestLedger) ScreenReplacementTx(rawTx common.Bytes, precedingRawTxs []common.Bytes) (*core.TxInfo, result.Result) {
+	accounts := tl.copyDelivered()
+	for _, precedingRawTx := range precedingRawTxs {
+		if _, res := tl.screenTx(accounts, precedingRawTx); res.IsError() {
+			return nil, res
+		}
+	}
+	return tl.screenTx(accounts, rawTx)
+}
+
+func (tl *screeningTestLedger) RescreenTxs(rawTxs []common.Bytes) []result.Result {
+	tl.numRescreens++
+	tl.screened = tl.copyDelivered()
+
+	results := make([]result.Result, len(rawTxs))
+	for i, rawTx := range rawTxs {
+		results[i] = tl.ScreenTxUnsafe(rawTx)
+	}
+	return results
+}
+
+func (tl *screeningTestLedger) copyDelivered() map[common.Address]*screeningTestAccount {
+	accounts := make(map[common.Address]*screeningTestAccount)
+	for address, account := range tl.delivered {
+		account := account
+		accounts[address] = &account
+	}
+	return accounts
+}
+
+func (tl *screeningTestLedger) screenTx(accounts map[common.Address]*screeningTestAccount, rawTx common.Bytes) (*core.TxInfo, result.Result) {
+	txInfo, res := tl.GetTxInfo(rawTx)
+	if res.IsError() {
+		return nil, res
+	}
+	if tl.forged[string(rawTx)] {
+		return nil, result.Error("Invalid signature")
+	}
+	account, ok := accounts[txInfo.Address]
+	if !ok {
+		account = &screeningTestAccount{}
+		accounts[txInfo.Address] = account
+	}
+	if txInfo.Sequence != account.sequence+1 {
+		return nil, result.Error("Invalid sequence").WithErrorCode(result.CodeInvalidSequence)
+	}
+	if account.spent+txInfo.EffectiveGasPrice.Int64() > tl.balances[txInfo.Address] {
+		return nil, result.Error("Insufficient fund")
+	}
+	account.sequence++
+	account.spent += txInfo.EffectiveGasPrice.Int64()
+	return txInfo, result.OK
+}
+
+func (tl *screeningTestLedger) GetTxInfo(rawTx common.Bytes) (*core.TxInfo, result.Result) {
+	fields := strings.Split(string(rawTx), ":")
+	if len(fields) != 3 {
+		return nil, result.Error("Invalid tx")
+	}
+	sequence, _ := strconv.ParseUint(fields[1], 10, 64)
+	gasPrice, _ := strconv.ParseInt(fields[2], 10, 64)
+	return &core.TxInfo{
+		Address:           common.HexToAddress(fields[0]),
+		Sequence:          sequence,
+		EffectiveGasPrice: big.NewInt(gasPrice),
+	}, result.OK
+}
+
+func createScreeningTestRawTx(address string, sequence uint64, gasPrice int64) common.Bytes {
+	return common.Bytes(fmt.Sprintf("%v:%v:%v", address, sequence, gasPrice))
+}
+
+type testSyncStatus struct{}
+
+func (ts testSyncStatus) HasSynced() bool {
+	return true
+}
+
 type TestLedger struct {
 	counter               int
 	effectiveGasPriceList []uint64
@@ -470,6 +714,22 @@ func (tl *TestLedger) ScreenTx(rawTx common.Bytes) (*core.TxInfo, result.Result)
 	return txInfo, result.OK
 }
 
+func (tl *TestLedger) ScreenReplacementTx(rawTx common.Bytes, precedingRawTxs []common.Bytes) (*core.TxInfo, result.Result) {
+	return nil, result.Error("Not supported by the test ledger")
+}
+
+func (tl *TestLedger) RescreenTxs(rawTxs []common.Bytes) []result.Result {
+	results := make([]result.Result, len(rawTxs))
+	for i := range results {
+		results[i] = result.OK
+	}
+	return results
+}
+
+func (tl *TestLedger) GetTxInfo(rawTx common.Bytes) (*core.TxInfo, result.Result) {
+	return nil, result.Error("Not supported by the test ledger")
+}
+
 func (tl *TestLedger) GetCurrentBlock() *core.Block {
 	return nil
 }
@@ -482,7 +742,7 @@ func (tl *TestLedger) ApplyBlockTxs(block *core.Block) result.Result {
 	return result.OK
 }
 
-func (tl *TestLedger) ResetState(height uint64, rootHash common.Hash) result.Result {
+func (tl *TestLedger) ResetState(block *core.Block) result.Result {
 	return result.OK
 }
 
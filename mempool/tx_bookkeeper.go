@@ -12,6 +12,8 @@ import (
 
 const defaultMaxNumTxs = uint(200000)
 
+// maxTxLife is the default time a transaction record is kept after the transaction leaves the
+// mempool, so that duplicates are still detected and the status remains queryable.
 const maxTxLife = 1 * time.Minute
 
 //
@@ -24,16 +26,22 @@ type transactionBookkeeper struct {
 	txList list.List            // FIFO list of transaction hashes
 
 	maxNumTxs uint
+	txLife    time.Duration
 }
 
 type TxRecord struct {
-	Hash      string
-	Status    TxStatus
-	CreatedAt time.Time
+	Hash           string
+	Status         TxStatus
+	EvictionReason TxEvictionReason
+	Message        string // error message if the tx was rejected or abandoned
+	ReplacedBy     string // hash of the replacement if the tx was replaced
+	CreatedAt      time.Time
+
+	seen bool // whether a rejected tx is still reported as seen
 }
 
-func (r *TxRecord) IsOutdated() bool {
-	return time.Since(r.CreatedAt) > maxTxLife
+func (r *TxRecord) IsOutdated(txLife time.Duration) bool {
+	return time.Since(r.CreatedAt) > txLife
 }
 
 type TxStatus int
@@ -41,13 +49,33 @@ type TxStatus int
 const (
 	TxStatusPending TxStatus = iota
 	TxStatusAbandoned
+	TxStatusEvicted
+	TxStatusReplaced
+	TxStatusRejected
 )
 
-func createTransactionBookkeeper(maxNumTxs uint) transactionBookkeeper {
+// TxEvictionReason describes why a transaction was evicted from the mempool
+type TxEvictionReason string
+
+const (
+	// TxEvictionReasonNone indicates that the transaction was not evicted
+	TxEvictionReasonNone TxEvictionReason = ""
+	// TxEvictionReasonLowFee indicates that the transaction was evicted by a transaction
+	// with a higher fee when the mempool was full
+	TxEvictionReasonLowFee TxEvictionReason = "low_fee"
+	// TxEvictionReasonExpired indicates that the account of the transaction did not send
+	// any new transaction within the lifetime of its transaction group
+	TxEvictionReasonExpired TxEvictionReason = "expired"
+)
+
+// createTransactionBookkeeper creates a bookkeeper that keeps the records of at most
+// maxNumTxs transactions, each for the duration of txLife.
+func createTransactionBookkeeper(maxNumTxs uint, txLife time.Duration) transactionBookkeeper {
 	return transactionBookkeeper{
 		mutex:     &sync.Mutex{},
 		txMap:     make(map[string]*TxRecord),
 		maxNumTxs: maxNumTxs,
+		txLife:    txLife,
 	}
 }
 
@@ -66,12 +94,20 @@ func (tb *transactionBookkeeper) hasSeen(rawTx common.Bytes) bool {
 	tb.removeOutdatedTxsUnsafe()
 
 	txhash := getTransactionHash(rawTx)
-	_, exists := tb.txMap[txhash]
-	return exists
+	txRecord, exists := tb.txMap[txhash]
+	// A rejected transaction could become valid later on, so it can be submitted again
+	return exists && (txRecord.Status != TxStatusRejected || txRecord.seen)
 }
 
 // getStatus returns a tx status and a boolean of whether the tx is known.
 func (tb *transactionBookkeeper) getStatus(txhash string) (TxStatus, bool) {
+	status, _, exists := tb.getStatusWithReason(txhash)
+	return status, exists
+}
+
+// getStatusWithReason returns a tx status, the reason if the tx has been evicted, and
+// a boolean of whether the tx is known.
+func (tb *transactionBookkeeper) getStatusWithReason(txhash string) (TxStatus, TxEvictionReason, bool) {
 	tb.mutex.Lock()
 	defer tb.mutex.Unlock()
 
@@ -81,9 +117,24 @@ func (tb *transactionBookkeeper) getStatus(txhash string) (TxStatus, bool) {
 	txRecord, exists := tb.txMap[txhash]
 
 	if !exists {
-		return TxStatusAbandoned, false
+		return TxStatusAbandoned, TxEvictionReasonNone, false
+	}
+	return txRecord.Status, txRecord.EvictionReason, true
+}
+
+// getRecord returns a copy of the record of a tx and a boolean of whether the tx is known.
+func (tb *transactionBookkeeper) getRecord(txhash string) (TxRecord, bool) {
+	tb.mutex.Lock()
+	defer tb.mutex.Unlock()
+
+	// Remove outdated Tx records
+	tb.removeOutdatedTxsUnsafe()
+
+	txRecord, exists := tb.txMap[txhash]
+	if !exists {
+		return TxRecord{}, false
 	}
-	return txRecord.Status, true
+	return *txRecord, true
 }
 
 func (tb *transactionBookkeeper) removeOutdatedTxsUnsafe() {
@@ -94,11 +145,13 @@ func (tb *transactionBookkeeper) removeOutdatedTxsUnsafe() {
 			return
 		}
 		txRecord := el.Value.(*TxRecord)
-		if !txRecord.IsOutdated() {
+		if !txRecord.IsOutdated(tb.txLife) {
 			return
 		}
 
-		if _, exists := tb.txMap[txRecord.Hash]; exists {
+		// The record might have been superseded by a newer one, e.g. when a rejected
+		// transaction is resubmitted
+		if tb.txMap[txRecord.Hash] == txRecord {
 			delete(tb.txMap, txRecord.Hash)
 		}
 		tb.txList.Remove(el)
@@ -113,30 +166,67 @@ func (tb *transactionBookkeeper) record(rawTx common.Bytes) bool {
 	// Remove outdated Tx records
 	tb.removeOutdatedTxsUnsafe()
 
-	if _, exists := tb.txMap[txhash]; exists {
+	if txRecord, exists := tb.txMap[txhash]; exists && txRecord.Status != TxStatusRejected {
 		return false
 	}
 
-	if uint(tb.txList.Len()) >= tb.maxNumTxs { // remove the oldest transactions
-		popped := tb.txList.Front()
-		poppedTxhash := popped.Value.(*TxRecord).Hash
-		delete(tb.txMap, poppedTxhash)
-		tb.txList.Remove(popped)
+	tb.addRecordUnsafe(&TxRecord{
+		Hash:      txhash,
+		Status:    TxStatusPending,
+		CreatedAt: time.Now(),
+	})
+
+	return true
+}
+
+// recordRejected records a transaction that failed to enter the mempool along with the
+// error message. It does not overwrite the record of a known transaction.
+func (tb *transactionBookkeeper) recordRejected(rawTx common.Bytes, message string) {
+	tb.recordRejectedWithSeen(rawTx, message, false)
+}
+
+// recordRejectedAsSeen records a rejected transaction like recordRejected, but the transaction
+// is reported as seen until the record expires, so it can not be submitted again in the meantime.
+func (tb *transactionBookkeeper) recordRejectedAsSeen(rawTx common.Bytes, message string) {
+	tb.recordRejectedWithSeen(rawTx, message, true)
+}
+
+func (tb *transactionBookkeeper) recordRejectedWithSeen(rawTx common.Bytes, message string, seen bool) {
+	tb.mutex.Lock()
+	defer tb.mutex.Unlock()
+	txhash := getTransactionHash(rawTx)
+
+	// Remove outdated Tx records
+	tb.removeOutdatedTxsUnsafe()
+
+	if txRecord, exists := tb.txMap[txhash]; exists && txRecord.Status != TxStatusRejected {
+		return
 	}
 
-	record := &TxRecord{
+	tb.addRecordUnsafe(&TxRecord{
 		Hash:      txhash,
-		Status:    TxStatusPending,
+		Status:    TxStatusRejected,
+		Message:   message,
 		CreatedAt: time.Now(),
+		seen:      seen,
+	})
+}
+
+func (tb *transactionBookkeeper) addRecordUnsafe(record *TxRecord) {
+	if uint(tb.txList.Len()) >= tb.maxNumTxs { // remove the oldest transactions
+		popped := tb.txList.Front()
+		poppedRecord := popped.Value.(*TxRecord)
+		if tb.txMap[poppedRecord.Hash] == poppedRecord {
+			delete(tb.txMap, poppedRecord.Hash)
+		}
+		tb.txList.Remove(popped)
 	}
-	tb.txMap[txhash] = record
 
+	tb.txMap[record.Hash] = record
 	tb.txList.PushBack(record)
-
-	return true
 }
 
-func (tb *transactionBookkeeper) markAbandoned(rawTx common.Bytes) {
+func (tb *transactionBookkeeper) markAbandoned(rawTx common.Bytes, message string) {
 	tb.mutex.Lock()
 	defer tb.mutex.Unlock()
 
@@ -145,6 +235,31 @@ func (tb *transactionBookkeeper) markAbandoned(rawTx common.Bytes) {
 		return
 	}
 	tb.txMap[txhash].Status = TxStatusAbandoned
+	tb.txMap[txhash].Message = message
+}
+
+func (tb *transactionBookkeeper) markEvicted(rawTx common.Bytes, reason TxEvictionReason) {
+	tb.mutex.Lock()
+	defer tb.mutex.Unlock()
+
+	txhash := getTransactionHash(rawTx)
+	if _, exists := tb.txMap[txhash]; !exists {
+		return
+	}
+	tb.txMap[txhash].Status = TxStatusEvicted
+	tb.txMap[txhash].EvictionReason = reason
+}
+
+func (tb *transactionBookkeeper) markReplaced(rawTx common.Bytes, replacement common.Bytes) {
+	tb.mutex.Lock()
+	defer tb.mutex.Unlock()
+
+	txhash := getTransactionHash(rawTx)
+	if _, exists := tb.txMap[txhash]; !exists {
+		return
+	}
+	tb.txMap[txhash].Status = TxStatusReplaced
+	tb.txMap[txhash].ReplacedBy = getTransactionHash(replacement)
 }
 
 func (tb *transactionBookkeeper) remove(rawTx common.Bytes) {
@@ -25,7 +25,7 @@ func TestTxBookkeeper(t *testing.T) {
 	log.Infof("tx5 hash: %v", getTransactionHash(tx5))
 
 	maxNumTxs := uint(3)
-	txb := createTransactionBookkeeper(maxNumTxs)
+	txb := createTransactionBookkeeper(maxNumTxs, maxTxLife)
 	assert.False(txb.hasSeen(tx1))
 	assert.False(txb.hasSeen(tx3))
 	assert.False(txb.hasSeen(tx5))
@@ -54,6 +54,53 @@ func TestTxBookkeeper(t *testing.T) {
 	assert.False(txb.hasSeen(tx5))
 }
 
+func TestTxBookkeeperRecordStatus(t *testing.T) {
+	assert := assert.New(t)
+
+	tx1 := createTestRawTx("1")
+	tx2 := createTestRawTx("2")
+	tx3 := createTestRawTx("3")
+
+	txb := createTransactionBookkeeper(defaultMaxNumTxs, maxTxLife)
+
+	// A rejected tx can be submitted again
+	txb.recordRejected(tx1, "Insufficient fund")
+	assert.False(txb.hasSeen(tx1))
+	record, exists := txb.getRecord(getTransactionHash(tx1))
+	assert.True(exists)
+	assert.Equal(TxStatusRejected, record.Status)
+	assert.Equal("Insufficient fund", record.Message)
+
+	assert.True(txb.record(tx1))
+	assert.True(txb.hasSeen(tx1))
+	record, _ = txb.getRecord(getTransactionHash(tx1))
+	assert.Equal(TxStatusPending, record.Status)
+
+	// A rejection does not overwrite the record of a known tx
+	txb.recordRejected(tx1, "Transaction already seen")
+	record, _ = txb.getRecord(getTransactionHash(tx1))
+	assert.Equal(TxStatusPending, record.Status)
+
+	// A rejected replacement is reported as seen
+	tx4 := createTestRawTx("4")
+	txb.recordRejectedAsSeen(tx4, "Replacement transaction underpriced")
+	assert.True(txb.hasSeen(tx4))
+	record, _ = txb.getRecord(getTransactionHash(tx4))
+	assert.Equal(TxStatusRejected, record.Status)
+
+	assert.True(txb.record(tx2))
+	txb.markReplaced(tx1, tx2)
+	record, _ = txb.getRecord(getTransactionHash(tx1))
+	assert.Equal(TxStatusReplaced, record.Status)
+	assert.Equal(getTransactionHash(tx2), record.ReplacedBy)
+
+	assert.True(txb.record(tx3))
+	txb.markAbandoned(tx3, "Invalid sequence")
+	record, _ = txb.getRecord(getTransactionHash(tx3))
+	assert.Equal(TxStatusAbandoned, record.Status)
+	assert.Equal("Invalid sequence", record.Message)
+}
+
 // --------------- Test Utilities --------------- //
 
 func createTestRawTx(rawTxStr string) common.Bytes {
@@ -0,0 +1,70 @@
+package mempool
+
+import (
+	"theta/common"
+	"theta/crypto"
+	"theta/store/database"
+)
+
+const txJournalPrefix = "mpj/"
+
+//
+// txJournal persists the candidate transactions so that they survive node restarts
+//
+type txJournal struct {
+	db database.Database
+}
+
+func newTxJournal(db database.Database) *txJournal {
+	return &txJournal{
+		db: db,
+	}
+}
+
+// txJournalKey constructs the DB key of the journal entry of the given transaction.
+func txJournalKey(rawTx common.Bytes) common.Bytes {
+	txhash := crypto.Keccak256Hash(rawTx)
+	return append(common.Bytes(txJournalPrefix), txhash[:]...)
+}
+
+func (j *txJournal) insert(rawTx common.Bytes) {
+	if err := j.db.Put(txJournalKey(rawTx), rawTx); err != nil {
+		logger.Errorf("Failed to journal tx, tx.hash: 0x%v, err: %v", getTransactionHash(rawTx), err)
+	}
+}
+
+func (j *txJournal) remove(rawTxs []common.Bytes) {
+	if len(rawTxs) == 0 {
+		return
+	}
+	batch := j.db.NewBatch()
+	for _, rawTx := range rawTxs {
+		batch.Delete(txJournalKey(rawTx))
+	}
+	if err := batch.Write(); err != nil {
+		logger.Errorf("Failed to remove %v txs from the journal, err: %v", len(rawTxs), err)
+	}
+}
+
+// load returns all the journaled transactions.
+func (j *txJournal) load() ([]common.Bytes, error) {
+	it := j.db.Iterator(common.Bytes(txJournalPrefix), nil)
+	defer it.Release()
+
+	rawTxs := []common.Bytes{}
+	for it.Next() {
+		rawTx := make(common.Bytes, len(it.Value()))
+		copy(rawTx, it.Value())
+		rawTxs = append(rawTxs, rawTx)
+	}
+	return rawTxs, it.Error()
+}
+
+// reset removes all the journaled transactions.
+func (j *txJournal) reset() {
+	rawTxs, err := j.load()
+	if err != nil {
+		logger.Errorf("Failed to load the tx journal: %v", err)
+	}
+	j.remove(rawTxs)
+}
@@ -0,0 +1,117 @@
+package mempool
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"theta/common"
+	p2psim "theta/p2p/simulation"
+	"theta/store/database/backend"
+)
+
+func TestTxJournal(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	db := backend.NewMemDatabase()
+	require.Nil(db.Put([]byte("other"), []byte("value")))
+	journal := newTxJournal(db)
+
+	tx1 := createTestRawTx("tx1")
+	tx2 := createTestRawTx("tx2")
+	tx3 := createTestRawTx("tx3")
+	journal.insert(tx1)
+	journal.insert(tx2)
+	journal.insert(tx3)
+	journal.insert(tx3)
+
+	rawTxs, err := journal.load()
+	require.Nil(err)
+	assert.ElementsMatch([]common.Bytes{tx1, tx2, tx3}, rawTxs)
+
+	journal.remove([]common.Bytes{tx2})
+	journal.remove(nil)
+	rawTxs, err = journal.load()
+	require.Nil(err)
+	assert.ElementsMatch([]common.Bytes{tx1, tx3}, rawTxs)
+
+	journal.reset()
+	rawTxs, err = journal.load()
+	require.Nil(err)
+	assert.Equal(0, len(rawTxs))
+
+	// Only the journal entries are removed
+	has, err := db.Has([]byte("other"))
+	require.Nil(err)
+	assert.True(has)
+}
+
+func TestMempoolJournalDisabledByDefault(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.False(viper.GetBool(common.CfgMempoolJournalEnabled))
+
+	p2psimnet := p2psim.NewSimnetWithHandler(nil)
+	mempool, ctx := newTestMempool("peer0", p2psimnet)
+	mempool.SetLedger(newScreeningTestLedger(map[string]int64{"A1": 1000}))
+	assert.Nil(mempool.journal)
+
+	assert.Nil(mempool.InsertTransaction(createScreeningTestRawTx("A1", 1, 100)))
+	assert.Nil(mempool.Start(ctx))
+	assert.Equal(1, mempool.Size())
+}
+
+func TestMempoolJournalRestore(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	db := backend.NewMemDatabase()
+	p2psimnet := p2psim.NewSimnetWithHandler(nil)
+
+	txA1 := createScreeningTestRawTx("A1", 1, 100)
+	txA2 := createScreeningTestRawTx("A1", 2, 100)
+	txA3 := createScreeningTestRawTx("A1", 3, 100)
+	txB1 := createScreeningTestRawTx("B1", 1, 100)
+	txC1 := createScreeningTestRawTx("C1", 1, 100)
+
+	// The candidate transactions are journaled, the committed ones are removed
+	mempool, ctx := newTestMempool("peer0", p2psimnet)
+	mempool.SetLedger(newScreeningTestLedger(map[string]int64{"A1": 1000, "B1": 1000, "C1": 1000}))
+	mempool.SetJournal(db)
+	require.Nil(mempool.Start(ctx))
+	for _, tx := range []common.Bytes{txA1, txA2, txA3, txB1, txC1} {
+		require.Nil(mempool.InsertTransaction(tx))
+	}
+	mempool.removeTxs([]common.Bytes{txC1})
+
+	rawTxs, err := mempool.journal.load()
+	require.Nil(err)
+	assert.ElementsMatch([]common.Bytes{txA1, txA2, txA3, txB1}, rawTxs)
+
+	// After a restart, the journaled transactions are screened against the current state in
+	// the order of sequence. The stale and invalid ones are dropped from the journal.
+	invalidTx := createTestRawTx("invalid")
+	newTxJournal(db).insert(invalidTx)
+
+	ledger := newScreeningTestLedger(map[string]int64{"A1": 1000, "B1": 1000, "C1": 1000})
+	ledger.screened[common.HexToAddress("A1")] = &screeningTestAccount{sequence: 1}
+	mempool, ctx = newTestMempool("peer1", p2psimnet)
+	mempool.SetLedger(ledger)
+	mempool.SetJournal(db)
+	require.Nil(mempool.Start(ctx))
+
+	assert.Equal(3, mempool.Size())
+	assert.Equal(uint64(3), ledger.screened[common.HexToAddress("A1")].sequence)
+	assert.Equal(uint64(1), ledger.screened[common.HexToAddress("B1")].sequence)
+	_, screened := ledger.screened[common.HexToAddress("C1")]
+	assert.False(screened)
+
+	rawTxs, err = mempool.journal.load()
+	require.Nil(err)
+	assert.ElementsMatch([]common.Bytes{txA2, txA3, txB1}, rawTxs)
+
+	// The restored transactions are not accepted again
+	assert.Equal(DuplicateTxError, mempool.InsertTransaction(txA2))
+}
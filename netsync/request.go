@@ -13,6 +13,7 @@ import (
 	"github.com/spf13/viper"
 	"theta/blockchain"
 	"theta/common"
+	"theta/common/metrics"
 	"theta/common/util"
 	"theta/core"
 	"theta/dispatcher"
@@ -141,6 +142,10 @@ type RequestManager struct {
 	aplock         *sync.RWMutex
 
 	reporter *rp.Reporter
+
+	pendingBlocksGauge  metrics.Gauge   // Gauge for the number of blocks pending download
+	pendingHeadersGauge metrics.Gauge   // Gauge for the number of headers whose blocks are pending download
+	timeoutCounter      metrics.Counter // Counter for the block requests that timed out
 }
 
 func NewRequestManager(syncMgr *SyncManager, reporter *rp.Reporter) *RequestManager {
@@ -175,6 +180,10 @@ func NewRequestManager(syncMgr *SyncManager, reporter *rp.Reporter) *RequestMana
 		aplock:         &sync.RWMutex{},
 
 		reporter: reporter,
+
+		pendingBlocksGauge:  metrics.GetOrRegisterGauge("sync/pending/blocks", nil),
+		pendingHeadersGauge: metrics.GetOrRegisterGauge("sync/pending/headers", nil),
+		timeoutCounter:      metrics.GetOrRegisterCounter("sync/download/timeouts", nil),
 	}
 
 	logger := util.GetLoggerForModule("request")
@@ -337,6 +346,9 @@ func (rm *RequestManager) tryToDownload() {
 		}
 	}
 	rm.pendingBlocksWithHeader = newQ
+
+	rm.pendingBlocksGauge.Update(int64(len(rm.pendingBlocksByHash)))
+	rm.pendingHeadersGauge.Update(int64(rm.pendingBlocksWithHeader.Len()))
 }
 
 //compatible with older version, download block from hash
@@ -347,6 +359,9 @@ func (rm *RequestManager) downloadBlockFromHash() {
 	for curr = rm.pendingBlocks.Front(); (rm.gossipQuota > 0 || rm.fastsyncQuota > 0) && curr != nil; curr = curr.Next() {
 		pendingBlock := curr.Value.(*PendingBlock)
 		if pendingBlock.HasExpired() || pendingBlock.HasTimedOut() {
+			if pendingBlock.status == RequestWaitingDataResp {
+				rm.timeoutCounter.Inc(1)
+			}
 			elToRemove = append(elToRemove, curr)
 			continue
 		}
@@ -458,6 +473,9 @@ func (rm *RequestManager) downloadBlockFromHeader() {
 		}
 		if pendingBlock.status == RequestToSendBodyReq ||
 			(pendingBlock.status == RequestWaitingBodyResp && pendingBlock.HasTimedOut()) {
+			if pendingBlock.status == RequestWaitingBodyResp {
+				rm.timeoutCounter.Inc(1)
+			}
 
 			peersWithBlock := util.Shuffle(pendingBlock.peers)
 			var randomPeerID string
@@ -138,6 +138,7 @@ func (sm *SyncManager) GetChannelIDs() []common.ChannelIDEnum {
 		common.ChannelIDCC,
 		common.ChannelIDVote,
 		common.ChannelIDGuardian,
+		common.ChannelIDEvidence,
 	}
 }
 
@@ -622,6 +623,23 @@ func (m *SyncManager) handleDataResponse(peerID string, data *dispatcher.DataRes
 			"peer":            peerID,
 		}).Debug("Received guardian vote")
 		m.handleGuardianVote(vote)
+	case common.ChannelIDEvidence:
+		evidence := &core.DoubleSignEvidence{}
+		err := rlp.DecodeBytes(data.Payload, evidence)
+		if err != nil {
+			m.logger.WithFields(log.Fields{
+				"channelID": data.ChannelID,
+				"payload":   data.Payload,
+				"error":     err,
+				"peerID":    peerID,
+			}).Warn("Failed to decode DataResponse payload")
+			return
+		}
+		m.logger.WithFields(log.Fields{
+			"evidence": evidence,
+			"peer":     peerID,
+		}).Debug("Received double sign evidence")
+		m.handleEvidence(evidence)
 	case common.ChannelIDHeader:
 		headers := &Headers{}
 		err := rlp.DecodeBytes(data.Payload, headers)
@@ -668,7 +686,7 @@ func (sm *SyncManager) handleHeader(header *core.BlockHeader, peerID []string) {
 		return
 	}
 
-	if hash, ok := core.HardcodeBlockHashes[header.Height]; ok {
+	if hash, ok := sm.consensus.ChainConfig().HardcodeBlockHashes[header.Height]; ok {
 		if hash != header.Hash().Hex() {
 			sm.logger.WithFields(log.Fields{
 				"block hash":   header.Hash().String(),
@@ -694,7 +712,7 @@ func (sm *SyncManager) handleBlock(block *core.Block) {
 		return
 	}
 
-	if hash, ok := core.HardcodeBlockHashes[block.Height]; ok {
+	if hash, ok := sm.consensus.ChainConfig().HardcodeBlockHashes[block.Height]; ok {
 		if hash != block.Hash().Hex() {
 			sm.logger.WithFields(log.Fields{
 				"block hash":   block.Hash().String(),
@@ -780,3 +798,9 @@ func (sm *SyncManager) handleVote(vote core.Vote) {
 func (sm *SyncManager) handleGuardianVote(vote *core.AggregatedVotes) {
 	sm.PassdownMessage(vote)
 }
+
+// handleEvidence passes the evidence down to the consensus engine, which validates the evidence
+// and gossips it if it is new.
+func (sm *SyncManager) handleEvidence(evidence *core.DoubleSignEvidence) {
+	sm.PassdownMessage(evidence)
+}
@@ -192,6 +192,8 @@ func NewMockConsensus(chain *blockchain.Chain, lfb *core.ExtendedBlock) *MockCon
 // AddMessage(msg interface{})
 // FinalizedBlocks() chan *Block
 // GetLastFinalizedBlock() *ExtendedBlock
+// GetPendingEvidence() []*DoubleSignEvidence
+// ChainConfig() *ChainConfig
 
 func (c *MockConsensus) ID() string {
 	return ""
@@ -221,6 +223,12 @@ func (c *MockConsensus) FinalizedBlocks() chan *core.Block {
 func (c *MockConsensus) GetLastFinalizedBlock() *core.ExtendedBlock {
 	return c.lfb
 }
+func (c *MockConsensus) GetPendingEvidence() []*core.DoubleSignEvidence {
+	return nil
+}
+func (c *MockConsensus) ChainConfig() *core.ChainConfig {
+	return core.MainnetChainConfig()
+}
 
 func TestCollectBlocks(t *testing.T) {
 	assert := assert.New(t)
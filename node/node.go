@@ -2,6 +2,8 @@ package node
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
 	"reflect"
 	"sync"
@@ -9,11 +11,13 @@ import (
 	"github.com/spf13/viper"
 	"theta/blockchain"
 	"theta/common"
+	"theta/common/metrics/prometheus"
 	"theta/consensus"
 	"theta/core"
 	"theta/crypto"
 	dp "theta/dispatcher"
 	ld "theta/ledger"
+	"theta/ledger/state"
 	mp "theta/mempool"
 	"theta/netsync"
 	"theta/p2p"
@@ -24,6 +28,7 @@ import (
 	"theta/store"
 	"theta/store/database"
 	"theta/store/kvstore"
+	"theta/thriftrpcserver"
 )
 
 type Node struct {
@@ -36,6 +41,8 @@ type Node struct {
 	Ledger           core.Ledger
 	Mempool          *mp.Mempool
 	RPC              *rpc.ThetaRPCServer
+	Thrift           *thriftrpcserver.ThriftServer
+	Prometheus       *prometheus.Server
 	reporter         *rp.Reporter
 
 	// Life cycle
@@ -59,6 +66,10 @@ type Params struct {
 }
 
 func NewNode(params *Params) *Node {
+	if err := setupStorageMode(params.DB); err != nil {
+		log.Fatalf("Failed to set up the storage mode: %v", err)
+	}
+
 	store := kvstore.NewKVStore(params.DB)
 	chain := blockchain.NewChain(params.ChainID, store, params.Root)
 	validatorManager := consensus.NewRotatingValidatorManager()
@@ -74,6 +85,9 @@ func NewNode(params *Params) *Node {
 	validatorManager.SetConsensusEngine(consensus)
 	consensus.SetLedger(ledger)
 	mempool.SetLedger(ledger)
+	if viper.GetBool(common.CfgMempoolJournalEnabled) {
+		mempool.SetJournal(params.DB)
+	}
 	txMsgHandler := mp.CreateMempoolMessageHandler(mempool)
 
 	if !reflect.ValueOf(params.Network).IsNil() {
@@ -117,9 +131,79 @@ func NewNode(params *Params) *Node {
 	if viper.GetBool(common.CfgRPCEnabled) {
 		node.RPC = rpc.NewThetaRPCServer(mempool, ledger, dispatcher, chain, consensus)
 	}
+	if viper.GetBool(common.CfgThriftEnabled) {
+		service := rpc.NewThetaRPCService(mempool, ledger, dispatcher, chain, consensus)
+		thriftServer, err := thriftrpcserver.NewThriftServer(service, params.ChainID)
+		if err != nil {
+			log.Fatalf("Failed to create thrift server: %v", err)
+		}
+		node.Thrift = thriftServer
+	}
+	if viper.GetBool(common.CfgPrometheusEnabled) {
+		address := viper.GetString(common.CfgPrometheusAddress) + ":" + viper.GetString(common.CfgPrometheusPort)
+		node.Prometheus = prometheus.NewServer(address, nil)
+	}
 	return node
 }
 
+// setupStorageMode checks that the database is compatible with the configured storage mode. An
+// archive node retains the full state history and all the optional indexes, hence it refuses to
+// start on a database which has been pruned, or whose account tx index has not been maintained
+// since the database was created. The database of an archive node is marked as such, and a
+// regular node refuses to prune the history it holds.
+func setupStorageMode(db database.Database) error {
+	kvStore := kvstore.NewKVStore(db)
+	isArchive := false
+	kvStore.Get(state.ArchiveModeKey(), &isArchive)
+
+	isFresh := false
+	if _, err := db.Get([]byte(consensus.DBStateStubKey)); err == store.ErrKeyNotFound {
+		isFresh = true
+	} else if err != nil {
+		return err
+	}
+
+	if !viper.GetBool(common.CfgStorageArchiveMode) {
+		if isArchive && viper.GetBool(common.CfgStorageStatePruningEnabled) {
+			return errors.New("the database holds the history of an archive node, enable the archive mode or disable state pruning to retain it")
+		}
+		return updateAccountTxIndexMark(kvStore, isFresh, viper.GetBool(common.CfgStorageIndexAccountTxs))
+	}
+
+	var prunedHeight uint64
+	if err := kvStore.Get(state.StatePruningProgressKey(), &prunedHeight); err == nil {
+		return fmt.Errorf("the state has been pruned up to height %v, the archive mode requires a database which has never been pruned", prunedHeight)
+	}
+	isIndexComplete := false
+	kvStore.Get(blockchain.AccountTxIndexCompleteKey(), &isIndexComplete)
+	if !isFresh && !isIndexComplete {
+		return errors.New("the account transactions of the blocks in the database have not all been indexed, the archive mode requires a database which has indexed them since it was created")
+	}
+	if !isArchive {
+		if err := kvStore.Put(state.ArchiveModeKey(), true); err != nil {
+			return err
+		}
+	}
+
+	viper.Set(common.CfgStorageStatePruningEnabled, false)
+	viper.Set(common.CfgStorageIndexAccountTxs, true)
+	return updateAccountTxIndexMark(kvStore, isFresh, true)
+}
+
+// updateAccountTxIndexMark marks the account tx index of a fresh database as complete if the
+// index is enabled, and removes the mark once the index is disabled.
+func updateAccountTxIndexMark(kvStore store.Store, isFresh bool, indexEnabled bool) error {
+	if indexEnabled && isFresh {
+		return kvStore.Put(blockchain.AccountTxIndexCompleteKey(), true)
+	}
+	if !indexEnabled {
+		if err := kvStore.Delete(blockchain.AccountTxIndexCompleteKey()); err != nil && err != store.ErrKeyNotFound {
+			return err
+		}
+	}
+	return nil
+}
+
 // Start starts sub components and kick off the main loop.
 func (n *Node) Start(ctx context.Context) {
 	c, cancel := context.WithCancel(ctx)
@@ -135,6 +219,12 @@ func (n *Node) Start(ctx context.Context) {
 	if viper.GetBool(common.CfgRPCEnabled) {
 		n.RPC.Start(n.ctx)
 	}
+	if viper.GetBool(common.CfgThriftEnabled) {
+		n.Thrift.Start(n.ctx)
+	}
+	if viper.GetBool(common.CfgPrometheusEnabled) {
+		n.Prometheus.Start(n.ctx)
+	}
 }
 
 // Stop notifies all sub components to stop without blocking.
@@ -149,4 +239,10 @@ func (n *Node) Wait() {
 	if n.RPC != nil {
 		n.RPC.Wait()
 	}
+	if n.Thrift != nil {
+		n.Thrift.Wait()
+	}
+	if n.Prometheus != nil {
+		n.Prometheus.Wait()
+	}
 }
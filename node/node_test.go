@@ -1 +1,74 @@
 package node
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"theta/common"
+	"theta/consensus"
+	"theta/ledger/state"
+	"theta/store/database/backend"
+	"theta/store/kvstore"
+)
+
+func TestSetupStorageMode(t *testing.T) {
+	assert := assert.New(t)
+
+	for _, key := range []string{common.CfgStorageArchiveMode, common.CfgStorageStatePruningEnabled, common.CfgStorageIndexAccountTxs} {
+		defer viper.Set(key, viper.Get(key))
+	}
+
+	// Regular node
+	db := backend.NewMemDatabase()
+	viper.Set(common.CfgStorageArchiveMode, false)
+	viper.Set(common.CfgStorageStatePruningEnabled, true)
+	assert.Nil(setupStorageMode(db))
+	assert.True(viper.GetBool(common.CfgStorageStatePruningEnabled))
+
+	// Archive node on a fresh database
+	viper.Set(common.CfgStorageArchiveMode, true)
+	viper.Set(common.CfgStorageIndexAccountTxs, false)
+	assert.Nil(setupStorageMode(db))
+	assert.False(viper.GetBool(common.CfgStorageStatePruningEnabled))
+	assert.True(viper.GetBool(common.CfgStorageIndexAccountTxs))
+	assert.Nil(setupStorageMode(db)) // restart
+
+	// A regular node can't prune the database of an archive node
+	viper.Set(common.CfgStorageArchiveMode, false)
+	viper.Set(common.CfgStorageStatePruningEnabled, true)
+	assert.NotNil(setupStorageMode(db))
+	viper.Set(common.CfgStorageStatePruningEnabled, false)
+	assert.Nil(setupStorageMode(db))
+
+	// Archive node on a pruned database
+	prunedDB := backend.NewMemDatabase()
+	assert.Nil(kvstore.NewKVStore(prunedDB).Put(state.StatePruningProgressKey(), uint64(100)))
+	viper.Set(common.CfgStorageArchiveMode, true)
+	assert.NotNil(setupStorageMode(prunedDB))
+
+	// Archive node on an existing database without the account tx index
+	existingDB := backend.NewMemDatabase()
+	assert.Nil(existingDB.Put([]byte(consensus.DBStateStubKey), []byte("stub")))
+	assert.NotNil(setupStorageMode(existingDB))
+
+	// Archive node on an existing database which has indexed the account txs since it was created
+	indexedDB := backend.NewMemDatabase()
+	viper.Set(common.CfgStorageArchiveMode, false)
+	viper.Set(common.CfgStorageIndexAccountTxs, true)
+	assert.Nil(setupStorageMode(indexedDB))
+	assert.Nil(indexedDB.Put([]byte(consensus.DBStateStubKey), []byte("stub")))
+	assert.Nil(setupStorageMode(indexedDB)) // restart
+	viper.Set(common.CfgStorageArchiveMode, true)
+	assert.Nil(setupStorageMode(indexedDB))
+
+	// The index is no longer complete once it has been disabled
+	viper.Set(common.CfgStorageArchiveMode, false)
+	viper.Set(common.CfgStorageStatePruningEnabled, false)
+	viper.Set(common.CfgStorageIndexAccountTxs, false)
+	assert.Nil(setupStorageMode(indexedDB))
+	viper.Set(common.CfgStorageIndexAccountTxs, true)
+	assert.Nil(setupStorageMode(indexedDB))
+	viper.Set(common.CfgStorageArchiveMode, true)
+	assert.NotNil(setupStorageMode(indexedDB))
+}
@@ -27,6 +27,12 @@ var logger *log.Entry = log.WithFields(log.Fields{"prefix": "p2p"})
 // A connection has a ChannelGroup which can contain multiple Channels
 //
 type Connection struct {
+	// Number of payload bytes sent and received. The flowrate monitors only throttle
+	// the connection and do not account for the bytes transferred. Accessed atomically,
+	// hence placed first for 64-bit alignment.
+	bytesSent uint64
+	bytesRecv uint64
+
 	netconn net.Conn
 
 	bufWriter   *bufio.Writer
@@ -110,6 +116,7 @@ func CreateConnection(netconn net.Conn, config ConnectionConfig) *Connection {
 	channelPing := createDefaultChannel(common.ChannelIDPing)
 	channelGuardian := createDefaultChannel(common.ChannelIDGuardian)
 	channelNATMapping := createDefaultChannel(common.ChannelIDNATMapping)
+	channelEvidence := createDefaultChannel(common.ChannelIDEvidence)
 	channels := []*Channel{
 		&channelCheckpoint,
 		&channelHeader,
@@ -121,6 +128,7 @@ func CreateConnection(netconn net.Conn, config ConnectionConfig) *Connection {
 		&channelPing,
 		&channelGuardian,
 		&channelNATMapping,
+		&channelEvidence,
 	}
 
 	success, channelGroup := createChannelGroup(getDefaultChannelGroupConfig(), channels)
@@ -387,14 +395,20 @@ func (conn *Connection) readPacket() (*Packet, error) {
 }
 
 func (conn *Connection) writePacket(packet *Packet) error {
-	// Plaintext transport.
+	var err error
 	if conn.rw == nil {
-		return rlp.Encode(conn.bufWriter, packet)
+		// Plaintext transport.
+		err = rlp.Encode(conn.bufWriter, packet)
+	} else {
+		// Encrypted transport.
+		conn.wmu.Lock()
+		err = conn.rw.WritePacket(packet)
+		conn.wmu.Unlock()
 	}
-	// Encrypted transport.
-	conn.wmu.Lock()
-	defer conn.wmu.Unlock()
-	return conn.rw.WritePacket(packet)
+	if err == nil {
+		atomic.AddUint64(&conn.bytesSent, uint64(len(packet.Bytes)))
+	}
+	return err
 }
 
 func (conn *Connection) recvRoutine() {
@@ -420,6 +434,7 @@ func (conn *Connection) recvRoutine() {
 			return
 		}
 		conn.recvMonitor.Update(int(1))
+		atomic.AddUint64(&conn.bytesRecv, uint64(len(packet.Bytes)))
 		switch packet.ChannelID {
 		case common.ChannelIDPing:
 			conn.handlePingPong(packet)
@@ -540,6 +555,16 @@ func (conn *Connection) sendPacket() (success bool, exhausted bool) {
 
 // --------------------- Utils --------------------- //
 
+// BytesSent returns the number of payload bytes sent over the connection
+func (conn *Connection) BytesSent() uint64 {
+	return atomic.LoadUint64(&conn.bytesSent)
+}
+
+// BytesReceived returns the number of payload bytes received over the connection
+func (conn *Connection) BytesReceived() uint64 {
+	return atomic.LoadUint64(&conn.bytesRecv)
+}
+
 // GetNetconn returns the attached network connection
 func (conn *Connection) GetNetconn() net.Conn {
 	return conn.netconn
@@ -10,6 +10,7 @@ import (
 
 	"theta/common"
 	mm "theta/common/math"
+	"theta/common/metrics"
 	nu "theta/p2p/netutil"
 
 	"github.com/spf13/viper"
@@ -99,6 +100,7 @@ func (pt *PeerTable) AddPeer(peer *Peer) bool {
 
 	pt.peerMap[peer.ID()] = peer
 	pt.addrMap[peer.NetAddress().String()] = peer
+	registerPeerMetrics(peer)
 
 	pt.persistPeers()
 
@@ -124,6 +126,8 @@ func (pt *PeerTable) DeletePeer(peerID string) {
 		}
 	}
 
+	unregisterPeerMetrics(peerID)
+
 	logger.Infof("Deleted peer %v from the peer table", peerID)
 
 	pt.persistPeers()
@@ -145,6 +149,7 @@ func (pt *PeerTable) PurgeOldestPeer() *Peer {
 		delete(pt.peerMap, peer.ID())
 		delete(pt.addrMap, peer.NetAddress().String())
 		pt.peers = append(pt.peers[:idx], pt.peers[idx+1:]...)
+		unregisterPeerMetrics(peer.ID())
 	}
 
 	logger.Infof("Purged the oldest peer %v from the peer table, idx: %v", peer.ID(), idx)
@@ -287,3 +292,32 @@ func (pt *PeerTable) writeToDB(key, value string) {
 		pt.db.Put([]byte(key), []byte(value), nil)
 	}
 }
+
+// registerPeerMetrics registers the gauges for the number of bytes exchanged with the
+// given peer, replacing the gauges of a previous peer with the same ID.
+func registerPeerMetrics(peer *Peer) {
+	if !metrics.Enabled {
+		return
+	}
+	conn := peer.GetConnection()
+	if conn == nil {
+		return
+	}
+	unregisterPeerMetrics(peer.ID())
+	metrics.Register(peerMetricName(peer.ID(), "sent"), metrics.NewFunctionalGauge(func() int64 {
+		return int64(conn.BytesSent())
+	}))
+	metrics.Register(peerMetricName(peer.ID(), "received"), metrics.NewFunctionalGauge(func() int64 {
+		return int64(conn.BytesReceived())
+	}))
+}
+
+// unregisterPeerMetrics unregisters the gauges of the peer with the given ID.
+func unregisterPeerMetrics(peerID string) {
+	metrics.Unregister(peerMetricName(peerID, "sent"))
+	metrics.Unregister(peerMetricName(peerID, "received"))
+}
+
+func peerMetricName(peerID string, direction string) string {
+	return "p2p/peers/" + peerID + "/bytes/" + direction
+}
@@ -30,6 +30,7 @@ var Channels = []cmn.ChannelIDEnum{
 	cmn.ChannelIDPeerDiscovery,
 	cmn.ChannelIDPing,
 	cmn.ChannelIDGuardian,
+	cmn.ChannelIDEvidence,
 }
 
 //
@@ -112,6 +113,28 @@ func (peer *Peer) AcceptStream(channel cmn.ChannelIDEnum, stream *transport.Buff
 	}
 }
 
+// BytesSent returns the number of bytes sent to the peer over the open streams
+func (peer *Peer) BytesSent() int64 {
+	peer.mutex.Lock()
+	defer peer.mutex.Unlock()
+	total := int64(0)
+	for _, stream := range peer.streamMap {
+		total += stream.BytesSent()
+	}
+	return total
+}
+
+// BytesReceived returns the number of bytes received from the peer over the open streams
+func (peer *Peer) BytesReceived() int64 {
+	peer.mutex.Lock()
+	defer peer.mutex.Unlock()
+	total := int64(0)
+	for _, stream := range peer.streamMap {
+		total += stream.BytesReceived()
+	}
+	return total
+}
+
 func (peer *Peer) StopStream(channel cmn.ChannelIDEnum) {
 	peer.mutex.Lock()
 	defer peer.mutex.Unlock()
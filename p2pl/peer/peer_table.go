@@ -10,6 +10,7 @@ import (
 	pr "github.com/libp2p/go-libp2p-core/peer"
 	"theta/common"
 	mm "theta/common/math"
+	"theta/common/metrics"
 
 	"github.com/spf13/viper"
 	"github.com/syndtr/goleveldb/leveldb"
@@ -92,6 +93,7 @@ func (pt *PeerTable) AddPeer(peer *Peer) bool {
 	}
 
 	pt.peerMap[peer.ID()] = peer
+	registerPeerMetrics(peer)
 
 	pt.persistPeers()
 
@@ -113,6 +115,7 @@ func (pt *PeerTable) DeletePeer(peerID pr.ID) {
 			pt.peers = append(pt.peers[:idx], pt.peers[idx+1:]...)
 		}
 	}
+	unregisterPeerMetrics(peerID)
 
 	pt.persistPeers()
 }
@@ -248,3 +251,24 @@ func (pt *PeerTable) GetTotalNumPeers() uint {
 
 	return uint(len(pt.peers))
 }
+
+// registerPeerMetrics registers the gauges for the number of bytes exchanged with the
+// given peer, replacing the gauges of a previous peer with the same ID.
+func registerPeerMetrics(peer *Peer) {
+	if !metrics.Enabled {
+		return
+	}
+	unregisterPeerMetrics(peer.ID())
+	metrics.Register(peerMetricName(peer.ID(), "sent"), metrics.NewFunctionalGauge(peer.BytesSent))
+	metrics.Register(peerMetricName(peer.ID(), "received"), metrics.NewFunctionalGauge(peer.BytesReceived))
+}
+
+// unregisterPeerMetrics unregisters the gauges of the peer with the given ID.
+func unregisterPeerMetrics(peerID pr.ID) {
+	metrics.Unregister(peerMetricName(peerID, "sent"))
+	metrics.Unregister(peerMetricName(peerID, "received"))
+}
+
+func peerMetricName(peerID pr.ID, direction string) string {
+	return "p2p/peers/" + peerID.String() + "/bytes/" + direction
+}
@@ -134,6 +134,11 @@ func (rb *RecvBuffer) Read() ([]byte, error) {
 	return msg, nil
 }
 
+// Status returns the transfer status of the RecvBuffer. It is goroutine safe
+func (rb *RecvBuffer) Status() flowrate.Status {
+	return rb.recvMonitor.Status()
+}
+
 // GetSize returns the size of the SendBuffer. It is goroutine safe
 func (rb *RecvBuffer) GetSize() int {
 	return int(atomic.LoadInt32(&rb.queueSize))
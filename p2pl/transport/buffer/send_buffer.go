@@ -107,6 +107,11 @@ func (sb *SendBuffer) Stop() {
 	close(sb.queue)
 }
 
+// Status returns the transfer status of the SendBuffer. It is goroutine safe
+func (sb *SendBuffer) Status() flowrate.Status {
+	return sb.sendMonitor.Status()
+}
+
 // GetSize returns the size of the SendBuffer. It is goroutine safe
 func (sb *SendBuffer) GetSize() int {
 	return int(atomic.LoadInt32(&sb.queueSize))
@@ -99,6 +99,16 @@ func (s *BufferedStream) Write(msg []byte) (int, error) {
 	return len(msg), nil
 }
 
+// BytesSent returns the number of bytes sent over the stream
+func (s *BufferedStream) BytesSent() int64 {
+	return s.sendBuf.Status().Bytes
+}
+
+// BytesReceived returns the number of bytes received over the stream
+func (s *BufferedStream) BytesReceived() int64 {
+	return s.recvBuf.Status().Bytes
+}
+
 // Close closes the stream for writing. Reading will still work (that
 // is, the remote side can still write).
 func (s *BufferedStream) Close() error {
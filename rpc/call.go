@@ -1,19 +1,28 @@
 package rpc
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
 
 	"theta/common"
+	"theta/core"
+	"theta/crypto"
 	"theta/ledger/state"
 	"theta/ledger/types"
 	"theta/ledger/vm"
+	"theta/rpc/tnt20"
 )
 
+// revertReasonMethodID is the selector of Error(string), which the return data of a reverted
+// call starts with when the contract provides a reason
+var revertReasonMethodID = crypto.Keccak256([]byte("Error(string)"))[:4]
+
 // ------------------------------- CallSmartContract -----------------------------------
 
 type CallSmartContractArgs struct {
 	SctxBytes string `json:"sctx_bytes"`
+	BlockQueryArgs
 }
 
 type CallSmartContractResult struct {
@@ -25,43 +34,178 @@ type CallSmartContractResult struct {
 
 // CallSmartContract calls the smart contract. However, calling a smart contract does NOT modify
 // the globally consensus state. It can be used for dry run, or for retrieving info from smart contracts
-// without actually spending gas.
+// without actually spending gas. If a block height or hash is specified, the smart contract is called
+// on top of the state of that block.
 func (t *ThetaRPCService) CallSmartContract(args *CallSmartContractArgs, result *CallSmartContractResult) (err error) {
-	var ledgerState *state.StoreView
-	ledgerState, err = t.ledger.GetDeliveredSnapshot()
+	sctx, err := decodeSmartContractTx(args.SctxBytes)
+	if err != nil {
+		return err
+	}
+
+	vmRet, contractAddr, gasUsed, vmErr, err := t.executeSmartContract(sctx, args.BlockQueryArgs)
 	if err != nil {
 		return err
 	}
 
+	result.VmReturn = hex.EncodeToString(vmRet)
+	result.ContractAddress = contractAddr
+	result.GasUsed = common.JSONUint64(gasUsed)
+	if vmErr != nil {
+		result.VmError = vmErr.Error()
+	}
+
+	return nil
+}
+
+// executeSmartContract executes the smart contract transaction on top of the delivered state, or
+// the state of the selected block, without modifying the globally consensus state.
+func (t *ThetaRPCService) executeSmartContract(sctx *types.SmartContractTx, at BlockQueryArgs) (vmRet common.Bytes,
+	contractAddr common.Address, gasUsed uint64, vmErr error, err error) {
+	var ledgerState *state.StoreView
+	var parentBlock *core.Block
+	if at.isSet() {
+		ledgerState, parentBlock, err = t.getSmartContractSnapshotAt(at)
+	} else {
+		ledgerState, parentBlock, err = t.getSmartContractSnapshot()
+	}
+	if err != nil {
+		return
+	}
+
+	vmRet, contractAddr, gasUsed, vmErr = vm.Execute(parentBlock, sctx, ledgerState)
+	ledgerState.Save()
+	return
+}
+
+// getSmartContractSnapshot returns a snapshot of the delivered state and its parent block for
+// executing smart contracts.
+func (t *ThetaRPCService) getSmartContractSnapshot() (*state.StoreView, *core.Block, error) {
+	ledgerState, err := t.ledger.GetDeliveredSnapshot()
+	if err != nil {
+		return nil, nil, err
+	}
+
 	blockHeight := ledgerState.Height() + 1 // the view points to the parent of the current block
-	if blockHeight < common.HeightEnableSmartContract {
-		return fmt.Errorf("Smart contract feature not enabled until block height %v.", common.HeightEnableSmartContract)
+	heightEnableSmartContract := t.ledger.ChainConfig().HeightEnableSmartContract
+	if blockHeight < heightEnableSmartContract {
+		return nil, nil, fmt.Errorf("Smart contract feature not enabled until block height %v.", heightEnableSmartContract)
 	}
 
-	sctxBytes, err := hex.DecodeString(args.SctxBytes)
+	return ledgerState, t.ledger.State().ParentBlock(), nil
+}
+
+// getSmartContractSnapshotAt returns the state of the selected block for executing smart contracts
+// as if they were included in the next block.
+func (t *ThetaRPCService) getSmartContractSnapshotAt(at BlockQueryArgs) (*state.StoreView, *core.Block, error) {
+	ledgerState, block, err := t.getStoreViewAt(at)
 	if err != nil {
-		return err
+		return nil, nil, err
+	}
+
+	heightEnableSmartContract := t.ledger.ChainConfig().HeightEnableSmartContract
+	if block.Height+1 < heightEnableSmartContract {
+		return nil, nil, fmt.Errorf("Smart contract feature not enabled until block height %v.", heightEnableSmartContract)
+	}
+
+	return ledgerState, block, nil
+}
+
+func decodeSmartContractTx(sctxHex string) (*types.SmartContractTx, error) {
+	sctxBytes, err := hex.DecodeString(sctxHex)
+	if err != nil {
+		return nil, err
 	}
 
 	tx, err := types.TxFromBytes(sctxBytes)
 	if err != nil {
-		return fmt.Errorf("Failed to parse SmartContractTx, error: %v", err)
+		return nil, fmt.Errorf("Failed to parse SmartContractTx, error: %v", err)
 	}
 	sctx, ok := tx.(*types.SmartContractTx)
 	if !ok {
-		return fmt.Errorf("Failed to parse SmartContractTx: %v", args.SctxBytes)
+		return nil, fmt.Errorf("Failed to parse SmartContractTx: %v", sctxHex)
 	}
+	return sctx, nil
+}
 
-	parentBlock := t.ledger.State().ParentBlock()
-	vmRet, contractAddr, gasUsed, vmErr := vm.Execute(parentBlock, sctx, ledgerState)
-	ledgerState.Save()
+// ------------------------------- EstimateGas -----------------------------------
+
+type EstimateGasArgs struct {
+	SctxBytes string `json:"sctx_bytes"`
+}
+
+type EstimateGasResult struct {
+	GasLimit     common.JSONUint64 `json:"gas_limit"`
+	VmReturn     string            `json:"vm_return"`
+	VmError      string            `json:"vm_error"`
+	RevertReason string            `json:"revert_reason"`
+}
+
+// EstimateGas searches for the minimal gas limit with which the smart contract transaction
+// succeeds on top of the delivered state. The gas limit of the given transaction is ignored.
+// If the transaction fails even with the maximum gas limit, the VM error and the revert
+// reason are returned instead.
+func (t *ThetaRPCService) EstimateGas(args *EstimateGasArgs, result *EstimateGasResult) (err error) {
+	sctx, err := decodeSmartContractTx(args.SctxBytes)
+	if err != nil {
+		return err
+	}
 
+	ledgerState, parentBlock, err := t.getSmartContractSnapshot()
+	if err != nil {
+		return err
+	}
+
+	// Each execution starts from the same state, which is restored by reverting to the snapshot
+	// instead of copying the view
+	snapshot := ledgerState.Snapshot()
+	execute := func(gasLimit uint64) (common.Bytes, uint64, error) {
+		ledgerState.RevertToSnapshot(snapshot)
+		ledgerState.ResetLogs()
+		ledgerState.ResetRefund()
+		tx := *sctx
+		tx.GasLimit = gasLimit
+		vmRet, _, gasUsed, vmErr := vm.Execute(parentBlock, &tx, ledgerState)
+		return vmRet, gasUsed, vmErr
+	}
+
+	maxGasLimit := t.ledger.ChainConfig().MaximumTxGasLimit
+	vmRet, gasUsed, vmErr := execute(maxGasLimit)
 	result.VmReturn = hex.EncodeToString(vmRet)
-	result.ContractAddress = contractAddr
-	result.GasUsed = common.JSONUint64(gasUsed)
 	if vmErr != nil {
 		result.VmError = vmErr.Error()
+		result.RevertReason = decodeRevertReason(vmRet)
+		return nil
 	}
 
+	// The transaction fails with any gas limit lower than the gas used, and succeeds with
+	// the maximum gas limit
+	lo, hi := gasUsed-1, maxGasLimit
+	if gasUsed == 0 {
+		lo = 0
+	}
+	for lo+1 < hi {
+		mid := lo + (hi-lo)/2
+		if _, _, vmErr := execute(mid); vmErr == nil {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+	result.GasLimit = common.JSONUint64(hi)
+
 	return nil
 }
+
+// decodeRevertReason returns the reason in the return data of a reverted call, or an empty
+// string if the contract does not provide one.
+func decodeRevertReason(vmRet common.Bytes) string {
+	// The reason is ABI encoded as the offset, the length and the content of the string
+	if len(vmRet) < len(revertReasonMethodID)+64 || !bytes.Equal(vmRet[:len(revertReasonMethodID)], revertReasonMethodID) {
+		return ""
+	}
+	reason, err := tnt20.UnpackString(vmRet[len(revertReasonMethodID):])
+	if err != nil {
+		return ""
+	}
+	return reason
+}
@@ -0,0 +1,119 @@
+package rpc
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"theta/blockchain"
+	"theta/common"
+	"theta/core"
+	"theta/ledger"
+	"theta/ledger/state"
+	"theta/ledger/types"
+	"theta/store/database/backend"
+)
+
+func TestDecodeRevertReason(t *testing.T) {
+	assert := assert.New(t)
+
+	// Error("Insufficient balance")
+	vmRet, err := hex.DecodeString("08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000014" +
+		"496e73756666696369656e742062616c616e6365000000000000000000000000")
+	assert.Nil(err)
+	assert.Equal("Insufficient balance", decodeRevertReason(vmRet))
+
+	assert.Equal("", decodeRevertReason(nil))
+	assert.Equal("", decodeRevertReason(vmRet[4:]))
+	assert.Equal("", decodeRevertReason(vmRet[:36]))
+}
+
+func TestEstimateGas(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	chainConfig := core.MainnetChainConfig()
+	chainConfig.ChainID = "estimate_gas_test_chain"
+	chainConfig.HeightEnableSmartContract = 0
+	chainConfig.MaximumTxGasLimit = 200000
+	require.Nil(core.RegisterChainConfig(chainConfig))
+
+	sender := common.HexToAddress("0x0000000000000000000000000000000000000a01")
+	receiver := common.HexToAddress("0x0000000000000000000000000000000000000a02")
+	gasChecker := common.HexToAddress("0x0000000000000000000000000000000000000a03")
+	reverter := common.HexToAddress("0x0000000000000000000000000000000000000a04")
+	looper := common.HexToAddress("0x0000000000000000000000000000000000000a05")
+
+	revertReason, err := hex.DecodeString("08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000014" +
+		"496e73756666696369656e742062616c616e6365000000000000000000000000")
+	require.Nil(err)
+
+	db := backend.NewMemDatabase()
+	sv := state.NewStoreView(0, common.Hash{}, db)
+	sv.SetAccount(sender, &types.Account{Address: sender, Balance: types.NewCoins(0, 1e18)})
+	// Stops if more than 100000 gas is left when the code starts, reverts otherwise
+	sv.SetCode(gasChecker, common.Hex2Bytes("5a620186a011600a57005b60006000fd"))
+	// Reverts with Error("Insufficient balance")
+	sv.SetCode(reverter, append(common.Hex2Bytes("6064600c60003960646000fd"), revertReason...))
+	// Loops forever
+	sv.SetCode(looper, common.Hex2Bytes("5b600056"))
+	root := sv.Save()
+
+	block := core.NewBlock()
+	block.ChainID = chainConfig.ChainID
+	block.Height = 1
+	block.StateHash = root
+	block.Timestamp = big.NewInt(1)
+	thetaLedger := ledger.NewLedger(chainConfig.ChainID, db, blockchain.CreateTestChain(), nil, nil, nil)
+	require.True(thetaLedger.ResetState(block).IsOK())
+	service := &ThetaRPCService{ledger: thetaLedger}
+
+	estimateGas := func(to common.Address, value int64) *EstimateGasResult {
+		sctx := &types.SmartContractTx{
+			From:     types.TxInput{Address: sender, Coins: types.NewCoins(0, value)},
+			To:       types.TxOutput{Address: to},
+			GasLimit: 1,
+			GasPrice: big.NewInt(1),
+		}
+		raw, err := types.TxToBytes(sctx)
+		require.Nil(err)
+		result := &EstimateGasResult{}
+		require.Nil(service.EstimateGas(&EstimateGasArgs{SctxBytes: hex.EncodeToString(raw)}, result))
+		return result
+	}
+
+	// A simple transfer only costs the intrinsic gas
+	result := estimateGas(receiver, 1000)
+	assert.Equal("", result.VmError)
+	assert.Equal(common.JSONUint64(21000), result.GasLimit)
+
+	// The transaction runs out of gas with the gas it uses at the maximum gas limit, the estimate
+	// is the minimal gas limit with which it succeeds
+	result = estimateGas(gasChecker, 0)
+	assert.Equal("", result.VmError)
+	assert.Equal(common.JSONUint64(21000+2+100000), result.GasLimit)
+
+	// The revert reason of a reverting contract is returned
+	result = estimateGas(reverter, 0)
+	assert.Equal(common.JSONUint64(0), result.GasLimit)
+	assert.NotEqual("", result.VmError)
+	assert.Equal("Insufficient balance", result.RevertReason)
+	assert.Equal(hex.EncodeToString(revertReason), result.VmReturn)
+
+	// The estimate is capped by the maximum gas limit
+	result = estimateGas(looper, 0)
+	assert.Equal(common.JSONUint64(0), result.GasLimit)
+	assert.NotEqual("", result.VmError)
+
+	// The estimation does not modify the delivered state
+	view, err := thetaLedger.GetDeliveredSnapshot()
+	require.Nil(err)
+	assert.Equal(root, view.Hash())
+	assert.Nil(view.GetAccount(receiver))
+}
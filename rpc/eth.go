@@ -0,0 +1,665 @@
+package rpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"strings"
+
+	"theta/blockchain"
+	"theta/common"
+	"theta/common/hexutil"
+	"theta/core"
+	"theta/crypto"
+	"theta/ledger/state"
+	"theta/ledger/types"
+	"theta/ledger/vm"
+	"theta/version"
+)
+
+// EthRPCService exposes a subset of the Ethereum JSON-RPC API (the "eth_" namespace) so
+// that Ethereum tooling such as web3.js and ethers.js can talk to a Theta node.
+type EthRPCService struct {
+	theta *ThetaRPCService
+}
+
+// NetRPCService implements the "net_" namespace of the Ethereum JSON-RPC API.
+type NetRPCService struct {
+	theta *ThetaRPCService
+}
+
+// Web3RPCService implements the "web3_" namespace of the Ethereum JSON-RPC API.
+type Web3RPCService struct {
+	theta *ThetaRPCService
+}
+
+// ------------------------------- eth_chainId -----------------------------------
+
+type EthChainIdArgs struct{}
+
+func (e *EthRPCService) ChainId(args *EthChainIdArgs, result *hexutil.Big) (err error) {
+	chainID := vm.MapChainID(e.theta.ledger.State().GetChainID())
+	*result = hexutil.Big(*chainID)
+	return nil
+}
+
+// ------------------------------- eth_blockNumber -----------------------------------
+
+type EthBlockNumberArgs struct{}
+
+func (e *EthRPCService) BlockNumber(args *EthBlockNumberArgs, result *hexutil.Uint64) (err error) {
+	ledgerState, err := e.theta.ledger.GetFinalizedSnapshot()
+	if err != nil {
+		return err
+	}
+	*result = hexutil.Uint64(ledgerState.Height())
+	return nil
+}
+
+// ------------------------------- eth_getBalance -----------------------------------
+
+type EthGetBalanceArgs struct {
+	Address common.Address
+	Block   string
+}
+
+func (a *EthGetBalanceArgs) UnmarshalJSON(data []byte) error {
+	return unmarshalEthParams(data, &a.Address, &a.Block)
+}
+
+// GetBalance returns the TFuelWei balance of the given address, since TFuel is the gas token.
+func (e *EthRPCService) GetBalance(args *EthGetBalanceArgs, result *hexutil.Big) (err error) {
+	ledgerState, err := e.getEthStoreView(args.Block)
+	if err != nil {
+		return err
+	}
+
+	balance := big.NewInt(0)
+	account := ledgerState.GetAccount(args.Address)
+	if account != nil && account.Balance.TFuelWei != nil {
+		balance = account.Balance.TFuelWei
+	}
+	*result = hexutil.Big(*balance)
+	return nil
+}
+
+// ------------------------------- eth_call -----------------------------------
+
+type EthCallObject struct {
+	From     *common.Address `json:"from"`
+	To       *common.Address `json:"to"`
+	Gas      *hexutil.Uint64 `json:"gas"`
+	GasPrice *hexutil.Big    `json:"gasPrice"`
+	Value    *hexutil.Big    `json:"value"`
+	Data     hexutil.Bytes   `json:"data"`
+	Input    hexutil.Bytes   `json:"input"`
+}
+
+type EthCallArgs struct {
+	Call  EthCallObject
+	Block string
+}
+
+func (a *EthCallArgs) UnmarshalJSON(data []byte) error {
+	return unmarshalEthParams(data, &a.Call, &a.Block)
+}
+
+// Call executes a message call against the latest delivered state, or the state of the given
+// block, without creating a transaction.
+func (e *EthRPCService) Call(args *EthCallArgs, result *hexutil.Bytes) (err error) {
+	vmRet, _, vmErr, err := e.executeEthCall(&args.Call, args.Block, e.theta.ledger.ChainConfig().MaximumTxGasLimit)
+	if err != nil {
+		return err
+	}
+	if vmErr != nil {
+		return fmt.Errorf("execution reverted: %v", vmErr)
+	}
+	*result = hexutil.Bytes(vmRet)
+	return nil
+}
+
+// ------------------------------- eth_estimateGas -----------------------------------
+
+type EthEstimateGasArgs struct {
+	Call  EthCallObject
+	Block string
+}
+
+func (a *EthEstimateGasArgs) UnmarshalJSON(data []byte) error {
+	return unmarshalEthParams(data, &a.Call, &a.Block)
+}
+
+// EstimateGas executes the call with the maximum gas limit against the latest delivered state,
+// or the state of the given block, and returns the gas consumed.
+func (e *EthRPCService) EstimateGas(args *EthEstimateGasArgs, result *hexutil.Uint64) (err error) {
+	_, gasUsed, vmErr, err := e.executeEthCall(&args.Call, args.Block, e.theta.ledger.ChainConfig().MaximumTxGasLimit)
+	if err != nil {
+		return err
+	}
+	if vmErr != nil {
+		return fmt.Errorf("execution reverted: %v", vmErr)
+	}
+	*result = hexutil.Uint64(gasUsed)
+	return nil
+}
+
+// ------------------------------- eth_sendRawTransaction -----------------------------------
+
+type EthSendRawTransactionArgs struct {
+	Data string
+}
+
+func (a *EthSendRawTransactionArgs) UnmarshalJSON(data []byte) error {
+	return unmarshalEthParams(data, &a.Data)
+}
+
+// SendRawTransaction accepts a signed Ethereum transaction whose payload is the sign bytes
+// of a Theta SmartContractTx (i.e. the EthereumTxWrapper scheme), and submits the wrapped
+// SmartContractTx to the mempool. The returned hash is the hash of the Theta transaction.
+func (e *EthRPCService) SendRawTransaction(args *EthSendRawTransactionArgs, result *common.Hash) (err error) {
+	rawEthTx, err := decodeTxHexBytes(args.Data)
+	if err != nil {
+		return err
+	}
+
+	sctx, err := types.SmartContractTxFromEthereumTx(e.theta.ledger.State().GetChainID(), rawEthTx)
+	if err != nil {
+		return err
+	}
+
+	txBytes, err := types.TxToBytes(sctx)
+	if err != nil {
+		return err
+	}
+
+	hash := crypto.Keccak256Hash(txBytes)
+	logger.Infof("Broadcast Ethereum raw transaction: %v, hash: %v", args.Data, hash.Hex())
+
+	err = e.theta.mempool.InsertTransaction(txBytes)
+	if err != nil {
+		return err
+	}
+
+	e.theta.mempool.BroadcastTx(txBytes)
+
+	*result = hash
+	return nil
+}
+
+// ------------------------------- eth_getTransactionReceipt -----------------------------------
+
+type EthGetTransactionReceiptArgs struct {
+	Hash common.Hash
+}
+
+func (a *EthGetTransactionReceiptArgs) UnmarshalJSON(data []byte) error {
+	return unmarshalEthParams(data, &a.Hash)
+}
+
+type EthTransactionReceipt struct {
+	TransactionHash   common.Hash     `json:"transactionHash"`
+	TransactionIndex  hexutil.Uint64  `json:"transactionIndex"`
+	BlockHash         common.Hash     `json:"blockHash"`
+	BlockNumber       hexutil.Uint64  `json:"blockNumber"`
+	From              common.Address  `json:"from"`
+	To                *common.Address `json:"to"`
+	GasUsed           hexutil.Uint64  `json:"gasUsed"`
+	CumulativeGasUsed hexutil.Uint64  `json:"cumulativeGasUsed"`
+	ContractAddress   *common.Address `json:"contractAddress"`
+	Logs              []*EthLog       `json:"logs"`
+	LogsBloom         hexutil.Bytes   `json:"logsBloom"`
+	Status            hexutil.Uint64  `json:"status"`
+}
+
+type EthGetTransactionReceiptResult struct {
+	*EthTransactionReceipt
+}
+
+// MarshalJSON returns null for unknown transactions, as Ethereum clients expect.
+func (r EthGetTransactionReceiptResult) MarshalJSON() ([]byte, error) {
+	if r.EthTransactionReceipt == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(r.EthTransactionReceipt)
+}
+
+func (e *EthRPCService) GetTransactionReceipt(args *EthGetTransactionReceiptArgs, result *EthGetTransactionReceiptResult) (err error) {
+	receipt, found := e.theta.chain.FindTxReceiptByHash(args.Hash)
+	if !found {
+		return nil
+	}
+	raw, block, found := e.theta.chain.FindTxByHash(args.Hash)
+	if !found {
+		return nil
+	}
+	tx, err := types.TxFromBytes(raw)
+	if err != nil {
+		return err
+	}
+	sctx, ok := tx.(*types.SmartContractTx)
+	if !ok {
+		return nil
+	}
+
+	txIndex := findTxIndex(block, args.Hash)
+	r := &EthTransactionReceipt{
+		TransactionHash:   args.Hash,
+		TransactionIndex:  hexutil.Uint64(txIndex),
+		BlockHash:         block.Hash(),
+		BlockNumber:       hexutil.Uint64(block.Height),
+		From:              sctx.From.Address,
+		GasUsed:           hexutil.Uint64(receipt.GasUsed),
+		CumulativeGasUsed: hexutil.Uint64(e.cumulativeGasUsed(block, txIndex)),
+		Logs:              []*EthLog{},
+		Status:            hexutil.Uint64(1),
+	}
+	if (sctx.To.Address == common.Address{}) {
+		contractAddr := receipt.ContractAddress
+		r.ContractAddress = &contractAddr
+	} else {
+		to := sctx.To.Address
+		r.To = &to
+	}
+	if receipt.EvmErr != "" {
+		r.Status = hexutil.Uint64(0)
+	}
+
+	for i, log := range receipt.Logs {
+		r.Logs = append(r.Logs, newEthLog(log, block, args.Hash, txIndex, uint64(i)))
+	}
+	r.LogsBloom = hexutil.Bytes(types.LogsBloom(receipt.Logs).Bytes())
+
+	result.EthTransactionReceipt = r
+	return nil
+}
+
+// ------------------------------- eth_getLogs -----------------------------------
+
+type EthLogFilter struct {
+	FromBlock string          `json:"fromBlock"`
+	ToBlock   string          `json:"toBlock"`
+	BlockHash *common.Hash    `json:"blockHash"`
+	Address   json.RawMessage `json:"address"`
+	Topics    []interface{}   `json:"topics"`
+}
+
+type EthGetLogsArgs struct {
+	Filter EthLogFilter
+}
+
+func (a *EthGetLogsArgs) UnmarshalJSON(data []byte) error {
+	return unmarshalEthParams(data, &a.Filter)
+}
+
+type EthLog struct {
+	Address          common.Address `json:"address"`
+	Topics           []common.Hash  `json:"topics"`
+	Data             hexutil.Bytes  `json:"data"`
+	BlockNumber      hexutil.Uint64 `json:"blockNumber"`
+	BlockHash        common.Hash    `json:"blockHash"`
+	TransactionHash  common.Hash    `json:"transactionHash"`
+	TransactionIndex hexutil.Uint64 `json:"transactionIndex"`
+	LogIndex         hexutil.Uint64 `json:"logIndex"`
+	Removed          bool           `json:"removed"`
+}
+
+type EthGetLogsResult []*EthLog
+
+func (e *EthRPCService) GetLogs(args *EthGetLogsArgs, result *EthGetLogsResult) (err error) {
+	filter := &blockchain.LogFilter{}
+	filter.Addresses, err = parseEthFilterAddresses(args.Filter.Address)
+	if err != nil {
+		return err
+	}
+	filter.Topics, err = parseEthFilterTopics(args.Filter.Topics)
+	if err != nil {
+		return err
+	}
+
+	if args.Filter.BlockHash != nil {
+		block, err := e.theta.chain.FindBlock(*args.Filter.BlockHash)
+		if err != nil {
+			return err
+		}
+		filter.FromHeight = block.Height
+		filter.ToHeight = block.Height
+	} else {
+		filter.FromHeight, err = e.resolveEthBlockHeight(args.Filter.FromBlock)
+		if err != nil {
+			return err
+		}
+		filter.ToHeight, err = e.resolveEthBlockHeight(args.Filter.ToBlock)
+		if err != nil {
+			return err
+		}
+	}
+	if err = validateLogFilterRange(filter); err != nil {
+		return err
+	}
+
+	*result = EthGetLogsResult{}
+	for _, entry := range e.theta.chain.FilterLogs(filter) {
+		if args.Filter.BlockHash != nil && entry.BlockHash != *args.Filter.BlockHash {
+			continue
+		}
+		*result = append(*result, &EthLog{
+			Address:          entry.Address,
+			Topics:           entry.Topics,
+			Data:             hexutil.Bytes(entry.Data),
+			BlockNumber:      hexutil.Uint64(entry.BlockHeight),
+			BlockHash:        entry.BlockHash,
+			TransactionHash:  entry.TxHash,
+			TransactionIndex: hexutil.Uint64(entry.TxIndex),
+			LogIndex:         hexutil.Uint64(entry.LogIndex),
+		})
+	}
+
+	return nil
+}
+
+// ------------------------------- net_version -----------------------------------
+
+type NetVersionArgs struct{}
+
+func (n *NetRPCService) Version(args *NetVersionArgs, result *string) (err error) {
+	*result = vm.MapChainID(n.theta.ledger.State().GetChainID()).String()
+	return nil
+}
+
+// ------------------------------- net_listening -----------------------------------
+
+type NetListeningArgs struct{}
+
+func (n *NetRPCService) Listening(args *NetListeningArgs, result *bool) (err error) {
+	*result = true
+	return nil
+}
+
+// ------------------------------- net_peerCount -----------------------------------
+
+type NetPeerCountArgs struct{}
+
+func (n *NetRPCService) PeerCount(args *NetPeerCountArgs, result *hexutil.Uint64) (err error) {
+	*result = hexutil.Uint64(len(n.theta.dispatcher.Peers()))
+	return nil
+}
+
+// ------------------------------- web3_clientVersion -----------------------------------
+
+type Web3ClientVersionArgs struct{}
+
+func (w *Web3RPCService) ClientVersion(args *Web3ClientVersionArgs, result *string) (err error) {
+	*result = fmt.Sprintf("Theta/v%v/%v", version.Version, version.GitHash)
+	return nil
+}
+
+// ------------------------------- Utils -----------------------------------
+
+// unmarshalEthParams decodes the positional parameter list of an Ethereum JSON-RPC call.
+// Trailing parameters may be omitted.
+func unmarshalEthParams(data []byte, params ...interface{}) error {
+	var rawParams []json.RawMessage
+	if err := json.Unmarshal(data, &rawParams); err != nil {
+		return fmt.Errorf("Expected a list of parameters: %v", err)
+	}
+	if len(rawParams) > len(params) {
+		return fmt.Errorf("Too many parameters, expected at most %v", len(params))
+	}
+	for i, rawParam := range rawParams {
+		if err := json.Unmarshal(rawParam, params[i]); err != nil {
+			return fmt.Errorf("Invalid parameter %v: %v", i, err)
+		}
+	}
+	return nil
+}
+
+// getEthStoreView returns the store view for the given block tag or hex encoded block number. The
+// "latest" (the default) and "pending" tags read the finalized and the screened state respectively,
+// while the other blocks are read from the state of the selected finalized block.
+func (e *EthRPCService) getEthStoreView(blockTag string) (*state.StoreView, error) {
+	switch blockTag {
+	case "", "latest":
+		return e.theta.ledger.GetFinalizedSnapshot()
+	case "pending":
+		return e.theta.ledger.GetScreenedSnapshot()
+	case "earliest":
+		ledgerState, _, err := e.theta.getStoreViewAt(BlockQueryArgs{Height: 0})
+		return ledgerState, err
+	}
+	height, err := hexutil.DecodeUint64(blockTag)
+	if err != nil {
+		return nil, fmt.Errorf("Unsupported block parameter: %v", blockTag)
+	}
+	ledgerState, _, err := e.theta.getStoreViewAt(BlockQueryArgs{Height: common.JSONUint64(height)})
+	return ledgerState, err
+}
+
+// resolveEthBlockHeight converts a block tag or hex encoded block number to a height.
+func (e *EthRPCService) resolveEthBlockHeight(blockTag string) (uint64, error) {
+	switch blockTag {
+	case "", "latest", "pending":
+		ledgerState, err := e.theta.ledger.GetFinalizedSnapshot()
+		if err != nil {
+			return 0, err
+		}
+		return ledgerState.Height(), nil
+	case "earliest":
+		return 0, nil
+	}
+	return hexutil.DecodeUint64(blockTag)
+}
+
+// getEthCallSnapshot returns the state and the parent block for executing a call at the given
+// block tag or hex encoded block number. The calls at the latest or pending block are executed
+// on top of the delivered state.
+func (e *EthRPCService) getEthCallSnapshot(blockTag string) (*state.StoreView, *core.Block, error) {
+	switch blockTag {
+	case "", "latest", "pending":
+		return e.theta.getSmartContractSnapshot()
+	case "earliest":
+		return e.theta.getSmartContractSnapshotAt(BlockQueryArgs{Height: 0})
+	}
+	height, err := hexutil.DecodeUint64(blockTag)
+	if err != nil {
+		return nil, nil, fmt.Errorf("Unsupported block parameter: %v", blockTag)
+	}
+	return e.theta.getSmartContractSnapshotAt(BlockQueryArgs{Height: common.JSONUint64(height)})
+}
+
+func (e *EthRPCService) executeEthCall(call *EthCallObject, blockTag string, defaultGasLimit uint64) (
+	vmRet common.Bytes, gasUsed uint64, vmErr error, err error) {
+	ledgerState, parentBlock, err := e.getEthCallSnapshot(blockTag)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+
+	chainConfig := e.theta.ledger.ChainConfig()
+	sctx := &types.SmartContractTx{
+		GasLimit: defaultGasLimit,
+		GasPrice: new(big.Int).SetUint64(chainConfig.MinimumGasPrice),
+		Data:     common.Bytes(call.Data),
+	}
+	if len(call.Input) > 0 {
+		sctx.Data = common.Bytes(call.Input)
+	}
+	if call.From != nil {
+		sctx.From.Address = *call.From
+	}
+	if call.To != nil {
+		sctx.To.Address = *call.To
+	}
+	if call.Gas != nil {
+		sctx.GasLimit = uint64(*call.Gas)
+	}
+	if call.GasPrice != nil {
+		sctx.GasPrice = call.GasPrice.ToInt()
+	}
+	value := big.NewInt(0)
+	if call.Value != nil {
+		value = call.Value.ToInt()
+	}
+	sctx.From.Coins = types.Coins{ThetaWei: big.NewInt(0), TFuelWei: value}
+
+	vmRet, _, gasUsed, vmErr = vm.Execute(parentBlock, sctx, ledgerState)
+	return vmRet, gasUsed, vmErr, nil
+}
+
+// cumulativeGasUsed returns the total gas used by the transactions in the block up to and including
+// the one at the given index. Only the smart contract transactions have receipts that record gas.
+func (e *EthRPCService) cumulativeGasUsed(block *core.ExtendedBlock, txIndex uint64) uint64 {
+	gasUsed := uint64(0)
+	for idx := uint64(0); idx <= txIndex && idx < uint64(len(block.Txs)); idx++ {
+		receipt, found := e.theta.chain.FindTxReceiptByHash(crypto.Keccak256Hash(block.Txs[idx]))
+		if found {
+			gasUsed += receipt.GasUsed
+		}
+	}
+	return gasUsed
+}
+
+func findTxIndex(block *core.ExtendedBlock, txHash common.Hash) uint64 {
+	for idx, txBytes := range block.Txs {
+		if crypto.Keccak256Hash(txBytes) == txHash {
+			return uint64(idx)
+		}
+	}
+	return 0
+}
+
+func newEthLog(log *types.Log, block *core.ExtendedBlock, txHash common.Hash, txIndex, logIndex uint64) *EthLog {
+	return &EthLog{
+		Address:          log.Address,
+		Topics:           log.Topics,
+		Data:             hexutil.Bytes(log.Data),
+		BlockNumber:      hexutil.Uint64(block.Height),
+		BlockHash:        block.Hash(),
+		TransactionHash:  txHash,
+		TransactionIndex: hexutil.Uint64(txIndex),
+		LogIndex:         hexutil.Uint64(logIndex),
+	}
+}
+
+func parseEthFilterAddresses(raw json.RawMessage) ([]common.Address, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+	var address common.Address
+	if err := json.Unmarshal(raw, &address); err == nil {
+		return []common.Address{address}, nil
+	}
+	var addresses []common.Address
+	if err := json.Unmarshal(raw, &addresses); err != nil {
+		return nil, fmt.Errorf("Invalid address filter: %v", err)
+	}
+	return addresses, nil
+}
+
+// parseEthFilterTopics parses the topics filter. Each position is either null (matches
+// any topic), a single topic, or a list of alternative topics.
+func parseEthFilterTopics(rawTopics []interface{}) ([][]common.Hash, error) {
+	topics := make([][]common.Hash, len(rawTopics))
+	for i, rawTopic := range rawTopics {
+		switch t := rawTopic.(type) {
+		case nil:
+		case string:
+			topics[i] = []common.Hash{common.HexToHash(t)}
+		case []interface{}:
+			for _, alt := range t {
+				altStr, ok := alt.(string)
+				if !ok {
+					return nil, fmt.Errorf("Invalid topic filter at position %v", i)
+				}
+				topics[i] = append(topics[i], common.HexToHash(altStr))
+			}
+		default:
+			return nil, fmt.Errorf("Invalid topic filter at position %v", i)
+		}
+	}
+	return topics, nil
+}
+
+// ethMethodHandler rewrites Ethereum style method names (e.g. "eth_getBalance") to the
+// "Service.Method" form expected by net/rpc (e.g. "eth.GetBalance").
+func ethMethodHandler(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Body == nil {
+			handler.ServeHTTP(w, r)
+			return
+		}
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		r.Body = ioutil.NopCloser(bytes.NewBuffer(rewriteEthMethods(body)))
+		handler.ServeHTTP(w, r)
+	})
+}
+
+func rewriteEthMethods(body []byte) []byte {
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		var reqs []map[string]json.RawMessage
+		if err := json.Unmarshal(trimmed, &reqs); err != nil {
+			return body
+		}
+		rewritten := false
+		for _, req := range reqs {
+			rewritten = rewriteEthMethod(req) || rewritten
+		}
+		if !rewritten {
+			return body
+		}
+		newBody, err := json.Marshal(reqs)
+		if err != nil {
+			return body
+		}
+		return newBody
+	}
+
+	var req map[string]json.RawMessage
+	if err := json.Unmarshal(trimmed, &req); err != nil {
+		return body
+	}
+	if !rewriteEthMethod(req) {
+		return body
+	}
+	newBody, err := json.Marshal(req)
+	if err != nil {
+		return body
+	}
+	return newBody
+}
+
+func rewriteEthMethod(req map[string]json.RawMessage) bool {
+	rawMethod, ok := req["method"]
+	if !ok {
+		return false
+	}
+	var method string
+	if err := json.Unmarshal(rawMethod, &method); err != nil {
+		return false
+	}
+	if strings.Contains(method, ".") {
+		return false
+	}
+	parts := strings.SplitN(method, "_", 2)
+	if len(parts) != 2 || len(parts[1]) == 0 {
+		return false
+	}
+	switch parts[0] {
+	case "eth", "net", "web3":
+	default:
+		return false
+	}
+	newMethod := parts[0] + "." + strings.ToUpper(parts[1][:1]) + parts[1][1:]
+	rawNewMethod, err := json.Marshal(newMethod)
+	if err != nil {
+		return false
+	}
+	req["method"] = rawNewMethod
+	return true
+}
@@ -0,0 +1,354 @@
+package rpc
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"theta/blockchain"
+	"theta/common"
+	"theta/common/hexutil"
+	"theta/core"
+	"theta/crypto"
+	"theta/ledger"
+	"theta/ledger/state"
+	"theta/ledger/types"
+	"theta/store/database/backend"
+)
+
+func TestRewriteEthMethods(t *testing.T) {
+	assert := assert.New(t)
+
+	body := rewriteEthMethods([]byte(`{"jsonrpc":"2.0","id":1,"method":"eth_getBalance","params":["0x0000000000000000000000000000000000000001","latest"]}`))
+	var req map[string]interface{}
+	assert.Nil(json.Unmarshal(body, &req))
+	assert.Equal("eth.GetBalance", req["method"])
+
+	body = rewriteEthMethods([]byte(`[{"jsonrpc":"2.0","id":1,"method":"net_version"},{"jsonrpc":"2.0","id":2,"method":"theta.GetStatus","params":[{}]}]`))
+	var reqs []map[string]interface{}
+	assert.Nil(json.Unmarshal(body, &reqs))
+	assert.Equal("net.Version", reqs[0]["method"])
+	assert.Equal("theta.GetStatus", reqs[1]["method"])
+
+	original := []byte(`{"jsonrpc":"2.0","id":1,"method":"theta.GetAccount","params":[{"address":"0x1"}]}`)
+	assert.Equal(original, rewriteEthMethods(original))
+}
+
+func TestEthArgsUnmarshal(t *testing.T) {
+	assert := assert.New(t)
+
+	var balanceArgs EthGetBalanceArgs
+	assert.Nil(json.Unmarshal([]byte(`["0x2e833968e5bb786ae419c4d13189fb081cc43bab","pending"]`), &balanceArgs))
+	assert.Equal(common.HexToAddress("0x2e833968e5bb786ae419c4d13189fb081cc43bab"), balanceArgs.Address)
+	assert.Equal("pending", balanceArgs.Block)
+
+	var callArgs EthCallArgs
+	assert.Nil(json.Unmarshal([]byte(`[{"to":"0x2e833968e5bb786ae419c4d13189fb081cc43bab","data":"0x70a08231"}]`), &callArgs))
+	assert.Equal(common.HexToAddress("0x2e833968e5bb786ae419c4d13189fb081cc43bab"), *callArgs.Call.To)
+	assert.Equal(common.Hex2Bytes("70a08231"), []byte(callArgs.Call.Data))
+	assert.Equal("", callArgs.Block)
+
+	assert.NotNil(json.Unmarshal([]byte(`{"address":"0x1"}`), &balanceArgs))
+	assert.NotNil(json.Unmarshal([]byte(`["0x1","latest","extra"]`), &balanceArgs))
+}
+
+func TestEthCallAtBlock(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	chainConfig := core.MainnetChainConfig()
+	chainConfig.ChainID = "eth_call_test_chain"
+	chainConfig.HeightEnableSmartContract = 0
+	require.Nil(core.RegisterChainConfig(chainConfig))
+
+	db := backend.NewMemDatabase()
+	chain := blockchain.CreateTestChain()
+	thetaLedger := ledger.NewLedger(chainConfig.ChainID, db, chain, nil, nil, nil)
+	service := &EthRPCService{theta: &ThetaRPCService{ledger: thetaLedger, chain: chain}}
+
+	// Returns the value of the storage slot 0
+	contract := common.HexToAddress("0x0000000000000000000000000000000000000b01")
+	storageKey := common.Hash{}
+	storeView := state.NewStoreView(0, common.Hash{}, db)
+	storeView.SetCode(contract, common.Hex2Bytes("60005460005260206000f3"))
+	storeView.SetState(contract, storageKey, common.BytesToHash([]byte{0xa}))
+	root1 := storeView.Save()
+	storeView.SetState(contract, storageKey, common.BytesToHash([]byte{0xb}))
+	root2 := storeView.Save()
+
+	parent := chain.Root().Block
+	blocks := []*core.Block{}
+	for i, root := range []common.Hash{root1, root2} {
+		block := core.NewBlock()
+		block.ChainID = "testchain"
+		block.Height = uint64(i + 1)
+		block.Parent = parent.Hash()
+		block.StateHash = root
+		block.Timestamp = big.NewInt(int64(i + 1))
+		_, err := chain.AddBlock(block)
+		require.Nil(err)
+		blocks = append(blocks, block)
+		parent = block
+	}
+	require.Nil(chain.FinalizePreviousBlocks(blocks[1].Hash()))
+	require.True(thetaLedger.ResetState(blocks[1]).IsOK())
+
+	call := func(block string) (common.Hash, error) {
+		var args EthCallArgs
+		require.Nil(json.Unmarshal([]byte(`[{"to":"`+contract.Hex()+`"},"`+block+`"]`), &args))
+		var result hexutil.Bytes
+		err := service.Call(&args, &result)
+		return common.BytesToHash(result), err
+	}
+
+	for _, block := range []string{"", "latest", "pending", "0x2"} {
+		value, err := call(block)
+		assert.Nil(err)
+		assert.Equal(common.BytesToHash([]byte{0xb}), value, block)
+	}
+	value, err := call("0x1")
+	assert.Nil(err)
+	assert.Equal(common.BytesToHash([]byte{0xa}), value)
+
+	_, err = call("0x3")
+	assert.NotNil(err)
+	_, err = call("safe")
+	assert.NotNil(err)
+
+	var estimateArgs EthEstimateGasArgs
+	require.Nil(json.Unmarshal([]byte(`[{"to":"`+contract.Hex()+`"},"0x1"]`), &estimateArgs))
+	var gas hexutil.Uint64
+	assert.Nil(service.EstimateGas(&estimateArgs, &gas))
+	assert.True(gas > 21000)
+
+	require.Nil(json.Unmarshal([]byte(`[{"to":"`+contract.Hex()+`"},"0x3"]`), &estimateArgs))
+	assert.NotNil(service.EstimateGas(&estimateArgs, &gas))
+}
+
+func TestEthGetBalanceAtBlock(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	chainConfig := core.MainnetChainConfig()
+	chainConfig.ChainID = "eth_get_balance_test_chain"
+	require.Nil(core.RegisterChainConfig(chainConfig))
+
+	db := backend.NewMemDatabase()
+	chain := blockchain.CreateTestChain()
+	thetaLedger := ledger.NewLedger(chainConfig.ChainID, db, chain, nil, nil, nil)
+	service := &EthRPCService{theta: &ThetaRPCService{ledger: thetaLedger, chain: chain}}
+
+	address := common.HexToAddress("0x0000000000000000000000000000000000000a01")
+	storeView := state.NewStoreView(0, common.Hash{}, db)
+	account := types.NewAccount(address)
+	account.Balance = types.NewCoins(0, 100)
+	storeView.SetAccount(address, account)
+	root1 := storeView.Save()
+	account.Balance = types.NewCoins(0, 200)
+	storeView.SetAccount(address, account)
+	root2 := storeView.Save()
+
+	parent := chain.Root().Block
+	blocks := []*core.Block{}
+	for i, root := range []common.Hash{root1, root2} {
+		block := core.NewBlock()
+		block.ChainID = "testchain"
+		block.Height = uint64(i + 1)
+		block.Parent = parent.Hash()
+		block.StateHash = root
+		block.Timestamp = big.NewInt(int64(i + 1))
+		_, err := chain.AddBlock(block)
+		require.Nil(err)
+		blocks = append(blocks, block)
+		parent = block
+	}
+	require.Nil(chain.FinalizePreviousBlocks(blocks[1].Hash()))
+	require.True(thetaLedger.ResetState(blocks[1]).IsOK())
+	thetaLedger.FinalizeState(blocks[1].Height, blocks[1].StateHash)
+
+	getBalance := func(block string) (*big.Int, error) {
+		var args EthGetBalanceArgs
+		require.Nil(json.Unmarshal([]byte(`["`+address.Hex()+`","`+block+`"]`), &args))
+		var result hexutil.Big
+		err := service.GetBalance(&args, &result)
+		return result.ToInt(), err
+	}
+
+	for _, block := range []string{"", "latest", "pending", "0x2"} {
+		balance, err := getBalance(block)
+		assert.Nil(err, block)
+		assert.Equal(big.NewInt(200), balance, block)
+	}
+	balance, err := getBalance("0x1")
+	assert.Nil(err)
+	assert.Equal(big.NewInt(100), balance)
+
+	_, err = getBalance("0x3")
+	assert.NotNil(err)
+	_, err = getBalance("safe")
+	assert.NotNil(err)
+}
+
+func TestEthGetTransactionReceipt(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	chain := blockchain.CreateTestChain()
+	service := &EthRPCService{theta: &ThetaRPCService{chain: chain}}
+
+	from := common.HexToAddress("0x2e833968e5bb786ae419c4d13189fb081cc43bab")
+	to := common.HexToAddress("0x0d2fd67d573c8ecb4161510fc00754d64b401f86")
+	sctx1 := &types.SmartContractTx{
+		From:     types.TxInput{Address: from, Coins: types.NewCoins(0, 0), Sequence: 1},
+		To:       types.TxOutput{Address: to},
+		GasLimit: 100000,
+		GasPrice: big.NewInt(1),
+	}
+	sendTx := &types.SendTx{
+		Fee:     types.NewCoins(0, 1),
+		Inputs:  []types.TxInput{{Address: from, Coins: types.NewCoins(0, 11), Sequence: 2}},
+		Outputs: []types.TxOutput{{Address: to, Coins: types.NewCoins(0, 10)}},
+	}
+	sctx2 := &types.SmartContractTx{
+		From:     types.TxInput{Address: from, Coins: types.NewCoins(0, 0), Sequence: 3},
+		To:       types.TxOutput{Address: to},
+		GasLimit: 100000,
+		GasPrice: big.NewInt(1),
+	}
+	raws := []common.Bytes{}
+	for _, tx := range []types.Tx{sctx1, sendTx, sctx2} {
+		raw, err := types.TxToBytes(tx)
+		require.Nil(err)
+		raws = append(raws, raw)
+	}
+	chain.AddTxReceipt(sctx1, nil, nil, common.Address{}, 30000, nil)
+	chain.AddTxReceipt(sctx2, nil, nil, common.Address{}, 25000, nil)
+
+	block := core.NewBlock()
+	block.ChainID = "testchain"
+	block.Height = 1
+	block.Parent = chain.Root().Hash()
+	block.Timestamp = big.NewInt(1)
+	block.Txs = raws
+	eb, err := chain.AddBlock(block)
+	require.Nil(err)
+	chain.AddTxsToIndex(eb, true)
+
+	getReceipt := func(raw common.Bytes) *EthTransactionReceipt {
+		args := &EthGetTransactionReceiptArgs{Hash: crypto.Keccak256Hash(raw)}
+		var result EthGetTransactionReceiptResult
+		require.Nil(service.GetTransactionReceipt(args, &result))
+		return result.EthTransactionReceipt
+	}
+
+	// The cumulative gas used sums up the gas used by the preceding transactions in the block
+	receipt := getReceipt(raws[0])
+	require.NotNil(receipt)
+	assert.Equal(hexutil.Uint64(30000), receipt.GasUsed)
+	assert.Equal(hexutil.Uint64(30000), receipt.CumulativeGasUsed)
+
+	receipt = getReceipt(raws[2])
+	require.NotNil(receipt)
+	assert.Equal(hexutil.Uint64(2), receipt.TransactionIndex)
+	assert.Equal(hexutil.Uint64(25000), receipt.GasUsed)
+	assert.Equal(hexutil.Uint64(55000), receipt.CumulativeGasUsed)
+
+	assert.Nil(getReceipt(raws[1]))
+}
+
+func TestEthGetLogs(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	chain := blockchain.CreateTestChain()
+	service := &EthRPCService{theta: &ThetaRPCService{chain: chain}}
+
+	addrA := common.HexToAddress("0x2e833968e5bb786ae419c4d13189fb081cc43bab")
+	addrB := common.HexToAddress("0x0d2fd67d573c8ecb4161510fc00754d64b401f86")
+	topic0 := common.HexToHash("0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef")
+	topic1 := common.HexToHash("0x01")
+	topic2 := common.HexToHash("0x02")
+	topic3 := common.HexToHash("0x03")
+
+	sctx := &types.SmartContractTx{
+		From:     types.TxInput{Address: addrA, Coins: types.NewCoins(0, 0)},
+		To:       types.TxOutput{Address: addrB},
+		GasLimit: 100000,
+		GasPrice: big.NewInt(1),
+	}
+	raw, err := types.TxToBytes(sctx)
+	require.Nil(err)
+	chain.AddTxReceipt(sctx, []*types.Log{
+		{Address: addrA, Topics: []common.Hash{topic0, topic1}},
+		{Address: addrB, Topics: []common.Hash{topic0, topic2}},
+		{Address: addrA, Topics: []common.Hash{topic3}},
+	}, nil, common.Address{}, 0, nil)
+
+	block := core.NewBlock()
+	block.ChainID = "testchain"
+	block.Height = 1
+	block.Parent = chain.Root().Hash()
+	block.Timestamp = big.NewInt(1)
+	block.Txs = []common.Bytes{raw}
+	eb, err := chain.AddBlock(block)
+	require.Nil(err)
+	require.Nil(chain.FinalizePreviousBlocks(block.Hash()))
+	chain.AddBlockBloom(eb)
+
+	getLogs := func(filter string) ([]*EthLog, error) {
+		var args EthGetLogsArgs
+		if err := json.Unmarshal([]byte(`[`+filter+`]`), &args); err != nil {
+			return nil, err
+		}
+		var result EthGetLogsResult
+		err := service.GetLogs(&args, &result)
+		return result, err
+	}
+	logIndexes := func(logs []*EthLog) []uint64 {
+		indexes := []uint64{}
+		for _, log := range logs {
+			indexes = append(indexes, uint64(log.LogIndex))
+		}
+		return indexes
+	}
+
+	tests := []struct {
+		filter   string
+		expected []uint64
+	}{
+		{`{"fromBlock":"0x1","toBlock":"0x1"}`, []uint64{0, 1, 2}},
+		{`{"fromBlock":"0x1","toBlock":"0x1","address":"` + addrA.Hex() + `"}`, []uint64{0, 2}},
+		{`{"fromBlock":"0x1","toBlock":"0x1","address":["` + addrB.Hex() + `"]}`, []uint64{1}},
+		{`{"fromBlock":"0x1","toBlock":"0x1","address":null,"topics":["` + topic0.Hex() + `"]}`, []uint64{0, 1}},
+		{`{"fromBlock":"0x1","toBlock":"0x1","topics":[null,["` + topic2.Hex() + `","` + topic1.Hex() + `"]]}`, []uint64{0, 1}},
+		{`{"fromBlock":"0x1","toBlock":"0x1","topics":["` + topic0.Hex() + `",null,"` + topic3.Hex() + `"]}`, []uint64{}},
+		{`{"fromBlock":"0x1","toBlock":"0x1","address":"` + addrA.Hex() + `","topics":["` + topic3.Hex() + `"]}`, []uint64{2}},
+		{`{"blockHash":"` + block.Hash().Hex() + `","topics":[["` + topic3.Hex() + `"]]}`, []uint64{2}},
+		{`{"fromBlock":"0x2","toBlock":"0x2"}`, []uint64{}},
+	}
+	for _, test := range tests {
+		logs, err := getLogs(test.filter)
+		require.Nil(err, test.filter)
+		assert.Equal(test.expected, logIndexes(logs), test.filter)
+	}
+
+	logs, err := getLogs(`{"fromBlock":"0x1","toBlock":"0x1","topics":["` + topic3.Hex() + `"]}`)
+	require.Nil(err)
+	require.Equal(1, len(logs))
+	assert.Equal(addrA, logs[0].Address)
+	assert.Equal([]common.Hash{topic3}, logs[0].Topics)
+	assert.Equal(hexutil.Uint64(1), logs[0].BlockNumber)
+	assert.Equal(block.Hash(), logs[0].BlockHash)
+	assert.Equal(crypto.Keccak256Hash(raw), logs[0].TransactionHash)
+
+	_, err = getLogs(`{"fromBlock":"0x1","toBlock":"0x1","topics":[1]}`)
+	assert.NotNil(err)
+	_, err = getLogs(`{"fromBlock":"0x1","toBlock":"0x1","topics":[[1]]}`)
+	assert.NotNil(err)
+	_, err = getLogs(`{"fromBlock":"0x1","toBlock":"0x1","address":1}`)
+	assert.NotNil(err)
+	_, err = getLogs(`{"fromBlock":"0x2","toBlock":"0x1"}`)
+	assert.NotNil(err)
+}
@@ -8,6 +8,8 @@ import (
 	"strings"
 	"time"
 
+	"github.com/spf13/viper"
+
 	"theta/blockchain"
 	"theta/crypto/bls"
 
@@ -44,6 +46,7 @@ type GetAccountArgs struct {
 	Name    string `json:"name"`
 	Address string `json:"address"`
 	Preview bool   `json:"preview"` // preview the account balance from the ScreenedView
+	BlockQueryArgs
 }
 
 type GetAccountResult struct {
@@ -59,7 +62,12 @@ func (t *ThetaRPCService) GetAccount(args *GetAccountArgs, result *GetAccountRes
 	result.Address = args.Address
 
 	var ledgerState *state.StoreView
-	if args.Preview {
+	if args.BlockQueryArgs.isSet() {
+		if args.Preview {
+			return errors.New("Preview can't be combined with a block height or hash")
+		}
+		ledgerState, _, err = t.getStoreViewAt(args.BlockQueryArgs)
+	} else if args.Preview {
 		ledgerState, err = t.ledger.GetScreenedSnapshot()
 	} else {
 		ledgerState, err = t.ledger.GetFinalizedSnapshot()
@@ -82,6 +90,7 @@ func (t *ThetaRPCService) GetAccount(args *GetAccountArgs, result *GetAccountRes
 
 type GetSplitRuleArgs struct {
 	ResourceID string `json:"resource_id"`
+	BlockQueryArgs
 }
 
 type GetSplitRuleResult struct {
@@ -93,7 +102,13 @@ func (t *ThetaRPCService) GetSplitRule(args *GetSplitRuleArgs, result *GetSplitR
 		return errors.New("ResourceID must be specified")
 	}
 	resourceID := args.ResourceID
-	ledgerState, err := t.ledger.GetDeliveredSnapshot()
+
+	var ledgerState *state.StoreView
+	if args.BlockQueryArgs.isSet() {
+		ledgerState, _, err = t.getStoreViewAt(args.BlockQueryArgs)
+	} else {
+		ledgerState, err = t.ledger.GetDeliveredSnapshot()
+	}
 	if err != nil {
 		return err
 	}
@@ -101,6 +116,104 @@ func (t *ThetaRPCService) GetSplitRule(args *GetSplitRuleArgs, result *GetSplitR
 	return nil
 }
 
+// ------------------------------- GetStorageAt -----------------------------------
+
+type GetStorageAtArgs struct {
+	Address string `json:"address"`
+	Key     string `json:"key"`
+	BlockQueryArgs
+}
+
+type GetStorageAtResult struct {
+	Value common.Hash `json:"value"`
+}
+
+// GetStorageAt returns the value of the given storage slot of a smart contract. It reads the
+// latest finalized state unless a block height or hash is specified.
+func (t *ThetaRPCService) GetStorageAt(args *GetStorageAtArgs, result *GetStorageAtResult) (err error) {
+	if args.Address == "" {
+		return errors.New("Address must be specified")
+	}
+	if args.Key == "" {
+		return errors.New("Storage key must be specified")
+	}
+	address := common.HexToAddress(args.Address)
+	key := common.HexToHash(args.Key)
+
+	var ledgerState *state.StoreView
+	if args.BlockQueryArgs.isSet() {
+		ledgerState, _, err = t.getStoreViewAt(args.BlockQueryArgs)
+	} else {
+		ledgerState, err = t.ledger.GetFinalizedSnapshot()
+	}
+	if err != nil {
+		return err
+	}
+
+	result.Value = ledgerState.GetState(address, key)
+	return nil
+}
+
+// ------------------------------- GetProof -----------------------------------
+
+// maxProofStorageKeys is the maximum number of storage slots a single proof query can cover
+const maxProofStorageKeys = 100
+
+type GetProofArgs struct {
+	Address     string   `json:"address"`
+	StorageKeys []string `json:"storage_keys"`
+	BlockQueryArgs
+}
+
+type GetProofResult struct {
+	BlockHash   common.Hash       `json:"block_hash"`
+	BlockHeight common.JSONUint64 `json:"block_height"`
+	StateRoot   common.Hash       `json:"state_root"`
+	*state.AccountProof
+}
+
+// GetProof returns the merkle proof of the account against the state root of the selected block,
+// and the merkle proofs of the given storage slots against the storage root of the account. The
+// latest finalized block is used if no block is specified. The proof can be verified with
+// state.VerifyAccountProof().
+func (t *ThetaRPCService) GetProof(args *GetProofArgs, result *GetProofResult) (err error) {
+	if args.Address == "" {
+		return errors.New("Address must be specified")
+	}
+	if len(args.StorageKeys) > maxProofStorageKeys {
+		return fmt.Errorf("Can't prove more than %v storage slots at a time", maxProofStorageKeys)
+	}
+	address := common.HexToAddress(args.Address)
+	storageKeys := make([]common.Hash, len(args.StorageKeys))
+	for i, key := range args.StorageKeys {
+		storageKeys[i] = common.HexToHash(key)
+	}
+
+	at := args.BlockQueryArgs
+	if !at.isSet() {
+		finalizedView, err := t.ledger.GetFinalizedSnapshot()
+		if err != nil {
+			return err
+		}
+		at.Height = common.JSONUint64(finalizedView.Height())
+	}
+	ledgerState, block, err := t.getStoreViewAt(at)
+	if err != nil {
+		return err
+	}
+
+	proof, err := ledgerState.GetProof(address, storageKeys)
+	if err != nil {
+		return err
+	}
+
+	result.BlockHash = block.Hash()
+	result.BlockHeight = common.JSONUint64(block.Height)
+	result.StateRoot = block.StateHash
+	result.AccountProof = proof
+	return nil
+}
+
 // ------------------------------ GetTransaction -----------------------------------
 
 type GetTransactionArgs struct {
@@ -115,6 +228,8 @@ type GetTransactionResult struct {
 	Type        byte                       `json:"type"`
 	Tx          types.Tx                   `json:"transaction"`
 	Receipt     *blockchain.TxReceiptEntry `json:"receipt"`
+
+	EvictionReason string `json:"eviction_reason,omitempty"`
 }
 
 type TxStatus string
@@ -124,6 +239,10 @@ const (
 	TxStatusPending   = "pending"
 	TxStatusFinalized = "finalized"
 	TxStatusAbandoned = "abandoned"
+	TxStatusEvicted   = "evicted"
+	TxStatusReplaced  = "replaced"
+	TxStatusIncluded  = "included"
+	TxStatusDropped   = "dropped"
 )
 
 func (t *ThetaRPCService) GetTransaction(args *GetTransactionArgs, result *GetTransactionResult) (err error) {
@@ -135,10 +254,15 @@ func (t *ThetaRPCService) GetTransaction(args *GetTransactionArgs, result *GetTr
 
 	raw, block, found := t.chain.FindTxByHash(hash)
 	if !found {
-		txStatus, exists := t.mempool.GetTransactionStatus(args.Hash)
-		if exists {
+		txStatus, evictionReason, exists := t.mempool.GetTransactionStatus(hex.EncodeToString(hash[:]))
+		if exists && txStatus != mempool.TxStatusRejected {
 			if txStatus == mempool.TxStatusAbandoned {
 				result.Status = TxStatusAbandoned
+			} else if txStatus == mempool.TxStatusEvicted {
+				result.Status = TxStatusEvicted
+				result.EvictionReason = string(evictionReason)
+			} else if txStatus == mempool.TxStatusReplaced {
+				result.Status = TxStatusReplaced
 			} else {
 				result.Status = TxStatusPending
 			}
@@ -172,6 +296,101 @@ func (t *ThetaRPCService) GetTransaction(args *GetTransactionArgs, result *GetTr
 	return nil
 }
 
+// ------------------------------ GetTransactionStatuses -----------------------------------
+
+// maxTxStatusesQueryLimit is the maximum number of transactions a single status query can cover
+const maxTxStatusesQueryLimit = 100
+
+type GetTransactionStatusesArgs struct {
+	Hashes []string `json:"hashes"`
+}
+
+type GetTransactionStatusesResult struct {
+	Statuses []*TxLifecycleStatus `json:"statuses"`
+}
+
+// TxLifecycleStatus describes where a transaction is in its lifecycle. The status is one of
+// "pending", "included", "finalized", "dropped" and "not_found". The reason of a dropped
+// transaction is one of "rejected", "abandoned", "replaced", "low_fee" and "expired".
+type TxLifecycleStatus struct {
+	Hash        common.Hash        `json:"hash"`
+	Status      TxStatus           `json:"status"`
+	Reason      string             `json:"reason,omitempty"`
+	Message     string             `json:"message,omitempty"`
+	ReplacedBy  *common.Hash       `json:"replaced_by,omitempty"`
+	BlockHash   *common.Hash       `json:"block_hash,omitempty"`
+	BlockHeight *common.JSONUint64 `json:"block_height,omitempty"`
+	EvmError    string             `json:"evm_error,omitempty"`
+}
+
+// GetTransactionStatuses returns the lifecycle statuses of the given transactions. The statuses
+// of the transactions that left the mempool without being included in a block are retained
+// for the configured time window.
+func (t *ThetaRPCService) GetTransactionStatuses(args *GetTransactionStatusesArgs, result *GetTransactionStatusesResult) (err error) {
+	if len(args.Hashes) == 0 {
+		return errors.New("Transanction hashes must be specified")
+	}
+	if len(args.Hashes) > maxTxStatusesQueryLimit {
+		return fmt.Errorf("Can't query more than %v transactions at a time", maxTxStatusesQueryLimit)
+	}
+
+	result.Statuses = []*TxLifecycleStatus{}
+	for _, hashStr := range args.Hashes {
+		result.Statuses = append(result.Statuses, t.getTxLifecycleStatus(common.HexToHash(hashStr)))
+	}
+	return nil
+}
+
+func (t *ThetaRPCService) getTxLifecycleStatus(hash common.Hash) *TxLifecycleStatus {
+	status := &TxLifecycleStatus{
+		Hash: hash,
+	}
+
+	_, block, found := t.chain.FindTxByHash(hash)
+	if found {
+		blockHash := block.Hash()
+		blockHeight := common.JSONUint64(block.Height)
+		status.BlockHash = &blockHash
+		status.BlockHeight = &blockHeight
+		if block.Status.IsFinalized() {
+			status.Status = TxStatusFinalized
+		} else {
+			status.Status = TxStatusIncluded
+		}
+		if receipt, found := t.chain.FindTxReceiptByHash(hash); found {
+			status.EvmError = receipt.EvmErr
+		}
+		return status
+	}
+
+	record, exists := t.mempool.GetTransactionRecord(hex.EncodeToString(hash[:]))
+	if !exists {
+		status.Status = TxStatusNotFound
+		return status
+	}
+
+	status.Message = record.Message
+	switch record.Status {
+	case mempool.TxStatusPending:
+		status.Status = TxStatusPending
+	case mempool.TxStatusRejected:
+		status.Status = TxStatusDropped
+		status.Reason = "rejected"
+	case mempool.TxStatusAbandoned:
+		status.Status = TxStatusDropped
+		status.Reason = "abandoned"
+	case mempool.TxStatusEvicted:
+		status.Status = TxStatusDropped
+		status.Reason = string(record.EvictionReason)
+	case mempool.TxStatusReplaced:
+		replacedBy := common.HexToHash(record.ReplacedBy)
+		status.Status = TxStatusDropped
+		status.Reason = "replaced"
+		status.ReplacedBy = &replacedBy
+	}
+	return status
+}
+
 // ------------------------------ GetPendingTransactions -----------------------------------
 
 type GetPendingTransactionsArgs struct {
@@ -239,6 +458,9 @@ const (
 	TxTypeDepositStake
 	TxTypeWithdrawStake
 	TxTypeDepositStakeTxV2
+	TxTypeEquivocationSlash
+	TxTypeValidatorProfile
+	TxTypeRedelegateStake
 )
 
 func (t *ThetaRPCService) GetBlock(args *GetBlockArgs, result *GetBlockResult) (err error) {
@@ -455,6 +677,7 @@ type GetStatusResult struct {
 	CurrentHeight              common.JSONUint64 `json:"current_height"`
 	CurrentTime                *common.JSONBig   `json:"current_time"`
 	Syncing                    bool              `json:"syncing"`
+	ArchiveMode                bool              `json:"archive_mode"`
 }
 
 func (t *ThetaRPCService) GetStatus(args *GetStatusArgs, result *GetStatusResult) (err error) {
@@ -493,6 +716,7 @@ func (t *ThetaRPCService) GetStatus(args *GetStatusArgs, result *GetStatusResult
 	}
 
 	result.Syncing = !t.consensus.HasSynced()
+	result.ArchiveMode = viper.GetBool(common.CfgStorageArchiveMode)
 
 	return
 }
@@ -523,9 +747,10 @@ type GetVcpResult struct {
 }
 
 type BlockHashVcpPair struct {
-	BlockHash  common.Hash
-	Vcp        *core.ValidatorCandidatePool
-	HeightList *types.HeightList
+	BlockHash         common.Hash
+	Vcp               *core.ValidatorCandidatePool
+	HeightList        *types.HeightList
+	ValidatorProfiles []*core.ValidatorProfile // Commission profiles of the candidates that have set one
 }
 
 func (t *ThetaRPCService) GetVcpByHeight(args *GetVcpByHeightArgs, result *GetVcpResult) (err error) {
@@ -548,10 +773,17 @@ func (t *ThetaRPCService) GetVcpByHeight(args *GetVcpByHeightArgs, result *GetVc
 		}
 		vcp := blockStoreView.GetValidatorCandidatePool()
 		hl := blockStoreView.GetStakeTransactionHeightList()
+		profiles := []*core.ValidatorProfile{}
+		for _, candidate := range vcp.SortedCandidates {
+			if profile := blockStoreView.GetValidatorProfile(candidate.Holder); profile != nil {
+				profiles = append(profiles, profile)
+			}
+		}
 		blockHashVcpPairs = append(blockHashVcpPairs, BlockHashVcpPair{
-			BlockHash:  blockHash,
-			Vcp:        vcp,
-			HeightList: hl,
+			BlockHash:         blockHash,
+			Vcp:               vcp,
+			HeightList:        hl,
+			ValidatorProfiles: profiles,
 		})
 	}
 
@@ -605,6 +837,70 @@ func (t *ThetaRPCService) GetGcpByHeight(args *GetGcpByHeightArgs, result *GetGc
 	return nil
 }
 
+// ------------------------------ GetGuardianUptime -----------------------------------
+
+type GetGuardianUptimeArgs struct {
+	Address string `json:"address"`
+	BlockQueryArgs
+}
+
+type GetGuardianUptimeResult struct {
+	Uptimes []GuardianUptimeInfo `json:"uptimes"`
+}
+
+type GuardianUptimeInfo struct {
+	Holder           common.Address    `json:"holder"`
+	Participated     common.JSONUint64 `json:"participated"`
+	Checkpoints      common.JSONUint64 `json:"checkpoints"`
+	UptimePercentage common.JSONUint64 `json:"uptime_percentage"`
+	LastCheckpoint   common.JSONUint64 `json:"last_checkpoint"`
+	Participation    []bool            `json:"participation"`
+}
+
+// GetGuardianUptime returns the participation of a guardian in the recent checkpoint votes. If no
+// address is specified, it returns the uptime of all the guardians with stake in the GCP.
+func (t *ThetaRPCService) GetGuardianUptime(args *GetGuardianUptimeArgs, result *GetGuardianUptimeResult) (err error) {
+	var ledgerState *state.StoreView
+	if args.BlockQueryArgs.isSet() {
+		ledgerState, _, err = t.getStoreViewAt(args.BlockQueryArgs)
+	} else {
+		ledgerState, err = t.ledger.GetFinalizedSnapshot()
+	}
+	if err != nil {
+		return err
+	}
+
+	holders := []common.Address{}
+	if args.Address != "" {
+		holders = append(holders, common.HexToAddress(args.Address))
+	} else {
+		for _, g := range ledgerState.GetGuardianCandidatePool().WithStake().SortedGuardians {
+			holders = append(holders, g.Holder)
+		}
+	}
+
+	result.Uptimes = []GuardianUptimeInfo{}
+	for _, holder := range holders {
+		uptime := ledgerState.GetGuardianUptime(holder)
+		if uptime == nil {
+			if args.Address != "" {
+				return fmt.Errorf("Uptime of guardian %v is not found", holder.Hex())
+			}
+			uptime = core.NewGuardianUptime(holder)
+		}
+		result.Uptimes = append(result.Uptimes, GuardianUptimeInfo{
+			Holder:           uptime.Holder,
+			Participated:     common.JSONUint64(uptime.NumParticipated()),
+			Checkpoints:      common.JSONUint64(len(uptime.Participation)),
+			UptimePercentage: common.JSONUint64(uptime.UptimePercentage()),
+			LastCheckpoint:   common.JSONUint64(uptime.LastCheckpoint),
+			Participation:    uptime.Participation,
+		})
+	}
+
+	return nil
+}
+
 // ------------------------------ GetGuardianKey -----------------------------------
 
 type GetGuardianInfoArgs struct{}
@@ -637,8 +933,177 @@ func (t *ThetaRPCService) GetGuardianInfo(args *GetGuardianInfoArgs, result *Get
 	return nil
 }
 
+// ------------------------------ GetLogs -----------------------------------
+
+// maxLogsQueryBlockRange is the maximum number of blocks a single log query can cover
+const maxLogsQueryBlockRange = 5000
+
+type GetLogsArgs struct {
+	FromHeight common.JSONUint64 `json:"from_height"`
+	ToHeight   common.JSONUint64 `json:"to_height"`
+	Addresses  []common.Address  `json:"addresses"`
+	Topics     [][]common.Hash   `json:"topics"`
+}
+
+type GetLogsResult struct {
+	Logs []*blockchain.LogEntry `json:"logs"`
+}
+
+// GetLogs returns the smart contract logs emitted in the given range of finalized blocks
+// that match the addresses and topics. Topics are matched by position, and an empty list
+// at a position matches any topic.
+func (t *ThetaRPCService) GetLogs(args *GetLogsArgs, result *GetLogsResult) (err error) {
+	filter := &blockchain.LogFilter{
+		FromHeight: uint64(args.FromHeight),
+		ToHeight:   uint64(args.ToHeight),
+		Addresses:  args.Addresses,
+		Topics:     args.Topics,
+	}
+	if err = validateLogFilterRange(filter); err != nil {
+		return err
+	}
+
+	result.Logs = t.chain.FilterLogs(filter)
+	return nil
+}
+
+// ------------------------------ GetAccountTransactions -----------------------------------
+
+const (
+	// defaultAccountTxsQueryLimit is the number of transactions returned if no limit is specified
+	defaultAccountTxsQueryLimit = 100
+	// maxAccountTxsQueryLimit is the maximum number of transactions a single query can return
+	maxAccountTxsQueryLimit = 1000
+)
+
+type GetAccountTransactionsArgs struct {
+	Address    string            `json:"address"`
+	FromHeight common.JSONUint64 `json:"from_height"`
+	Limit      common.JSONUint64 `json:"limit"`
+	Cursor     string            `json:"cursor"`
+}
+
+type GetAccountTransactionsResult struct {
+	Transactions []*AccountTransaction `json:"transactions"`
+	NextCursor   string                `json:"next_cursor"`
+}
+
+type AccountTransaction struct {
+	*blockchain.AccountTxIndexEntry
+	Type byte     `json:"type"`
+	Tx   types.Tx `json:"transaction"`
+}
+
+// GetAccountTransactions returns the finalized transactions in which the address is involved,
+// in ascending order of block height. The query starts at FromHeight, or at Cursor when
+// continuing from the NextCursor returned by a previous query.
+func (t *ThetaRPCService) GetAccountTransactions(args *GetAccountTransactionsArgs, result *GetAccountTransactionsResult) (err error) {
+	if !viper.GetBool(common.CfgStorageIndexAccountTxs) {
+		return errors.New("Account transaction index is not enabled")
+	}
+	if args.Address == "" {
+		return errors.New("Address must be specified")
+	}
+	limit := int(args.Limit)
+	if limit == 0 {
+		limit = defaultAccountTxsQueryLimit
+	}
+	if limit > maxAccountTxsQueryLimit {
+		return fmt.Errorf("Can't query more than %v transactions at a time", maxAccountTxsQueryLimit)
+	}
+
+	cursor := blockchain.AccountTxCursor(uint64(args.FromHeight), 0)
+	if args.Cursor != "" {
+		cursor, err = hex.DecodeString(strings.TrimPrefix(args.Cursor, "0x"))
+		if err != nil || len(cursor) != blockchain.AccountTxCursorLength {
+			return errors.New("Invalid cursor")
+		}
+	}
+
+	entries, next, err := t.chain.FindAccountTxs(common.HexToAddress(args.Address), cursor, limit)
+	if err != nil {
+		return err
+	}
+
+	result.Transactions = []*AccountTransaction{}
+	for _, entry := range entries {
+		raw, _, found := t.chain.FindTxByHash(entry.TxHash)
+		if !found {
+			return fmt.Errorf("Failed to find transaction %v", entry.TxHash.Hex())
+		}
+		tx, err := types.TxFromBytes(raw)
+		if err != nil {
+			return err
+		}
+		result.Transactions = append(result.Transactions, &AccountTransaction{
+			AccountTxIndexEntry: entry,
+			Type:                getTxType(tx),
+			Tx:                  tx,
+		})
+	}
+	if next != nil {
+		result.NextCursor = hex.EncodeToString(next)
+	}
+
+	return nil
+}
+
 // ------------------------------ Utils ------------------------------
 
+// BlockQueryArgs selects the block whose state a query reads, by either its hash or its
+// height. The finalized block is used when selecting by height. If neither is specified,
+// the query reads the latest state.
+type BlockQueryArgs struct {
+	Height    common.JSONUint64 `json:"height"`
+	BlockHash common.Hash       `json:"block_hash"`
+}
+
+func (a BlockQueryArgs) isSet() bool {
+	return a.Height != 0 || a.BlockHash != common.Hash{}
+}
+
+// getStoreViewAt returns the view of the state right after the selected block is applied,
+// along with the block itself.
+func (t *ThetaRPCService) getStoreViewAt(args BlockQueryArgs) (*state.StoreView, *core.Block, error) {
+	var block *core.ExtendedBlock
+	if (args.BlockHash != common.Hash{}) {
+		b, err := t.chain.FindBlock(args.BlockHash)
+		if err != nil {
+			return nil, nil, fmt.Errorf("Block %v is not found", args.BlockHash.Hex())
+		}
+		if !b.Status.IsValid() {
+			return nil, nil, fmt.Errorf("Block %v has not been validated", args.BlockHash.Hex())
+		}
+		block = b
+	} else {
+		for _, b := range t.chain.FindBlocksByHeight(uint64(args.Height)) {
+			if b.Status.IsFinalized() {
+				block = b
+				break
+			}
+		}
+		if block == nil {
+			return nil, nil, fmt.Errorf("No finalized block found at height %v", args.Height)
+		}
+	}
+
+	storeView := state.NewStoreView(block.Height, block.StateHash, t.ledger.State().DB())
+	if storeView == nil { // might have been pruned
+		return nil, nil, fmt.Errorf("The state at height %v does not exist, it might have been pruned", block.Height)
+	}
+	return storeView, block.Block, nil
+}
+
+func validateLogFilterRange(filter *blockchain.LogFilter) error {
+	if filter.FromHeight > filter.ToHeight {
+		return errors.New("Starting height must not be greater than ending height")
+	}
+	if filter.ToHeight-filter.FromHeight >= maxLogsQueryBlockRange {
+		return fmt.Errorf("Can't query logs for more than %v blocks at a time", maxLogsQueryBlockRange)
+	}
+	return nil
+}
+
 func getTxType(tx types.Tx) byte {
 	t := byte(0x0)
 	switch tx.(type) {
@@ -664,6 +1129,12 @@ func getTxType(tx types.Tx) byte {
 		t = TxTypeWithdrawStake
 	case *types.DepositStakeTxV2:
 		t = TxTypeDepositStakeTxV2
+	case *types.EquivocationSlashTx:
+		t = TxTypeEquivocationSlash
+	case *types.ValidatorProfileTx:
+		t = TxTypeValidatorProfile
+	case *types.RedelegateStakeTx:
+		t = TxTypeRedelegateStake
 	}
 
 	return t
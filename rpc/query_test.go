@@ -0,0 +1,121 @@
+package rpc
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"theta/blockchain"
+	"theta/common"
+	"theta/core"
+	"theta/ledger"
+	"theta/ledger/state"
+	"theta/ledger/types"
+	"theta/store/database/backend"
+)
+
+func TestHistoricalStateQueries(t *testing.T) {
+	assert := assert.New(t)
+
+	db := backend.NewMemDatabase()
+	chain := blockchain.CreateTestChain()
+	service := &ThetaRPCService{
+		ledger: ledger.NewLedger("testchain", db, chain, nil, nil, nil),
+		chain:  chain,
+	}
+
+	privAcc := types.MakeAccWithInitBalance("acc_secret_0", types.NewCoins(100, 1000))
+	address := privAcc.Address
+	storageKey := common.BytesToHash([]byte{0x1})
+
+	storeView := state.NewStoreView(0, common.Hash{}, db)
+	storeView.SetAccount(address, &privAcc.Account)
+	storeView.SetState(address, storageKey, common.BytesToHash([]byte{0xa}))
+	root1 := storeView.Save()
+
+	privAcc.Account.Balance = types.NewCoins(200, 2000)
+	storeView.SetAccount(address, &privAcc.Account)
+	storeView.SetState(address, storageKey, common.BytesToHash([]byte{0xb}))
+	root2 := storeView.Save()
+
+	// Block 3 points to a state that is not in the DB, e.g. pruned
+	parent := chain.Root().Block
+	blocks := []*core.Block{}
+	for i, root := range []common.Hash{root1, root2, common.HexToHash("0x3")} {
+		block := core.NewBlock()
+		block.ChainID = "testchain"
+		block.Height = uint64(i + 1)
+		block.Parent = parent.Hash()
+		block.StateHash = root
+		block.Timestamp = big.NewInt(int64(i + 1))
+		_, err := chain.AddBlock(block)
+		assert.Nil(err)
+		blocks = append(blocks, block)
+		parent = block
+	}
+	assert.Nil(chain.FinalizePreviousBlocks(blocks[2].Hash()))
+
+	getAccount := func(args *GetAccountArgs) (*GetAccountResult, error) {
+		args.Address = address.Hex()
+		result := &GetAccountResult{}
+		err := service.GetAccount(args, result)
+		return result, err
+	}
+
+	result, err := getAccount(&GetAccountArgs{BlockQueryArgs: BlockQueryArgs{Height: 1}})
+	assert.Nil(err)
+	assert.Equal(int64(1000), result.Balance.TFuelWei.Int64())
+
+	result, err = getAccount(&GetAccountArgs{BlockQueryArgs: BlockQueryArgs{Height: 2}})
+	assert.Nil(err)
+	assert.Equal(int64(2000), result.Balance.TFuelWei.Int64())
+
+	result, err = getAccount(&GetAccountArgs{BlockQueryArgs: BlockQueryArgs{BlockHash: blocks[0].Hash()}})
+	assert.Nil(err)
+	assert.Equal(int64(1000), result.Balance.TFuelWei.Int64())
+
+	_, err = getAccount(&GetAccountArgs{BlockQueryArgs: BlockQueryArgs{Height: 3}})
+	assert.NotNil(err)
+	assert.Contains(err.Error(), "pruned")
+
+	_, err = getAccount(&GetAccountArgs{BlockQueryArgs: BlockQueryArgs{Height: 4}})
+	assert.NotNil(err)
+
+	_, err = getAccount(&GetAccountArgs{BlockQueryArgs: BlockQueryArgs{BlockHash: common.HexToHash("0x4")}})
+	assert.NotNil(err)
+
+	_, err = getAccount(&GetAccountArgs{Preview: true, BlockQueryArgs: BlockQueryArgs{Height: 1}})
+	assert.NotNil(err)
+
+	storageResult := &GetStorageAtResult{}
+	err = service.GetStorageAt(&GetStorageAtArgs{
+		Address:        address.Hex(),
+		Key:            storageKey.Hex(),
+		BlockQueryArgs: BlockQueryArgs{Height: 1},
+	}, storageResult)
+	assert.Nil(err)
+	assert.Equal(common.BytesToHash([]byte{0xa}), storageResult.Value)
+
+	err = service.GetStorageAt(&GetStorageAtArgs{
+		Address:        address.Hex(),
+		Key:            storageKey.Hex(),
+		BlockQueryArgs: BlockQueryArgs{BlockHash: blocks[1].Hash()},
+	}, storageResult)
+	assert.Nil(err)
+	assert.Equal(common.BytesToHash([]byte{0xb}), storageResult.Value)
+
+	proofResult := &GetProofResult{}
+	err = service.GetProof(&GetProofArgs{
+		Address:        address.Hex(),
+		StorageKeys:    []string{storageKey.Hex()},
+		BlockQueryArgs: BlockQueryArgs{Height: 1},
+	}, proofResult)
+	assert.Nil(err)
+	assert.Equal(blocks[0].Hash(), proofResult.BlockHash)
+	assert.Equal(root1, proofResult.StateRoot)
+	account, err := state.VerifyAccountProof(root1, proofResult.AccountProof)
+	assert.Nil(err)
+	assert.Equal(int64(1000), account.Balance.TFuelWei.Int64())
+	_, err = state.VerifyAccountProof(root2, proofResult.AccountProof)
+	assert.NotNil(err)
+}
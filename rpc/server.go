@@ -29,7 +29,7 @@ import (
 	"golang.org/x/net/websocket"
 )
 
-var logger *log.Entry
+var logger *log.Entry = log.WithFields(log.Fields{"prefix": "rpc"})
 
 type ThetaRPCService struct {
 	mempool    *mempool.Mempool
@@ -38,6 +38,8 @@ type ThetaRPCService struct {
 	chain      *blockchain.Chain
 	consensus  *consensus.ConsensusEngine
 
+	subscriptions *SubscriptionManager
+
 	// Life cycle
 	wg      *sync.WaitGroup
 	ctx     context.Context
@@ -55,32 +57,43 @@ type ThetaRPCServer struct {
 	listener net.Listener
 }
 
+// NewThetaRPCService creates a new instance of ThetaRPCService. The service can be
+// used directly by in-process servers which do not go through the JSON-RPC endpoint.
+func NewThetaRPCService(mempool *mempool.Mempool, ledger *ledger.Ledger, dispatcher *dispatcher.Dispatcher,
+	chain *blockchain.Chain, consensus *consensus.ConsensusEngine) *ThetaRPCService {
+	return &ThetaRPCService{
+		mempool:       mempool,
+		ledger:        ledger,
+		dispatcher:    dispatcher,
+		chain:         chain,
+		consensus:     consensus,
+		subscriptions: NewSubscriptionManager(),
+		wg:            &sync.WaitGroup{},
+	}
+}
+
 // NewThetaRPCServer creates a new instance of ThetaRPCServer.
 func NewThetaRPCServer(mempool *mempool.Mempool, ledger *ledger.Ledger, dispatcher *dispatcher.Dispatcher,
 	chain *blockchain.Chain, consensus *consensus.ConsensusEngine) *ThetaRPCServer {
 	t := &ThetaRPCServer{
-		ThetaRPCService: &ThetaRPCService{
-			wg: &sync.WaitGroup{},
-		},
+		ThetaRPCService: NewThetaRPCService(mempool, ledger, dispatcher, chain, consensus),
 	}
 
-	t.mempool = mempool
-	t.ledger = ledger
-	t.dispatcher = dispatcher
-	t.chain = chain
-	t.consensus = consensus
-
 	s := rpc.NewServer()
 	s.RegisterName("theta", t.ThetaRPCService)
+	s.RegisterName("eth", &EthRPCService{theta: t.ThetaRPCService})
+	s.RegisterName("net", &NetRPCService{theta: t.ThetaRPCService})
+	s.RegisterName("web3", &Web3RPCService{theta: t.ThetaRPCService})
 
 	t.handler = s
 
 	t.router = mux.NewRouter()
 	t.router.Handle("/", &defaultHTTPHandler{})
-	t.router.Handle("/rpc", corsMiddleware(TimeoutHandler(jsonrpc2.HTTPHandler(s), viper.GetDuration(common.CfgRPCTimeoutSecs)*time.Second, "")))
+	t.router.Handle("/rpc", corsMiddleware(TimeoutHandler(ethMethodHandler(jsonrpc2.HTTPHandler(s)), viper.GetDuration(common.CfgRPCTimeoutSecs)*time.Second, "")))
 	t.router.Handle("/ws", websocket.Handler(func(ws *websocket.Conn) {
 		s.ServeCodec(jsonrpc2.NewServerCodec(ws, s))
 	}))
+	t.router.Handle("/ws/subscribe", websocket.Handler(t.subscriptions.ServeConn))
 
 	t.server = &http.Server{
 		Handler: t.router,
@@ -102,6 +115,9 @@ func (t *ThetaRPCServer) Start(ctx context.Context) {
 
 	t.wg.Add(1)
 	go t.txCallback()
+
+	t.wg.Add(1)
+	go t.subscriptionLoop()
 }
 
 func (t *ThetaRPCServer) mainLoop() {
@@ -0,0 +1,423 @@
+package rpc
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/spf13/viper"
+	"golang.org/x/net/websocket"
+
+	"theta/blockchain"
+	"theta/common"
+	"theta/common/hexutil"
+	"theta/core"
+	"theta/crypto"
+)
+
+// Subscription topics
+const (
+	SubscriptionTopicNewBlocks       = "new_blocks"
+	SubscriptionTopicFinalizedBlocks = "finalized_blocks"
+	SubscriptionTopicPendingTxs      = "pending_txs"
+	SubscriptionTopicLogs            = "logs"
+)
+
+const (
+	subscribeMethod    = "theta.Subscribe"
+	unsubscribeMethod  = "theta.Unsubscribe"
+	notificationMethod = "theta.Subscription"
+)
+
+var (
+	errTooManySubscriptions = errors.New("Too many subscriptions on this connection")
+	errUnknownSubscription  = errors.New("Subscription not found")
+)
+
+// ------------------------------ Messages -----------------------------------
+
+type subscriptionRequest struct {
+	Version string           `json:"jsonrpc"`
+	ID      *json.RawMessage `json:"id"`
+	Method  string           `json:"method"`
+	Params  *json.RawMessage `json:"params"`
+}
+
+type subscriptionError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type subscriptionResponse struct {
+	Version string             `json:"jsonrpc"`
+	ID      *json.RawMessage   `json:"id"`
+	Result  interface{}        `json:"result,omitempty"`
+	Error   *subscriptionError `json:"error,omitempty"`
+}
+
+type subscriptionNotification struct {
+	Version string                         `json:"jsonrpc"`
+	Method  string                         `json:"method"`
+	Params  subscriptionNotificationParams `json:"params"`
+}
+
+type subscriptionNotificationParams struct {
+	Subscription string      `json:"subscription"`
+	Result       interface{} `json:"result"`
+}
+
+type SubscribeArgs struct {
+	Topic     string           `json:"topic"`
+	Addresses []common.Address `json:"addresses"` // only for the logs topic
+	Topics    [][]common.Hash  `json:"topics"`    // only for the logs topic
+}
+
+type SubscribeResult struct {
+	Subscription string `json:"subscription"`
+}
+
+type UnsubscribeArgs struct {
+	Subscription string `json:"subscription"`
+}
+
+type UnsubscribeResult struct {
+	Unsubscribed bool `json:"unsubscribed"`
+}
+
+// SubscriptionBlockResult is the payload of the new_blocks and finalized_blocks notifications.
+type SubscriptionBlockResult struct {
+	*core.BlockHeader
+	Hash   common.Hash `json:"hash"`
+	NumTxs int         `json:"num_txs"`
+}
+
+// SubscriptionTxResult is the payload of the pending_txs notifications.
+type SubscriptionTxResult struct {
+	Hash common.Hash `json:"hash"`
+}
+
+// ------------------------------ SubscriptionManager -----------------------------------
+
+type subscription struct {
+	id     string
+	topic  string
+	filter *blockchain.LogFilter
+}
+
+type subscriptionConn struct {
+	ws            *websocket.Conn
+	outgoing      chan interface{}
+	done          chan struct{}
+	subscriptions map[string]*subscription
+}
+
+// SubscriptionManager tracks the websocket connections and their subscriptions, and
+// dispatches the notifications. Each connection has a bounded queue of outgoing messages;
+// a connection that does not keep up with the notifications is closed.
+type SubscriptionManager struct {
+	mu    *sync.Mutex
+	conns map[*subscriptionConn]bool
+
+	maxSubscriptionsPerConn int
+	queueSize               int
+}
+
+// NewSubscriptionManager creates a new instance of SubscriptionManager.
+func NewSubscriptionManager() *SubscriptionManager {
+	return &SubscriptionManager{
+		mu:                      &sync.Mutex{},
+		conns:                   make(map[*subscriptionConn]bool),
+		maxSubscriptionsPerConn: viper.GetInt(common.CfgRPCMaxSubscriptionsPerConnection),
+		queueSize:               viper.GetInt(common.CfgRPCSubscriptionQueueSize),
+	}
+}
+
+// ServeConn handles the subscribe/unsubscribe requests of a websocket connection until it is closed.
+func (sm *SubscriptionManager) ServeConn(ws *websocket.Conn) {
+	conn := sm.addConn(ws)
+	defer sm.removeConn(conn)
+
+	go sm.writeLoop(conn)
+
+	for {
+		var req subscriptionRequest
+		if err := websocket.JSON.Receive(ws, &req); err != nil {
+			return
+		}
+		if !sm.enqueue(conn, sm.handleRequest(conn, &req)) {
+			return
+		}
+	}
+}
+
+// CloseAll closes all the websocket connections.
+func (sm *SubscriptionManager) CloseAll() {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	for conn := range sm.conns {
+		sm.closeConnUnsafe(conn)
+	}
+}
+
+func (sm *SubscriptionManager) addConn(ws *websocket.Conn) *subscriptionConn {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	conn := &subscriptionConn{
+		ws:            ws,
+		outgoing:      make(chan interface{}, sm.queueSize),
+		done:          make(chan struct{}),
+		subscriptions: make(map[string]*subscription),
+	}
+	sm.conns[conn] = true
+	return conn
+}
+
+func (sm *SubscriptionManager) removeConn(conn *subscriptionConn) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	sm.closeConnUnsafe(conn)
+}
+
+func (sm *SubscriptionManager) closeConnUnsafe(conn *subscriptionConn) {
+	if _, ok := sm.conns[conn]; !ok {
+		return
+	}
+	delete(sm.conns, conn)
+	close(conn.done)
+	if conn.ws != nil {
+		conn.ws.Close()
+	}
+}
+
+func (sm *SubscriptionManager) writeLoop(conn *subscriptionConn) {
+	for {
+		select {
+		case <-conn.done:
+			return
+		case msg := <-conn.outgoing:
+			if err := websocket.JSON.Send(conn.ws, msg); err != nil {
+				sm.removeConn(conn)
+				return
+			}
+		}
+	}
+}
+
+// enqueue adds the message to the outgoing queue of the connection without blocking. The
+// connection is closed if the queue is full.
+func (sm *SubscriptionManager) enqueue(conn *subscriptionConn, msg interface{}) bool {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	return sm.enqueueUnsafe(conn, msg)
+}
+
+func (sm *SubscriptionManager) enqueueUnsafe(conn *subscriptionConn, msg interface{}) bool {
+	if _, ok := sm.conns[conn]; !ok {
+		return false
+	}
+	select {
+	case conn.outgoing <- msg:
+		return true
+	default:
+		logger.Warnf("Closing slow subscription connection, pending notifications: %v", len(conn.outgoing))
+		sm.closeConnUnsafe(conn)
+		return false
+	}
+}
+
+func (sm *SubscriptionManager) handleRequest(conn *subscriptionConn, req *subscriptionRequest) *subscriptionResponse {
+	resp := &subscriptionResponse{
+		Version: "2.0",
+		ID:      req.ID,
+	}
+
+	var err error
+	switch req.Method {
+	case subscribeMethod:
+		args := &SubscribeArgs{}
+		if err = unmarshalSubscriptionParams(req.Params, args); err == nil {
+			var id string
+			id, err = sm.subscribe(conn, args)
+			resp.Result = &SubscribeResult{Subscription: id}
+		}
+	case unsubscribeMethod:
+		args := &UnsubscribeArgs{}
+		if err = unmarshalSubscriptionParams(req.Params, args); err == nil {
+			err = sm.unsubscribe(conn, args.Subscription)
+			resp.Result = &UnsubscribeResult{Unsubscribed: err == nil}
+		}
+	default:
+		err = fmt.Errorf("Method not found: %v", req.Method)
+	}
+
+	if err != nil {
+		resp.Result = nil
+		resp.Error = &subscriptionError{Code: -32000, Message: err.Error()}
+	}
+	return resp
+}
+
+func (sm *SubscriptionManager) subscribe(conn *subscriptionConn, args *SubscribeArgs) (string, error) {
+	sub := &subscription{
+		topic: args.Topic,
+	}
+	switch args.Topic {
+	case SubscriptionTopicNewBlocks, SubscriptionTopicFinalizedBlocks, SubscriptionTopicPendingTxs:
+	case SubscriptionTopicLogs:
+		sub.filter = &blockchain.LogFilter{
+			Addresses: args.Addresses,
+			Topics:    args.Topics,
+		}
+	default:
+		return "", fmt.Errorf("Unsupported subscription topic: %v", args.Topic)
+	}
+
+	idBytes := make([]byte, 16)
+	if _, err := rand.Read(idBytes); err != nil {
+		return "", err
+	}
+	sub.id = hexutil.Encode(idBytes)
+
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	if len(conn.subscriptions) >= sm.maxSubscriptionsPerConn {
+		return "", errTooManySubscriptions
+	}
+	conn.subscriptions[sub.id] = sub
+	return sub.id, nil
+}
+
+func (sm *SubscriptionManager) unsubscribe(conn *subscriptionConn, id string) error {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	if _, ok := conn.subscriptions[id]; !ok {
+		return errUnknownSubscription
+	}
+	delete(conn.subscriptions, id)
+	return nil
+}
+
+// publish sends the result produced by getResult to every subscription of the given topic.
+// getResult returns false if the notification should be skipped for the subscription.
+func (sm *SubscriptionManager) publish(topic string, getResult func(sub *subscription) (interface{}, bool)) {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	for conn := range sm.conns {
+		for _, sub := range conn.subscriptions {
+			if sub.topic != topic {
+				continue
+			}
+			result, ok := getResult(sub)
+			if !ok {
+				continue
+			}
+			notification := &subscriptionNotification{
+				Version: "2.0",
+				Method:  notificationMethod,
+				Params: subscriptionNotificationParams{
+					Subscription: sub.id,
+					Result:       result,
+				},
+			}
+			if !sm.enqueueUnsafe(conn, notification) {
+				break
+			}
+		}
+	}
+}
+
+func (sm *SubscriptionManager) hasSubscribers(topic string) bool {
+	sm.mu.Lock()
+	defer sm.mu.Unlock()
+
+	for conn := range sm.conns {
+		for _, sub := range conn.subscriptions {
+			if sub.topic == topic {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// PublishBlock notifies the subscribers of the given block topic.
+func (sm *SubscriptionManager) PublishBlock(topic string, block *core.Block) {
+	result := &SubscriptionBlockResult{
+		BlockHeader: block.BlockHeader,
+		Hash:        block.Hash(),
+		NumTxs:      len(block.Txs),
+	}
+	sm.publish(topic, func(sub *subscription) (interface{}, bool) {
+		return result, true
+	})
+}
+
+// PublishPendingTx notifies the subscribers of the pending_txs topic.
+func (sm *SubscriptionManager) PublishPendingTx(rawTx common.Bytes) {
+	result := &SubscriptionTxResult{
+		Hash: crypto.Keccak256Hash(rawTx),
+	}
+	sm.publish(SubscriptionTopicPendingTxs, func(sub *subscription) (interface{}, bool) {
+		return result, true
+	})
+}
+
+// PublishLogs notifies the subscribers of the logs topic with the logs of the given finalized block.
+func (sm *SubscriptionManager) PublishLogs(chain *blockchain.Chain, block *core.Block) {
+	if !sm.hasSubscribers(SubscriptionTopicLogs) {
+		return
+	}
+
+	allLogs := chain.FilterLogs(&blockchain.LogFilter{
+		FromHeight: block.Height,
+		ToHeight:   block.Height,
+	})
+	for _, entry := range allLogs {
+		if entry.BlockHash != block.Hash() {
+			continue
+		}
+		e := entry
+		sm.publish(SubscriptionTopicLogs, func(sub *subscription) (interface{}, bool) {
+			return e, sub.filter.Match(e.Log)
+		})
+	}
+}
+
+// subscriptionLoop publishes the valid blocks and the pending transactions to the subscribers.
+// Finalized blocks are published by txCallback, which owns the finalized block channel.
+func (t *ThetaRPCService) subscriptionLoop() {
+	defer t.wg.Done()
+
+	for {
+		select {
+		case <-t.ctx.Done():
+			t.subscriptions.CloseAll()
+			return
+		case block := <-t.consensus.ValidBlocks():
+			t.subscriptions.PublishBlock(SubscriptionTopicNewBlocks, block)
+		case rawTx := <-t.mempool.InsertedTxs():
+			t.subscriptions.PublishPendingTx(rawTx)
+		}
+	}
+}
+
+// unmarshalSubscriptionParams decodes the params either as a single element list or as an object.
+func unmarshalSubscriptionParams(params *json.RawMessage, x interface{}) error {
+	if params == nil {
+		return errors.New("Missing params")
+	}
+	list := [1]interface{}{x}
+	if err := json.Unmarshal(*params, &list); err == nil {
+		return nil
+	}
+	return json.Unmarshal(*params, x)
+}
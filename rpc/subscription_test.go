@@ -0,0 +1,102 @@
+package rpc
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"theta/common"
+	"theta/core"
+)
+
+func newTestSubscriptionManager(maxSubscriptions, queueSize int) *SubscriptionManager {
+	sm := NewSubscriptionManager()
+	sm.maxSubscriptionsPerConn = maxSubscriptions
+	sm.queueSize = queueSize
+	return sm
+}
+
+func subscribeRequest(params string) *subscriptionRequest {
+	raw := json.RawMessage(params)
+	id := json.RawMessage("1")
+	return &subscriptionRequest{Version: "2.0", ID: &id, Method: subscribeMethod, Params: &raw}
+}
+
+func TestSubscribeAndUnsubscribe(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	sm := newTestSubscriptionManager(2, 16)
+	conn := sm.addConn(nil)
+
+	resp := sm.handleRequest(conn, subscribeRequest(`[{"topic":"new_blocks"}]`))
+	require.Nil(resp.Error)
+	id := resp.Result.(*SubscribeResult).Subscription
+	assert.NotEmpty(id)
+
+	resp = sm.handleRequest(conn, subscribeRequest(`{"topic":"pending_txs"}`))
+	require.Nil(resp.Error)
+
+	// Exceeds the per-connection limit
+	resp = sm.handleRequest(conn, subscribeRequest(`[{"topic":"finalized_blocks"}]`))
+	require.NotNil(resp.Error)
+	assert.Equal(errTooManySubscriptions.Error(), resp.Error.Message)
+
+	resp = sm.handleRequest(conn, subscribeRequest(`[{"topic":"unknown"}]`))
+	assert.NotNil(resp.Error)
+
+	raw := json.RawMessage(`[{"subscription":"` + id + `"}]`)
+	resp = sm.handleRequest(conn, &subscriptionRequest{Method: unsubscribeMethod, Params: &raw})
+	require.Nil(resp.Error)
+	assert.True(resp.Result.(*UnsubscribeResult).Unsubscribed)
+
+	resp = sm.handleRequest(conn, &subscriptionRequest{Method: unsubscribeMethod, Params: &raw})
+	assert.NotNil(resp.Error)
+}
+
+func TestSubscriptionPublish(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	sm := newTestSubscriptionManager(4, 16)
+	conn1 := sm.addConn(nil)
+	conn2 := sm.addConn(nil)
+
+	id1, err := sm.subscribe(conn1, &SubscribeArgs{Topic: SubscriptionTopicFinalizedBlocks})
+	require.Nil(err)
+	_, err = sm.subscribe(conn2, &SubscribeArgs{Topic: SubscriptionTopicPendingTxs})
+	require.Nil(err)
+
+	block := core.CreateTestBlock("b1", "")
+	sm.PublishBlock(SubscriptionTopicFinalizedBlocks, block)
+	sm.PublishPendingTx(common.Bytes("tx1"))
+
+	require.Equal(1, len(conn1.outgoing))
+	notification := (<-conn1.outgoing).(*subscriptionNotification)
+	assert.Equal(id1, notification.Params.Subscription)
+	assert.Equal(block.Hash(), notification.Params.Result.(*SubscriptionBlockResult).Hash)
+
+	require.Equal(1, len(conn2.outgoing))
+	_, ok := (<-conn2.outgoing).(*subscriptionNotification).Params.Result.(*SubscriptionTxResult)
+	assert.True(ok)
+}
+
+func TestSubscriptionSlowConsumer(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	sm := newTestSubscriptionManager(4, 2)
+	conn := sm.addConn(nil)
+	_, err := sm.subscribe(conn, &SubscribeArgs{Topic: SubscriptionTopicPendingTxs})
+	require.Nil(err)
+
+	sm.PublishPendingTx(common.Bytes("tx1"))
+	sm.PublishPendingTx(common.Bytes("tx2"))
+	assert.Equal(1, len(sm.conns))
+
+	// The queue is full, the connection should be dropped
+	sm.PublishPendingTx(common.Bytes("tx3"))
+	assert.Equal(0, len(sm.conns))
+	assert.False(sm.enqueue(conn, "msg"))
+}
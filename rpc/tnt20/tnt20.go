@@ -0,0 +1,141 @@
+// Package tnt20 encodes the calls to TNT-20 token contracts and decodes their return
+// values following the Solidity contract ABI.
+package tnt20
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"theta/common"
+	"theta/common/math"
+	"theta/crypto"
+)
+
+const wordSize = 32
+
+var (
+	methodBalanceOf    = methodID("balanceOf(address)")
+	methodAllowance    = methodID("allowance(address,address)")
+	methodName         = methodID("name()")
+	methodSymbol       = methodID("symbol()")
+	methodDecimals     = methodID("decimals()")
+	methodTotalSupply  = methodID("totalSupply()")
+	methodTransfer     = methodID("transfer(address,uint256)")
+	methodApprove      = methodID("approve(address,uint256)")
+	methodTransferFrom = methodID("transferFrom(address,address,uint256)")
+
+	errInvalidReturn = errors.New("invalid return value")
+)
+
+// methodID returns the first 4 bytes of the Keccak256 hash of the method signature.
+func methodID(signature string) []byte {
+	return crypto.Keccak256([]byte(signature))[:4]
+}
+
+// PackBalanceOf returns the call data of balanceOf(owner).
+func PackBalanceOf(owner common.Address) []byte {
+	return pack(methodBalanceOf, packAddress(owner))
+}
+
+// PackAllowance returns the call data of allowance(owner, spender).
+func PackAllowance(owner, spender common.Address) []byte {
+	return pack(methodAllowance, packAddress(owner), packAddress(spender))
+}
+
+// PackName returns the call data of name().
+func PackName() []byte {
+	return pack(methodName)
+}
+
+// PackSymbol returns the call data of symbol().
+func PackSymbol() []byte {
+	return pack(methodSymbol)
+}
+
+// PackDecimals returns the call data of decimals().
+func PackDecimals() []byte {
+	return pack(methodDecimals)
+}
+
+// PackTotalSupply returns the call data of totalSupply().
+func PackTotalSupply() []byte {
+	return pack(methodTotalSupply)
+}
+
+// PackTransfer returns the call data of transfer(to, amount).
+func PackTransfer(to common.Address, amount *big.Int) ([]byte, error) {
+	amountWord, err := packUint256(amount)
+	if err != nil {
+		return nil, err
+	}
+	return pack(methodTransfer, packAddress(to), amountWord), nil
+}
+
+// PackApprove returns the call data of approve(spender, amount).
+func PackApprove(spender common.Address, amount *big.Int) ([]byte, error) {
+	amountWord, err := packUint256(amount)
+	if err != nil {
+		return nil, err
+	}
+	return pack(methodApprove, packAddress(spender), amountWord), nil
+}
+
+// PackTransferFrom returns the call data of transferFrom(from, to, amount).
+func PackTransferFrom(from, to common.Address, amount *big.Int) ([]byte, error) {
+	amountWord, err := packUint256(amount)
+	if err != nil {
+		return nil, err
+	}
+	return pack(methodTransferFrom, packAddress(from), packAddress(to), amountWord), nil
+}
+
+// UnpackUint256 decodes the uint256 returned by balanceOf, allowance, decimals and totalSupply.
+func UnpackUint256(ret []byte) (*big.Int, error) {
+	if len(ret) < wordSize {
+		return nil, errInvalidReturn
+	}
+	return new(big.Int).SetBytes(ret[:wordSize]), nil
+}
+
+// UnpackString decodes the string returned by name and symbol. Legacy tokens which
+// return bytes32 instead of string are supported as well.
+func UnpackString(ret []byte) (string, error) {
+	if len(ret) == wordSize {
+		return string(bytes.TrimRight(ret, "\x00")), nil
+	}
+	if len(ret) < 2*wordSize {
+		return "", errInvalidReturn
+	}
+	offset := new(big.Int).SetBytes(ret[:wordSize])
+	if !offset.IsUint64() || offset.Uint64() > uint64(len(ret)-wordSize) {
+		return "", errInvalidReturn
+	}
+	start := offset.Uint64() + wordSize
+	length := new(big.Int).SetBytes(ret[offset.Uint64():start])
+	if !length.IsUint64() || length.Uint64() > uint64(len(ret))-start {
+		return "", errInvalidReturn
+	}
+	return string(ret[start : start+length.Uint64()]), nil
+}
+
+func pack(method []byte, words ...[]byte) []byte {
+	data := make([]byte, 0, len(method)+len(words)*wordSize)
+	data = append(data, method...)
+	for _, word := range words {
+		data = append(data, word...)
+	}
+	return data
+}
+
+func packAddress(address common.Address) []byte {
+	return common.LeftPadBytes(address.Bytes(), wordSize)
+}
+
+func packUint256(value *big.Int) ([]byte, error) {
+	if value == nil || value.Sign() < 0 || value.BitLen() > 256 {
+		return nil, fmt.Errorf("Amount must be between 0 and 2^256-1: %v", value)
+	}
+	return math.PaddedBigBytes(value, wordSize), nil
+}
@@ -0,0 +1,84 @@
+package tnt20
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"theta/common"
+)
+
+func TestMethodIDs(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal("70a08231", hex.EncodeToString(methodBalanceOf))
+	assert.Equal("dd62ed3e", hex.EncodeToString(methodAllowance))
+	assert.Equal("06fdde03", hex.EncodeToString(methodName))
+	assert.Equal("95d89b41", hex.EncodeToString(methodSymbol))
+	assert.Equal("313ce567", hex.EncodeToString(methodDecimals))
+	assert.Equal("18160ddd", hex.EncodeToString(methodTotalSupply))
+	assert.Equal("a9059cbb", hex.EncodeToString(methodTransfer))
+	assert.Equal("095ea7b3", hex.EncodeToString(methodApprove))
+	assert.Equal("23b872dd", hex.EncodeToString(methodTransferFrom))
+}
+
+func TestPack(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	to := common.HexToAddress("0x0d2fd67d573c8ecb4161510fc00754d64b401f86")
+	data, err := PackTransfer(to, big.NewInt(1000))
+	require.Nil(err)
+	assert.Equal("a9059cbb"+
+		"0000000000000000000000000d2fd67d573c8ecb4161510fc00754d64b401f86"+
+		"00000000000000000000000000000000000000000000000000000000000003e8", hex.EncodeToString(data))
+
+	max := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+	data, err = PackApprove(to, max)
+	require.Nil(err)
+	assert.Equal(4+2*wordSize, len(data))
+
+	_, err = PackApprove(to, new(big.Int).Add(max, big.NewInt(1)))
+	assert.NotNil(err)
+	_, err = PackTransfer(to, big.NewInt(-1))
+	assert.NotNil(err)
+
+	data, err = PackTransferFrom(to, to, big.NewInt(1))
+	require.Nil(err)
+	assert.Equal(4+3*wordSize, len(data))
+	assert.Equal(4+wordSize, len(PackBalanceOf(to)))
+	assert.Equal(4, len(PackDecimals()))
+}
+
+func TestUnpack(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	ret, _ := hex.DecodeString("0000000000000000000000000000000000000000000000000000000000000012")
+	decimals, err := UnpackUint256(ret)
+	require.Nil(err)
+	assert.Equal(int64(18), decimals.Int64())
+
+	_, err = UnpackUint256(ret[:10])
+	assert.NotNil(err)
+
+	ret, _ = hex.DecodeString("0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000005" +
+		"5468657461000000000000000000000000000000000000000000000000000000")
+	name, err := UnpackString(ret)
+	require.Nil(err)
+	assert.Equal("Theta", name)
+
+	ret, _ = hex.DecodeString("5446554500000000000000000000000000000000000000000000000000000000")
+	symbol, err := UnpackString(ret)
+	require.Nil(err)
+	assert.Equal("TFUE", symbol)
+
+	ret, _ = hex.DecodeString("0000000000000000000000000000000000000000000000000000000000000020" +
+		"00000000000000000000000000000000000000000000000000000000000000ff" +
+		"5468657461000000000000000000000000000000000000000000000000000000")
+	_, err = UnpackString(ret)
+	assert.NotNil(err)
+}
@@ -0,0 +1,166 @@
+package rpc
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"theta/common"
+	"theta/ledger/types"
+	"theta/rpc/tnt20"
+)
+
+// tokenCallGasLimit is the gas limit of the read-only calls to the TNT-20 contracts.
+const tokenCallGasLimit = 1000000
+
+// ------------------------------- GetTokenInfo -----------------------------------
+
+type GetTokenInfoArgs struct {
+	ContractAddress string `json:"contract_address"`
+}
+
+type GetTokenInfoResult struct {
+	ContractAddress common.Address    `json:"contract_address"`
+	Name            string            `json:"name"`
+	Symbol          string            `json:"symbol"`
+	Decimals        common.JSONUint64 `json:"decimals"`
+	TotalSupply     *common.JSONBig   `json:"total_supply"`
+}
+
+func (t *ThetaRPCService) GetTokenInfo(args *GetTokenInfoArgs, result *GetTokenInfoResult) (err error) {
+	if args.ContractAddress == "" {
+		return errors.New("Contract address must be specified")
+	}
+	contractAddr := common.HexToAddress(args.ContractAddress)
+	result.ContractAddress = contractAddr
+
+	ret, err := t.callToken(contractAddr, tnt20.PackName())
+	if err != nil {
+		return err
+	}
+	if result.Name, err = tnt20.UnpackString(ret); err != nil {
+		return fmt.Errorf("Failed to decode token name: %v", err)
+	}
+
+	ret, err = t.callToken(contractAddr, tnt20.PackSymbol())
+	if err != nil {
+		return err
+	}
+	if result.Symbol, err = tnt20.UnpackString(ret); err != nil {
+		return fmt.Errorf("Failed to decode token symbol: %v", err)
+	}
+
+	ret, err = t.callToken(contractAddr, tnt20.PackDecimals())
+	if err != nil {
+		return err
+	}
+	decimals, err := tnt20.UnpackUint256(ret)
+	if err != nil || !decimals.IsUint64() {
+		return fmt.Errorf("Failed to decode token decimals: %v", err)
+	}
+	result.Decimals = common.JSONUint64(decimals.Uint64())
+
+	ret, err = t.callToken(contractAddr, tnt20.PackTotalSupply())
+	if err != nil {
+		return err
+	}
+	totalSupply, err := tnt20.UnpackUint256(ret)
+	if err != nil {
+		return fmt.Errorf("Failed to decode token total supply: %v", err)
+	}
+	result.TotalSupply = (*common.JSONBig)(totalSupply)
+
+	return nil
+}
+
+// ------------------------------- GetTokenBalance -----------------------------------
+
+type GetTokenBalanceArgs struct {
+	ContractAddress string `json:"contract_address"`
+	Address         string `json:"address"`
+}
+
+type GetTokenBalanceResult struct {
+	Balance *common.JSONBig `json:"balance"`
+}
+
+func (t *ThetaRPCService) GetTokenBalance(args *GetTokenBalanceArgs, result *GetTokenBalanceResult) (err error) {
+	if args.ContractAddress == "" {
+		return errors.New("Contract address must be specified")
+	}
+	if args.Address == "" {
+		return errors.New("Address must be specified")
+	}
+
+	ret, err := t.callToken(common.HexToAddress(args.ContractAddress), tnt20.PackBalanceOf(common.HexToAddress(args.Address)))
+	if err != nil {
+		return err
+	}
+	balance, err := tnt20.UnpackUint256(ret)
+	if err != nil {
+		return fmt.Errorf("Failed to decode token balance: %v", err)
+	}
+	result.Balance = (*common.JSONBig)(balance)
+
+	return nil
+}
+
+// ------------------------------- GetTokenAllowance -----------------------------------
+
+type GetTokenAllowanceArgs struct {
+	ContractAddress string `json:"contract_address"`
+	Owner           string `json:"owner"`
+	Spender         string `json:"spender"`
+}
+
+type GetTokenAllowanceResult struct {
+	Allowance *common.JSONBig `json:"allowance"`
+}
+
+func (t *ThetaRPCService) GetTokenAllowance(args *GetTokenAllowanceArgs, result *GetTokenAllowanceResult) (err error) {
+	if args.ContractAddress == "" {
+		return errors.New("Contract address must be specified")
+	}
+	if args.Owner == "" || args.Spender == "" {
+		return errors.New("Owner and spender must be specified")
+	}
+
+	data := tnt20.PackAllowance(common.HexToAddress(args.Owner), common.HexToAddress(args.Spender))
+	ret, err := t.callToken(common.HexToAddress(args.ContractAddress), data)
+	if err != nil {
+		return err
+	}
+	allowance, err := tnt20.UnpackUint256(ret)
+	if err != nil {
+		return fmt.Errorf("Failed to decode token allowance: %v", err)
+	}
+	result.Allowance = (*common.JSONBig)(allowance)
+
+	return nil
+}
+
+// -------------------------- Utilities -------------------------- //
+
+// callToken calls a read-only method of the TNT-20 contract. The call does not need
+// a funded account, hence it is sent from the zero address.
+func (t *ThetaRPCService) callToken(contractAddr common.Address, data []byte) (common.Bytes, error) {
+	sctx := &types.SmartContractTx{
+		From: types.TxInput{
+			Coins: types.NewCoins(0, 0),
+		},
+		To: types.TxOutput{
+			Address: contractAddr,
+		},
+		GasLimit: tokenCallGasLimit,
+		GasPrice: big.NewInt(0),
+		Data:     data,
+	}
+	vmRet, _, _, vmErr, err := t.executeSmartContract(sctx, BlockQueryArgs{})
+	if err != nil {
+		return nil, err
+	}
+	if vmErr != nil {
+		return nil, fmt.Errorf("Failed to call token contract %v: %v", contractAddr.Hex(), vmErr)
+	}
+	return vmRet, nil
+}
@@ -0,0 +1,211 @@
+package rpc
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"theta/common"
+	"theta/common/math"
+	"theta/crypto"
+	"theta/ledger/vm"
+)
+
+const (
+	// TracerStructLogger records the opcode-level execution steps
+	TracerStructLogger = "struct_logger"
+	// TracerCallTracer records the tree of calls and contract creations
+	TracerCallTracer = "call_tracer"
+)
+
+// TraceConfig holds the tracing options shared by TraceTransaction and TraceCall
+type TraceConfig struct {
+	Tracer         string `json:"tracer"` // "struct_logger" (default) or "call_tracer"
+	DisableMemory  bool   `json:"disable_memory"`
+	DisableStack   bool   `json:"disable_stack"`
+	DisableStorage bool   `json:"disable_storage"`
+	Limit          int    `json:"limit"` // maximum number of struct logs, zero means unlimited
+}
+
+type StructLogResult struct {
+	Pc      uint64            `json:"pc"`
+	Op      string            `json:"op"`
+	Gas     common.JSONUint64 `json:"gas"`
+	GasCost common.JSONUint64 `json:"gas_cost"`
+	Depth   int               `json:"depth"`
+	Error   string            `json:"error,omitempty"`
+	Stack   []string          `json:"stack,omitempty"`
+	Memory  []string          `json:"memory,omitempty"`
+	Storage map[string]string `json:"storage,omitempty"`
+}
+
+type TraceResult struct {
+	Gas          common.JSONUint64 `json:"gas"`
+	Failed       bool              `json:"failed"`
+	ReturnValue  string            `json:"return_value"`
+	VmError      string            `json:"vm_error,omitempty"`
+	RevertReason string            `json:"revert_reason,omitempty"`
+	StructLogs   []StructLogResult `json:"struct_logs,omitempty"`
+	CallTrace    *vm.CallFrame     `json:"call_trace,omitempty"`
+}
+
+// ------------------------------- TraceTransaction -----------------------------------
+
+type TraceTransactionArgs struct {
+	Hash string `json:"hash"`
+	TraceConfig
+}
+
+// TraceTransaction re-executes a committed smart contract transaction on top of the state of
+// the parent block of its block, and returns the trace of the execution.
+func (t *ThetaRPCService) TraceTransaction(args *TraceTransactionArgs, result *TraceResult) (err error) {
+	if args.Hash == "" {
+		return errors.New("Transanction hash must be specified")
+	}
+	hash := common.HexToHash(args.Hash)
+
+	_, block, found := t.chain.FindTxByHash(hash)
+	if !found {
+		return fmt.Errorf("Transaction %v is not found in the chain", hash.Hex())
+	}
+	txIndex := -1
+	for i, rawTx := range block.Txs {
+		if crypto.Keccak256Hash(rawTx) == hash {
+			txIndex = i
+			break
+		}
+	}
+	if txIndex < 0 {
+		return fmt.Errorf("Transaction %v is not found in block %v", hash.Hex(), block.Hash().Hex())
+	}
+
+	tracer, err := newTracer(&args.TraceConfig)
+	if err != nil {
+		return err
+	}
+
+	vmRet, gasUsed, vmErr, res := t.ledger.TraceTx(block.Block, txIndex, tracer)
+	if res.IsError() {
+		return errors.New(res.Message)
+	}
+
+	formatTraceResult(tracer, vmRet, gasUsed, vmErr, result)
+	return nil
+}
+
+// ------------------------------- TraceCall -----------------------------------
+
+type TraceCallArgs struct {
+	SctxBytes string `json:"sctx_bytes"`
+	TraceConfig
+}
+
+// TraceCall executes the smart contract transaction on top of the delivered state, the same way
+// as CallSmartContract, and returns the trace of the execution.
+func (t *ThetaRPCService) TraceCall(args *TraceCallArgs, result *TraceResult) (err error) {
+	sctx, err := decodeSmartContractTx(args.SctxBytes)
+	if err != nil {
+		return err
+	}
+
+	tracer, err := newTracer(&args.TraceConfig)
+	if err != nil {
+		return err
+	}
+
+	ledgerState, parentBlock, err := t.getSmartContractSnapshot()
+	if err != nil {
+		return err
+	}
+
+	vmRet, _, gasUsed, vmErr := vm.ExecuteWithTracer(parentBlock, sctx, ledgerState, tracer)
+
+	formatTraceResult(tracer, vmRet, gasUsed, vmErr, result)
+	return nil
+}
+
+// ------------------------------- Utils -----------------------------------
+
+func newTracer(config *TraceConfig) (vm.Tracer, error) {
+	switch config.Tracer {
+	case "", TracerStructLogger:
+		return vm.NewStructLogger(&vm.LogConfig{
+			DisableMemory:  config.DisableMemory,
+			DisableStack:   config.DisableStack,
+			DisableStorage: config.DisableStorage,
+			Limit:          config.Limit,
+		}), nil
+	case TracerCallTracer:
+		return vm.NewCallTracer(), nil
+	default:
+		return nil, fmt.Errorf("Unsupported tracer: %v", config.Tracer)
+	}
+}
+
+func formatTraceResult(tracer vm.Tracer, vmRet common.Bytes, gasUsed uint64, vmErr error, result *TraceResult) {
+	result.Gas = common.JSONUint64(gasUsed)
+	result.ReturnValue = hex.EncodeToString(vmRet)
+	if vmErr != nil {
+		result.Failed = true
+		result.VmError = vmErr.Error()
+		result.RevertReason = decodeRevertReason(vmRet)
+	}
+
+	switch tracer := tracer.(type) {
+	case *vm.StructLogger:
+		result.StructLogs = formatStructLogs(tracer.StructLogs())
+	case *vm.CallTracer:
+		result.CallTrace = tracer.Result()
+		fillRevertReasons(result.CallTrace)
+	}
+}
+
+func formatStructLogs(structLogs []vm.StructLog) []StructLogResult {
+	formatted := make([]StructLogResult, len(structLogs))
+	for i, log := range structLogs {
+		formatted[i] = StructLogResult{
+			Pc:      log.Pc,
+			Op:      log.Op.String(),
+			Gas:     common.JSONUint64(log.Gas),
+			GasCost: common.JSONUint64(log.GasCost),
+			Depth:   log.Depth,
+			Error:   log.ErrorString(),
+		}
+		if log.Stack != nil {
+			formatted[i].Stack = make([]string, len(log.Stack))
+			for j, value := range log.Stack {
+				formatted[i].Stack[j] = hex.EncodeToString(math.PaddedBigBytes(value, 32))
+			}
+		}
+		if log.Memory != nil {
+			formatted[i].Memory = make([]string, 0, (len(log.Memory)+31)/32)
+			for j := 0; j < len(log.Memory); j += 32 {
+				end := j + 32
+				if end > len(log.Memory) {
+					end = len(log.Memory)
+				}
+				formatted[i].Memory = append(formatted[i].Memory, hex.EncodeToString(log.Memory[j:end]))
+			}
+		}
+		if log.Storage != nil {
+			formatted[i].Storage = make(map[string]string, len(log.Storage))
+			for key, value := range log.Storage {
+				formatted[i].Storage[hex.EncodeToString(key[:])] = hex.EncodeToString(value[:])
+			}
+		}
+	}
+	return formatted
+}
+
+// fillRevertReasons decodes the revert reasons of the failed calls in the call tree
+func fillRevertReasons(frame *vm.CallFrame) {
+	if frame == nil {
+		return
+	}
+	if frame.Err() != nil {
+		frame.RevertReason = decodeRevertReason(common.Bytes(frame.Output))
+	}
+	for _, call := range frame.Calls {
+		fillRevertReasons(call)
+	}
+}
@@ -0,0 +1,112 @@
+package rpc
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"theta/common"
+	"theta/core"
+	"theta/ledger/state"
+	"theta/ledger/types"
+	"theta/ledger/vm"
+	"theta/store/database/backend"
+)
+
+func TestFormatTraceResult(t *testing.T) {
+	assert := assert.New(t)
+
+	storeView := state.NewStoreView(0, common.Hash{}, backend.NewMemDatabase())
+	caller := types.MakeAccWithInitBalance("acc_secret_0", types.NewCoins(90000000, 50000000000))
+	storeView.SetAccount(caller.Address, &caller.Account)
+
+	// ASM:
+	// push 0x0
+	// push 0x0
+	// revert
+	revertAddr := common.HexToAddress("0x00000000000000000000000000000000000000bb")
+	revertCode, _ := hex.DecodeString("60006000fd")
+	storeView.CreateAccount(revertAddr)
+	storeView.SetCode(revertAddr, revertCode)
+
+	// ASM:
+	// push 0x0 (x5)
+	// push20 <revertAddr>
+	// push2 0xffff
+	// call
+	// pop
+	// stop
+	proxyAddr := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	proxyCode, _ := hex.DecodeString("60006000600060006000" + "73" + hex.EncodeToString(revertAddr[:]) + "61ffff" + "f1" + "50" + "00")
+	storeView.CreateAccount(proxyAddr)
+	storeView.SetCode(proxyAddr, proxyCode)
+	storeView.Save()
+
+	parentBlock := &core.Block{
+		BlockHeader: &core.BlockHeader{
+			ChainID: "privatenet",
+			Height:  1,
+		},
+	}
+	sctx := &types.SmartContractTx{
+		From: types.TxInput{
+			Address: caller.Address,
+			Coins:   types.NewCoins(0, 0),
+		},
+		To:       types.TxOutput{Address: proxyAddr},
+		GasLimit: 100000,
+		GasPrice: big.NewInt(5000),
+	}
+
+	// Call tracer
+	tracer, err := newTracer(&TraceConfig{Tracer: TracerCallTracer})
+	assert.Nil(err)
+	view, err := storeView.Copy()
+	assert.Nil(err)
+	vmRet, _, gasUsed, vmErr := vm.ExecuteWithTracer(parentBlock, sctx, view, tracer)
+	assert.Nil(vmErr)
+
+	var result TraceResult
+	formatTraceResult(tracer, vmRet, gasUsed, vmErr, &result)
+	assert.False(result.Failed)
+	assert.Equal(common.JSONUint64(gasUsed), result.Gas)
+	assert.NotNil(result.CallTrace)
+	assert.Equal("CALL", result.CallTrace.Type)
+	assert.Equal(caller.Address, result.CallTrace.From)
+	assert.Equal(proxyAddr, result.CallTrace.To)
+	assert.Empty(result.CallTrace.Error)
+	assert.Equal(1, len(result.CallTrace.Calls))
+
+	subcall := result.CallTrace.Calls[0]
+	assert.Equal("CALL", subcall.Type)
+	assert.Equal(proxyAddr, subcall.From)
+	assert.Equal(revertAddr, subcall.To)
+	assert.Equal(common.JSONUint64(0xffff), subcall.Gas)
+	assert.NotEmpty(subcall.Error)
+	assert.Empty(subcall.Calls)
+
+	// Struct logger
+	tracer, err = newTracer(&TraceConfig{DisableMemory: true})
+	assert.Nil(err)
+	view, err = storeView.Copy()
+	assert.Nil(err)
+	vmRet, _, gasUsed, vmErr = vm.ExecuteWithTracer(parentBlock, sctx, view, tracer)
+	assert.Nil(vmErr)
+
+	result = TraceResult{}
+	formatTraceResult(tracer, vmRet, gasUsed, vmErr, &result)
+	assert.Nil(result.CallTrace)
+	assert.Equal(13, len(result.StructLogs))
+	assert.Equal("PUSH1", result.StructLogs[0].Op)
+	assert.Equal(1, result.StructLogs[0].Depth)
+	assert.Nil(result.StructLogs[0].Memory)
+	assert.Equal("CALL", result.StructLogs[7].Op)
+	assert.Equal(7, len(result.StructLogs[7].Stack))
+	assert.Equal(2, result.StructLogs[8].Depth)
+	assert.Equal("REVERT", result.StructLogs[10].Op)
+	assert.Equal("STOP", result.StructLogs[12].Op)
+
+	_, err = newTracer(&TraceConfig{Tracer: "js_tracer"})
+	assert.NotNil(err)
+}
@@ -107,6 +107,9 @@ func (t *ThetaRPCService) txCallback() {
 				}
 			}
 
+			t.subscriptions.PublishBlock(SubscriptionTopicFinalizedBlocks, block)
+			t.subscriptions.PublishLogs(t.chain, block)
+
 			logger.Infof("Done processing finalized block, height=%v", block.Height)
 		case <-timer.C:
 			logger.Debugf("txCallbackManager.Trim()")
@@ -33,6 +33,15 @@ func ExcludeTxs(txs []common.Bytes, exclusionTxMap map[string]bool, chain *block
 		if _, ok := t.(*types.WithdrawStakeTx); ok {
 			continue
 		}
+		if _, ok := t.(*types.EquivocationSlashTx); ok {
+			continue
+		}
+		if _, ok := t.(*types.ValidatorProfileTx); ok {
+			continue
+		}
+		if _, ok := t.(*types.RedelegateStakeTx); ok {
+			continue
+		}
 
 		hash := crypto.Keccak256Hash(tx).Hex()
 		if _, ok := exclusionTxMap[hash]; !ok {
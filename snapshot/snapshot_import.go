@@ -495,7 +495,7 @@ func loadChainSegment(filePath string, start, end uint64, prevBlock *core.Extend
 				if proofTrio.First.Header.Height == core.GenesisBlockHeight {
 					provenValSet, err = checkGenesisBlock(proofTrio.Second.Header, db)
 				} else {
-					provenValSet, err = getValidatorSetFromVCPProof(proofTrio.First.Header.StateHash, &proofTrio.First.Proof)
+					provenValSet, err = getValidatorSetFromVCPProof(proofTrio.First.Header, &proofTrio.First.Proof)
 				}
 				if err != nil {
 					return nil, fmt.Errorf("Failed to retrieve validator set from VCP proof: %v", err)
@@ -757,7 +757,7 @@ func checkProofTrios(proofTrios []core.SnapshotBlockTrio, db database.Database)
 			if err := validateVotes(provenValSet, second.Header, third.Header.HCC.Votes); err != nil {
 				return nil, fmt.Errorf("Failed to validate voteSet, %v", err)
 			}
-			provenValSet, err = getValidatorSetFromVCPProof(first.Header.StateHash, &first.Proof)
+			provenValSet, err = getValidatorSetFromVCPProof(first.Header, &first.Proof)
 			if err != nil {
 				return nil, fmt.Errorf("Failed to retrieve validator set from VCP proof: %v", err)
 			}
@@ -780,7 +780,7 @@ func checkTailTrio(sv *state.StoreView, provenValSet *core.ValidatorSet, tailTri
 		}
 	} else {
 		validateVotes(provenValSet, third.Header, third.VoteSet)
-		retrievedValSet := getValidatorSetFromSV(sv)
+		retrievedValSet := getValidatorSetFromSV(sv, third.Header.ChainID)
 		if !provenValSet.Equals(retrievedValSet) {
 			return fmt.Errorf("The latest proven and retrieved validator set does not match")
 		}
@@ -814,13 +814,13 @@ func checkGenesisBlock(block *core.BlockHeader, db database.Database) (*core.Val
 	// genesis validator set from its state trie
 	gsv := state.NewStoreView(block.Height, block.StateHash, db)
 
-	genesisValidatorSet := getValidatorSetFromSV(gsv)
+	genesisValidatorSet := getValidatorSetFromSV(gsv, block.ChainID)
 
 	return genesisValidatorSet, nil
 }
 
-func getValidatorSetFromVCPProof(stateHash common.Hash, recoverredVp *core.VCPProof) (*core.ValidatorSet, error) {
-	serializedVCP, _, err := trie.VerifyProof(stateHash, state.ValidatorCandidatePoolKey(), recoverredVp)
+func getValidatorSetFromVCPProof(header *core.BlockHeader, recoverredVp *core.VCPProof) (*core.ValidatorSet, error) {
+	serializedVCP, _, err := trie.VerifyProof(header.StateHash, state.ValidatorCandidatePoolKey(), recoverredVp)
 	if err != nil {
 		return nil, err
 	}
@@ -830,12 +830,13 @@ func getValidatorSetFromVCPProof(stateHash common.Hash, recoverredVp *core.VCPPr
 	if err != nil {
 		return nil, err
 	}
-	return consensus.SelectTopStakeHoldersAsValidators(vcp), nil
+	maxNumValidators := core.GetChainConfig(header.ChainID).MaxValidatorCount
+	return consensus.SelectTopStakeHoldersAsValidators(vcp, maxNumValidators), nil
 }
 
-func getValidatorSetFromSV(sv *state.StoreView) *core.ValidatorSet {
+func getValidatorSetFromSV(sv *state.StoreView, chainID string) *core.ValidatorSet {
 	vcp := sv.GetValidatorCandidatePool()
-	return consensus.SelectTopStakeHoldersAsValidators(vcp)
+	return consensus.SelectTopStakeHoldersAsValidators(vcp, core.GetChainConfig(chainID).MaxValidatorCount)
 }
 
 func validateVotes(validatorSet *core.ValidatorSet, block *core.BlockHeader, voteSet *core.VoteSet) error {
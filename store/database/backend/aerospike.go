@@ -1,9 +1,11 @@
 package backend
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/aerospike/aerospike-client-go"
+	"github.com/aerospike/aerospike-client-go/types"
 	"theta/store"
 	"theta/store/database"
 )
@@ -15,6 +17,8 @@ const (
 	Set       string = "store"
 	ValueBin  string = "value"
 	RefBin    string = "ref"
+	IndexBin  string = "index"
+	IndexName string = "store_index"
 )
 
 // AerospikeDatabase a MongoDB wrapped object.
@@ -38,6 +42,17 @@ func NewAerospikeDatabase() (*AerospikeDatabase, error) {
 		return nil, err
 	}
 
+	task, err := client.CreateIndex(nil, Namespace, Set, IndexName, IndexBin, aerospike.NUMERIC)
+	if err != nil {
+		if ae, ok := err.(types.AerospikeError); !ok || ae.ResultCode() != types.INDEX_FOUND {
+			client.Close()
+			return nil, err
+		}
+	} else if err = <-task.OnComplete(); err != nil {
+		client.Close()
+		return nil, err
+	}
+
 	return &AerospikeDatabase{
 		client: client,
 	}, nil
@@ -46,9 +61,12 @@ func NewAerospikeDatabase() (*AerospikeDatabase, error) {
 // Put puts the given key / value to the database
 func (db *AerospikeDatabase) Put(key []byte, value []byte) error {
 	bin := aerospike.NewBin(ValueBin, value)
+	index := aerospike.NewBin(IndexBin, sortIndex(key))
 	writePolicy := aerospike.NewWritePolicy(0, 0)
 	writePolicy.Timeout = 300 * time.Millisecond
-	err := db.client.PutBins(writePolicy, getDBKey(key), bin)
+	// Store the user key along with the digest so that scans can return it
+	writePolicy.SendKey = true
+	err := db.client.PutBins(writePolicy, getDBKey(key), bin, index)
 	return err
 }
 
@@ -142,6 +160,53 @@ func (db *AerospikeDatabase) CountReference(key []byte) (int, error) {
 	return ref, nil
 }
 
+// Iterator returns an iterator over the keys with the given prefix, starting at the
+// key composed of the prefix and the start key. The records in range are queried using
+// the index of the leading bytes of the keys. Aerospike queries are unordered, hence
+// the matching keys are sorted in memory, except for a full scan whose records are
+// streamed in the order of the scan. Only the records written with their user key can
+// be returned, the iteration fails on the first record without one.
+func (db *AerospikeDatabase) Iterator(prefix []byte, start []byte) database.Iterator {
+	var recordset *aerospike.Recordset
+	var err error
+	if len(prefix) == 0 && len(start) == 0 {
+		recordset, err = db.client.ScanAll(nil, Namespace, Set, ValueBin)
+	} else {
+		begin, end := sortIndexRange(prefix, start)
+		statement := aerospike.NewStatement(Namespace, Set, ValueBin)
+		if err = statement.Addfilter(aerospike.NewRangeFilter(IndexBin, begin, end)); err == nil {
+			recordset, err = db.client.Query(nil, statement)
+		}
+	}
+	if err != nil {
+		return newErrorIterator(err)
+	}
+
+	results := recordset.Results()
+	next := func() ([]byte, []byte, bool, error) {
+		res, ok := <-results
+		if !ok {
+			return nil, nil, false, nil
+		}
+		if res.Err != nil {
+			return nil, nil, false, res.Err
+		}
+		var key []byte
+		if res.Record.Key.Value() != nil {
+			key, _ = res.Record.Key.Value().GetObject().([]byte)
+		}
+		if key == nil {
+			return nil, nil, false, fmt.Errorf("Record %x has no user key", res.Record.Key.Digest())
+		}
+		value, _ := res.Record.Bins[ValueBin].([]byte)
+		return key, value, true, nil
+	}
+	release := func() {
+		recordset.Close()
+	}
+	return scanIterator(prefix, start, next, release)
+}
+
 func (db *AerospikeDatabase) Close() {
 	db.client.Close()
 }
@@ -26,3 +26,9 @@ func TestAerospikeDB_PutGet(t *testing.T) {
 	defer close()
 	testPutGet(db, batch, t)
 }
+
+func TestAerospikeDB_Iterator(t *testing.T) {
+	db, _, close := newTestAerospikeDB()
+	defer close()
+	testIterator(db, t)
+}
@@ -0,0 +1,49 @@
+package backend
+
+import (
+	"fmt"
+	"path"
+
+	"theta/store/database"
+)
+
+// Names of the supported database backends
+const (
+	BackendLevelDB   = "leveldb"
+	BackendBadgerDB  = "badger"
+	BackendMemDB     = "memdb"
+	BackendMongoDB   = "mongodb"
+	BackendMgoDB     = "mgodb"
+	BackendAerospike = "aerospike"
+)
+
+// OpenDatabase opens the database of the given backend. For LevelDB, the main and the
+// reference databases are located in the "main" and "ref" sub-directories of dir, and for
+// BadgerDB the database is located in the "badger" sub-directory. The other backends are
+// either in-memory or connect to their servers with the default settings, hence dir is
+// not used. The cache size and handle count only apply to LevelDB. When the metrics
+// collection is enabled, the read and write latencies are reported per backend.
+func OpenDatabase(backend string, dir string, cache int, handles int) (database.Database, error) {
+	var db database.Database
+	var err error
+	switch backend {
+	case BackendLevelDB:
+		db, err = NewLDBDatabase(path.Join(dir, "main"), path.Join(dir, "ref"), cache, handles)
+	case BackendBadgerDB:
+		db, err = NewBadgerDatabase(path.Join(dir, "badger"))
+	case BackendMemDB:
+		db = NewMemDatabase()
+	case BackendMongoDB:
+		db, err = NewMongoDatabase()
+	case BackendMgoDB:
+		db, err = NewMgoDatabase()
+	case BackendAerospike:
+		db, err = NewAerospikeDatabase()
+	default:
+		return nil, fmt.Errorf("Unsupported storage backend: %v", backend)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return newMeteredDatabase(db, backend), nil
+}
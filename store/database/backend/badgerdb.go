@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 
 	"github.com/dgraph-io/badger"
+	"theta/common"
 	"theta/store"
 	"theta/store/database"
 )
@@ -164,6 +165,17 @@ func (db *BadgerDatabase) CountReference(key []byte) (int, error) {
 	return document.Reference, nil
 }
 
+// Iterator returns an iterator over the keys with the given prefix, starting at
+// the key composed of the prefix and the start key.
+func (db *BadgerDatabase) Iterator(prefix []byte, start []byte) database.Iterator {
+	txn := db.db.NewTransaction(false)
+	opts := badger.DefaultIteratorOptions
+	opts.Prefix = prefix
+	it := txn.NewIterator(opts)
+	it.Seek(append(common.CopyBytes(prefix), start...))
+	return &badgerIterator{txn: txn, it: it, prefix: prefix}
+}
+
 func (db *BadgerDatabase) Close() {
 	db.db.Close()
 }
@@ -174,6 +186,67 @@ func (db *BadgerDatabase) NewBatch() database.Batch {
 	return batch
 }
 
+// badgerIterator iterates over a read-only transaction, which is discarded on release.
+type badgerIterator struct {
+	txn     *badger.Txn
+	it      *badger.Iterator
+	prefix  []byte
+	started bool
+	key     []byte
+	value   []byte
+	err     error
+}
+
+func (bi *badgerIterator) Next() bool {
+	if bi.it == nil || bi.err != nil {
+		return false
+	}
+	if bi.started {
+		bi.it.Next()
+	}
+	bi.started = true
+	if !bi.it.ValidForPrefix(bi.prefix) {
+		bi.key, bi.value = nil, nil
+		return false
+	}
+
+	item := bi.it.Item()
+	var document Document
+	err := item.Value(func(val []byte) error {
+		return json.Unmarshal(val, &document)
+	})
+	if err != nil {
+		bi.err = err
+		bi.key, bi.value = nil, nil
+		return false
+	}
+	bi.key = item.KeyCopy(bi.key[:0])
+	bi.value = document.Value
+	return true
+}
+
+func (bi *badgerIterator) Error() error {
+	return bi.err
+}
+
+func (bi *badgerIterator) Key() []byte {
+	return bi.key
+}
+
+func (bi *badgerIterator) Value() []byte {
+	return bi.value
+}
+
+func (bi *badgerIterator) Release() {
+	if bi.it == nil {
+		return
+	}
+	bi.it.Close()
+	bi.txn.Discard()
+	bi.it, bi.txn = nil, nil
+	bi.key, bi.value = nil, nil
+}
+
 type badgerdbBatch struct {
 	db         *badger.DB
 	puts       []Document
@@ -34,3 +34,9 @@ func TestBadgerDB_PutGet(t *testing.T) {
 	defer close()
 	testPutGet(db, batch, t)
 }
+
+func TestBadgerDB_Iterator(t *testing.T) {
+	db, _, close := newTestBDB()
+	defer close()
+	testIterator(db, t)
+}
@@ -0,0 +1,226 @@
+package backend
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"math"
+	"sort"
+
+	"theta/store/database"
+)
+
+// sliceIterator iterates over a snapshot of key/value pairs sorted by key. It is
+// used by the backends that cannot iterate over the keys in order natively.
+type sliceIterator struct {
+	keys   [][]byte
+	values [][]byte
+	index  int
+	err    error
+}
+
+// newSliceIterator creates an iterator over the given key/value pairs. The pairs
+// are sorted in place.
+func newSliceIterator(keys, values [][]byte) *sliceIterator {
+	sort.Sort(&kvSorter{keys: keys, values: values})
+	return &sliceIterator{
+		keys:   keys,
+		values: values,
+		index:  -1,
+	}
+}
+
+// newErrorIterator creates an empty iterator that reports the given error.
+func newErrorIterator(err error) *sliceIterator {
+	return &sliceIterator{index: -1, err: err}
+}
+
+func (it *sliceIterator) Next() bool {
+	if it.index >= len(it.keys) {
+		return false
+	}
+	it.index++
+	return it.index < len(it.keys)
+}
+
+func (it *sliceIterator) Error() error {
+	return it.err
+}
+
+func (it *sliceIterator) Key() []byte {
+	if it.index < 0 || it.index >= len(it.keys) {
+		return nil
+	}
+	return it.keys[it.index]
+}
+
+func (it *sliceIterator) Value() []byte {
+	if it.index < 0 || it.index >= len(it.keys) {
+		return nil
+	}
+	return it.values[it.index]
+}
+
+func (it *sliceIterator) Release() {
+	it.keys, it.values = nil, nil
+}
+
+type kvSorter struct {
+	keys   [][]byte
+	values [][]byte
+}
+
+func (s *kvSorter) Len() int { return len(s.keys) }
+
+func (s *kvSorter) Less(i, j int) bool { return bytes.Compare(s.keys[i], s.keys[j]) < 0 }
+
+func (s *kvSorter) Swap(i, j int) {
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+	s.values[i], s.values[j] = s.values[j], s.values[i]
+}
+
+// inRange checks if the key has the given prefix and is not less than the start
+// key, which is relative to the prefix.
+func inRange(key, prefix, start []byte) bool {
+	if !bytes.HasPrefix(key, prefix) {
+		return false
+	}
+	return bytes.Compare(key[len(prefix):], start) >= 0
+}
+
+// tableIterator strips the table prefix from the keys of the underlying iterator.
+type tableIterator struct {
+	database.Iterator
+	prefix int
+}
+
+func (it *tableIterator) Key() []byte {
+	key := it.Iterator.Key()
+	if key == nil {
+		return nil
+	}
+	return key[it.prefix:]
+}
+
+// streamIterator iterates over the key/value pairs produced by a backend scan or
+// query as they are received, skipping the keys out of range. It is used for the
+// ordered range queries and for the full scans, which would not fit in memory.
+type streamIterator struct {
+	next    func() (key []byte, value []byte, ok bool, err error)
+	release func()
+	prefix  []byte
+	start   []byte
+	key     []byte
+	value   []byte
+	done    bool
+	err     error
+}
+
+// newStreamIterator creates an iterator over the key/value pairs returned by next
+// until it reports that the scan is exhausted or fails. The release function frees
+// the resources of the scan.
+func newStreamIterator(prefix, start []byte, next func() ([]byte, []byte, bool, error), release func()) *streamIterator {
+	return &streamIterator{
+		next:    next,
+		release: release,
+		prefix:  prefix,
+		start:   start,
+	}
+}
+
+func (it *streamIterator) Next() bool {
+	for !it.done {
+		key, value, ok, err := it.next()
+		if err != nil || !ok {
+			it.err = err
+			it.Release()
+			break
+		}
+		if inRange(key, it.prefix, it.start) {
+			it.key, it.value = key, value
+			return true
+		}
+	}
+	it.key, it.value = nil, nil
+	return false
+}
+
+func (it *streamIterator) Error() error {
+	return it.err
+}
+
+func (it *streamIterator) Key() []byte {
+	return it.key
+}
+
+func (it *streamIterator) Value() []byte {
+	return it.value
+}
+
+func (it *streamIterator) Release() {
+	if !it.done {
+		it.done = true
+		it.release()
+	}
+}
+
+// scanIterator returns the iterator over the key/value pairs of a backend scan. The
+// pairs of a full scan, i.e. with neither a prefix nor a start key, are streamed in
+// the order of the scan. Otherwise the pairs in range are sorted in memory.
+func scanIterator(prefix, start []byte, next func() ([]byte, []byte, bool, error), release func()) database.Iterator {
+	it := newStreamIterator(prefix, start, next, release)
+	if len(prefix) == 0 && len(start) == 0 {
+		return it
+	}
+	defer it.Release()
+
+	keys, values := [][]byte{}, [][]byte{}
+	for it.Next() {
+		keys = append(keys, it.Key())
+		values = append(values, it.Value())
+	}
+	if err := it.Error(); err != nil {
+		return newErrorIterator(err)
+	}
+	return newSliceIterator(keys, values)
+}
+
+// keyRange returns the range of the keys with the given prefix, starting at the key
+// composed of the prefix and the start key. The upper bound is exclusive, and nil if
+// the range is not bounded above, i.e. the prefix is empty or all 0xff bytes.
+func keyRange(prefix, start []byte) (lower []byte, upper []byte) {
+	lower = append(append([]byte{}, prefix...), start...)
+	for i := len(prefix) - 1; i >= 0; i-- {
+		if prefix[i] < 0xff {
+			upper = append([]byte{}, prefix[:i+1]...)
+			upper[i]++
+			break
+		}
+	}
+	return lower, upper
+}
+
+// sortKey encodes the key into a string that sorts like the key. The backends that
+// do not order the binary keys bytewise index it to support range queries.
+func sortKey(key []byte) string {
+	return hex.EncodeToString(key)
+}
+
+// sortIndex encodes the first 8 bytes of the key into an integer that sorts like
+// them. The backends that only support integer range queries index it. Distinct keys
+// may share an index, hence the keys must still be checked against the range.
+func sortIndex(key []byte) int64 {
+	var head [8]byte
+	copy(head[:], key)
+	return int64(binary.BigEndian.Uint64(head[:]) ^ (1 << 63))
+}
+
+// sortIndexRange returns the inclusive range of the sort indices of the keys with
+// the given prefix, starting at the key composed of the prefix and the start key.
+func sortIndexRange(prefix, start []byte) (begin int64, end int64) {
+	lower, upper := keyRange(prefix, start)
+	if upper == nil {
+		return sortIndex(lower), math.MaxInt64
+	}
+	return sortIndex(lower), sortIndex(upper)
+}
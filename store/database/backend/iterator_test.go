@@ -0,0 +1,137 @@
+package backend
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func newTestScan(keys []string, err error) (func() ([]byte, []byte, bool, error), func(), *int) {
+	index, released := 0, 0
+	next := func() ([]byte, []byte, bool, error) {
+		if index >= len(keys) {
+			return nil, nil, false, err
+		}
+		key := keys[index]
+		index++
+		return []byte(key), []byte("v" + key), true, nil
+	}
+	release := func() {
+		released++
+	}
+	return next, release, &released
+}
+
+func TestScanIterator(t *testing.T) {
+	entries := []string{"txr/3", "txr/1", "vote/1", "txr/20", "tx", "txr/2"}
+
+	tests := []struct {
+		prefix, start string
+		expected      []string
+	}{
+		// A full scan is streamed in the order of the scan
+		{"", "", entries},
+		{"txr/", "", []string{"txr/1", "txr/2", "txr/20", "txr/3"}},
+		{"txr/", "2", []string{"txr/2", "txr/20", "txr/3"}},
+		{"", "tx", []string{"tx", "txr/1", "txr/2", "txr/20", "txr/3", "vote/1"}},
+		{"block/", "", nil},
+	}
+	for _, test := range tests {
+		next, release, released := newTestScan(entries, nil)
+		it := scanIterator([]byte(test.prefix), []byte(test.start), next, release)
+		var keys []string
+		for it.Next() {
+			keys = append(keys, string(it.Key()))
+			if string(it.Value()) != "v"+string(it.Key()) {
+				t.Fatalf("iterator returned wrong value for %q, got %q", it.Key(), it.Value())
+			}
+		}
+		if err := it.Error(); err != nil {
+			t.Fatalf("iterator failed: %v", err)
+		}
+		it.Release()
+
+		if fmt.Sprint(keys) != fmt.Sprint(test.expected) {
+			t.Fatalf("iterator(%q, %q) returned wrong keys, got %q expected %q", test.prefix, test.start, keys, test.expected)
+		}
+		if *released != 1 {
+			t.Fatalf("iterator(%q, %q) released the scan %v times", test.prefix, test.start, *released)
+		}
+	}
+}
+
+func TestScanIteratorError(t *testing.T) {
+	scanErr := errors.New("Record has no user key")
+
+	// The keys received before the error are streamed by a full scan
+	next, release, released := newTestScan([]string{"a", "b"}, scanErr)
+	it := scanIterator(nil, nil, next, release)
+	var keys []string
+	for it.Next() {
+		keys = append(keys, string(it.Key()))
+	}
+	if fmt.Sprint(keys) != "[a b]" || it.Error() != scanErr {
+		t.Fatalf("full scan returned %q, err: %v", keys, it.Error())
+	}
+	if it.Key() != nil || it.Value() != nil {
+		t.Fatalf("iterator returned a pair after the error")
+	}
+	it.Release()
+	if *released != 1 {
+		t.Fatalf("full scan released %v times", *released)
+	}
+
+	// A prefix scan returns no key at all
+	next, release, released = newTestScan([]string{"a", "b"}, scanErr)
+	it = scanIterator([]byte("a"), nil, next, release)
+	if it.Next() || it.Error() != scanErr {
+		t.Fatalf("prefix scan did not fail, err: %v", it.Error())
+	}
+	if *released != 1 {
+		t.Fatalf("prefix scan released %v times", *released)
+	}
+}
+
+func TestKeyRange(t *testing.T) {
+	tests := []struct {
+		prefix, start  string
+		lower, upper   string
+		unboundedAbove bool
+	}{
+		{"", "", "", "", true},
+		{"txr/", "", "txr/", "txr0", false},
+		{"txr/", "2", "txr/2", "txr0", false},
+		{"", "tx", "tx", "", true},
+		{"a\xff\xff", "b", "a\xff\xffb", "b", false},
+		{"\xff", "", "\xff", "", true},
+	}
+	for _, test := range tests {
+		lower, upper := keyRange([]byte(test.prefix), []byte(test.start))
+		if string(lower) != test.lower || string(upper) != test.upper || (upper == nil) != test.unboundedAbove {
+			t.Fatalf("keyRange(%q, %q) returned [%q, %q)", test.prefix, test.start, lower, upper)
+		}
+	}
+}
+
+func TestSortKeyOrder(t *testing.T) {
+	keys := []string{"", "\x00", "\x00\xff", "\x01", "a", "ab", "b", "\xff"}
+	for i := 1; i < len(keys); i++ {
+		if sortKey([]byte(keys[i-1])) >= sortKey([]byte(keys[i])) {
+			t.Fatalf("sort key of %q is not less than the one of %q", keys[i-1], keys[i])
+		}
+		if sortIndex([]byte(keys[i-1])) > sortIndex([]byte(keys[i])) {
+			t.Fatalf("sort index of %q is greater than the one of %q", keys[i-1], keys[i])
+		}
+	}
+
+	// The keys in range have their sort indices in the range of the prefix
+	begin, end := sortIndexRange([]byte("txr/"), []byte("2"))
+	for _, key := range []string{"txr/2", "txr/20", "txr/3", "txr/\xff\xff\xff\xff\xff"} {
+		if index := sortIndex([]byte(key)); index < begin || index > end {
+			t.Fatalf("sort index of %q is out of range", key)
+		}
+	}
+	if index := sortIndex([]byte("txs")); index <= end {
+		t.Fatalf("sort index of %q is in range", "txs")
+	}
+}
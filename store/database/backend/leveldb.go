@@ -245,6 +245,21 @@ func (db *LDBDatabase) NewIteratorWithPrefix(prefix []byte) iterator.Iterator {
 	return db.db.NewIterator(util.BytesPrefix(prefix), nil)
 }
 
+// Iterator returns an iterator over the keys with the given prefix, starting at
+// the key composed of the prefix and the start key.
+func (db *LDBDatabase) Iterator(prefix []byte, start []byte) database.Iterator {
+	return db.db.NewIterator(bytesPrefixRange(prefix, start), nil)
+}
+
+// bytesPrefixRange returns key range that satisfy
+// - the given prefix, and
+// - the given seek position
+func bytesPrefixRange(prefix, start []byte) *util.Range {
+	r := util.BytesPrefix(prefix)
+	r.Start = append(r.Start, start...)
+	return r
+}
+
 func (db *LDBDatabase) Close() {
 	// Stop the metrics collection to avoid internal database races
 	db.quitLock.Lock()
@@ -604,6 +619,13 @@ func (dt *table) CountReference(key []byte) (int, error) {
 	return dt.db.CountReference(key)
 }
 
+func (dt *table) Iterator(prefix []byte, start []byte) database.Iterator {
+	return &tableIterator{
+		Iterator: dt.db.Iterator(append([]byte(dt.prefix), prefix...), start),
+		prefix:   len(dt.prefix),
+	}
+}
+
 func (dt *table) Close() {
 	// Do nothing; don't close the underlying DB.
 }
@@ -496,3 +496,60 @@ func testParallelPutGet(db database.Database, t *testing.T) {
 	}
 	pending.Wait()
 }
+
+func TestLDB_Iterator(t *testing.T) {
+	db, remove := newTestLDB()
+	defer remove()
+	testIterator(db, t)
+}
+
+func TestMemoryDB_Iterator(t *testing.T) {
+	testIterator(NewMemDatabase(), t)
+}
+
+func TestTable_Iterator(t *testing.T) {
+	db := NewMemDatabase()
+	db.Put([]byte("txr/1"), []byte("other"))
+	testIterator(NewTable(db, "tbl/"), t)
+}
+
+func testIterator(db database.Database, t *testing.T) {
+	entries := []string{"txr/3", "txr/1", "vote/1", "txr/20", "tx", "txr/2"}
+	for _, k := range entries {
+		if err := db.Put([]byte(k), []byte("v"+k)); err != nil {
+			t.Fatalf("put failed: %v", err)
+		}
+	}
+
+	tests := []struct {
+		prefix, start string
+		expected      []string
+	}{
+		{"txr/", "", []string{"txr/1", "txr/2", "txr/20", "txr/3"}},
+		{"txr/", "2", []string{"txr/2", "txr/20", "txr/3"}},
+		{"txr/", "21", []string{"txr/3"}},
+		{"txr/", "4", nil},
+		{"vote/", "", []string{"vote/1"}},
+		{"block/", "", nil},
+		{"", "tx", []string{"tx", "txr/1", "txr/2", "txr/20", "txr/3", "vote/1"}},
+	}
+	for _, test := range tests {
+		it := db.Iterator([]byte(test.prefix), []byte(test.start))
+		var keys []string
+		for it.Next() {
+			key := string(it.Key())
+			if !bytes.Equal(it.Value(), []byte("v"+key)) {
+				t.Fatalf("iterator returned wrong value for %q, got %q", key, it.Value())
+			}
+			keys = append(keys, key)
+		}
+		if err := it.Error(); err != nil {
+			t.Fatalf("iterator failed: %v", err)
+		}
+		it.Release()
+
+		if fmt.Sprint(keys) != fmt.Sprint(test.expected) {
+			t.Fatalf("iterator(%q, %q) returned wrong keys, got %q expected %q", test.prefix, test.start, keys, test.expected)
+		}
+	}
+}
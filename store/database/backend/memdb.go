@@ -85,6 +85,22 @@ func (db *MemDatabase) Keys() [][]byte {
 	return keys
 }
 
+// Iterator returns an iterator over a snapshot of the keys with the given prefix,
+// starting at the key composed of the prefix and the start key.
+func (db *MemDatabase) Iterator(prefix []byte, start []byte) database.Iterator {
+	db.lock.RLock()
+	defer db.lock.RUnlock()
+
+	keys, values := [][]byte{}, [][]byte{}
+	for key, value := range db.db {
+		if inRange([]byte(key), prefix, start) {
+			keys = append(keys, []byte(key))
+			values = append(values, common.CopyBytes(value))
+		}
+	}
+	return newSliceIterator(keys, values)
+}
+
 func (db *MemDatabase) Delete(key []byte) error {
 	db.lock.Lock()
 	defer db.lock.Unlock()
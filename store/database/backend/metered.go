@@ -0,0 +1,69 @@
+package backend
+
+import (
+	"time"
+
+	"theta/common/metrics"
+	"theta/store/database"
+)
+
+// meteredDatabase wraps a database and measures the latency of its reads and writes.
+type meteredDatabase struct {
+	database.Database
+
+	readTimer  metrics.Timer // Timer for measuring the latency of reads
+	writeTimer metrics.Timer // Timer for measuring the latency of writes, including batch writes
+}
+
+// newMeteredDatabase wraps db so that the read and write latencies are reported under
+// "storage/<backend>/read" and "storage/<backend>/write". The database is returned as is
+// when the metrics collection is disabled.
+func newMeteredDatabase(db database.Database, backend string) database.Database {
+	if !metrics.Enabled {
+		return db
+	}
+	return &meteredDatabase{
+		Database:   db,
+		readTimer:  metrics.GetOrRegisterTimer("storage/"+backend+"/read", nil),
+		writeTimer: metrics.GetOrRegisterTimer("storage/"+backend+"/write", nil),
+	}
+}
+
+func (db *meteredDatabase) Get(key []byte) ([]byte, error) {
+	defer db.readTimer.UpdateSince(time.Now())
+	return db.Database.Get(key)
+}
+
+func (db *meteredDatabase) Has(key []byte) (bool, error) {
+	defer db.readTimer.UpdateSince(time.Now())
+	return db.Database.Has(key)
+}
+
+func (db *meteredDatabase) Put(key []byte, value []byte) error {
+	defer db.writeTimer.UpdateSince(time.Now())
+	return db.Database.Put(key, value)
+}
+
+func (db *meteredDatabase) Delete(key []byte) error {
+	defer db.writeTimer.UpdateSince(time.Now())
+	return db.Database.Delete(key)
+}
+
+func (db *meteredDatabase) NewBatch() database.Batch {
+	return &meteredBatch{
+		Batch:      db.Database.NewBatch(),
+		writeTimer: db.writeTimer,
+	}
+}
+
+// meteredBatch measures the latency of committing a batch.
+type meteredBatch struct {
+	database.Batch
+
+	writeTimer metrics.Timer
+}
+
+func (b *meteredBatch) Write() error {
+	defer b.writeTimer.UpdateSince(time.Now())
+	return b.Batch.Write()
+}
@@ -33,6 +33,10 @@ func NewMgoDatabase() (*MgoDatabase, error) {
 	}
 
 	collection := session.DB(Database).C(Collection)
+	if err := collection.EnsureIndexKey(SortKey); err != nil {
+		session.Close()
+		return nil, err
+	}
 
 	return &MgoDatabase{
 		session:    session,
@@ -43,7 +47,7 @@ func NewMgoDatabase() (*MgoDatabase, error) {
 // Put puts the given key / value to the database
 func (db *MgoDatabase) Put(key []byte, value []byte) error {
 	selector := bson.M{Id: key}
-	update := bson.M{"$set": bson.M{Value: value}}
+	update := bson.M{"$set": bson.M{Value: value, SortKey: sortKey(key)}}
 	_, err := db.collection.Upsert(selector, update)
 	return err
 }
@@ -116,6 +120,30 @@ func (db *MgoDatabase) CountReference(key []byte) (int, error) {
 	return result.Reference, nil
 }
 
+// Iterator returns an iterator over the keys with the given prefix, starting at the
+// key composed of the prefix and the start key. The documents in range are queried
+// and streamed in order using the index of the sort keys.
+func (db *MgoDatabase) Iterator(prefix []byte, start []byte) database.Iterator {
+	lower, upper := keyRange(prefix, start)
+	bounds := bson.M{"$gte": sortKey(lower)}
+	if upper != nil {
+		bounds["$lt"] = sortKey(upper)
+	}
+	iter := db.collection.Find(bson.M{SortKey: bounds}).Sort(SortKey).Iter()
+
+	next := func() ([]byte, []byte, bool, error) {
+		document := new(Document)
+		if !iter.Next(document) {
+			return nil, nil, false, iter.Close()
+		}
+		return document.Key, document.Value, true, nil
+	}
+	release := func() {
+		iter.Close()
+	}
+	return newStreamIterator(prefix, start, next, release)
+}
+
 func (db *MgoDatabase) Close() {
 	db.session.Close()
 }
@@ -135,7 +163,7 @@ type mgodbBatch struct {
 
 func (b *mgodbBatch) Put(key, value []byte) error {
 	selector := bson.M{Id: key}
-	update := bson.M{"$set": bson.M{Value: value}}
+	update := bson.M{"$set": bson.M{Value: value, SortKey: sortKey(key)}}
 	b.b.Upsert(selector, update)
 	b.size += len(value)
 	return nil
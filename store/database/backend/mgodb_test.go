@@ -26,3 +26,9 @@ func TestMgoDB_PutGet(t *testing.T) {
 	defer close()
 	testPutGet(db, batch, t)
 }
+
+func TestMgoDB_Iterator(t *testing.T) {
+	db, _, close := newTestMgoDB()
+	defer close()
+	testIterator(db, t)
+}
@@ -17,14 +17,18 @@ const (
 	Id         string = "_id"
 	Value      string = "value"
 	Reference  string = "ref"
+	SortKey    string = "key"
 	Database   string = "peer_service"
 	Collection string = "peer"
 )
 
+// Document is the document of a key. MongoDB orders binary keys by length first,
+// hence the key is also stored as an indexed sort key for range queries.
 type Document struct {
 	Key       []byte `bson:"_id" json:"k,omitempty"`
 	Value     []byte `bson:"value" json:"v"`
 	Reference int    `bson:"ref" json:"ref,omitempty"`
+	SortKey   string `bson:"key,omitempty" json:"-"`
 }
 
 // MongoDatabase a MongoDB wrapped object.
@@ -49,6 +53,11 @@ func NewMongoDatabase() (*MongoDatabase, error) {
 	db := client.Database(Database)
 	collection := db.Collection(Collection)
 
+	index := mongo.IndexModel{Keys: bson.NewDocument(bson.EC.Int32(SortKey, 1))}
+	if _, err := collection.Indexes().CreateOne(context.Background(), index); err != nil {
+		return nil, err
+	}
+
 	return &MongoDatabase{
 		client:     client,
 		collection: collection,
@@ -58,7 +67,7 @@ func NewMongoDatabase() (*MongoDatabase, error) {
 // Put puts the given key / value to the database
 func (db *MongoDatabase) Put(key []byte, value []byte) error {
 	filter := bson.NewDocument(bson.EC.Binary(Id, key))
-	document := Document{Key: key, Value: value}
+	document := Document{Key: key, Value: value, SortKey: sortKey(key)}
 	updator := map[string]Document{"$set": document}
 	option := updateopt.Upsert(true)
 	_, err := db.collection.UpdateOne(nil, filter, updator, option)
@@ -155,6 +164,38 @@ func (db *MongoDatabase) CountReference(key []byte) (int, error) {
 	return result.Reference, err
 }
 
+// Iterator returns an iterator over the keys with the given prefix, starting at the
+// key composed of the prefix and the start key. The documents in range are queried
+// and streamed in order using the index of the sort keys.
+func (db *MongoDatabase) Iterator(prefix []byte, start []byte) database.Iterator {
+	lower, upper := keyRange(prefix, start)
+	bounds := []*bson.Element{bson.EC.String("$gte", sortKey(lower))}
+	if upper != nil {
+		bounds = append(bounds, bson.EC.String("$lt", sortKey(upper)))
+	}
+	filter := bson.NewDocument(bson.EC.SubDocumentFromElements(SortKey, bounds...))
+	order := findopt.Sort(bson.NewDocument(bson.EC.Int32(SortKey, 1)))
+	cursor, err := db.collection.Find(nil, filter, order)
+	if err != nil {
+		return newErrorIterator(err)
+	}
+
+	next := func() ([]byte, []byte, bool, error) {
+		if !cursor.Next(nil) {
+			return nil, nil, false, cursor.Err()
+		}
+		document := new(Document)
+		if err := cursor.Decode(document); err != nil {
+			return nil, nil, false, err
+		}
+		return document.Key, document.Value, true, nil
+	}
+	release := func() {
+		cursor.Close(nil)
+	}
+	return newStreamIterator(prefix, start, next, release)
+}
+
 func (db *MongoDatabase) Close() {
 	err := db.client.Disconnect(context.Background())
 	if err == nil {
@@ -41,12 +41,23 @@ type Dereferencer interface {
 	Dereference(key []byte) error
 }
 
+// Iteratee wraps the database iteration operation supported by all databases.
+type Iteratee interface {
+	// Iterator creates an iterator over the subset of database content with a
+	// particular key prefix, starting at a particular initial key (or after, if
+	// it does not exist). The start key is relative to the prefix. The backends
+	// that cannot iterate over the keys in order natively return the keys of a
+	// full scan, i.e. with neither a prefix nor a start key, in arbitrary order.
+	Iterator(prefix []byte, start []byte) Iterator
+}
+
 // Database wraps all database operations. All methods are safe for concurrent use.
 type Database interface {
 	Putter
 	Deleter
 	Referencer
 	Dereferencer
+	Iteratee
 	Get(key []byte) ([]byte, error)
 	Has(key []byte) (bool, error)
 	CountReference(key []byte) (int, error)
@@ -54,6 +65,33 @@ type Database interface {
 	NewBatch() Batch
 }
 
+// Iterator iterates over the key/value pairs of a database in ascending key
+// order. The iterator must be released after use. An iterator is not safe for
+// concurrent use, but it is safe to use multiple iterators concurrently.
+type Iterator interface {
+	// Next moves the iterator to the next key/value pair. It returns false if
+	// the iterator is exhausted.
+	Next() bool
+
+	// Error returns any accumulated error. Exhausting all the key/value pairs
+	// is not considered to be an error.
+	Error() error
+
+	// Key returns the key of the current key/value pair, or nil if done. The
+	// caller should not modify the contents of the returned slice, and its
+	// contents may change on the next call to Next.
+	Key() []byte
+
+	// Value returns the value of the current key/value pair, or nil if done.
+	// The caller should not modify the contents of the returned slice, and its
+	// contents may change on the next call to Next.
+	Value() []byte
+
+	// Release releases associated resources. Release should always succeed and
+	// can be called multiple times without causing error.
+	Release()
+}
+
 // Batch is a write-only database that commits changes to its host database
 // when Write is called. Batch cannot be used concurrently.
 type Batch interface {
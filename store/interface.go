@@ -9,4 +9,5 @@ type Store interface {
 	Put(key common.Bytes, value interface{}) error
 	Delete(key common.Bytes) error
 	Get(key common.Bytes, value interface{}) error
+	Traverse(prefix, start common.Bytes, cb func(key, value common.Bytes) bool) error
 }
@@ -39,3 +39,19 @@ func (store *KVStore) Get(key common.Bytes, value interface{}) error {
 	}
 	return rlp.DecodeBytes(encodedValue, value)
 }
+
+// Traverse iterates over the DB entries with key having prefix in ascending key order,
+// starting at the key composed of prefix and start, and calls cb on each key/value pair
+// until cb returns false. The value passed to cb is RLP encoded and can be decoded with
+// rlp.DecodeBytes.
+func (store *KVStore) Traverse(prefix, start common.Bytes, cb func(key, value common.Bytes) bool) error {
+	it := store.db.Iterator(prefix, start)
+	defer it.Release()
+
+	for it.Next() {
+		if !cb(common.CopyBytes(it.Key()), common.CopyBytes(it.Value())) {
+			break
+		}
+	}
+	return it.Error()
+}
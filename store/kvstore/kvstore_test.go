@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"theta/common"
+	"theta/rlp"
 	"theta/store"
 	"theta/store/database/backend"
 )
@@ -152,3 +153,40 @@ func TestKVStore(t *testing.T) {
 	assert.NotNil(err)
 	assert.Equal(store.ErrKeyNotFound, err)
 }
+
+func TestKVStoreTraverse(t *testing.T) {
+	assert := assert.New(t)
+
+	kvstore := NewKVStore(backend.NewMemDatabase())
+	assert.Nil(kvstore.Put([]byte("txr/b"), "world"))
+	assert.Nil(kvstore.Put([]byte("txr/a"), "hello"))
+	assert.Nil(kvstore.Put([]byte("vote/a"), "vote"))
+
+	var keys, values []string
+	err := kvstore.Traverse([]byte("txr/"), nil, func(key, value common.Bytes) bool {
+		var str string
+		assert.Nil(rlp.DecodeBytes(value, &str))
+		keys = append(keys, string(key))
+		values = append(values, str)
+		return true
+	})
+	assert.Nil(err)
+	assert.Equal([]string{"txr/a", "txr/b"}, keys)
+	assert.Equal([]string{"hello", "world"}, values)
+
+	keys = nil
+	err = kvstore.Traverse([]byte("txr/"), []byte("b"), func(key, value common.Bytes) bool {
+		keys = append(keys, string(key))
+		return true
+	})
+	assert.Nil(err)
+	assert.Equal([]string{"txr/b"}, keys)
+
+	keys = nil
+	err = kvstore.Traverse(nil, nil, func(key, value common.Bytes) bool {
+		keys = append(keys, string(key))
+		return len(keys) < 2
+	})
+	assert.Nil(err)
+	assert.Equal([]string{"txr/a", "txr/b"}, keys)
+}
@@ -0,0 +1,214 @@
+// Package migrate copies the content of a database to a database of another backend.
+package migrate
+
+import (
+	"bytes"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+	"theta/common"
+	"theta/core"
+	"theta/crypto"
+	"theta/ledger/types"
+	"theta/store"
+	"theta/store/database"
+	"theta/store/kvstore"
+	"theta/store/trie"
+)
+
+var logger *log.Entry = log.WithFields(log.Fields{"prefix": "migrate"})
+
+// progressInterval is the number of keys copied between progress reports
+const progressInterval = 100000
+
+// numSpotCheckedBlocks is the number of the last finalized blocks whose keys are compared
+// between the source and the destination databases
+const numSpotCheckedBlocks = 1000
+
+// accountKeyPrefix is the prefix of the account keys in the state trie
+var accountKeyPrefix = common.Bytes("ls/a/")
+
+// txIndexKeyPrefix and txReceiptKeyPrefix are the prefixes of the tx index and tx receipt
+// keys, followed by the tx hash
+var (
+	txIndexKeyPrefix   = common.Bytes("tx/")
+	txReceiptKeyPrefix = common.Bytes("txr/")
+)
+
+// Stats summarizes a migration.
+type Stats struct {
+	Keys       uint64 // number of key/value pairs copied
+	References uint64 // number of references copied
+}
+
+// CopyDatabase copies all the key/value pairs together with their reference counts
+// from src to dst. The state tries are copied along since their nodes are stored as
+// key/value pairs keyed by the node hashes.
+func CopyDatabase(src, dst database.Database) (*Stats, error) {
+	stats := &Stats{}
+	batch := dst.NewBatch()
+
+	it := src.Iterator(nil, nil)
+	defer it.Release()
+
+	for it.Next() {
+		key := common.CopyBytes(it.Key())
+		if err := batch.Put(key, common.CopyBytes(it.Value())); err != nil {
+			return nil, err
+		}
+
+		ref, err := src.CountReference(key)
+		if err != nil && err != store.ErrKeyNotFound {
+			return nil, fmt.Errorf("Failed to count references of %x: %v", key, err)
+		}
+		for i := 0; i < ref; i++ {
+			if err := batch.Reference(key); err != nil {
+				return nil, err
+			}
+		}
+
+		stats.Keys++
+		stats.References += uint64(ref)
+		if batch.ValueSize() >= database.IdealBatchSize {
+			if err := batch.Write(); err != nil {
+				return nil, err
+			}
+			batch.Reset()
+		}
+		if stats.Keys%progressInterval == 0 {
+			logger.Infof("Copied %v keys", stats.Keys)
+		}
+	}
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+	if err := batch.Write(); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// CountKeys returns the number of key/value pairs and references in db.
+func CountKeys(db database.Database) (*Stats, error) {
+	stats := &Stats{}
+	it := db.Iterator(nil, nil)
+	defer it.Release()
+
+	for it.Next() {
+		ref, err := db.CountReference(it.Key())
+		if err != nil && err != store.ErrKeyNotFound {
+			return nil, fmt.Errorf("Failed to count references of %x: %v", it.Key(), err)
+		}
+		stats.Keys++
+		stats.References += uint64(ref)
+	}
+	if err := it.Error(); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// VerifyCopy checks that dst holds as many keys and references as src, and that the last
+// finalized blocks up to the given block, together with the index entries and receipts
+// of their transactions, are identical in both databases.
+func VerifyCopy(src, dst database.Database, lastFinalizedBlock common.Hash) error {
+	srcStats, err := CountKeys(src)
+	if err != nil {
+		return fmt.Errorf("Failed to count the keys of the source database: %v", err)
+	}
+	dstStats, err := CountKeys(dst)
+	if err != nil {
+		return fmt.Errorf("Failed to count the keys of the destination database: %v", err)
+	}
+	if *srcStats != *dstStats {
+		return fmt.Errorf("Found %v keys and %v references in the destination database, expected %v keys and %v references",
+			dstStats.Keys, dstStats.References, srcStats.Keys, srcStats.References)
+	}
+
+	srcStore := kvstore.NewKVStore(src)
+	hash := lastFinalizedBlock
+	for i := 0; i < numSpotCheckedBlocks && hash != (common.Hash{}); i++ {
+		block := &core.ExtendedBlock{}
+		if err := srcStore.Get(hash[:], block); err != nil {
+			if err == store.ErrKeyNotFound && i > 0 {
+				// Reached the first block kept by the source database
+				break
+			}
+			return fmt.Errorf("Failed to load block %v: %v", hash.Hex(), err)
+		}
+		if err := compareKey(src, dst, hash[:], true); err != nil {
+			return err
+		}
+		for _, tx := range block.Txs {
+			txHash := crypto.Keccak256Hash(tx)
+			if err := compareKey(src, dst, append(common.CopyBytes(txIndexKeyPrefix), txHash[:]...), true); err != nil {
+				return err
+			}
+			if err := compareKey(src, dst, append(common.CopyBytes(txReceiptKeyPrefix), txHash[:]...), false); err != nil {
+				return err
+			}
+		}
+		hash = block.Parent
+	}
+	return nil
+}
+
+// compareKey checks that the value of the given key is the same in src and dst. The key
+// must be present in src if required is set.
+func compareKey(src, dst database.Database, key common.Bytes, required bool) error {
+	srcValue, err := src.Get(key)
+	srcFound := err == nil
+	if err != nil && (required || err != store.ErrKeyNotFound) {
+		return fmt.Errorf("Failed to load key %x from the source database: %v", key, err)
+	}
+	dstValue, err := dst.Get(key)
+	dstFound := err == nil
+	if err != nil && err != store.ErrKeyNotFound {
+		return fmt.Errorf("Failed to load key %x from the destination database: %v", key, err)
+	}
+	if srcFound != dstFound || !bytes.Equal(srcValue, dstValue) {
+		return fmt.Errorf("Key %x does not match the source database", key)
+	}
+	return nil
+}
+
+// VerifyState checks that all the nodes of the state trie with the given root, as well as
+// the storage tries of the accounts, are present in db and match their hashes.
+func VerifyState(db database.Database, root common.Hash) error {
+	return verifyTrie(db, root, true)
+}
+
+func verifyTrie(db database.Database, root common.Hash, isState bool) error {
+	tr, err := trie.New(root, trie.NewDatabase(db))
+	if err != nil {
+		return err
+	}
+
+	it := tr.NodeIterator(nil)
+	for it.Next(true) {
+		if hash := it.Hash(); hash != (common.Hash{}) {
+			blob, err := db.Get(hash[:])
+			if err != nil {
+				return fmt.Errorf("Failed to load trie node %v: %v", hash.Hex(), err)
+			}
+			if crypto.Keccak256Hash(blob) != hash {
+				return fmt.Errorf("Trie node %v does not match its hash", hash.Hex())
+			}
+		}
+
+		if !isState || !it.Leaf() || !bytes.HasPrefix(it.LeafKey(), accountKeyPrefix) {
+			continue
+		}
+		account := &types.Account{}
+		if err := types.FromBytes(it.LeafBlob(), account); err != nil {
+			return fmt.Errorf("Failed to decode account %x: %v", it.LeafKey(), err)
+		}
+		if (account.Root == (common.Hash{})) || (account.Root == core.EmptyRootHash) {
+			continue
+		}
+		if err := verifyTrie(db, account.Root, false); err != nil {
+			return fmt.Errorf("Invalid storage of account %v: %v", account.Address.Hex(), err)
+		}
+	}
+	return it.Error()
+}
@@ -0,0 +1,123 @@
+package migrate
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"theta/common"
+	"theta/core"
+	"theta/crypto"
+	"theta/ledger/state"
+	"theta/ledger/types"
+	"theta/store/database/backend"
+	"theta/store/kvstore"
+)
+
+func TestMigrate(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	src := backend.NewMemDatabase()
+	require.Nil(src.Put([]byte("cs/ss"), []byte("stub")))
+	require.Nil(src.Put([]byte("ref"), []byte("value")))
+	require.Nil(src.Reference([]byte("ref")))
+	require.Nil(src.Reference([]byte("ref")))
+
+	addr := common.HexToAddress("0x0000000000000000000000000000000000000a01")
+	sv := state.NewStoreView(0, common.Hash{}, src)
+	sv.SetAccount(addr, types.NewAccount(addr))
+	sv.SetState(addr, common.HexToHash("0x01"), common.HexToHash("0x02"))
+	root := sv.Save()
+	require.Nil(VerifyState(src, root))
+
+	dir, err := ioutil.TempDir("", "theta-migrate-test")
+	require.Nil(err)
+	defer os.RemoveAll(dir)
+	dst, err := backend.OpenDatabase(backend.BackendLevelDB, dir, 0, 0)
+	require.Nil(err)
+	defer dst.Close()
+
+	stats, err := CopyDatabase(src, dst)
+	require.Nil(err)
+	assert.Equal(uint64(src.Len()), stats.Keys)
+
+	for _, key := range src.Keys() {
+		srcValue, _ := src.Get(key)
+		dstValue, err := dst.Get(key)
+		require.Nil(err)
+		assert.Equal(srcValue, dstValue)
+	}
+	ref, err := dst.CountReference([]byte("ref"))
+	require.Nil(err)
+	assert.Equal(2, ref)
+
+	require.Nil(VerifyState(dst, root))
+	account := state.NewStoreView(0, root, dst).GetAccount(addr)
+	require.NotNil(account)
+
+	// Missing storage trie nodes must be detected.
+	require.Nil(dst.Delete(account.Root[:]))
+	assert.NotNil(VerifyState(dst, root))
+}
+
+func TestVerifyCopy(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	src := backend.NewMemDatabase()
+	srcStore := kvstore.NewKVStore(src)
+	parent := common.Hash{}
+	var lastFinalized common.Hash
+	var txHashes []common.Hash
+	for height := uint64(1); height <= 3; height++ {
+		block := core.NewBlock()
+		block.Height = height
+		block.Parent = parent
+		tx := common.Bytes(fmt.Sprintf("tx%v", height))
+		block.Txs = []common.Bytes{tx}
+		block.UpdateHash()
+
+		eb := &core.ExtendedBlock{Block: block}
+		hash := eb.Hash()
+		require.Nil(srcStore.Put(hash[:], eb))
+		txHash := crypto.Keccak256Hash(tx)
+		require.Nil(src.Put(append(common.Bytes("tx/"), txHash[:]...), []byte("index")))
+		txHashes = append(txHashes, txHash)
+		parent, lastFinalized = hash, hash
+	}
+	require.Nil(src.Put([]byte("ref"), []byte("value")))
+	require.Nil(src.Reference([]byte("ref")))
+
+	copyDatabase := func() *backend.MemDatabase {
+		dst := backend.NewMemDatabase()
+		_, err := CopyDatabase(src, dst)
+		require.Nil(err)
+		return dst
+	}
+	require.Nil(VerifyCopy(src, copyDatabase(), lastFinalized))
+
+	// Missing keys must be detected.
+	dst := copyDatabase()
+	require.Nil(dst.Delete([]byte("ref")))
+	assert.NotNil(VerifyCopy(src, dst, lastFinalized))
+
+	// Missing references must be detected.
+	dst = copyDatabase()
+	require.Nil(dst.Dereference([]byte("ref")))
+	assert.NotNil(VerifyCopy(src, dst, lastFinalized))
+
+	// Mismatching tx index entries must be detected, even if the key count matches.
+	dst = copyDatabase()
+	require.Nil(dst.Put(append(common.Bytes("tx/"), txHashes[0][:]...), []byte("other")))
+	assert.NotNil(VerifyCopy(src, dst, lastFinalized))
+
+	// Tx receipts must be copied if present.
+	dst = copyDatabase()
+	require.Nil(src.Put(append(common.Bytes("txr/"), txHashes[1][:]...), []byte("receipt")))
+	require.Nil(dst.Put(append(common.Bytes("txr/"), txHashes[2][:]...), []byte("receipt")))
+	assert.NotNil(VerifyCopy(src, dst, lastFinalized))
+}
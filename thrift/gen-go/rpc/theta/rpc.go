@@ -26,6 +26,9 @@ const (
   TErrorCode_ENotFound TErrorCode = -1
   TErrorCode_EUnknown TErrorCode = -2
   TErrorCode_EDataExisted TErrorCode = -3
+  TErrorCode_EInvalidArgument TErrorCode = -4
+  TErrorCode_EUnavailable TErrorCode = -5
+  TErrorCode_EKeyLocked TErrorCode = -6
 )
 
 func (p TErrorCode) String() string {
@@ -34,6 +37,9 @@ func (p TErrorCode) String() string {
   case TErrorCode_ENotFound: return "ENotFound"
   case TErrorCode_EUnknown: return "EUnknown"
   case TErrorCode_EDataExisted: return "EDataExisted"
+  case TErrorCode_EInvalidArgument: return "EInvalidArgument"
+  case TErrorCode_EUnavailable: return "EUnavailable"
+  case TErrorCode_EKeyLocked: return "EKeyLocked"
   }
   return "<UNSET>"
 }
@@ -44,6 +50,9 @@ func TErrorCodeFromString(s string) (TErrorCode, error) {
   case "ENotFound": return TErrorCode_ENotFound, nil 
   case "EUnknown": return TErrorCode_EUnknown, nil 
   case "EDataExisted": return TErrorCode_EDataExisted, nil 
+  case "EInvalidArgument": return TErrorCode_EInvalidArgument, nil 
+  case "EUnavailable": return TErrorCode_EUnavailable, nil 
+  case "EKeyLocked": return TErrorCode_EKeyLocked, nil 
   }
   return TErrorCode(0), fmt.Errorf("not a valid TErrorCode string")
 }
@@ -79,6 +88,138 @@ func (p * TErrorCode) Value() (driver.Value, error) {
   }
 return int64(*p), nil
 }
+// Attributes:
+//  - Code
+//  - Message
+type TThetaException struct {
+  Code TErrorCode `thrift:"code,1" db:"code" json:"code"`
+  Message string `thrift:"message,2" db:"message" json:"message"`
+}
+
+func NewTThetaException() *TThetaException {
+  return &TThetaException{}
+}
+
+
+func (p *TThetaException) GetCode() TErrorCode {
+  return p.Code
+}
+
+func (p *TThetaException) GetMessage() string {
+  return p.Message
+}
+func (p *TThetaException) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.I32 {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 2:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField2(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *TThetaException)  ReadField1(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  temp := TErrorCode(v)
+  p.Code = temp
+}
+  return nil
+}
+
+func (p *TThetaException)  ReadField2(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.Message = v
+}
+  return nil
+}
+
+func (p *TThetaException) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("TThetaException"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *TThetaException) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:code: ", p), err) }
+  if err := oprot.WriteI32(int32(p.Code)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.code (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:code: ", p), err) }
+  return err
+}
+
+func (p *TThetaException) writeField2(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:message: ", p), err) }
+  if err := oprot.WriteString(string(p.Message)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.message (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:message: ", p), err) }
+  return err
+}
+
+func (p *TThetaException) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("TThetaException(%+v)", *p)
+}
+
+func (p *TThetaException) Error() string {
+  return p.String()
+}
+
 // Attributes:
 //  - Version
 //  - GitHash
@@ -1547,51 +1688,32 @@ func (p *TransactionInBlock) String() string {
 }
 
 // Attributes:
-//  - BlockHash
-//  - BlockHeight
-//  - Status
-//  - Hash
-//  - Transaction
-type TransactionResult_ struct {
-  BlockHash string `thrift:"block_hash,1" db:"block_hash" json:"block_hash"`
-  BlockHeight string `thrift:"block_height,2" db:"block_height" json:"block_height"`
-  Status string `thrift:"status,3" db:"status" json:"status"`
-  Hash string `thrift:"hash,4" db:"hash" json:"hash"`
-  Transaction *TransactionData `thrift:"transaction,5" db:"transaction" json:"transaction"`
+//  - Address
+//  - Topics
+//  - Data
+type Log struct {
+  Address string `thrift:"address,1" db:"address" json:"address"`
+  Topics []string `thrift:"topics,2" db:"topics" json:"topics"`
+  Data string `thrift:"data,3" db:"data" json:"data"`
 }
 
-func NewTransactionResult_() *TransactionResult_ {
-  return &TransactionResult_{}
+func NewLog() *Log {
+  return &Log{}
 }
 
 
-func (p *TransactionResult_) GetBlockHash() string {
-  return p.BlockHash
-}
-
-func (p *TransactionResult_) GetBlockHeight() string {
-  return p.BlockHeight
+func (p *Log) GetAddress() string {
+  return p.Address
 }
 
-func (p *TransactionResult_) GetStatus() string {
-  return p.Status
+func (p *Log) GetTopics() []string {
+  return p.Topics
 }
 
-func (p *TransactionResult_) GetHash() string {
-  return p.Hash
-}
-var TransactionResult__Transaction_DEFAULT *TransactionData
-func (p *TransactionResult_) GetTransaction() *TransactionData {
-  if !p.IsSetTransaction() {
-    return TransactionResult__Transaction_DEFAULT
-  }
-return p.Transaction
-}
-func (p *TransactionResult_) IsSetTransaction() bool {
-  return p.Transaction != nil
+func (p *Log) GetData() string {
+  return p.Data
 }
-
-func (p *TransactionResult_) Read(iprot thrift.TProtocol) error {
+func (p *Log) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -1615,7 +1737,7 @@ func (p *TransactionResult_) Read(iprot thrift.TProtocol) error {
         }
       }
     case 2:
-      if fieldTypeId == thrift.STRING {
+      if fieldTypeId == thrift.LIST {
         if err := p.ReadField2(iprot); err != nil {
           return err
         }
@@ -1634,26 +1756,6 @@ func (p *TransactionResult_) Read(iprot thrift.TProtocol) error {
           return err
         }
       }
-    case 4:
-      if fieldTypeId == thrift.STRING {
-        if err := p.ReadField4(iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(fieldTypeId); err != nil {
-          return err
-        }
-      }
-    case 5:
-      if fieldTypeId == thrift.STRUCT {
-        if err := p.ReadField5(iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(fieldTypeId); err != nil {
-          return err
-        }
-      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -1669,59 +1771,53 @@ func (p *TransactionResult_) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *TransactionResult_)  ReadField1(iprot thrift.TProtocol) error {
+func (p *Log)  ReadField1(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 1: ", err)
 } else {
-  p.BlockHash = v
+  p.Address = v
 }
   return nil
 }
 
-func (p *TransactionResult_)  ReadField2(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 2: ", err)
+func (p *Log)  ReadField2(iprot thrift.TProtocol) error {
+  _, size, err := iprot.ReadListBegin()
+  if err != nil {
+    return thrift.PrependError("error reading list begin: ", err)
+  }
+  tSlice := make([]string, 0, size)
+  p.Topics =  tSlice
+  for i := 0; i < size; i ++ {
+var _elem3 string
+    if v, err := iprot.ReadString(); err != nil {
+    return thrift.PrependError("error reading field 0: ", err)
 } else {
-  p.BlockHeight = v
+    _elem3 = v
 }
+    p.Topics = append(p.Topics, _elem3)
+  }
+  if err := iprot.ReadListEnd(); err != nil {
+    return thrift.PrependError("error reading list end: ", err)
+  }
   return nil
 }
 
-func (p *TransactionResult_)  ReadField3(iprot thrift.TProtocol) error {
+func (p *Log)  ReadField3(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 3: ", err)
 } else {
-  p.Status = v
-}
-  return nil
-}
-
-func (p *TransactionResult_)  ReadField4(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 4: ", err)
-} else {
-  p.Hash = v
-}
-  return nil
+  p.Data = v
 }
-
-func (p *TransactionResult_)  ReadField5(iprot thrift.TProtocol) error {
-  p.Transaction = &TransactionData{}
-  if err := p.Transaction.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Transaction), err)
-  }
   return nil
 }
 
-func (p *TransactionResult_) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("TransactionResult"); err != nil {
+func (p *Log) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("Log"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
-    if err := p.writeField4(oprot); err != nil { return err }
-    if err := p.writeField5(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -1730,112 +1826,96 @@ func (p *TransactionResult_) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *TransactionResult_) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("block_hash", thrift.STRING, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:block_hash: ", p), err) }
-  if err := oprot.WriteString(string(p.BlockHash)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.block_hash (1) field write error: ", p), err) }
+func (p *Log) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("address", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:address: ", p), err) }
+  if err := oprot.WriteString(string(p.Address)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.address (1) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:block_hash: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:address: ", p), err) }
   return err
 }
 
-func (p *TransactionResult_) writeField2(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("block_height", thrift.STRING, 2); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:block_height: ", p), err) }
-  if err := oprot.WriteString(string(p.BlockHeight)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.block_height (2) field write error: ", p), err) }
+func (p *Log) writeField2(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("topics", thrift.LIST, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:topics: ", p), err) }
+  if err := oprot.WriteListBegin(thrift.STRING, len(p.Topics)); err != nil {
+    return thrift.PrependError("error writing list begin: ", err)
+  }
+  for _, v := range p.Topics {
+    if err := oprot.WriteString(string(v)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err) }
+  }
+  if err := oprot.WriteListEnd(); err != nil {
+    return thrift.PrependError("error writing list end: ", err)
+  }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:block_height: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:topics: ", p), err) }
   return err
 }
 
-func (p *TransactionResult_) writeField3(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("status", thrift.STRING, 3); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:status: ", p), err) }
-  if err := oprot.WriteString(string(p.Status)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.status (3) field write error: ", p), err) }
+func (p *Log) writeField3(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("data", thrift.STRING, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:data: ", p), err) }
+  if err := oprot.WriteString(string(p.Data)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.data (3) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:status: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:data: ", p), err) }
   return err
 }
 
-func (p *TransactionResult_) writeField4(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("hash", thrift.STRING, 4); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:hash: ", p), err) }
-  if err := oprot.WriteString(string(p.Hash)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.hash (4) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 4:hash: ", p), err) }
-  return err
-}
-
-func (p *TransactionResult_) writeField5(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("transaction", thrift.STRUCT, 5); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:transaction: ", p), err) }
-  if err := p.Transaction.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Transaction), err)
-  }
-  if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 5:transaction: ", p), err) }
-  return err
-}
-
-func (p *TransactionResult_) String() string {
+func (p *Log) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("TransactionResult_(%+v)", *p)
+  return fmt.Sprintf("Log(%+v)", *p)
 }
 
 // Attributes:
-//  - Fee
-//  - Inputs
-//  - Outputs
-type TransactionData struct {
-  Fee *Fee `thrift:"fee,1" db:"fee" json:"fee"`
-  Inputs *Input `thrift:"inputs,2" db:"inputs" json:"inputs"`
-  Outputs *Output `thrift:"outputs,3" db:"outputs" json:"outputs"`
+//  - TxHash
+//  - Logs
+//  - EvmRet
+//  - ContractAddress
+//  - GasUsed
+//  - EvmError
+type TxReceipt struct {
+  TxHash string `thrift:"tx_hash,1" db:"tx_hash" json:"tx_hash"`
+  Logs []*Log `thrift:"logs,2" db:"logs" json:"logs"`
+  EvmRet string `thrift:"evm_ret,3" db:"evm_ret" json:"evm_ret"`
+  ContractAddress string `thrift:"contract_address,4" db:"contract_address" json:"contract_address"`
+  GasUsed string `thrift:"gas_used,5" db:"gas_used" json:"gas_used"`
+  EvmError string `thrift:"evm_error,6" db:"evm_error" json:"evm_error"`
 }
 
-func NewTransactionData() *TransactionData {
-  return &TransactionData{}
+func NewTxReceipt() *TxReceipt {
+  return &TxReceipt{}
 }
 
-var TransactionData_Fee_DEFAULT *Fee
-func (p *TransactionData) GetFee() *Fee {
-  if !p.IsSetFee() {
-    return TransactionData_Fee_DEFAULT
-  }
-return p.Fee
-}
-var TransactionData_Inputs_DEFAULT *Input
-func (p *TransactionData) GetInputs() *Input {
-  if !p.IsSetInputs() {
-    return TransactionData_Inputs_DEFAULT
-  }
-return p.Inputs
+
+func (p *TxReceipt) GetTxHash() string {
+  return p.TxHash
 }
-var TransactionData_Outputs_DEFAULT *Output
-func (p *TransactionData) GetOutputs() *Output {
-  if !p.IsSetOutputs() {
-    return TransactionData_Outputs_DEFAULT
-  }
-return p.Outputs
+
+func (p *TxReceipt) GetLogs() []*Log {
+  return p.Logs
 }
-func (p *TransactionData) IsSetFee() bool {
-  return p.Fee != nil
+
+func (p *TxReceipt) GetEvmRet() string {
+  return p.EvmRet
 }
 
-func (p *TransactionData) IsSetInputs() bool {
-  return p.Inputs != nil
+func (p *TxReceipt) GetContractAddress() string {
+  return p.ContractAddress
 }
 
-func (p *TransactionData) IsSetOutputs() bool {
-  return p.Outputs != nil
+func (p *TxReceipt) GetGasUsed() string {
+  return p.GasUsed
 }
 
-func (p *TransactionData) Read(iprot thrift.TProtocol) error {
+func (p *TxReceipt) GetEvmError() string {
+  return p.EvmError
+}
+func (p *TxReceipt) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -1849,7 +1929,7 @@ func (p *TransactionData) Read(iprot thrift.TProtocol) error {
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
     case 1:
-      if fieldTypeId == thrift.STRUCT {
+      if fieldTypeId == thrift.STRING {
         if err := p.ReadField1(iprot); err != nil {
           return err
         }
@@ -1859,7 +1939,7 @@ func (p *TransactionData) Read(iprot thrift.TProtocol) error {
         }
       }
     case 2:
-      if fieldTypeId == thrift.STRUCT {
+      if fieldTypeId == thrift.LIST {
         if err := p.ReadField2(iprot); err != nil {
           return err
         }
@@ -1869,7 +1949,7 @@ func (p *TransactionData) Read(iprot thrift.TProtocol) error {
         }
       }
     case 3:
-      if fieldTypeId == thrift.STRUCT {
+      if fieldTypeId == thrift.STRING {
         if err := p.ReadField3(iprot); err != nil {
           return err
         }
@@ -1878,6 +1958,36 @@ func (p *TransactionData) Read(iprot thrift.TProtocol) error {
           return err
         }
       }
+    case 4:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField4(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 5:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField5(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 6:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField6(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -1893,37 +2003,81 @@ func (p *TransactionData) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *TransactionData)  ReadField1(iprot thrift.TProtocol) error {
-  p.Fee = &Fee{}
-  if err := p.Fee.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Fee), err)
-  }
+func (p *TxReceipt)  ReadField1(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.TxHash = v
+}
   return nil
 }
 
-func (p *TransactionData)  ReadField2(iprot thrift.TProtocol) error {
-  p.Inputs = &Input{}
-  if err := p.Inputs.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Inputs), err)
+func (p *TxReceipt)  ReadField2(iprot thrift.TProtocol) error {
+  _, size, err := iprot.ReadListBegin()
+  if err != nil {
+    return thrift.PrependError("error reading list begin: ", err)
+  }
+  tSlice := make([]*Log, 0, size)
+  p.Logs =  tSlice
+  for i := 0; i < size; i ++ {
+    _elem4 := &Log{}
+    if err := _elem4.Read(iprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem4), err)
+    }
+    p.Logs = append(p.Logs, _elem4)
+  }
+  if err := iprot.ReadListEnd(); err != nil {
+    return thrift.PrependError("error reading list end: ", err)
   }
   return nil
 }
 
-func (p *TransactionData)  ReadField3(iprot thrift.TProtocol) error {
-  p.Outputs = &Output{}
-  if err := p.Outputs.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Outputs), err)
-  }
+func (p *TxReceipt)  ReadField3(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 3: ", err)
+} else {
+  p.EvmRet = v
+}
   return nil
 }
 
-func (p *TransactionData) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("TransactionData"); err != nil {
+func (p *TxReceipt)  ReadField4(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 4: ", err)
+} else {
+  p.ContractAddress = v
+}
+  return nil
+}
+
+func (p *TxReceipt)  ReadField5(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 5: ", err)
+} else {
+  p.GasUsed = v
+}
+  return nil
+}
+
+func (p *TxReceipt)  ReadField6(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 6: ", err)
+} else {
+  p.EvmError = v
+}
+  return nil
+}
+
+func (p *TxReceipt) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("TxReceipt"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
+    if err := p.writeField4(oprot); err != nil { return err }
+    if err := p.writeField5(oprot); err != nil { return err }
+    if err := p.writeField6(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -1932,61 +2086,153 @@ func (p *TransactionData) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *TransactionData) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("fee", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:fee: ", p), err) }
-  if err := p.Fee.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Fee), err)
-  }
+func (p *TxReceipt) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("tx_hash", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:tx_hash: ", p), err) }
+  if err := oprot.WriteString(string(p.TxHash)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.tx_hash (1) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:fee: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:tx_hash: ", p), err) }
   return err
 }
 
-func (p *TransactionData) writeField2(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("inputs", thrift.STRUCT, 2); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:inputs: ", p), err) }
-  if err := p.Inputs.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Inputs), err)
+func (p *TxReceipt) writeField2(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("logs", thrift.LIST, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:logs: ", p), err) }
+  if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Logs)); err != nil {
+    return thrift.PrependError("error writing list begin: ", err)
+  }
+  for _, v := range p.Logs {
+    if err := v.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+    }
+  }
+  if err := oprot.WriteListEnd(); err != nil {
+    return thrift.PrependError("error writing list end: ", err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:inputs: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:logs: ", p), err) }
   return err
 }
 
-func (p *TransactionData) writeField3(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("outputs", thrift.STRUCT, 3); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:outputs: ", p), err) }
-  if err := p.Outputs.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Outputs), err)
-  }
+func (p *TxReceipt) writeField3(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("evm_ret", thrift.STRING, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:evm_ret: ", p), err) }
+  if err := oprot.WriteString(string(p.EvmRet)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.evm_ret (3) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:outputs: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:evm_ret: ", p), err) }
   return err
 }
 
-func (p *TransactionData) String() string {
+func (p *TxReceipt) writeField4(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("contract_address", thrift.STRING, 4); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:contract_address: ", p), err) }
+  if err := oprot.WriteString(string(p.ContractAddress)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.contract_address (4) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 4:contract_address: ", p), err) }
+  return err
+}
+
+func (p *TxReceipt) writeField5(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("gas_used", thrift.STRING, 5); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:gas_used: ", p), err) }
+  if err := oprot.WriteString(string(p.GasUsed)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.gas_used (5) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 5:gas_used: ", p), err) }
+  return err
+}
+
+func (p *TxReceipt) writeField6(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("evm_error", thrift.STRING, 6); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:evm_error: ", p), err) }
+  if err := oprot.WriteString(string(p.EvmError)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.evm_error (6) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 6:evm_error: ", p), err) }
+  return err
+}
+
+func (p *TxReceipt) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("TransactionData(%+v)", *p)
+  return fmt.Sprintf("TxReceipt(%+v)", *p)
 }
 
 // Attributes:
-//  - TxHashes
-type PendingTransaction struct {
-  TxHashes []string `thrift:"tx_hashes,1" db:"tx_hashes" json:"tx_hashes"`
+//  - BlockHash
+//  - BlockHeight
+//  - Status
+//  - Hash
+//  - Type
+//  - Raw
+//  - JSON
+//  - Receipt
+type Transaction struct {
+  BlockHash string `thrift:"block_hash,1" db:"block_hash" json:"block_hash"`
+  BlockHeight string `thrift:"block_height,2" db:"block_height" json:"block_height"`
+  Status string `thrift:"status,3" db:"status" json:"status"`
+  Hash string `thrift:"hash,4" db:"hash" json:"hash"`
+  Type int32 `thrift:"type,5" db:"type" json:"type"`
+  Raw *RawTransaction `thrift:"raw,6" db:"raw" json:"raw"`
+  JSON string `thrift:"json,7" db:"json" json:"json"`
+  Receipt *TxReceipt `thrift:"receipt,8" db:"receipt" json:"receipt"`
 }
 
-func NewPendingTransaction() *PendingTransaction {
-  return &PendingTransaction{}
+func NewTransaction() *Transaction {
+  return &Transaction{}
 }
 
 
-func (p *PendingTransaction) GetTxHashes() []string {
-  return p.TxHashes
+func (p *Transaction) GetBlockHash() string {
+  return p.BlockHash
 }
-func (p *PendingTransaction) Read(iprot thrift.TProtocol) error {
+
+func (p *Transaction) GetBlockHeight() string {
+  return p.BlockHeight
+}
+
+func (p *Transaction) GetStatus() string {
+  return p.Status
+}
+
+func (p *Transaction) GetHash() string {
+  return p.Hash
+}
+
+func (p *Transaction) GetType() int32 {
+  return p.Type
+}
+var Transaction_Raw_DEFAULT *RawTransaction
+func (p *Transaction) GetRaw() *RawTransaction {
+  if !p.IsSetRaw() {
+    return Transaction_Raw_DEFAULT
+  }
+return p.Raw
+}
+
+func (p *Transaction) GetJSON() string {
+  return p.JSON
+}
+var Transaction_Receipt_DEFAULT *TxReceipt
+func (p *Transaction) GetReceipt() *TxReceipt {
+  if !p.IsSetReceipt() {
+    return Transaction_Receipt_DEFAULT
+  }
+return p.Receipt
+}
+func (p *Transaction) IsSetRaw() bool {
+  return p.Raw != nil
+}
+
+func (p *Transaction) IsSetReceipt() bool {
+  return p.Receipt != nil
+}
+
+func (p *Transaction) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -2000,7 +2246,7 @@ func (p *PendingTransaction) Read(iprot thrift.TProtocol) error {
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
     case 1:
-      if fieldTypeId == thrift.LIST {
+      if fieldTypeId == thrift.STRING {
         if err := p.ReadField1(iprot); err != nil {
           return err
         }
@@ -2009,124 +2255,19 @@ func (p *PendingTransaction) Read(iprot thrift.TProtocol) error {
           return err
         }
       }
-    default:
-      if err := iprot.Skip(fieldTypeId); err != nil {
-        return err
+    case 2:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField2(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
       }
-    }
-    if err := iprot.ReadFieldEnd(); err != nil {
-      return err
-    }
-  }
-  if err := iprot.ReadStructEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-  }
-  return nil
-}
-
-func (p *PendingTransaction)  ReadField1(iprot thrift.TProtocol) error {
-  _, size, err := iprot.ReadListBegin()
-  if err != nil {
-    return thrift.PrependError("error reading list begin: ", err)
-  }
-  tSlice := make([]string, 0, size)
-  p.TxHashes =  tSlice
-  for i := 0; i < size; i ++ {
-var _elem3 string
-    if v, err := iprot.ReadString(); err != nil {
-    return thrift.PrependError("error reading field 0: ", err)
-} else {
-    _elem3 = v
-}
-    p.TxHashes = append(p.TxHashes, _elem3)
-  }
-  if err := iprot.ReadListEnd(); err != nil {
-    return thrift.PrependError("error reading list end: ", err)
-  }
-  return nil
-}
-
-func (p *PendingTransaction) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("PendingTransaction"); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
-  if p != nil {
-    if err := p.writeField1(oprot); err != nil { return err }
-  }
-  if err := oprot.WriteFieldStop(); err != nil {
-    return thrift.PrependError("write field stop error: ", err) }
-  if err := oprot.WriteStructEnd(); err != nil {
-    return thrift.PrependError("write struct stop error: ", err) }
-  return nil
-}
-
-func (p *PendingTransaction) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("tx_hashes", thrift.LIST, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:tx_hashes: ", p), err) }
-  if err := oprot.WriteListBegin(thrift.STRING, len(p.TxHashes)); err != nil {
-    return thrift.PrependError("error writing list begin: ", err)
-  }
-  for _, v := range p.TxHashes {
-    if err := oprot.WriteString(string(v)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err) }
-  }
-  if err := oprot.WriteListEnd(); err != nil {
-    return thrift.PrependError("error writing list end: ", err)
-  }
-  if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:tx_hashes: ", p), err) }
-  return err
-}
-
-func (p *PendingTransaction) String() string {
-  if p == nil {
-    return "<nil>"
-  }
-  return fmt.Sprintf("PendingTransaction(%+v)", *p)
-}
-
-// Attributes:
-//  - Hash
-//  - Block
-type BroadcastRawTransaction struct {
-  Hash string `thrift:"hash,1" db:"hash" json:"hash"`
-  Block *TransactionBlock `thrift:"block,2" db:"block" json:"block"`
-}
-
-func NewBroadcastRawTransaction() *BroadcastRawTransaction {
-  return &BroadcastRawTransaction{}
-}
-
-
-func (p *BroadcastRawTransaction) GetHash() string {
-  return p.Hash
-}
-var BroadcastRawTransaction_Block_DEFAULT *TransactionBlock
-func (p *BroadcastRawTransaction) GetBlock() *TransactionBlock {
-  if !p.IsSetBlock() {
-    return BroadcastRawTransaction_Block_DEFAULT
-  }
-return p.Block
-}
-func (p *BroadcastRawTransaction) IsSetBlock() bool {
-  return p.Block != nil
-}
-
-func (p *BroadcastRawTransaction) Read(iprot thrift.TProtocol) error {
-  if _, err := iprot.ReadStructBegin(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
-  }
-
-
-  for {
-    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
-    if err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
-    }
-    if fieldTypeId == thrift.STOP { break; }
-    switch fieldId {
-    case 1:
+    case 3:
       if fieldTypeId == thrift.STRING {
-        if err := p.ReadField1(iprot); err != nil {
+        if err := p.ReadField3(iprot); err != nil {
           return err
         }
       } else {
@@ -2134,9 +2275,49 @@ func (p *BroadcastRawTransaction) Read(iprot thrift.TProtocol) error {
           return err
         }
       }
-    case 2:
+    case 4:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField4(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 5:
+      if fieldTypeId == thrift.I32 {
+        if err := p.ReadField5(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 6:
       if fieldTypeId == thrift.STRUCT {
-        if err := p.ReadField2(iprot); err != nil {
+        if err := p.ReadField6(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 7:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField7(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 8:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField8(iprot); err != nil {
           return err
         }
       } else {
@@ -2159,29 +2340,88 @@ func (p *BroadcastRawTransaction) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *BroadcastRawTransaction)  ReadField1(iprot thrift.TProtocol) error {
+func (p *Transaction)  ReadField1(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.BlockHash = v
+}
+  return nil
+}
+
+func (p *Transaction)  ReadField2(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.BlockHeight = v
+}
+  return nil
+}
+
+func (p *Transaction)  ReadField3(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 3: ", err)
+} else {
+  p.Status = v
+}
+  return nil
+}
+
+func (p *Transaction)  ReadField4(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 4: ", err)
 } else {
   p.Hash = v
 }
   return nil
 }
 
-func (p *BroadcastRawTransaction)  ReadField2(iprot thrift.TProtocol) error {
-  p.Block = &TransactionBlock{}
-  if err := p.Block.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Block), err)
+func (p *Transaction)  ReadField5(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 5: ", err)
+} else {
+  p.Type = v
+}
+  return nil
+}
+
+func (p *Transaction)  ReadField6(iprot thrift.TProtocol) error {
+  p.Raw = &RawTransaction{}
+  if err := p.Raw.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Raw), err)
   }
   return nil
 }
 
-func (p *BroadcastRawTransaction) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("BroadcastRawTransaction"); err != nil {
+func (p *Transaction)  ReadField7(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 7: ", err)
+} else {
+  p.JSON = v
+}
+  return nil
+}
+
+func (p *Transaction)  ReadField8(iprot thrift.TProtocol) error {
+  p.Receipt = &TxReceipt{}
+  if err := p.Receipt.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Receipt), err)
+  }
+  return nil
+}
+
+func (p *Transaction) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("Transaction"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+    if err := p.writeField4(oprot); err != nil { return err }
+    if err := p.writeField5(oprot); err != nil { return err }
+    if err := p.writeField6(oprot); err != nil { return err }
+    if err := p.writeField7(oprot); err != nil { return err }
+    if err := p.writeField8(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -2190,122 +2430,141 @@ func (p *BroadcastRawTransaction) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *BroadcastRawTransaction) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("hash", thrift.STRING, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:hash: ", p), err) }
-  if err := oprot.WriteString(string(p.Hash)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.hash (1) field write error: ", p), err) }
+func (p *Transaction) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("block_hash", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:block_hash: ", p), err) }
+  if err := oprot.WriteString(string(p.BlockHash)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.block_hash (1) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:hash: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:block_hash: ", p), err) }
   return err
 }
 
-func (p *BroadcastRawTransaction) writeField2(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("block", thrift.STRUCT, 2); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:block: ", p), err) }
-  if err := p.Block.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Block), err)
-  }
+func (p *Transaction) writeField2(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("block_height", thrift.STRING, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:block_height: ", p), err) }
+  if err := oprot.WriteString(string(p.BlockHeight)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.block_height (2) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:block: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:block_height: ", p), err) }
   return err
 }
 
-func (p *BroadcastRawTransaction) String() string {
-  if p == nil {
-    return "<nil>"
-  }
-  return fmt.Sprintf("BroadcastRawTransaction(%+v)", *p)
-}
-
-// Attributes:
-//  - ChainID
-//  - Epoch
-//  - Height
-//  - Parent
-//  - HCC
-//  - TxHash
-//  - ReceiptHash
-//  - Bloom
-//  - StateHash
-//  - Timestamp
-//  - Proposer
-//  - Signature
-type TransactionBlock struct {
-  ChainID string `thrift:"ChainID,1" db:"ChainID" json:"ChainID"`
-  Epoch int64 `thrift:"Epoch,2" db:"Epoch" json:"Epoch"`
-  Height int64 `thrift:"Height,3" db:"Height" json:"Height"`
-  Parent string `thrift:"Parent,4" db:"Parent" json:"Parent"`
-  HCC *HCC `thrift:"HCC,5" db:"HCC" json:"HCC"`
-  TxHash string `thrift:"TxHash,6" db:"TxHash" json:"TxHash"`
-  ReceiptHash string `thrift:"ReceiptHash,7" db:"ReceiptHash" json:"ReceiptHash"`
-  Bloom string `thrift:"Bloom,8" db:"Bloom" json:"Bloom"`
-  StateHash string `thrift:"StateHash,9" db:"StateHash" json:"StateHash"`
-  Timestamp int64 `thrift:"Timestamp,10" db:"Timestamp" json:"Timestamp"`
-  Proposer string `thrift:"Proposer,11" db:"Proposer" json:"Proposer"`
-  Signature string `thrift:"Signature,12" db:"Signature" json:"Signature"`
+func (p *Transaction) writeField3(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("status", thrift.STRING, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:status: ", p), err) }
+  if err := oprot.WriteString(string(p.Status)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.status (3) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:status: ", p), err) }
+  return err
 }
 
-func NewTransactionBlock() *TransactionBlock {
-  return &TransactionBlock{}
+func (p *Transaction) writeField4(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("hash", thrift.STRING, 4); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:hash: ", p), err) }
+  if err := oprot.WriteString(string(p.Hash)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.hash (4) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 4:hash: ", p), err) }
+  return err
 }
 
-
-func (p *TransactionBlock) GetChainID() string {
-  return p.ChainID
+func (p *Transaction) writeField5(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("type", thrift.I32, 5); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:type: ", p), err) }
+  if err := oprot.WriteI32(int32(p.Type)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.type (5) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 5:type: ", p), err) }
+  return err
 }
 
-func (p *TransactionBlock) GetEpoch() int64 {
-  return p.Epoch
+func (p *Transaction) writeField6(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("raw", thrift.STRUCT, 6); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:raw: ", p), err) }
+  if err := p.Raw.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Raw), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 6:raw: ", p), err) }
+  return err
 }
 
-func (p *TransactionBlock) GetHeight() int64 {
-  return p.Height
+func (p *Transaction) writeField7(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("json", thrift.STRING, 7); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:json: ", p), err) }
+  if err := oprot.WriteString(string(p.JSON)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.json (7) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 7:json: ", p), err) }
+  return err
 }
 
-func (p *TransactionBlock) GetParent() string {
-  return p.Parent
-}
-var TransactionBlock_HCC_DEFAULT *HCC
-func (p *TransactionBlock) GetHCC() *HCC {
-  if !p.IsSetHCC() {
-    return TransactionBlock_HCC_DEFAULT
+func (p *Transaction) writeField8(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("receipt", thrift.STRUCT, 8); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:receipt: ", p), err) }
+  if err := p.Receipt.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Receipt), err)
   }
-return p.HCC
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 8:receipt: ", p), err) }
+  return err
 }
 
-func (p *TransactionBlock) GetTxHash() string {
-  return p.TxHash
+func (p *Transaction) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("Transaction(%+v)", *p)
 }
 
-func (p *TransactionBlock) GetReceiptHash() string {
-  return p.ReceiptHash
+// Attributes:
+//  - BlockHash
+//  - BlockHeight
+//  - Status
+//  - Hash
+//  - Transaction
+type TransactionResult_ struct {
+  BlockHash string `thrift:"block_hash,1" db:"block_hash" json:"block_hash"`
+  BlockHeight string `thrift:"block_height,2" db:"block_height" json:"block_height"`
+  Status string `thrift:"status,3" db:"status" json:"status"`
+  Hash string `thrift:"hash,4" db:"hash" json:"hash"`
+  Transaction *TransactionData `thrift:"transaction,5" db:"transaction" json:"transaction"`
 }
 
-func (p *TransactionBlock) GetBloom() string {
-  return p.Bloom
+func NewTransactionResult_() *TransactionResult_ {
+  return &TransactionResult_{}
 }
 
-func (p *TransactionBlock) GetStateHash() string {
-  return p.StateHash
+
+func (p *TransactionResult_) GetBlockHash() string {
+  return p.BlockHash
 }
 
-func (p *TransactionBlock) GetTimestamp() int64 {
-  return p.Timestamp
+func (p *TransactionResult_) GetBlockHeight() string {
+  return p.BlockHeight
 }
 
-func (p *TransactionBlock) GetProposer() string {
-  return p.Proposer
+func (p *TransactionResult_) GetStatus() string {
+  return p.Status
 }
 
-func (p *TransactionBlock) GetSignature() string {
-  return p.Signature
+func (p *TransactionResult_) GetHash() string {
+  return p.Hash
 }
-func (p *TransactionBlock) IsSetHCC() bool {
-  return p.HCC != nil
+var TransactionResult__Transaction_DEFAULT *TransactionData
+func (p *TransactionResult_) GetTransaction() *TransactionData {
+  if !p.IsSetTransaction() {
+    return TransactionResult__Transaction_DEFAULT
+  }
+return p.Transaction
+}
+func (p *TransactionResult_) IsSetTransaction() bool {
+  return p.Transaction != nil
 }
 
-func (p *TransactionBlock) Read(iprot thrift.TProtocol) error {
+func (p *TransactionResult_) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -2329,7 +2588,7 @@ func (p *TransactionBlock) Read(iprot thrift.TProtocol) error {
         }
       }
     case 2:
-      if fieldTypeId == thrift.I64 {
+      if fieldTypeId == thrift.STRING {
         if err := p.ReadField2(iprot); err != nil {
           return err
         }
@@ -2339,7 +2598,7 @@ func (p *TransactionBlock) Read(iprot thrift.TProtocol) error {
         }
       }
     case 3:
-      if fieldTypeId == thrift.I64 {
+      if fieldTypeId == thrift.STRING {
         if err := p.ReadField3(iprot); err != nil {
           return err
         }
@@ -2368,76 +2627,6 @@ func (p *TransactionBlock) Read(iprot thrift.TProtocol) error {
           return err
         }
       }
-    case 6:
-      if fieldTypeId == thrift.STRING {
-        if err := p.ReadField6(iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(fieldTypeId); err != nil {
-          return err
-        }
-      }
-    case 7:
-      if fieldTypeId == thrift.STRING {
-        if err := p.ReadField7(iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(fieldTypeId); err != nil {
-          return err
-        }
-      }
-    case 8:
-      if fieldTypeId == thrift.STRING {
-        if err := p.ReadField8(iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(fieldTypeId); err != nil {
-          return err
-        }
-      }
-    case 9:
-      if fieldTypeId == thrift.STRING {
-        if err := p.ReadField9(iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(fieldTypeId); err != nil {
-          return err
-        }
-      }
-    case 10:
-      if fieldTypeId == thrift.I64 {
-        if err := p.ReadField10(iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(fieldTypeId); err != nil {
-          return err
-        }
-      }
-    case 11:
-      if fieldTypeId == thrift.STRING {
-        if err := p.ReadField11(iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(fieldTypeId); err != nil {
-          return err
-        }
-      }
-    case 12:
-      if fieldTypeId == thrift.STRING {
-        if err := p.ReadField12(iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(fieldTypeId); err != nil {
-          return err
-        }
-      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -2453,286 +2642,173 @@ func (p *TransactionBlock) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *TransactionBlock)  ReadField1(iprot thrift.TProtocol) error {
+func (p *TransactionResult_)  ReadField1(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 1: ", err)
 } else {
-  p.ChainID = v
+  p.BlockHash = v
 }
   return nil
 }
 
-func (p *TransactionBlock)  ReadField2(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI64(); err != nil {
+func (p *TransactionResult_)  ReadField2(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 2: ", err)
 } else {
-  p.Epoch = v
+  p.BlockHeight = v
 }
   return nil
 }
 
-func (p *TransactionBlock)  ReadField3(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI64(); err != nil {
+func (p *TransactionResult_)  ReadField3(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 3: ", err)
 } else {
-  p.Height = v
+  p.Status = v
 }
   return nil
 }
 
-func (p *TransactionBlock)  ReadField4(iprot thrift.TProtocol) error {
+func (p *TransactionResult_)  ReadField4(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 4: ", err)
 } else {
-  p.Parent = v
+  p.Hash = v
 }
   return nil
 }
 
-func (p *TransactionBlock)  ReadField5(iprot thrift.TProtocol) error {
-  p.HCC = &HCC{}
-  if err := p.HCC.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.HCC), err)
+func (p *TransactionResult_)  ReadField5(iprot thrift.TProtocol) error {
+  p.Transaction = &TransactionData{}
+  if err := p.Transaction.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Transaction), err)
   }
   return nil
 }
 
-func (p *TransactionBlock)  ReadField6(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 6: ", err)
-} else {
-  p.TxHash = v
-}
-  return nil
-}
-
-func (p *TransactionBlock)  ReadField7(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 7: ", err)
-} else {
-  p.ReceiptHash = v
-}
+func (p *TransactionResult_) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("TransactionResult"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+    if err := p.writeField4(oprot); err != nil { return err }
+    if err := p.writeField5(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
   return nil
 }
 
-func (p *TransactionBlock)  ReadField8(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 8: ", err)
-} else {
-  p.Bloom = v
-}
-  return nil
-}
-
-func (p *TransactionBlock)  ReadField9(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 9: ", err)
-} else {
-  p.StateHash = v
-}
-  return nil
-}
-
-func (p *TransactionBlock)  ReadField10(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI64(); err != nil {
-  return thrift.PrependError("error reading field 10: ", err)
-} else {
-  p.Timestamp = v
-}
-  return nil
-}
-
-func (p *TransactionBlock)  ReadField11(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 11: ", err)
-} else {
-  p.Proposer = v
-}
-  return nil
-}
-
-func (p *TransactionBlock)  ReadField12(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 12: ", err)
-} else {
-  p.Signature = v
-}
-  return nil
-}
-
-func (p *TransactionBlock) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("TransactionBlock"); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
-  if p != nil {
-    if err := p.writeField1(oprot); err != nil { return err }
-    if err := p.writeField2(oprot); err != nil { return err }
-    if err := p.writeField3(oprot); err != nil { return err }
-    if err := p.writeField4(oprot); err != nil { return err }
-    if err := p.writeField5(oprot); err != nil { return err }
-    if err := p.writeField6(oprot); err != nil { return err }
-    if err := p.writeField7(oprot); err != nil { return err }
-    if err := p.writeField8(oprot); err != nil { return err }
-    if err := p.writeField9(oprot); err != nil { return err }
-    if err := p.writeField10(oprot); err != nil { return err }
-    if err := p.writeField11(oprot); err != nil { return err }
-    if err := p.writeField12(oprot); err != nil { return err }
-  }
-  if err := oprot.WriteFieldStop(); err != nil {
-    return thrift.PrependError("write field stop error: ", err) }
-  if err := oprot.WriteStructEnd(); err != nil {
-    return thrift.PrependError("write struct stop error: ", err) }
-  return nil
-}
-
-func (p *TransactionBlock) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("ChainID", thrift.STRING, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ChainID: ", p), err) }
-  if err := oprot.WriteString(string(p.ChainID)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.ChainID (1) field write error: ", p), err) }
+func (p *TransactionResult_) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("block_hash", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:block_hash: ", p), err) }
+  if err := oprot.WriteString(string(p.BlockHash)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.block_hash (1) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ChainID: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:block_hash: ", p), err) }
   return err
 }
 
-func (p *TransactionBlock) writeField2(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("Epoch", thrift.I64, 2); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Epoch: ", p), err) }
-  if err := oprot.WriteI64(int64(p.Epoch)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.Epoch (2) field write error: ", p), err) }
+func (p *TransactionResult_) writeField2(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("block_height", thrift.STRING, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:block_height: ", p), err) }
+  if err := oprot.WriteString(string(p.BlockHeight)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.block_height (2) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Epoch: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:block_height: ", p), err) }
   return err
 }
 
-func (p *TransactionBlock) writeField3(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("Height", thrift.I64, 3); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:Height: ", p), err) }
-  if err := oprot.WriteI64(int64(p.Height)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.Height (3) field write error: ", p), err) }
+func (p *TransactionResult_) writeField3(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("status", thrift.STRING, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:status: ", p), err) }
+  if err := oprot.WriteString(string(p.Status)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.status (3) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:Height: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:status: ", p), err) }
   return err
 }
 
-func (p *TransactionBlock) writeField4(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("Parent", thrift.STRING, 4); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:Parent: ", p), err) }
-  if err := oprot.WriteString(string(p.Parent)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.Parent (4) field write error: ", p), err) }
+func (p *TransactionResult_) writeField4(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("hash", thrift.STRING, 4); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:hash: ", p), err) }
+  if err := oprot.WriteString(string(p.Hash)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.hash (4) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 4:Parent: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 4:hash: ", p), err) }
   return err
 }
 
-func (p *TransactionBlock) writeField5(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("HCC", thrift.STRUCT, 5); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:HCC: ", p), err) }
-  if err := p.HCC.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.HCC), err)
+func (p *TransactionResult_) writeField5(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("transaction", thrift.STRUCT, 5); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:transaction: ", p), err) }
+  if err := p.Transaction.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Transaction), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 5:HCC: ", p), err) }
-  return err
-}
-
-func (p *TransactionBlock) writeField6(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("TxHash", thrift.STRING, 6); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:TxHash: ", p), err) }
-  if err := oprot.WriteString(string(p.TxHash)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.TxHash (6) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 6:TxHash: ", p), err) }
-  return err
-}
-
-func (p *TransactionBlock) writeField7(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("ReceiptHash", thrift.STRING, 7); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:ReceiptHash: ", p), err) }
-  if err := oprot.WriteString(string(p.ReceiptHash)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.ReceiptHash (7) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 7:ReceiptHash: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 5:transaction: ", p), err) }
   return err
 }
 
-func (p *TransactionBlock) writeField8(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("Bloom", thrift.STRING, 8); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:Bloom: ", p), err) }
-  if err := oprot.WriteString(string(p.Bloom)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.Bloom (8) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 8:Bloom: ", p), err) }
-  return err
+func (p *TransactionResult_) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("TransactionResult_(%+v)", *p)
 }
 
-func (p *TransactionBlock) writeField9(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("StateHash", thrift.STRING, 9); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 9:StateHash: ", p), err) }
-  if err := oprot.WriteString(string(p.StateHash)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.StateHash (9) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 9:StateHash: ", p), err) }
-  return err
+// Attributes:
+//  - Fee
+//  - Inputs
+//  - Outputs
+type TransactionData struct {
+  Fee *Fee `thrift:"fee,1" db:"fee" json:"fee"`
+  Inputs *Input `thrift:"inputs,2" db:"inputs" json:"inputs"`
+  Outputs *Output `thrift:"outputs,3" db:"outputs" json:"outputs"`
 }
 
-func (p *TransactionBlock) writeField10(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("Timestamp", thrift.I64, 10); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:Timestamp: ", p), err) }
-  if err := oprot.WriteI64(int64(p.Timestamp)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.Timestamp (10) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 10:Timestamp: ", p), err) }
-  return err
+func NewTransactionData() *TransactionData {
+  return &TransactionData{}
 }
 
-func (p *TransactionBlock) writeField11(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("Proposer", thrift.STRING, 11); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 11:Proposer: ", p), err) }
-  if err := oprot.WriteString(string(p.Proposer)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.Proposer (11) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 11:Proposer: ", p), err) }
-  return err
+var TransactionData_Fee_DEFAULT *Fee
+func (p *TransactionData) GetFee() *Fee {
+  if !p.IsSetFee() {
+    return TransactionData_Fee_DEFAULT
+  }
+return p.Fee
 }
-
-func (p *TransactionBlock) writeField12(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("Signature", thrift.STRING, 12); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 12:Signature: ", p), err) }
-  if err := oprot.WriteString(string(p.Signature)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.Signature (12) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 12:Signature: ", p), err) }
-  return err
+var TransactionData_Inputs_DEFAULT *Input
+func (p *TransactionData) GetInputs() *Input {
+  if !p.IsSetInputs() {
+    return TransactionData_Inputs_DEFAULT
+  }
+return p.Inputs
 }
-
-func (p *TransactionBlock) String() string {
-  if p == nil {
-    return "<nil>"
+var TransactionData_Outputs_DEFAULT *Output
+func (p *TransactionData) GetOutputs() *Output {
+  if !p.IsSetOutputs() {
+    return TransactionData_Outputs_DEFAULT
   }
-  return fmt.Sprintf("TransactionBlock(%+v)", *p)
+return p.Outputs
 }
-
-// Attributes:
-//  - Votes
-//  - BlockHash
-type HCC struct {
-  Votes []*Vote `thrift:"Votes,1" db:"Votes" json:"Votes"`
-  BlockHash string `thrift:"BlockHash,2" db:"BlockHash" json:"BlockHash"`
+func (p *TransactionData) IsSetFee() bool {
+  return p.Fee != nil
 }
 
-func NewHCC() *HCC {
-  return &HCC{}
+func (p *TransactionData) IsSetInputs() bool {
+  return p.Inputs != nil
 }
 
-
-func (p *HCC) GetVotes() []*Vote {
-  return p.Votes
+func (p *TransactionData) IsSetOutputs() bool {
+  return p.Outputs != nil
 }
 
-func (p *HCC) GetBlockHash() string {
-  return p.BlockHash
-}
-func (p *HCC) Read(iprot thrift.TProtocol) error {
+func (p *TransactionData) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -2746,7 +2822,7 @@ func (p *HCC) Read(iprot thrift.TProtocol) error {
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
     case 1:
-      if fieldTypeId == thrift.LIST {
+      if fieldTypeId == thrift.STRUCT {
         if err := p.ReadField1(iprot); err != nil {
           return err
         }
@@ -2756,7 +2832,7 @@ func (p *HCC) Read(iprot thrift.TProtocol) error {
         }
       }
     case 2:
-      if fieldTypeId == thrift.STRING {
+      if fieldTypeId == thrift.STRUCT {
         if err := p.ReadField2(iprot); err != nil {
           return err
         }
@@ -2765,11 +2841,21 @@ func (p *HCC) Read(iprot thrift.TProtocol) error {
           return err
         }
       }
-    default:
-      if err := iprot.Skip(fieldTypeId); err != nil {
-        return err
-      }
-    }
+    case 3:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField3(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
     if err := iprot.ReadFieldEnd(); err != nil {
       return err
     }
@@ -2780,41 +2866,37 @@ func (p *HCC) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *HCC)  ReadField1(iprot thrift.TProtocol) error {
-  _, size, err := iprot.ReadListBegin()
-  if err != nil {
-    return thrift.PrependError("error reading list begin: ", err)
-  }
-  tSlice := make([]*Vote, 0, size)
-  p.Votes =  tSlice
-  for i := 0; i < size; i ++ {
-    _elem4 := &Vote{}
-    if err := _elem4.Read(iprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem4), err)
-    }
-    p.Votes = append(p.Votes, _elem4)
-  }
-  if err := iprot.ReadListEnd(); err != nil {
-    return thrift.PrependError("error reading list end: ", err)
+func (p *TransactionData)  ReadField1(iprot thrift.TProtocol) error {
+  p.Fee = &Fee{}
+  if err := p.Fee.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Fee), err)
   }
   return nil
 }
 
-func (p *HCC)  ReadField2(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 2: ", err)
-} else {
-  p.BlockHash = v
+func (p *TransactionData)  ReadField2(iprot thrift.TProtocol) error {
+  p.Inputs = &Input{}
+  if err := p.Inputs.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Inputs), err)
+  }
+  return nil
 }
+
+func (p *TransactionData)  ReadField3(iprot thrift.TProtocol) error {
+  p.Outputs = &Output{}
+  if err := p.Outputs.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Outputs), err)
+  }
   return nil
 }
 
-func (p *HCC) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("HCC"); err != nil {
+func (p *TransactionData) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("TransactionData"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -2823,81 +2905,61 @@ func (p *HCC) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *HCC) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("Votes", thrift.LIST, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Votes: ", p), err) }
-  if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Votes)); err != nil {
-    return thrift.PrependError("error writing list begin: ", err)
-  }
-  for _, v := range p.Votes {
-    if err := v.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
-    }
+func (p *TransactionData) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("fee", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:fee: ", p), err) }
+  if err := p.Fee.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Fee), err)
   }
-  if err := oprot.WriteListEnd(); err != nil {
-    return thrift.PrependError("error writing list end: ", err)
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:fee: ", p), err) }
+  return err
+}
+
+func (p *TransactionData) writeField2(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("inputs", thrift.STRUCT, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:inputs: ", p), err) }
+  if err := p.Inputs.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Inputs), err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Votes: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:inputs: ", p), err) }
   return err
 }
 
-func (p *HCC) writeField2(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("BlockHash", thrift.STRING, 2); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:BlockHash: ", p), err) }
-  if err := oprot.WriteString(string(p.BlockHash)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.BlockHash (2) field write error: ", p), err) }
+func (p *TransactionData) writeField3(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("outputs", thrift.STRUCT, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:outputs: ", p), err) }
+  if err := p.Outputs.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Outputs), err)
+  }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:BlockHash: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:outputs: ", p), err) }
   return err
 }
 
-func (p *HCC) String() string {
+func (p *TransactionData) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("HCC(%+v)", *p)
+  return fmt.Sprintf("TransactionData(%+v)", *p)
 }
 
 // Attributes:
-//  - Block
-//  - Epoch
-//  - Height
-//  - ID
-//  - Signature
-type Vote struct {
-  Block string `thrift:"Block,1" db:"Block" json:"Block"`
-  Epoch int32 `thrift:"Epoch,2" db:"Epoch" json:"Epoch"`
-  Height int32 `thrift:"Height,3" db:"Height" json:"Height"`
-  ID string `thrift:"ID,4" db:"ID" json:"ID"`
-  Signature string `thrift:"Signature,5" db:"Signature" json:"Signature"`
-}
-
-func NewVote() *Vote {
-  return &Vote{}
-}
-
-
-func (p *Vote) GetBlock() string {
-  return p.Block
-}
-
-func (p *Vote) GetEpoch() int32 {
-  return p.Epoch
+//  - TxHashes
+type PendingTransaction struct {
+  TxHashes []string `thrift:"tx_hashes,1" db:"tx_hashes" json:"tx_hashes"`
 }
 
-func (p *Vote) GetHeight() int32 {
-  return p.Height
+func NewPendingTransaction() *PendingTransaction {
+  return &PendingTransaction{}
 }
 
-func (p *Vote) GetID() string {
-  return p.ID
-}
 
-func (p *Vote) GetSignature() string {
-  return p.Signature
+func (p *PendingTransaction) GetTxHashes() []string {
+  return p.TxHashes
 }
-func (p *Vote) Read(iprot thrift.TProtocol) error {
+func (p *PendingTransaction) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -2911,7 +2973,7 @@ func (p *Vote) Read(iprot thrift.TProtocol) error {
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
     case 1:
-      if fieldTypeId == thrift.STRING {
+      if fieldTypeId == thrift.LIST {
         if err := p.ReadField1(iprot); err != nil {
           return err
         }
@@ -2920,46 +2982,6 @@ func (p *Vote) Read(iprot thrift.TProtocol) error {
           return err
         }
       }
-    case 2:
-      if fieldTypeId == thrift.I32 {
-        if err := p.ReadField2(iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(fieldTypeId); err != nil {
-          return err
-        }
-      }
-    case 3:
-      if fieldTypeId == thrift.I32 {
-        if err := p.ReadField3(iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(fieldTypeId); err != nil {
-          return err
-        }
-      }
-    case 4:
-      if fieldTypeId == thrift.STRING {
-        if err := p.ReadField4(iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(fieldTypeId); err != nil {
-          return err
-        }
-      }
-    case 5:
-      if fieldTypeId == thrift.STRING {
-        if err := p.ReadField5(iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(fieldTypeId); err != nil {
-          return err
-        }
-      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -2975,60 +2997,33 @@ func (p *Vote) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *Vote)  ReadField1(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 1: ", err)
-} else {
-  p.Block = v
-}
-  return nil
-}
-
-func (p *Vote)  ReadField2(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI32(); err != nil {
-  return thrift.PrependError("error reading field 2: ", err)
-} else {
-  p.Epoch = v
-}
-  return nil
-}
-
-func (p *Vote)  ReadField3(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI32(); err != nil {
-  return thrift.PrependError("error reading field 3: ", err)
-} else {
-  p.Height = v
-}
-  return nil
-}
-
-func (p *Vote)  ReadField4(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 4: ", err)
-} else {
-  p.ID = v
-}
-  return nil
-}
-
-func (p *Vote)  ReadField5(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 5: ", err)
+func (p *PendingTransaction)  ReadField1(iprot thrift.TProtocol) error {
+  _, size, err := iprot.ReadListBegin()
+  if err != nil {
+    return thrift.PrependError("error reading list begin: ", err)
+  }
+  tSlice := make([]string, 0, size)
+  p.TxHashes =  tSlice
+  for i := 0; i < size; i ++ {
+var _elem5 string
+    if v, err := iprot.ReadString(); err != nil {
+    return thrift.PrependError("error reading field 0: ", err)
 } else {
-  p.Signature = v
+    _elem5 = v
 }
+    p.TxHashes = append(p.TxHashes, _elem5)
+  }
+  if err := iprot.ReadListEnd(); err != nil {
+    return thrift.PrependError("error reading list end: ", err)
+  }
   return nil
 }
 
-func (p *Vote) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("Vote"); err != nil {
+func (p *PendingTransaction) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("PendingTransaction"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
-    if err := p.writeField2(oprot); err != nil { return err }
-    if err := p.writeField3(oprot); err != nil { return err }
-    if err := p.writeField4(oprot); err != nil { return err }
-    if err := p.writeField5(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -3037,78 +3032,59 @@ func (p *Vote) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *Vote) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("Block", thrift.STRING, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Block: ", p), err) }
-  if err := oprot.WriteString(string(p.Block)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.Block (1) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Block: ", p), err) }
-  return err
-}
-
-func (p *Vote) writeField2(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("Epoch", thrift.I32, 2); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Epoch: ", p), err) }
-  if err := oprot.WriteI32(int32(p.Epoch)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.Epoch (2) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Epoch: ", p), err) }
-  return err
-}
-
-func (p *Vote) writeField3(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("Height", thrift.I32, 3); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:Height: ", p), err) }
-  if err := oprot.WriteI32(int32(p.Height)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.Height (3) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:Height: ", p), err) }
-  return err
-}
-
-func (p *Vote) writeField4(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("ID", thrift.STRING, 4); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:ID: ", p), err) }
-  if err := oprot.WriteString(string(p.ID)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.ID (4) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 4:ID: ", p), err) }
-  return err
-}
-
-func (p *Vote) writeField5(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("Signature", thrift.STRING, 5); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:Signature: ", p), err) }
-  if err := oprot.WriteString(string(p.Signature)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.Signature (5) field write error: ", p), err) }
+func (p *PendingTransaction) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("tx_hashes", thrift.LIST, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:tx_hashes: ", p), err) }
+  if err := oprot.WriteListBegin(thrift.STRING, len(p.TxHashes)); err != nil {
+    return thrift.PrependError("error writing list begin: ", err)
+  }
+  for _, v := range p.TxHashes {
+    if err := oprot.WriteString(string(v)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err) }
+  }
+  if err := oprot.WriteListEnd(); err != nil {
+    return thrift.PrependError("error writing list end: ", err)
+  }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 5:Signature: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:tx_hashes: ", p), err) }
   return err
 }
 
-func (p *Vote) String() string {
+func (p *PendingTransaction) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("Vote(%+v)", *p)
+  return fmt.Sprintf("PendingTransaction(%+v)", *p)
 }
 
 // Attributes:
 //  - Hash
-type BroadcastRawTransactionAsync struct {
+//  - Block
+type BroadcastRawTransaction struct {
   Hash string `thrift:"hash,1" db:"hash" json:"hash"`
+  Block *TransactionBlock `thrift:"block,2" db:"block" json:"block"`
 }
 
-func NewBroadcastRawTransactionAsync() *BroadcastRawTransactionAsync {
-  return &BroadcastRawTransactionAsync{}
+func NewBroadcastRawTransaction() *BroadcastRawTransaction {
+  return &BroadcastRawTransaction{}
 }
 
 
-func (p *BroadcastRawTransactionAsync) GetHash() string {
+func (p *BroadcastRawTransaction) GetHash() string {
   return p.Hash
 }
-func (p *BroadcastRawTransactionAsync) Read(iprot thrift.TProtocol) error {
+var BroadcastRawTransaction_Block_DEFAULT *TransactionBlock
+func (p *BroadcastRawTransaction) GetBlock() *TransactionBlock {
+  if !p.IsSetBlock() {
+    return BroadcastRawTransaction_Block_DEFAULT
+  }
+return p.Block
+}
+func (p *BroadcastRawTransaction) IsSetBlock() bool {
+  return p.Block != nil
+}
+
+func (p *BroadcastRawTransaction) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -3131,6 +3107,16 @@ func (p *BroadcastRawTransactionAsync) Read(iprot thrift.TProtocol) error {
           return err
         }
       }
+    case 2:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField2(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -3146,7 +3132,7 @@ func (p *BroadcastRawTransactionAsync) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *BroadcastRawTransactionAsync)  ReadField1(iprot thrift.TProtocol) error {
+func (p *BroadcastRawTransaction)  ReadField1(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 1: ", err)
 } else {
@@ -3155,11 +3141,20 @@ func (p *BroadcastRawTransactionAsync)  ReadField1(iprot thrift.TProtocol) error
   return nil
 }
 
-func (p *BroadcastRawTransactionAsync) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("BroadcastRawTransactionAsync"); err != nil {
+func (p *BroadcastRawTransaction)  ReadField2(iprot thrift.TProtocol) error {
+  p.Block = &TransactionBlock{}
+  if err := p.Block.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Block), err)
+  }
+  return nil
+}
+
+func (p *BroadcastRawTransaction) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("BroadcastRawTransaction"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -3168,7 +3163,7 @@ func (p *BroadcastRawTransactionAsync) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *BroadcastRawTransactionAsync) writeField1(oprot thrift.TProtocol) (err error) {
+func (p *BroadcastRawTransaction) writeField1(oprot thrift.TProtocol) (err error) {
   if err := oprot.WriteFieldBegin("hash", thrift.STRING, 1); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:hash: ", p), err) }
   if err := oprot.WriteString(string(p.Hash)); err != nil {
@@ -3178,11 +3173,22 @@ func (p *BroadcastRawTransactionAsync) writeField1(oprot thrift.TProtocol) (err
   return err
 }
 
-func (p *BroadcastRawTransactionAsync) String() string {
+func (p *BroadcastRawTransaction) writeField2(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("block", thrift.STRUCT, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:block: ", p), err) }
+  if err := p.Block.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Block), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:block: ", p), err) }
+  return err
+}
+
+func (p *BroadcastRawTransaction) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("BroadcastRawTransactionAsync(%+v)", *p)
+  return fmt.Sprintf("BroadcastRawTransaction(%+v)", *p)
 }
 
 // Attributes:
@@ -3190,89 +3196,89 @@ func (p *BroadcastRawTransactionAsync) String() string {
 //  - Epoch
 //  - Height
 //  - Parent
-//  - TransactionsHash
+//  - HCC
+//  - TxHash
+//  - ReceiptHash
+//  - Bloom
 //  - StateHash
 //  - Timestamp
 //  - Proposer
-//  - Children
-//  - Status
-//  - Hash
-//  - Hcc
-type BlockHeader struct {
-  ChainID string `thrift:"chain_id,1" db:"chain_id" json:"chain_id"`
-  Epoch string `thrift:"epoch,2" db:"epoch" json:"epoch"`
-  Height string `thrift:"height,3" db:"height" json:"height"`
-  Parent string `thrift:"parent,4" db:"parent" json:"parent"`
-  TransactionsHash string `thrift:"transactions_hash,5" db:"transactions_hash" json:"transactions_hash"`
-  StateHash string `thrift:"state_hash,6" db:"state_hash" json:"state_hash"`
-  Timestamp string `thrift:"timestamp,7" db:"timestamp" json:"timestamp"`
-  Proposer string `thrift:"proposer,8" db:"proposer" json:"proposer"`
-  Children []string `thrift:"children,9" db:"children" json:"children"`
-  Status int32 `thrift:"status,10" db:"status" json:"status"`
-  Hash string `thrift:"hash,11" db:"hash" json:"hash"`
-  Hcc *HCC `thrift:"hcc,12" db:"hcc" json:"hcc"`
+//  - Signature
+type TransactionBlock struct {
+  ChainID string `thrift:"ChainID,1" db:"ChainID" json:"ChainID"`
+  Epoch int64 `thrift:"Epoch,2" db:"Epoch" json:"Epoch"`
+  Height int64 `thrift:"Height,3" db:"Height" json:"Height"`
+  Parent string `thrift:"Parent,4" db:"Parent" json:"Parent"`
+  HCC *HCC `thrift:"HCC,5" db:"HCC" json:"HCC"`
+  TxHash string `thrift:"TxHash,6" db:"TxHash" json:"TxHash"`
+  ReceiptHash string `thrift:"ReceiptHash,7" db:"ReceiptHash" json:"ReceiptHash"`
+  Bloom string `thrift:"Bloom,8" db:"Bloom" json:"Bloom"`
+  StateHash string `thrift:"StateHash,9" db:"StateHash" json:"StateHash"`
+  Timestamp int64 `thrift:"Timestamp,10" db:"Timestamp" json:"Timestamp"`
+  Proposer string `thrift:"Proposer,11" db:"Proposer" json:"Proposer"`
+  Signature string `thrift:"Signature,12" db:"Signature" json:"Signature"`
 }
 
-func NewBlockHeader() *BlockHeader {
-  return &BlockHeader{}
+func NewTransactionBlock() *TransactionBlock {
+  return &TransactionBlock{}
 }
 
 
-func (p *BlockHeader) GetChainID() string {
+func (p *TransactionBlock) GetChainID() string {
   return p.ChainID
 }
 
-func (p *BlockHeader) GetEpoch() string {
+func (p *TransactionBlock) GetEpoch() int64 {
   return p.Epoch
 }
 
-func (p *BlockHeader) GetHeight() string {
+func (p *TransactionBlock) GetHeight() int64 {
   return p.Height
 }
 
-func (p *BlockHeader) GetParent() string {
+func (p *TransactionBlock) GetParent() string {
   return p.Parent
 }
-
-func (p *BlockHeader) GetTransactionsHash() string {
-  return p.TransactionsHash
+var TransactionBlock_HCC_DEFAULT *HCC
+func (p *TransactionBlock) GetHCC() *HCC {
+  if !p.IsSetHCC() {
+    return TransactionBlock_HCC_DEFAULT
+  }
+return p.HCC
 }
 
-func (p *BlockHeader) GetStateHash() string {
-  return p.StateHash
+func (p *TransactionBlock) GetTxHash() string {
+  return p.TxHash
 }
 
-func (p *BlockHeader) GetTimestamp() string {
-  return p.Timestamp
+func (p *TransactionBlock) GetReceiptHash() string {
+  return p.ReceiptHash
 }
 
-func (p *BlockHeader) GetProposer() string {
-  return p.Proposer
+func (p *TransactionBlock) GetBloom() string {
+  return p.Bloom
 }
 
-func (p *BlockHeader) GetChildren() []string {
-  return p.Children
+func (p *TransactionBlock) GetStateHash() string {
+  return p.StateHash
 }
 
-func (p *BlockHeader) GetStatus() int32 {
-  return p.Status
+func (p *TransactionBlock) GetTimestamp() int64 {
+  return p.Timestamp
 }
 
-func (p *BlockHeader) GetHash() string {
-  return p.Hash
+func (p *TransactionBlock) GetProposer() string {
+  return p.Proposer
 }
-var BlockHeader_Hcc_DEFAULT *HCC
-func (p *BlockHeader) GetHcc() *HCC {
-  if !p.IsSetHcc() {
-    return BlockHeader_Hcc_DEFAULT
-  }
-return p.Hcc
+
+func (p *TransactionBlock) GetSignature() string {
+  return p.Signature
 }
-func (p *BlockHeader) IsSetHcc() bool {
-  return p.Hcc != nil
+func (p *TransactionBlock) IsSetHCC() bool {
+  return p.HCC != nil
 }
 
-func (p *BlockHeader) Read(iprot thrift.TProtocol) error {
+func (p *TransactionBlock) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -3296,7 +3302,7 @@ func (p *BlockHeader) Read(iprot thrift.TProtocol) error {
         }
       }
     case 2:
-      if fieldTypeId == thrift.STRING {
+      if fieldTypeId == thrift.I64 {
         if err := p.ReadField2(iprot); err != nil {
           return err
         }
@@ -3306,7 +3312,7 @@ func (p *BlockHeader) Read(iprot thrift.TProtocol) error {
         }
       }
     case 3:
-      if fieldTypeId == thrift.STRING {
+      if fieldTypeId == thrift.I64 {
         if err := p.ReadField3(iprot); err != nil {
           return err
         }
@@ -3326,7 +3332,7 @@ func (p *BlockHeader) Read(iprot thrift.TProtocol) error {
         }
       }
     case 5:
-      if fieldTypeId == thrift.STRING {
+      if fieldTypeId == thrift.STRUCT {
         if err := p.ReadField5(iprot); err != nil {
           return err
         }
@@ -3366,7 +3372,7 @@ func (p *BlockHeader) Read(iprot thrift.TProtocol) error {
         }
       }
     case 9:
-      if fieldTypeId == thrift.LIST {
+      if fieldTypeId == thrift.STRING {
         if err := p.ReadField9(iprot); err != nil {
           return err
         }
@@ -3376,7 +3382,7 @@ func (p *BlockHeader) Read(iprot thrift.TProtocol) error {
         }
       }
     case 10:
-      if fieldTypeId == thrift.I32 {
+      if fieldTypeId == thrift.I64 {
         if err := p.ReadField10(iprot); err != nil {
           return err
         }
@@ -3396,7 +3402,7 @@ func (p *BlockHeader) Read(iprot thrift.TProtocol) error {
         }
       }
     case 12:
-      if fieldTypeId == thrift.STRUCT {
+      if fieldTypeId == thrift.STRING {
         if err := p.ReadField12(iprot); err != nil {
           return err
         }
@@ -3420,7 +3426,7 @@ func (p *BlockHeader) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *BlockHeader)  ReadField1(iprot thrift.TProtocol) error {
+func (p *TransactionBlock)  ReadField1(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 1: ", err)
 } else {
@@ -3429,8 +3435,8 @@ func (p *BlockHeader)  ReadField1(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *BlockHeader)  ReadField2(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
+func (p *TransactionBlock)  ReadField2(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
   return thrift.PrependError("error reading field 2: ", err)
 } else {
   p.Epoch = v
@@ -3438,8 +3444,8 @@ func (p *BlockHeader)  ReadField2(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *BlockHeader)  ReadField3(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
+func (p *TransactionBlock)  ReadField3(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
   return thrift.PrependError("error reading field 3: ", err)
 } else {
   p.Height = v
@@ -3447,7 +3453,7 @@ func (p *BlockHeader)  ReadField3(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *BlockHeader)  ReadField4(iprot thrift.TProtocol) error {
+func (p *TransactionBlock)  ReadField4(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 4: ", err)
 } else {
@@ -3456,92 +3462,79 @@ func (p *BlockHeader)  ReadField4(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *BlockHeader)  ReadField5(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 5: ", err)
-} else {
-  p.TransactionsHash = v
-}
+func (p *TransactionBlock)  ReadField5(iprot thrift.TProtocol) error {
+  p.HCC = &HCC{}
+  if err := p.HCC.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.HCC), err)
+  }
   return nil
 }
 
-func (p *BlockHeader)  ReadField6(iprot thrift.TProtocol) error {
+func (p *TransactionBlock)  ReadField6(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 6: ", err)
 } else {
-  p.StateHash = v
+  p.TxHash = v
 }
   return nil
 }
 
-func (p *BlockHeader)  ReadField7(iprot thrift.TProtocol) error {
+func (p *TransactionBlock)  ReadField7(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 7: ", err)
 } else {
-  p.Timestamp = v
+  p.ReceiptHash = v
 }
   return nil
 }
 
-func (p *BlockHeader)  ReadField8(iprot thrift.TProtocol) error {
+func (p *TransactionBlock)  ReadField8(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 8: ", err)
 } else {
-  p.Proposer = v
+  p.Bloom = v
 }
   return nil
 }
 
-func (p *BlockHeader)  ReadField9(iprot thrift.TProtocol) error {
-  _, size, err := iprot.ReadListBegin()
-  if err != nil {
-    return thrift.PrependError("error reading list begin: ", err)
-  }
-  tSlice := make([]string, 0, size)
-  p.Children =  tSlice
-  for i := 0; i < size; i ++ {
-var _elem5 string
-    if v, err := iprot.ReadString(); err != nil {
-    return thrift.PrependError("error reading field 0: ", err)
+func (p *TransactionBlock)  ReadField9(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 9: ", err)
 } else {
-    _elem5 = v
+  p.StateHash = v
 }
-    p.Children = append(p.Children, _elem5)
-  }
-  if err := iprot.ReadListEnd(); err != nil {
-    return thrift.PrependError("error reading list end: ", err)
-  }
   return nil
 }
 
-func (p *BlockHeader)  ReadField10(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI32(); err != nil {
+func (p *TransactionBlock)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
   return thrift.PrependError("error reading field 10: ", err)
 } else {
-  p.Status = v
+  p.Timestamp = v
 }
   return nil
 }
 
-func (p *BlockHeader)  ReadField11(iprot thrift.TProtocol) error {
+func (p *TransactionBlock)  ReadField11(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 11: ", err)
 } else {
-  p.Hash = v
+  p.Proposer = v
 }
   return nil
 }
 
-func (p *BlockHeader)  ReadField12(iprot thrift.TProtocol) error {
-  p.Hcc = &HCC{}
-  if err := p.Hcc.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Hcc), err)
-  }
+func (p *TransactionBlock)  ReadField12(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 12: ", err)
+} else {
+  p.Signature = v
+}
   return nil
 }
 
-func (p *BlockHeader) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("BlockHeader"); err != nil {
+func (p *TransactionBlock) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("TransactionBlock"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -3564,236 +3557,320 @@ func (p *BlockHeader) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *BlockHeader) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("chain_id", thrift.STRING, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:chain_id: ", p), err) }
+func (p *TransactionBlock) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("ChainID", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:ChainID: ", p), err) }
   if err := oprot.WriteString(string(p.ChainID)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.chain_id (1) field write error: ", p), err) }
+  return thrift.PrependError(fmt.Sprintf("%T.ChainID (1) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:chain_id: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:ChainID: ", p), err) }
   return err
 }
 
-func (p *BlockHeader) writeField2(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("epoch", thrift.STRING, 2); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:epoch: ", p), err) }
-  if err := oprot.WriteString(string(p.Epoch)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.epoch (2) field write error: ", p), err) }
+func (p *TransactionBlock) writeField2(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("Epoch", thrift.I64, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Epoch: ", p), err) }
+  if err := oprot.WriteI64(int64(p.Epoch)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.Epoch (2) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:epoch: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Epoch: ", p), err) }
   return err
 }
 
-func (p *BlockHeader) writeField3(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("height", thrift.STRING, 3); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:height: ", p), err) }
-  if err := oprot.WriteString(string(p.Height)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.height (3) field write error: ", p), err) }
+func (p *TransactionBlock) writeField3(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("Height", thrift.I64, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:Height: ", p), err) }
+  if err := oprot.WriteI64(int64(p.Height)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.Height (3) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:height: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:Height: ", p), err) }
   return err
 }
 
-func (p *BlockHeader) writeField4(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("parent", thrift.STRING, 4); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:parent: ", p), err) }
+func (p *TransactionBlock) writeField4(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("Parent", thrift.STRING, 4); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:Parent: ", p), err) }
   if err := oprot.WriteString(string(p.Parent)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.parent (4) field write error: ", p), err) }
+  return thrift.PrependError(fmt.Sprintf("%T.Parent (4) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 4:parent: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 4:Parent: ", p), err) }
   return err
 }
 
-func (p *BlockHeader) writeField5(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("transactions_hash", thrift.STRING, 5); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:transactions_hash: ", p), err) }
-  if err := oprot.WriteString(string(p.TransactionsHash)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.transactions_hash (5) field write error: ", p), err) }
+func (p *TransactionBlock) writeField5(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("HCC", thrift.STRUCT, 5); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:HCC: ", p), err) }
+  if err := p.HCC.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.HCC), err)
+  }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 5:transactions_hash: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 5:HCC: ", p), err) }
   return err
 }
 
-func (p *BlockHeader) writeField6(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("state_hash", thrift.STRING, 6); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:state_hash: ", p), err) }
-  if err := oprot.WriteString(string(p.StateHash)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.state_hash (6) field write error: ", p), err) }
+func (p *TransactionBlock) writeField6(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("TxHash", thrift.STRING, 6); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:TxHash: ", p), err) }
+  if err := oprot.WriteString(string(p.TxHash)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.TxHash (6) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 6:state_hash: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 6:TxHash: ", p), err) }
   return err
 }
 
-func (p *BlockHeader) writeField7(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("timestamp", thrift.STRING, 7); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:timestamp: ", p), err) }
-  if err := oprot.WriteString(string(p.Timestamp)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.timestamp (7) field write error: ", p), err) }
+func (p *TransactionBlock) writeField7(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("ReceiptHash", thrift.STRING, 7); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:ReceiptHash: ", p), err) }
+  if err := oprot.WriteString(string(p.ReceiptHash)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.ReceiptHash (7) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 7:timestamp: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 7:ReceiptHash: ", p), err) }
   return err
 }
 
-func (p *BlockHeader) writeField8(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("proposer", thrift.STRING, 8); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:proposer: ", p), err) }
-  if err := oprot.WriteString(string(p.Proposer)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.proposer (8) field write error: ", p), err) }
+func (p *TransactionBlock) writeField8(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("Bloom", thrift.STRING, 8); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:Bloom: ", p), err) }
+  if err := oprot.WriteString(string(p.Bloom)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.Bloom (8) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 8:proposer: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 8:Bloom: ", p), err) }
   return err
 }
 
-func (p *BlockHeader) writeField9(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("children", thrift.LIST, 9); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 9:children: ", p), err) }
-  if err := oprot.WriteListBegin(thrift.STRING, len(p.Children)); err != nil {
-    return thrift.PrependError("error writing list begin: ", err)
-  }
-  for _, v := range p.Children {
-    if err := oprot.WriteString(string(v)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err) }
-  }
-  if err := oprot.WriteListEnd(); err != nil {
-    return thrift.PrependError("error writing list end: ", err)
-  }
+func (p *TransactionBlock) writeField9(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("StateHash", thrift.STRING, 9); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 9:StateHash: ", p), err) }
+  if err := oprot.WriteString(string(p.StateHash)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.StateHash (9) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 9:children: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 9:StateHash: ", p), err) }
   return err
 }
 
-func (p *BlockHeader) writeField10(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("status", thrift.I32, 10); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:status: ", p), err) }
-  if err := oprot.WriteI32(int32(p.Status)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.status (10) field write error: ", p), err) }
+func (p *TransactionBlock) writeField10(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("Timestamp", thrift.I64, 10); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:Timestamp: ", p), err) }
+  if err := oprot.WriteI64(int64(p.Timestamp)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.Timestamp (10) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 10:status: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 10:Timestamp: ", p), err) }
   return err
 }
 
-func (p *BlockHeader) writeField11(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("hash", thrift.STRING, 11); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 11:hash: ", p), err) }
-  if err := oprot.WriteString(string(p.Hash)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.hash (11) field write error: ", p), err) }
+func (p *TransactionBlock) writeField11(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("Proposer", thrift.STRING, 11); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 11:Proposer: ", p), err) }
+  if err := oprot.WriteString(string(p.Proposer)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.Proposer (11) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 11:hash: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 11:Proposer: ", p), err) }
   return err
 }
 
-func (p *BlockHeader) writeField12(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("hcc", thrift.STRUCT, 12); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 12:hcc: ", p), err) }
-  if err := p.Hcc.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Hcc), err)
-  }
+func (p *TransactionBlock) writeField12(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("Signature", thrift.STRING, 12); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 12:Signature: ", p), err) }
+  if err := oprot.WriteString(string(p.Signature)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.Signature (12) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 12:hcc: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 12:Signature: ", p), err) }
   return err
 }
 
-func (p *BlockHeader) String() string {
+func (p *TransactionBlock) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("BlockHeader(%+v)", *p)
+  return fmt.Sprintf("TransactionBlock(%+v)", *p)
 }
 
 // Attributes:
-//  - ChainID
-//  - Epoch
-//  - Height
-//  - Parent
-//  - TransactionsHash
-//  - StateHash
-//  - Timestamp
-//  - Proposer
-//  - Children
-//  - Status
-//  - Hash
-//  - Transactions
-//  - Hcc
-type Block struct {
-  ChainID string `thrift:"chain_id,1" db:"chain_id" json:"chain_id"`
-  Epoch string `thrift:"epoch,2" db:"epoch" json:"epoch"`
-  Height string `thrift:"height,3" db:"height" json:"height"`
-  Parent string `thrift:"parent,4" db:"parent" json:"parent"`
-  TransactionsHash string `thrift:"transactions_hash,5" db:"transactions_hash" json:"transactions_hash"`
-  StateHash string `thrift:"state_hash,6" db:"state_hash" json:"state_hash"`
-  Timestamp string `thrift:"timestamp,7" db:"timestamp" json:"timestamp"`
-  Proposer string `thrift:"proposer,8" db:"proposer" json:"proposer"`
-  Children []string `thrift:"children,9" db:"children" json:"children"`
-  Status int32 `thrift:"status,10" db:"status" json:"status"`
-  Hash string `thrift:"hash,11" db:"hash" json:"hash"`
-  Transactions []*TransactionInBlock `thrift:"transactions,12" db:"transactions" json:"transactions"`
-  Hcc *HCC `thrift:"hcc,13" db:"hcc" json:"hcc"`
+//  - Votes
+//  - BlockHash
+type HCC struct {
+  Votes []*Vote `thrift:"Votes,1" db:"Votes" json:"Votes"`
+  BlockHash string `thrift:"BlockHash,2" db:"BlockHash" json:"BlockHash"`
 }
 
-func NewBlock() *Block {
-  return &Block{}
+func NewHCC() *HCC {
+  return &HCC{}
 }
 
 
-func (p *Block) GetChainID() string {
-  return p.ChainID
+func (p *HCC) GetVotes() []*Vote {
+  return p.Votes
 }
 
-func (p *Block) GetEpoch() string {
-  return p.Epoch
+func (p *HCC) GetBlockHash() string {
+  return p.BlockHash
 }
+func (p *HCC) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
 
-func (p *Block) GetHeight() string {
-  return p.Height
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.LIST {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 2:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField2(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
 }
 
-func (p *Block) GetParent() string {
-  return p.Parent
+func (p *HCC)  ReadField1(iprot thrift.TProtocol) error {
+  _, size, err := iprot.ReadListBegin()
+  if err != nil {
+    return thrift.PrependError("error reading list begin: ", err)
+  }
+  tSlice := make([]*Vote, 0, size)
+  p.Votes =  tSlice
+  for i := 0; i < size; i ++ {
+    _elem6 := &Vote{}
+    if err := _elem6.Read(iprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem6), err)
+    }
+    p.Votes = append(p.Votes, _elem6)
+  }
+  if err := iprot.ReadListEnd(); err != nil {
+    return thrift.PrependError("error reading list end: ", err)
+  }
+  return nil
 }
 
-func (p *Block) GetTransactionsHash() string {
-  return p.TransactionsHash
+func (p *HCC)  ReadField2(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.BlockHash = v
+}
+  return nil
 }
 
-func (p *Block) GetStateHash() string {
-  return p.StateHash
+func (p *HCC) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("HCC"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
 }
 
-func (p *Block) GetTimestamp() string {
-  return p.Timestamp
+func (p *HCC) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("Votes", thrift.LIST, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Votes: ", p), err) }
+  if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Votes)); err != nil {
+    return thrift.PrependError("error writing list begin: ", err)
+  }
+  for _, v := range p.Votes {
+    if err := v.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+    }
+  }
+  if err := oprot.WriteListEnd(); err != nil {
+    return thrift.PrependError("error writing list end: ", err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Votes: ", p), err) }
+  return err
 }
 
-func (p *Block) GetProposer() string {
-  return p.Proposer
+func (p *HCC) writeField2(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("BlockHash", thrift.STRING, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:BlockHash: ", p), err) }
+  if err := oprot.WriteString(string(p.BlockHash)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.BlockHash (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:BlockHash: ", p), err) }
+  return err
 }
 
-func (p *Block) GetChildren() []string {
-  return p.Children
+func (p *HCC) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("HCC(%+v)", *p)
 }
 
-func (p *Block) GetStatus() int32 {
-  return p.Status
+// Attributes:
+//  - Block
+//  - Epoch
+//  - Height
+//  - ID
+//  - Signature
+type Vote struct {
+  Block string `thrift:"Block,1" db:"Block" json:"Block"`
+  Epoch int32 `thrift:"Epoch,2" db:"Epoch" json:"Epoch"`
+  Height int32 `thrift:"Height,3" db:"Height" json:"Height"`
+  ID string `thrift:"ID,4" db:"ID" json:"ID"`
+  Signature string `thrift:"Signature,5" db:"Signature" json:"Signature"`
 }
 
-func (p *Block) GetHash() string {
-  return p.Hash
+func NewVote() *Vote {
+  return &Vote{}
 }
 
-func (p *Block) GetTransactions() []*TransactionInBlock {
-  return p.Transactions
+
+func (p *Vote) GetBlock() string {
+  return p.Block
 }
-var Block_Hcc_DEFAULT *HCC
-func (p *Block) GetHcc() *HCC {
-  if !p.IsSetHcc() {
-    return Block_Hcc_DEFAULT
-  }
-return p.Hcc
+
+func (p *Vote) GetEpoch() int32 {
+  return p.Epoch
 }
-func (p *Block) IsSetHcc() bool {
-  return p.Hcc != nil
+
+func (p *Vote) GetHeight() int32 {
+  return p.Height
 }
 
-func (p *Block) Read(iprot thrift.TProtocol) error {
+func (p *Vote) GetID() string {
+  return p.ID
+}
+
+func (p *Vote) GetSignature() string {
+  return p.Signature
+}
+func (p *Vote) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -3817,7 +3894,7 @@ func (p *Block) Read(iprot thrift.TProtocol) error {
         }
       }
     case 2:
-      if fieldTypeId == thrift.STRING {
+      if fieldTypeId == thrift.I32 {
         if err := p.ReadField2(iprot); err != nil {
           return err
         }
@@ -3827,7 +3904,7 @@ func (p *Block) Read(iprot thrift.TProtocol) error {
         }
       }
     case 3:
-      if fieldTypeId == thrift.STRING {
+      if fieldTypeId == thrift.I32 {
         if err := p.ReadField3(iprot); err != nil {
           return err
         }
@@ -3856,86 +3933,6 @@ func (p *Block) Read(iprot thrift.TProtocol) error {
           return err
         }
       }
-    case 6:
-      if fieldTypeId == thrift.STRING {
-        if err := p.ReadField6(iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(fieldTypeId); err != nil {
-          return err
-        }
-      }
-    case 7:
-      if fieldTypeId == thrift.STRING {
-        if err := p.ReadField7(iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(fieldTypeId); err != nil {
-          return err
-        }
-      }
-    case 8:
-      if fieldTypeId == thrift.STRING {
-        if err := p.ReadField8(iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(fieldTypeId); err != nil {
-          return err
-        }
-      }
-    case 9:
-      if fieldTypeId == thrift.LIST {
-        if err := p.ReadField9(iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(fieldTypeId); err != nil {
-          return err
-        }
-      }
-    case 10:
-      if fieldTypeId == thrift.I32 {
-        if err := p.ReadField10(iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(fieldTypeId); err != nil {
-          return err
-        }
-      }
-    case 11:
-      if fieldTypeId == thrift.STRING {
-        if err := p.ReadField11(iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(fieldTypeId); err != nil {
-          return err
-        }
-      }
-    case 12:
-      if fieldTypeId == thrift.LIST {
-        if err := p.ReadField12(iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(fieldTypeId); err != nil {
-          return err
-        }
-      }
-    case 13:
-      if fieldTypeId == thrift.STRUCT {
-        if err := p.ReadField13(iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(fieldTypeId); err != nil {
-          return err
-        }
-      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -3951,17 +3948,17 @@ func (p *Block) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *Block)  ReadField1(iprot thrift.TProtocol) error {
+func (p *Vote)  ReadField1(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 1: ", err)
 } else {
-  p.ChainID = v
+  p.Block = v
 }
   return nil
 }
 
-func (p *Block)  ReadField2(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
+func (p *Vote)  ReadField2(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
   return thrift.PrependError("error reading field 2: ", err)
 } else {
   p.Epoch = v
@@ -3969,8 +3966,8 @@ func (p *Block)  ReadField2(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *Block)  ReadField3(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
+func (p *Vote)  ReadField3(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
   return thrift.PrependError("error reading field 3: ", err)
 } else {
   p.Height = v
@@ -3978,121 +3975,26 @@ func (p *Block)  ReadField3(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *Block)  ReadField4(iprot thrift.TProtocol) error {
+func (p *Vote)  ReadField4(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 4: ", err)
 } else {
-  p.Parent = v
+  p.ID = v
 }
   return nil
 }
 
-func (p *Block)  ReadField5(iprot thrift.TProtocol) error {
+func (p *Vote)  ReadField5(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 5: ", err)
 } else {
-  p.TransactionsHash = v
-}
-  return nil
-}
-
-func (p *Block)  ReadField6(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 6: ", err)
-} else {
-  p.StateHash = v
-}
-  return nil
-}
-
-func (p *Block)  ReadField7(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 7: ", err)
-} else {
-  p.Timestamp = v
-}
-  return nil
-}
-
-func (p *Block)  ReadField8(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 8: ", err)
-} else {
-  p.Proposer = v
-}
-  return nil
-}
-
-func (p *Block)  ReadField9(iprot thrift.TProtocol) error {
-  _, size, err := iprot.ReadListBegin()
-  if err != nil {
-    return thrift.PrependError("error reading list begin: ", err)
-  }
-  tSlice := make([]string, 0, size)
-  p.Children =  tSlice
-  for i := 0; i < size; i ++ {
-var _elem6 string
-    if v, err := iprot.ReadString(); err != nil {
-    return thrift.PrependError("error reading field 0: ", err)
-} else {
-    _elem6 = v
-}
-    p.Children = append(p.Children, _elem6)
-  }
-  if err := iprot.ReadListEnd(); err != nil {
-    return thrift.PrependError("error reading list end: ", err)
-  }
-  return nil
-}
-
-func (p *Block)  ReadField10(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI32(); err != nil {
-  return thrift.PrependError("error reading field 10: ", err)
-} else {
-  p.Status = v
-}
-  return nil
-}
-
-func (p *Block)  ReadField11(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 11: ", err)
-} else {
-  p.Hash = v
-}
-  return nil
-}
-
-func (p *Block)  ReadField12(iprot thrift.TProtocol) error {
-  _, size, err := iprot.ReadListBegin()
-  if err != nil {
-    return thrift.PrependError("error reading list begin: ", err)
-  }
-  tSlice := make([]*TransactionInBlock, 0, size)
-  p.Transactions =  tSlice
-  for i := 0; i < size; i ++ {
-    _elem7 := &TransactionInBlock{}
-    if err := _elem7.Read(iprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem7), err)
-    }
-    p.Transactions = append(p.Transactions, _elem7)
-  }
-  if err := iprot.ReadListEnd(); err != nil {
-    return thrift.PrependError("error reading list end: ", err)
-  }
-  return nil
+  p.Signature = v
 }
-
-func (p *Block)  ReadField13(iprot thrift.TProtocol) error {
-  p.Hcc = &HCC{}
-  if err := p.Hcc.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Hcc), err)
-  }
   return nil
 }
 
-func (p *Block) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("Block"); err != nil {
+func (p *Vote) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("Vote"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -4100,14 +4002,6 @@ func (p *Block) Write(oprot thrift.TProtocol) error {
     if err := p.writeField3(oprot); err != nil { return err }
     if err := p.writeField4(oprot); err != nil { return err }
     if err := p.writeField5(oprot); err != nil { return err }
-    if err := p.writeField6(oprot); err != nil { return err }
-    if err := p.writeField7(oprot); err != nil { return err }
-    if err := p.writeField8(oprot); err != nil { return err }
-    if err := p.writeField9(oprot); err != nil { return err }
-    if err := p.writeField10(oprot); err != nil { return err }
-    if err := p.writeField11(oprot); err != nil { return err }
-    if err := p.writeField12(oprot); err != nil { return err }
-    if err := p.writeField13(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -4116,309 +4010,242 @@ func (p *Block) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *Block) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("chain_id", thrift.STRING, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:chain_id: ", p), err) }
-  if err := oprot.WriteString(string(p.ChainID)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.chain_id (1) field write error: ", p), err) }
+func (p *Vote) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("Block", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:Block: ", p), err) }
+  if err := oprot.WriteString(string(p.Block)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.Block (1) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:chain_id: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:Block: ", p), err) }
   return err
 }
 
-func (p *Block) writeField2(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("epoch", thrift.STRING, 2); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:epoch: ", p), err) }
-  if err := oprot.WriteString(string(p.Epoch)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.epoch (2) field write error: ", p), err) }
+func (p *Vote) writeField2(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("Epoch", thrift.I32, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:Epoch: ", p), err) }
+  if err := oprot.WriteI32(int32(p.Epoch)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.Epoch (2) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:epoch: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:Epoch: ", p), err) }
   return err
 }
 
-func (p *Block) writeField3(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("height", thrift.STRING, 3); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:height: ", p), err) }
-  if err := oprot.WriteString(string(p.Height)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.height (3) field write error: ", p), err) }
+func (p *Vote) writeField3(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("Height", thrift.I32, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:Height: ", p), err) }
+  if err := oprot.WriteI32(int32(p.Height)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.Height (3) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:height: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:Height: ", p), err) }
   return err
 }
 
-func (p *Block) writeField4(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("parent", thrift.STRING, 4); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:parent: ", p), err) }
-  if err := oprot.WriteString(string(p.Parent)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.parent (4) field write error: ", p), err) }
+func (p *Vote) writeField4(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("ID", thrift.STRING, 4); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:ID: ", p), err) }
+  if err := oprot.WriteString(string(p.ID)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.ID (4) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 4:parent: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 4:ID: ", p), err) }
   return err
 }
 
-func (p *Block) writeField5(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("transactions_hash", thrift.STRING, 5); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:transactions_hash: ", p), err) }
-  if err := oprot.WriteString(string(p.TransactionsHash)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.transactions_hash (5) field write error: ", p), err) }
+func (p *Vote) writeField5(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("Signature", thrift.STRING, 5); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:Signature: ", p), err) }
+  if err := oprot.WriteString(string(p.Signature)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.Signature (5) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 5:transactions_hash: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 5:Signature: ", p), err) }
   return err
 }
 
-func (p *Block) writeField6(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("state_hash", thrift.STRING, 6); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:state_hash: ", p), err) }
-  if err := oprot.WriteString(string(p.StateHash)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.state_hash (6) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 6:state_hash: ", p), err) }
-  return err
+func (p *Vote) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("Vote(%+v)", *p)
 }
 
-func (p *Block) writeField7(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("timestamp", thrift.STRING, 7); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:timestamp: ", p), err) }
-  if err := oprot.WriteString(string(p.Timestamp)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.timestamp (7) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 7:timestamp: ", p), err) }
-  return err
+// Attributes:
+//  - Hash
+type BroadcastRawTransactionAsync struct {
+  Hash string `thrift:"hash,1" db:"hash" json:"hash"`
 }
 
-func (p *Block) writeField8(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("proposer", thrift.STRING, 8); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:proposer: ", p), err) }
-  if err := oprot.WriteString(string(p.Proposer)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.proposer (8) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 8:proposer: ", p), err) }
-  return err
+func NewBroadcastRawTransactionAsync() *BroadcastRawTransactionAsync {
+  return &BroadcastRawTransactionAsync{}
 }
 
-func (p *Block) writeField9(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("children", thrift.LIST, 9); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 9:children: ", p), err) }
-  if err := oprot.WriteListBegin(thrift.STRING, len(p.Children)); err != nil {
-    return thrift.PrependError("error writing list begin: ", err)
+
+func (p *BroadcastRawTransactionAsync) GetHash() string {
+  return p.Hash
+}
+func (p *BroadcastRawTransactionAsync) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
-  for _, v := range p.Children {
-    if err := oprot.WriteString(string(v)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err) }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
   }
-  if err := oprot.WriteListEnd(); err != nil {
-    return thrift.PrependError("error writing list end: ", err)
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
   }
-  if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 9:children: ", p), err) }
-  return err
+  return nil
 }
 
-func (p *Block) writeField10(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("status", thrift.I32, 10); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:status: ", p), err) }
-  if err := oprot.WriteI32(int32(p.Status)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.status (10) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 10:status: ", p), err) }
-  return err
+func (p *BroadcastRawTransactionAsync)  ReadField1(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.Hash = v
 }
-
-func (p *Block) writeField11(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("hash", thrift.STRING, 11); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 11:hash: ", p), err) }
-  if err := oprot.WriteString(string(p.Hash)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.hash (11) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 11:hash: ", p), err) }
-  return err
+  return nil
 }
 
-func (p *Block) writeField12(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("transactions", thrift.LIST, 12); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 12:transactions: ", p), err) }
-  if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Transactions)); err != nil {
-    return thrift.PrependError("error writing list begin: ", err)
-  }
-  for _, v := range p.Transactions {
-    if err := v.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
-    }
-  }
-  if err := oprot.WriteListEnd(); err != nil {
-    return thrift.PrependError("error writing list end: ", err)
+func (p *BroadcastRawTransactionAsync) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("BroadcastRawTransactionAsync"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
   }
-  if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 12:transactions: ", p), err) }
-  return err
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
 }
 
-func (p *Block) writeField13(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("hcc", thrift.STRUCT, 13); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 13:hcc: ", p), err) }
-  if err := p.Hcc.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Hcc), err)
-  }
+func (p *BroadcastRawTransactionAsync) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("hash", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:hash: ", p), err) }
+  if err := oprot.WriteString(string(p.Hash)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.hash (1) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 13:hcc: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:hash: ", p), err) }
   return err
 }
 
-func (p *Block) String() string {
+func (p *BroadcastRawTransactionAsync) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("Block(%+v)", *p)
+  return fmt.Sprintf("BroadcastRawTransactionAsync(%+v)", *p)
 }
 
 // Attributes:
-//  - Thetawei
-//  - Tfuelwei
-type Coin struct {
-  Thetawei string `thrift:"thetawei,1" db:"thetawei" json:"thetawei"`
-  Tfuelwei string `thrift:"tfuelwei,2" db:"tfuelwei" json:"tfuelwei"`
+//  - ChainID
+//  - Epoch
+//  - Height
+//  - Parent
+//  - TransactionsHash
+//  - StateHash
+//  - Timestamp
+//  - Proposer
+//  - Children
+//  - Status
+//  - Hash
+//  - Hcc
+type BlockHeader struct {
+  ChainID string `thrift:"chain_id,1" db:"chain_id" json:"chain_id"`
+  Epoch string `thrift:"epoch,2" db:"epoch" json:"epoch"`
+  Height string `thrift:"height,3" db:"height" json:"height"`
+  Parent string `thrift:"parent,4" db:"parent" json:"parent"`
+  TransactionsHash string `thrift:"transactions_hash,5" db:"transactions_hash" json:"transactions_hash"`
+  StateHash string `thrift:"state_hash,6" db:"state_hash" json:"state_hash"`
+  Timestamp string `thrift:"timestamp,7" db:"timestamp" json:"timestamp"`
+  Proposer string `thrift:"proposer,8" db:"proposer" json:"proposer"`
+  Children []string `thrift:"children,9" db:"children" json:"children"`
+  Status int32 `thrift:"status,10" db:"status" json:"status"`
+  Hash string `thrift:"hash,11" db:"hash" json:"hash"`
+  Hcc *HCC `thrift:"hcc,12" db:"hcc" json:"hcc"`
 }
 
-func NewCoin() *Coin {
-  return &Coin{}
+func NewBlockHeader() *BlockHeader {
+  return &BlockHeader{}
 }
 
 
-func (p *Coin) GetThetawei() string {
-  return p.Thetawei
+func (p *BlockHeader) GetChainID() string {
+  return p.ChainID
 }
 
-func (p *Coin) GetTfuelwei() string {
-  return p.Tfuelwei
+func (p *BlockHeader) GetEpoch() string {
+  return p.Epoch
 }
-func (p *Coin) Read(iprot thrift.TProtocol) error {
-  if _, err := iprot.ReadStructBegin(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
-  }
-
 
-  for {
-    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
-    if err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
-    }
-    if fieldTypeId == thrift.STOP { break; }
-    switch fieldId {
-    case 1:
-      if fieldTypeId == thrift.STRING {
-        if err := p.ReadField1(iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(fieldTypeId); err != nil {
-          return err
-        }
-      }
-    case 2:
-      if fieldTypeId == thrift.STRING {
-        if err := p.ReadField2(iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(fieldTypeId); err != nil {
-          return err
-        }
-      }
-    default:
-      if err := iprot.Skip(fieldTypeId); err != nil {
-        return err
-      }
-    }
-    if err := iprot.ReadFieldEnd(); err != nil {
-      return err
-    }
-  }
-  if err := iprot.ReadStructEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-  }
-  return nil
+func (p *BlockHeader) GetHeight() string {
+  return p.Height
 }
 
-func (p *Coin)  ReadField1(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 1: ", err)
-} else {
-  p.Thetawei = v
-}
-  return nil
+func (p *BlockHeader) GetParent() string {
+  return p.Parent
 }
 
-func (p *Coin)  ReadField2(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 2: ", err)
-} else {
-  p.Tfuelwei = v
-}
-  return nil
+func (p *BlockHeader) GetTransactionsHash() string {
+  return p.TransactionsHash
 }
 
-func (p *Coin) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("Coin"); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
-  if p != nil {
-    if err := p.writeField1(oprot); err != nil { return err }
-    if err := p.writeField2(oprot); err != nil { return err }
-  }
-  if err := oprot.WriteFieldStop(); err != nil {
-    return thrift.PrependError("write field stop error: ", err) }
-  if err := oprot.WriteStructEnd(); err != nil {
-    return thrift.PrependError("write struct stop error: ", err) }
-  return nil
+func (p *BlockHeader) GetStateHash() string {
+  return p.StateHash
 }
 
-func (p *Coin) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("thetawei", thrift.STRING, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:thetawei: ", p), err) }
-  if err := oprot.WriteString(string(p.Thetawei)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.thetawei (1) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:thetawei: ", p), err) }
-  return err
+func (p *BlockHeader) GetTimestamp() string {
+  return p.Timestamp
 }
 
-func (p *Coin) writeField2(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("tfuelwei", thrift.STRING, 2); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:tfuelwei: ", p), err) }
-  if err := oprot.WriteString(string(p.Tfuelwei)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.tfuelwei (2) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:tfuelwei: ", p), err) }
-  return err
+func (p *BlockHeader) GetProposer() string {
+  return p.Proposer
 }
 
-func (p *Coin) String() string {
-  if p == nil {
-    return "<nil>"
-  }
-  return fmt.Sprintf("Coin(%+v)", *p)
+func (p *BlockHeader) GetChildren() []string {
+  return p.Children
 }
 
-// Attributes:
-//  - Thetawei
-//  - Tfuelwei
-type Fee struct {
-  Thetawei string `thrift:"thetawei,1" db:"thetawei" json:"thetawei"`
-  Tfuelwei string `thrift:"tfuelwei,2" db:"tfuelwei" json:"tfuelwei"`
+func (p *BlockHeader) GetStatus() int32 {
+  return p.Status
 }
 
-func NewFee() *Fee {
-  return &Fee{}
+func (p *BlockHeader) GetHash() string {
+  return p.Hash
 }
-
-
-func (p *Fee) GetThetawei() string {
-  return p.Thetawei
+var BlockHeader_Hcc_DEFAULT *HCC
+func (p *BlockHeader) GetHcc() *HCC {
+  if !p.IsSetHcc() {
+    return BlockHeader_Hcc_DEFAULT
+  }
+return p.Hcc
 }
-
-func (p *Fee) GetTfuelwei() string {
-  return p.Tfuelwei
+func (p *BlockHeader) IsSetHcc() bool {
+  return p.Hcc != nil
 }
-func (p *Fee) Read(iprot thrift.TProtocol) error {
+
+func (p *BlockHeader) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -4451,146 +4278,19 @@ func (p *Fee) Read(iprot thrift.TProtocol) error {
           return err
         }
       }
-    default:
-      if err := iprot.Skip(fieldTypeId); err != nil {
-        return err
+    case 3:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField3(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
       }
-    }
-    if err := iprot.ReadFieldEnd(); err != nil {
-      return err
-    }
-  }
-  if err := iprot.ReadStructEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-  }
-  return nil
-}
-
-func (p *Fee)  ReadField1(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 1: ", err)
-} else {
-  p.Thetawei = v
-}
-  return nil
-}
-
-func (p *Fee)  ReadField2(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 2: ", err)
-} else {
-  p.Tfuelwei = v
-}
-  return nil
-}
-
-func (p *Fee) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("Fee"); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
-  if p != nil {
-    if err := p.writeField1(oprot); err != nil { return err }
-    if err := p.writeField2(oprot); err != nil { return err }
-  }
-  if err := oprot.WriteFieldStop(); err != nil {
-    return thrift.PrependError("write field stop error: ", err) }
-  if err := oprot.WriteStructEnd(); err != nil {
-    return thrift.PrependError("write struct stop error: ", err) }
-  return nil
-}
-
-func (p *Fee) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("thetawei", thrift.STRING, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:thetawei: ", p), err) }
-  if err := oprot.WriteString(string(p.Thetawei)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.thetawei (1) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:thetawei: ", p), err) }
-  return err
-}
-
-func (p *Fee) writeField2(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("tfuelwei", thrift.STRING, 2); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:tfuelwei: ", p), err) }
-  if err := oprot.WriteString(string(p.Tfuelwei)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.tfuelwei (2) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:tfuelwei: ", p), err) }
-  return err
-}
-
-func (p *Fee) String() string {
-  if p == nil {
-    return "<nil>"
-  }
-  return fmt.Sprintf("Fee(%+v)", *p)
-}
-
-// Attributes:
-//  - Chain
-//  - FromAddress
-//  - GasPrice
-//  - GasLimit
-//  - Data
-//  - Seq
-//  - ToAddress
-type SmartContract struct {
-  Chain string `thrift:"chain,1" db:"chain" json:"chain"`
-  FromAddress string `thrift:"from_address,2" db:"from_address" json:"from_address"`
-  GasPrice string `thrift:"gas_price,3" db:"gas_price" json:"gas_price"`
-  GasLimit string `thrift:"gas_limit,4" db:"gas_limit" json:"gas_limit"`
-  Data string `thrift:"data,5" db:"data" json:"data"`
-  Seq string `thrift:"seq,6" db:"seq" json:"seq"`
-  ToAddress string `thrift:"to_address,7" db:"to_address" json:"to_address"`
-}
-
-func NewSmartContract() *SmartContract {
-  return &SmartContract{}
-}
-
-
-func (p *SmartContract) GetChain() string {
-  return p.Chain
-}
-
-func (p *SmartContract) GetFromAddress() string {
-  return p.FromAddress
-}
-
-func (p *SmartContract) GetGasPrice() string {
-  return p.GasPrice
-}
-
-func (p *SmartContract) GetGasLimit() string {
-  return p.GasLimit
-}
-
-func (p *SmartContract) GetData() string {
-  return p.Data
-}
-
-func (p *SmartContract) GetSeq() string {
-  return p.Seq
-}
-
-func (p *SmartContract) GetToAddress() string {
-  return p.ToAddress
-}
-func (p *SmartContract) Read(iprot thrift.TProtocol) error {
-  if _, err := iprot.ReadStructBegin(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
-  }
-
-
-  for {
-    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
-    if err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
-    }
-    if fieldTypeId == thrift.STOP { break; }
-    switch fieldId {
-    case 1:
+    case 4:
       if fieldTypeId == thrift.STRING {
-        if err := p.ReadField1(iprot); err != nil {
+        if err := p.ReadField4(iprot); err != nil {
           return err
         }
       } else {
@@ -4598,9 +4298,9 @@ func (p *SmartContract) Read(iprot thrift.TProtocol) error {
           return err
         }
       }
-    case 2:
+    case 5:
       if fieldTypeId == thrift.STRING {
-        if err := p.ReadField2(iprot); err != nil {
+        if err := p.ReadField5(iprot); err != nil {
           return err
         }
       } else {
@@ -4608,9 +4308,9 @@ func (p *SmartContract) Read(iprot thrift.TProtocol) error {
           return err
         }
       }
-    case 3:
+    case 6:
       if fieldTypeId == thrift.STRING {
-        if err := p.ReadField3(iprot); err != nil {
+        if err := p.ReadField6(iprot); err != nil {
           return err
         }
       } else {
@@ -4618,9 +4318,9 @@ func (p *SmartContract) Read(iprot thrift.TProtocol) error {
           return err
         }
       }
-    case 4:
+    case 7:
       if fieldTypeId == thrift.STRING {
-        if err := p.ReadField4(iprot); err != nil {
+        if err := p.ReadField7(iprot); err != nil {
           return err
         }
       } else {
@@ -4628,9 +4328,9 @@ func (p *SmartContract) Read(iprot thrift.TProtocol) error {
           return err
         }
       }
-    case 5:
+    case 8:
       if fieldTypeId == thrift.STRING {
-        if err := p.ReadField5(iprot); err != nil {
+        if err := p.ReadField8(iprot); err != nil {
           return err
         }
       } else {
@@ -4638,9 +4338,9 @@ func (p *SmartContract) Read(iprot thrift.TProtocol) error {
           return err
         }
       }
-    case 6:
-      if fieldTypeId == thrift.STRING {
-        if err := p.ReadField6(iprot); err != nil {
+    case 9:
+      if fieldTypeId == thrift.LIST {
+        if err := p.ReadField9(iprot); err != nil {
           return err
         }
       } else {
@@ -4648,9 +4348,29 @@ func (p *SmartContract) Read(iprot thrift.TProtocol) error {
           return err
         }
       }
-    case 7:
+    case 10:
+      if fieldTypeId == thrift.I32 {
+        if err := p.ReadField10(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 11:
       if fieldTypeId == thrift.STRING {
-        if err := p.ReadField7(iprot); err != nil {
+        if err := p.ReadField11(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 12:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField12(iprot); err != nil {
           return err
         }
       } else {
@@ -4673,271 +4393,380 @@ func (p *SmartContract) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *SmartContract)  ReadField1(iprot thrift.TProtocol) error {
+func (p *BlockHeader)  ReadField1(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 1: ", err)
 } else {
-  p.Chain = v
+  p.ChainID = v
 }
   return nil
 }
 
-func (p *SmartContract)  ReadField2(iprot thrift.TProtocol) error {
+func (p *BlockHeader)  ReadField2(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 2: ", err)
 } else {
-  p.FromAddress = v
+  p.Epoch = v
 }
   return nil
 }
 
-func (p *SmartContract)  ReadField3(iprot thrift.TProtocol) error {
+func (p *BlockHeader)  ReadField3(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 3: ", err)
 } else {
-  p.GasPrice = v
+  p.Height = v
 }
   return nil
 }
 
-func (p *SmartContract)  ReadField4(iprot thrift.TProtocol) error {
+func (p *BlockHeader)  ReadField4(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 4: ", err)
 } else {
-  p.GasLimit = v
+  p.Parent = v
 }
   return nil
 }
 
-func (p *SmartContract)  ReadField5(iprot thrift.TProtocol) error {
+func (p *BlockHeader)  ReadField5(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 5: ", err)
 } else {
-  p.Data = v
+  p.TransactionsHash = v
 }
   return nil
 }
 
-func (p *SmartContract)  ReadField6(iprot thrift.TProtocol) error {
+func (p *BlockHeader)  ReadField6(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 6: ", err)
 } else {
-  p.Seq = v
+  p.StateHash = v
 }
   return nil
 }
 
-func (p *SmartContract)  ReadField7(iprot thrift.TProtocol) error {
+func (p *BlockHeader)  ReadField7(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 7: ", err)
 } else {
-  p.ToAddress = v
+  p.Timestamp = v
 }
   return nil
 }
 
-func (p *SmartContract) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("SmartContract"); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
-  if p != nil {
-    if err := p.writeField1(oprot); err != nil { return err }
-    if err := p.writeField2(oprot); err != nil { return err }
-    if err := p.writeField3(oprot); err != nil { return err }
-    if err := p.writeField4(oprot); err != nil { return err }
-    if err := p.writeField5(oprot); err != nil { return err }
-    if err := p.writeField6(oprot); err != nil { return err }
-    if err := p.writeField7(oprot); err != nil { return err }
-  }
-  if err := oprot.WriteFieldStop(); err != nil {
-    return thrift.PrependError("write field stop error: ", err) }
-  if err := oprot.WriteStructEnd(); err != nil {
-    return thrift.PrependError("write struct stop error: ", err) }
+func (p *BlockHeader)  ReadField8(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 8: ", err)
+} else {
+  p.Proposer = v
+}
   return nil
 }
 
-func (p *SmartContract) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("chain", thrift.STRING, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:chain: ", p), err) }
-  if err := oprot.WriteString(string(p.Chain)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.chain (1) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:chain: ", p), err) }
-  return err
+func (p *BlockHeader)  ReadField9(iprot thrift.TProtocol) error {
+  _, size, err := iprot.ReadListBegin()
+  if err != nil {
+    return thrift.PrependError("error reading list begin: ", err)
+  }
+  tSlice := make([]string, 0, size)
+  p.Children =  tSlice
+  for i := 0; i < size; i ++ {
+var _elem7 string
+    if v, err := iprot.ReadString(); err != nil {
+    return thrift.PrependError("error reading field 0: ", err)
+} else {
+    _elem7 = v
 }
-
-func (p *SmartContract) writeField2(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("from_address", thrift.STRING, 2); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:from_address: ", p), err) }
-  if err := oprot.WriteString(string(p.FromAddress)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.from_address (2) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:from_address: ", p), err) }
-  return err
+    p.Children = append(p.Children, _elem7)
+  }
+  if err := iprot.ReadListEnd(); err != nil {
+    return thrift.PrependError("error reading list end: ", err)
+  }
+  return nil
 }
 
-func (p *SmartContract) writeField3(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("gas_price", thrift.STRING, 3); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:gas_price: ", p), err) }
-  if err := oprot.WriteString(string(p.GasPrice)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.gas_price (3) field write error: ", p), err) }
+func (p *BlockHeader)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.Status = v
+}
+  return nil
+}
+
+func (p *BlockHeader)  ReadField11(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 11: ", err)
+} else {
+  p.Hash = v
+}
+  return nil
+}
+
+func (p *BlockHeader)  ReadField12(iprot thrift.TProtocol) error {
+  p.Hcc = &HCC{}
+  if err := p.Hcc.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Hcc), err)
+  }
+  return nil
+}
+
+func (p *BlockHeader) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("BlockHeader"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+    if err := p.writeField4(oprot); err != nil { return err }
+    if err := p.writeField5(oprot); err != nil { return err }
+    if err := p.writeField6(oprot); err != nil { return err }
+    if err := p.writeField7(oprot); err != nil { return err }
+    if err := p.writeField8(oprot); err != nil { return err }
+    if err := p.writeField9(oprot); err != nil { return err }
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField11(oprot); err != nil { return err }
+    if err := p.writeField12(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *BlockHeader) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("chain_id", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:chain_id: ", p), err) }
+  if err := oprot.WriteString(string(p.ChainID)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.chain_id (1) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:gas_price: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:chain_id: ", p), err) }
   return err
 }
 
-func (p *SmartContract) writeField4(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("gas_limit", thrift.STRING, 4); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:gas_limit: ", p), err) }
-  if err := oprot.WriteString(string(p.GasLimit)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.gas_limit (4) field write error: ", p), err) }
+func (p *BlockHeader) writeField2(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("epoch", thrift.STRING, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:epoch: ", p), err) }
+  if err := oprot.WriteString(string(p.Epoch)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.epoch (2) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 4:gas_limit: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:epoch: ", p), err) }
   return err
 }
 
-func (p *SmartContract) writeField5(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("data", thrift.STRING, 5); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:data: ", p), err) }
-  if err := oprot.WriteString(string(p.Data)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.data (5) field write error: ", p), err) }
+func (p *BlockHeader) writeField3(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("height", thrift.STRING, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:height: ", p), err) }
+  if err := oprot.WriteString(string(p.Height)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.height (3) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 5:data: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:height: ", p), err) }
   return err
 }
 
-func (p *SmartContract) writeField6(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("seq", thrift.STRING, 6); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:seq: ", p), err) }
-  if err := oprot.WriteString(string(p.Seq)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.seq (6) field write error: ", p), err) }
+func (p *BlockHeader) writeField4(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("parent", thrift.STRING, 4); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:parent: ", p), err) }
+  if err := oprot.WriteString(string(p.Parent)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.parent (4) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 6:seq: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 4:parent: ", p), err) }
   return err
 }
 
-func (p *SmartContract) writeField7(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("to_address", thrift.STRING, 7); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:to_address: ", p), err) }
-  if err := oprot.WriteString(string(p.ToAddress)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.to_address (7) field write error: ", p), err) }
+func (p *BlockHeader) writeField5(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("transactions_hash", thrift.STRING, 5); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:transactions_hash: ", p), err) }
+  if err := oprot.WriteString(string(p.TransactionsHash)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.transactions_hash (5) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 7:to_address: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 5:transactions_hash: ", p), err) }
   return err
 }
 
-func (p *SmartContract) String() string {
-  if p == nil {
-    return "<nil>"
-  }
-  return fmt.Sprintf("SmartContract(%+v)", *p)
+func (p *BlockHeader) writeField6(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("state_hash", thrift.STRING, 6); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:state_hash: ", p), err) }
+  if err := oprot.WriteString(string(p.StateHash)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.state_hash (6) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 6:state_hash: ", p), err) }
+  return err
 }
 
-// Attributes:
-//  - Address
-type NewKey_ struct {
-  Address string `thrift:"address,1" db:"address" json:"address"`
+func (p *BlockHeader) writeField7(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("timestamp", thrift.STRING, 7); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:timestamp: ", p), err) }
+  if err := oprot.WriteString(string(p.Timestamp)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.timestamp (7) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 7:timestamp: ", p), err) }
+  return err
 }
 
-func NewNewKey_() *NewKey_ {
-  return &NewKey_{}
+func (p *BlockHeader) writeField8(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("proposer", thrift.STRING, 8); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:proposer: ", p), err) }
+  if err := oprot.WriteString(string(p.Proposer)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.proposer (8) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 8:proposer: ", p), err) }
+  return err
 }
 
-
-func (p *NewKey_) GetAddress() string {
-  return p.Address
-}
-func (p *NewKey_) Read(iprot thrift.TProtocol) error {
-  if _, err := iprot.ReadStructBegin(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+func (p *BlockHeader) writeField9(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("children", thrift.LIST, 9); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 9:children: ", p), err) }
+  if err := oprot.WriteListBegin(thrift.STRING, len(p.Children)); err != nil {
+    return thrift.PrependError("error writing list begin: ", err)
   }
-
-
-  for {
-    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
-    if err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
-    }
-    if fieldTypeId == thrift.STOP { break; }
-    switch fieldId {
-    case 1:
-      if fieldTypeId == thrift.STRING {
-        if err := p.ReadField1(iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(fieldTypeId); err != nil {
-          return err
-        }
-      }
-    default:
-      if err := iprot.Skip(fieldTypeId); err != nil {
-        return err
-      }
-    }
-    if err := iprot.ReadFieldEnd(); err != nil {
-      return err
-    }
+  for _, v := range p.Children {
+    if err := oprot.WriteString(string(v)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err) }
   }
-  if err := iprot.ReadStructEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  if err := oprot.WriteListEnd(); err != nil {
+    return thrift.PrependError("error writing list end: ", err)
   }
-  return nil
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 9:children: ", p), err) }
+  return err
 }
 
-func (p *NewKey_)  ReadField1(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 1: ", err)
-} else {
-  p.Address = v
-}
-  return nil
+func (p *BlockHeader) writeField10(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("status", thrift.I32, 10); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:status: ", p), err) }
+  if err := oprot.WriteI32(int32(p.Status)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.status (10) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 10:status: ", p), err) }
+  return err
 }
 
-func (p *NewKey_) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("NewKey"); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
-  if p != nil {
-    if err := p.writeField1(oprot); err != nil { return err }
-  }
-  if err := oprot.WriteFieldStop(); err != nil {
-    return thrift.PrependError("write field stop error: ", err) }
-  if err := oprot.WriteStructEnd(); err != nil {
-    return thrift.PrependError("write struct stop error: ", err) }
-  return nil
+func (p *BlockHeader) writeField11(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("hash", thrift.STRING, 11); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 11:hash: ", p), err) }
+  if err := oprot.WriteString(string(p.Hash)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.hash (11) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 11:hash: ", p), err) }
+  return err
 }
 
-func (p *NewKey_) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("address", thrift.STRING, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:address: ", p), err) }
-  if err := oprot.WriteString(string(p.Address)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.address (1) field write error: ", p), err) }
+func (p *BlockHeader) writeField12(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("hcc", thrift.STRUCT, 12); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 12:hcc: ", p), err) }
+  if err := p.Hcc.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Hcc), err)
+  }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:address: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 12:hcc: ", p), err) }
   return err
 }
 
-func (p *NewKey_) String() string {
+func (p *BlockHeader) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("NewKey_(%+v)", *p)
+  return fmt.Sprintf("BlockHeader(%+v)", *p)
 }
 
 // Attributes:
-//  - Addresses
-type ListKeys struct {
-  Addresses []string `thrift:"addresses,1" db:"addresses" json:"addresses"`
+//  - ChainID
+//  - Epoch
+//  - Height
+//  - Parent
+//  - TransactionsHash
+//  - StateHash
+//  - Timestamp
+//  - Proposer
+//  - Children
+//  - Status
+//  - Hash
+//  - Transactions
+//  - Hcc
+type Block struct {
+  ChainID string `thrift:"chain_id,1" db:"chain_id" json:"chain_id"`
+  Epoch string `thrift:"epoch,2" db:"epoch" json:"epoch"`
+  Height string `thrift:"height,3" db:"height" json:"height"`
+  Parent string `thrift:"parent,4" db:"parent" json:"parent"`
+  TransactionsHash string `thrift:"transactions_hash,5" db:"transactions_hash" json:"transactions_hash"`
+  StateHash string `thrift:"state_hash,6" db:"state_hash" json:"state_hash"`
+  Timestamp string `thrift:"timestamp,7" db:"timestamp" json:"timestamp"`
+  Proposer string `thrift:"proposer,8" db:"proposer" json:"proposer"`
+  Children []string `thrift:"children,9" db:"children" json:"children"`
+  Status int32 `thrift:"status,10" db:"status" json:"status"`
+  Hash string `thrift:"hash,11" db:"hash" json:"hash"`
+  Transactions []*TransactionInBlock `thrift:"transactions,12" db:"transactions" json:"transactions"`
+  Hcc *HCC `thrift:"hcc,13" db:"hcc" json:"hcc"`
 }
 
-func NewListKeys() *ListKeys {
-  return &ListKeys{}
+func NewBlock() *Block {
+  return &Block{}
 }
 
 
-func (p *ListKeys) GetAddresses() []string {
-  return p.Addresses
+func (p *Block) GetChainID() string {
+  return p.ChainID
 }
-func (p *ListKeys) Read(iprot thrift.TProtocol) error {
+
+func (p *Block) GetEpoch() string {
+  return p.Epoch
+}
+
+func (p *Block) GetHeight() string {
+  return p.Height
+}
+
+func (p *Block) GetParent() string {
+  return p.Parent
+}
+
+func (p *Block) GetTransactionsHash() string {
+  return p.TransactionsHash
+}
+
+func (p *Block) GetStateHash() string {
+  return p.StateHash
+}
+
+func (p *Block) GetTimestamp() string {
+  return p.Timestamp
+}
+
+func (p *Block) GetProposer() string {
+  return p.Proposer
+}
+
+func (p *Block) GetChildren() []string {
+  return p.Children
+}
+
+func (p *Block) GetStatus() int32 {
+  return p.Status
+}
+
+func (p *Block) GetHash() string {
+  return p.Hash
+}
+
+func (p *Block) GetTransactions() []*TransactionInBlock {
+  return p.Transactions
+}
+var Block_Hcc_DEFAULT *HCC
+func (p *Block) GetHcc() *HCC {
+  if !p.IsSetHcc() {
+    return Block_Hcc_DEFAULT
+  }
+return p.Hcc
+}
+func (p *Block) IsSetHcc() bool {
+  return p.Hcc != nil
+}
+
+func (p *Block) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -4951,7 +4780,7 @@ func (p *ListKeys) Read(iprot thrift.TProtocol) error {
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
     case 1:
-      if fieldTypeId == thrift.LIST {
+      if fieldTypeId == thrift.STRING {
         if err := p.ReadField1(iprot); err != nil {
           return err
         }
@@ -4960,6 +4789,126 @@ func (p *ListKeys) Read(iprot thrift.TProtocol) error {
           return err
         }
       }
+    case 2:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField2(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 3:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField3(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 4:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField4(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 5:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField5(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 6:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField6(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 7:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField7(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 8:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField8(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 9:
+      if fieldTypeId == thrift.LIST {
+        if err := p.ReadField9(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 10:
+      if fieldTypeId == thrift.I32 {
+        if err := p.ReadField10(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 11:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField11(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 12:
+      if fieldTypeId == thrift.LIST {
+        if err := p.ReadField12(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 13:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField13(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -4975,132 +4924,163 @@ func (p *ListKeys) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *ListKeys)  ReadField1(iprot thrift.TProtocol) error {
-  _, size, err := iprot.ReadListBegin()
-  if err != nil {
-    return thrift.PrependError("error reading list begin: ", err)
-  }
-  tSlice := make([]string, 0, size)
-  p.Addresses =  tSlice
-  for i := 0; i < size; i ++ {
-var _elem8 string
-    if v, err := iprot.ReadString(); err != nil {
-    return thrift.PrependError("error reading field 0: ", err)
+func (p *Block)  ReadField1(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
 } else {
-    _elem8 = v
+  p.ChainID = v
 }
-    p.Addresses = append(p.Addresses, _elem8)
-  }
-  if err := iprot.ReadListEnd(); err != nil {
-    return thrift.PrependError("error reading list end: ", err)
-  }
   return nil
 }
 
-func (p *ListKeys) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("ListKeys"); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
-  if p != nil {
-    if err := p.writeField1(oprot); err != nil { return err }
-  }
-  if err := oprot.WriteFieldStop(); err != nil {
-    return thrift.PrependError("write field stop error: ", err) }
-  if err := oprot.WriteStructEnd(); err != nil {
-    return thrift.PrependError("write struct stop error: ", err) }
+func (p *Block)  ReadField2(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.Epoch = v
+}
   return nil
 }
 
-func (p *ListKeys) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("addresses", thrift.LIST, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:addresses: ", p), err) }
-  if err := oprot.WriteListBegin(thrift.STRING, len(p.Addresses)); err != nil {
-    return thrift.PrependError("error writing list begin: ", err)
-  }
-  for _, v := range p.Addresses {
-    if err := oprot.WriteString(string(v)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err) }
-  }
-  if err := oprot.WriteListEnd(); err != nil {
-    return thrift.PrependError("error writing list end: ", err)
-  }
-  if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:addresses: ", p), err) }
-  return err
+func (p *Block)  ReadField3(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 3: ", err)
+} else {
+  p.Height = v
+}
+  return nil
 }
 
-func (p *ListKeys) String() string {
-  if p == nil {
-    return "<nil>"
-  }
-  return fmt.Sprintf("ListKeys(%+v)", *p)
+func (p *Block)  ReadField4(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 4: ", err)
+} else {
+  p.Parent = v
+}
+  return nil
 }
 
-// Attributes:
-//  - Unlocked
-type StatusKey struct {
-  Unlocked bool `thrift:"unlocked,1" db:"unlocked" json:"unlocked"`
+func (p *Block)  ReadField5(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 5: ", err)
+} else {
+  p.TransactionsHash = v
+}
+  return nil
 }
 
-func NewStatusKey() *StatusKey {
-  return &StatusKey{}
+func (p *Block)  ReadField6(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 6: ", err)
+} else {
+  p.StateHash = v
+}
+  return nil
 }
 
+func (p *Block)  ReadField7(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 7: ", err)
+} else {
+  p.Timestamp = v
+}
+  return nil
+}
 
-func (p *StatusKey) GetUnlocked() bool {
-  return p.Unlocked
+func (p *Block)  ReadField8(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 8: ", err)
+} else {
+  p.Proposer = v
 }
-func (p *StatusKey) Read(iprot thrift.TProtocol) error {
-  if _, err := iprot.ReadStructBegin(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  return nil
+}
+
+func (p *Block)  ReadField9(iprot thrift.TProtocol) error {
+  _, size, err := iprot.ReadListBegin()
+  if err != nil {
+    return thrift.PrependError("error reading list begin: ", err)
+  }
+  tSlice := make([]string, 0, size)
+  p.Children =  tSlice
+  for i := 0; i < size; i ++ {
+var _elem8 string
+    if v, err := iprot.ReadString(); err != nil {
+    return thrift.PrependError("error reading field 0: ", err)
+} else {
+    _elem8 = v
+}
+    p.Children = append(p.Children, _elem8)
+  }
+  if err := iprot.ReadListEnd(); err != nil {
+    return thrift.PrependError("error reading list end: ", err)
   }
+  return nil
+}
 
+func (p *Block)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.Status = v
+}
+  return nil
+}
 
-  for {
-    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
-    if err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
-    }
-    if fieldTypeId == thrift.STOP { break; }
-    switch fieldId {
-    case 1:
-      if fieldTypeId == thrift.BOOL {
-        if err := p.ReadField1(iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(fieldTypeId); err != nil {
-          return err
-        }
-      }
-    default:
-      if err := iprot.Skip(fieldTypeId); err != nil {
-        return err
-      }
-    }
-    if err := iprot.ReadFieldEnd(); err != nil {
-      return err
+func (p *Block)  ReadField11(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 11: ", err)
+} else {
+  p.Hash = v
+}
+  return nil
+}
+
+func (p *Block)  ReadField12(iprot thrift.TProtocol) error {
+  _, size, err := iprot.ReadListBegin()
+  if err != nil {
+    return thrift.PrependError("error reading list begin: ", err)
+  }
+  tSlice := make([]*TransactionInBlock, 0, size)
+  p.Transactions =  tSlice
+  for i := 0; i < size; i ++ {
+    _elem9 := &TransactionInBlock{}
+    if err := _elem9.Read(iprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem9), err)
     }
+    p.Transactions = append(p.Transactions, _elem9)
   }
-  if err := iprot.ReadStructEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  if err := iprot.ReadListEnd(); err != nil {
+    return thrift.PrependError("error reading list end: ", err)
   }
   return nil
 }
 
-func (p *StatusKey)  ReadField1(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadBool(); err != nil {
-  return thrift.PrependError("error reading field 1: ", err)
-} else {
-  p.Unlocked = v
-}
+func (p *Block)  ReadField13(iprot thrift.TProtocol) error {
+  p.Hcc = &HCC{}
+  if err := p.Hcc.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Hcc), err)
+  }
   return nil
 }
 
-func (p *StatusKey) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("StatusKey"); err != nil {
+func (p *Block) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("Block"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+    if err := p.writeField4(oprot); err != nil { return err }
+    if err := p.writeField5(oprot); err != nil { return err }
+    if err := p.writeField6(oprot); err != nil { return err }
+    if err := p.writeField7(oprot); err != nil { return err }
+    if err := p.writeField8(oprot); err != nil { return err }
+    if err := p.writeField9(oprot); err != nil { return err }
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField11(oprot); err != nil { return err }
+    if err := p.writeField12(oprot); err != nil { return err }
+    if err := p.writeField13(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -5109,62 +5089,182 @@ func (p *StatusKey) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *StatusKey) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("unlocked", thrift.BOOL, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:unlocked: ", p), err) }
-  if err := oprot.WriteBool(bool(p.Unlocked)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.unlocked (1) field write error: ", p), err) }
+func (p *Block) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("chain_id", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:chain_id: ", p), err) }
+  if err := oprot.WriteString(string(p.ChainID)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.chain_id (1) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:unlocked: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:chain_id: ", p), err) }
   return err
 }
 
-func (p *StatusKey) String() string {
-  if p == nil {
-    return "<nil>"
-  }
-  return fmt.Sprintf("StatusKey(%+v)", *p)
+func (p *Block) writeField2(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("epoch", thrift.STRING, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:epoch: ", p), err) }
+  if err := oprot.WriteString(string(p.Epoch)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.epoch (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:epoch: ", p), err) }
+  return err
 }
 
-// Attributes:
-//  - PrivateKey
-//  - To
-//  - Thetawei
-//  - Tfuelwei
-//  - Fee
-type Send struct {
-  PrivateKey string `thrift:"private_key,1" db:"private_key" json:"private_key"`
-  To string `thrift:"to,2" db:"to" json:"to"`
-  Thetawei string `thrift:"thetawei,3" db:"thetawei" json:"thetawei"`
-  Tfuelwei string `thrift:"tfuelwei,4" db:"tfuelwei" json:"tfuelwei"`
-  Fee string `thrift:"fee,5" db:"fee" json:"fee"`
+func (p *Block) writeField3(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("height", thrift.STRING, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:height: ", p), err) }
+  if err := oprot.WriteString(string(p.Height)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.height (3) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:height: ", p), err) }
+  return err
 }
 
-func NewSend() *Send {
-  return &Send{}
+func (p *Block) writeField4(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("parent", thrift.STRING, 4); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:parent: ", p), err) }
+  if err := oprot.WriteString(string(p.Parent)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.parent (4) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 4:parent: ", p), err) }
+  return err
 }
 
-
-func (p *Send) GetPrivateKey() string {
-  return p.PrivateKey
+func (p *Block) writeField5(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("transactions_hash", thrift.STRING, 5); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:transactions_hash: ", p), err) }
+  if err := oprot.WriteString(string(p.TransactionsHash)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.transactions_hash (5) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 5:transactions_hash: ", p), err) }
+  return err
 }
 
-func (p *Send) GetTo() string {
-  return p.To
+func (p *Block) writeField6(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("state_hash", thrift.STRING, 6); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:state_hash: ", p), err) }
+  if err := oprot.WriteString(string(p.StateHash)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.state_hash (6) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 6:state_hash: ", p), err) }
+  return err
 }
 
-func (p *Send) GetThetawei() string {
-  return p.Thetawei
+func (p *Block) writeField7(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("timestamp", thrift.STRING, 7); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:timestamp: ", p), err) }
+  if err := oprot.WriteString(string(p.Timestamp)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.timestamp (7) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 7:timestamp: ", p), err) }
+  return err
 }
 
-func (p *Send) GetTfuelwei() string {
-  return p.Tfuelwei
+func (p *Block) writeField8(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("proposer", thrift.STRING, 8); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:proposer: ", p), err) }
+  if err := oprot.WriteString(string(p.Proposer)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.proposer (8) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 8:proposer: ", p), err) }
+  return err
 }
 
-func (p *Send) GetFee() string {
-  return p.Fee
+func (p *Block) writeField9(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("children", thrift.LIST, 9); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 9:children: ", p), err) }
+  if err := oprot.WriteListBegin(thrift.STRING, len(p.Children)); err != nil {
+    return thrift.PrependError("error writing list begin: ", err)
+  }
+  for _, v := range p.Children {
+    if err := oprot.WriteString(string(v)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err) }
+  }
+  if err := oprot.WriteListEnd(); err != nil {
+    return thrift.PrependError("error writing list end: ", err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 9:children: ", p), err) }
+  return err
 }
-func (p *Send) Read(iprot thrift.TProtocol) error {
+
+func (p *Block) writeField10(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("status", thrift.I32, 10); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:status: ", p), err) }
+  if err := oprot.WriteI32(int32(p.Status)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.status (10) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 10:status: ", p), err) }
+  return err
+}
+
+func (p *Block) writeField11(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("hash", thrift.STRING, 11); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 11:hash: ", p), err) }
+  if err := oprot.WriteString(string(p.Hash)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.hash (11) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 11:hash: ", p), err) }
+  return err
+}
+
+func (p *Block) writeField12(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("transactions", thrift.LIST, 12); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 12:transactions: ", p), err) }
+  if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Transactions)); err != nil {
+    return thrift.PrependError("error writing list begin: ", err)
+  }
+  for _, v := range p.Transactions {
+    if err := v.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+    }
+  }
+  if err := oprot.WriteListEnd(); err != nil {
+    return thrift.PrependError("error writing list end: ", err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 12:transactions: ", p), err) }
+  return err
+}
+
+func (p *Block) writeField13(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("hcc", thrift.STRUCT, 13); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 13:hcc: ", p), err) }
+  if err := p.Hcc.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Hcc), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 13:hcc: ", p), err) }
+  return err
+}
+
+func (p *Block) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("Block(%+v)", *p)
+}
+
+// Attributes:
+//  - Thetawei
+//  - Tfuelwei
+type Coin struct {
+  Thetawei string `thrift:"thetawei,1" db:"thetawei" json:"thetawei"`
+  Tfuelwei string `thrift:"tfuelwei,2" db:"tfuelwei" json:"tfuelwei"`
+}
+
+func NewCoin() *Coin {
+  return &Coin{}
+}
+
+
+func (p *Coin) GetThetawei() string {
+  return p.Thetawei
+}
+
+func (p *Coin) GetTfuelwei() string {
+  return p.Tfuelwei
+}
+func (p *Coin) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -5197,36 +5297,6 @@ func (p *Send) Read(iprot thrift.TProtocol) error {
           return err
         }
       }
-    case 3:
-      if fieldTypeId == thrift.STRING {
-        if err := p.ReadField3(iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(fieldTypeId); err != nil {
-          return err
-        }
-      }
-    case 4:
-      if fieldTypeId == thrift.STRING {
-        if err := p.ReadField4(iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(fieldTypeId); err != nil {
-          return err
-        }
-      }
-    case 5:
-      if fieldTypeId == thrift.STRING {
-        if err := p.ReadField5(iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(fieldTypeId); err != nil {
-          return err
-        }
-      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -5242,60 +5312,30 @@ func (p *Send) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *Send)  ReadField1(iprot thrift.TProtocol) error {
+func (p *Coin)  ReadField1(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 1: ", err)
-} else {
-  p.PrivateKey = v
-}
-  return nil
-}
-
-func (p *Send)  ReadField2(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 2: ", err)
-} else {
-  p.To = v
-}
-  return nil
-}
-
-func (p *Send)  ReadField3(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 3: ", err)
 } else {
   p.Thetawei = v
 }
   return nil
 }
 
-func (p *Send)  ReadField4(iprot thrift.TProtocol) error {
+func (p *Coin)  ReadField2(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 4: ", err)
+  return thrift.PrependError("error reading field 2: ", err)
 } else {
   p.Tfuelwei = v
 }
   return nil
 }
 
-func (p *Send)  ReadField5(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 5: ", err)
-} else {
-  p.Fee = v
-}
-  return nil
-}
-
-func (p *Send) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("Send"); err != nil {
+func (p *Coin) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("Coin"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
-    if err := p.writeField3(oprot); err != nil { return err }
-    if err := p.writeField4(oprot); err != nil { return err }
-    if err := p.writeField5(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -5304,110 +5344,54 @@ func (p *Send) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *Send) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("private_key", thrift.STRING, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:private_key: ", p), err) }
-  if err := oprot.WriteString(string(p.PrivateKey)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.private_key (1) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:private_key: ", p), err) }
-  return err
-}
-
-func (p *Send) writeField2(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("to", thrift.STRING, 2); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:to: ", p), err) }
-  if err := oprot.WriteString(string(p.To)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.to (2) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:to: ", p), err) }
-  return err
-}
-
-func (p *Send) writeField3(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("thetawei", thrift.STRING, 3); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:thetawei: ", p), err) }
+func (p *Coin) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("thetawei", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:thetawei: ", p), err) }
   if err := oprot.WriteString(string(p.Thetawei)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.thetawei (3) field write error: ", p), err) }
+  return thrift.PrependError(fmt.Sprintf("%T.thetawei (1) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:thetawei: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:thetawei: ", p), err) }
   return err
 }
 
-func (p *Send) writeField4(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("tfuelwei", thrift.STRING, 4); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:tfuelwei: ", p), err) }
+func (p *Coin) writeField2(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("tfuelwei", thrift.STRING, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:tfuelwei: ", p), err) }
   if err := oprot.WriteString(string(p.Tfuelwei)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.tfuelwei (4) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 4:tfuelwei: ", p), err) }
-  return err
-}
-
-func (p *Send) writeField5(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("fee", thrift.STRING, 5); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:fee: ", p), err) }
-  if err := oprot.WriteString(string(p.Fee)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.fee (5) field write error: ", p), err) }
+  return thrift.PrependError(fmt.Sprintf("%T.tfuelwei (2) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 5:fee: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:tfuelwei: ", p), err) }
   return err
 }
 
-func (p *Send) String() string {
+func (p *Coin) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("Send(%+v)", *p)
+  return fmt.Sprintf("Coin(%+v)", *p)
 }
 
 // Attributes:
-//  - Jsonrpc
-//  - ID
-//  - Result_
-//  - Error
-type AccountResult_ struct {
-  Jsonrpc string `thrift:"jsonrpc,1" db:"jsonrpc" json:"jsonrpc"`
-  ID int32 `thrift:"id,2" db:"id" json:"id"`
-  Result_ *Account `thrift:"result,3" db:"result" json:"result"`
-  Error *Error `thrift:"error,4" db:"error" json:"error"`
+//  - Thetawei
+//  - Tfuelwei
+type Fee struct {
+  Thetawei string `thrift:"thetawei,1" db:"thetawei" json:"thetawei"`
+  Tfuelwei string `thrift:"tfuelwei,2" db:"tfuelwei" json:"tfuelwei"`
 }
 
-func NewAccountResult_() *AccountResult_ {
-  return &AccountResult_{}
+func NewFee() *Fee {
+  return &Fee{}
 }
 
 
-func (p *AccountResult_) GetJsonrpc() string {
-  return p.Jsonrpc
+func (p *Fee) GetThetawei() string {
+  return p.Thetawei
 }
 
-func (p *AccountResult_) GetID() int32 {
-  return p.ID
+func (p *Fee) GetTfuelwei() string {
+  return p.Tfuelwei
 }
-var AccountResult__Result__DEFAULT *Account
-func (p *AccountResult_) GetResult_() *Account {
-  if !p.IsSetResult_() {
-    return AccountResult__Result__DEFAULT
-  }
-return p.Result_
-}
-var AccountResult__Error_DEFAULT *Error
-func (p *AccountResult_) GetError() *Error {
-  if !p.IsSetError() {
-    return AccountResult__Error_DEFAULT
-  }
-return p.Error
-}
-func (p *AccountResult_) IsSetResult_() bool {
-  return p.Result_ != nil
-}
-
-func (p *AccountResult_) IsSetError() bool {
-  return p.Error != nil
-}
-
-func (p *AccountResult_) Read(iprot thrift.TProtocol) error {
+func (p *Fee) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -5431,7 +5415,7 @@ func (p *AccountResult_) Read(iprot thrift.TProtocol) error {
         }
       }
     case 2:
-      if fieldTypeId == thrift.I32 {
+      if fieldTypeId == thrift.STRING {
         if err := p.ReadField2(iprot); err != nil {
           return err
         }
@@ -5440,26 +5424,6 @@ func (p *AccountResult_) Read(iprot thrift.TProtocol) error {
           return err
         }
       }
-    case 3:
-      if fieldTypeId == thrift.STRUCT {
-        if err := p.ReadField3(iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(fieldTypeId); err != nil {
-          return err
-        }
-      }
-    case 4:
-      if fieldTypeId == thrift.STRUCT {
-        if err := p.ReadField4(iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(fieldTypeId); err != nil {
-          return err
-        }
-      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -5475,48 +5439,30 @@ func (p *AccountResult_) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *AccountResult_)  ReadField1(iprot thrift.TProtocol) error {
+func (p *Fee)  ReadField1(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 1: ", err)
 } else {
-  p.Jsonrpc = v
+  p.Thetawei = v
 }
   return nil
 }
 
-func (p *AccountResult_)  ReadField2(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI32(); err != nil {
+func (p *Fee)  ReadField2(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 2: ", err)
 } else {
-  p.ID = v
-}
-  return nil
-}
-
-func (p *AccountResult_)  ReadField3(iprot thrift.TProtocol) error {
-  p.Result_ = &Account{}
-  if err := p.Result_.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Result_), err)
-  }
-  return nil
+  p.Tfuelwei = v
 }
-
-func (p *AccountResult_)  ReadField4(iprot thrift.TProtocol) error {
-  p.Error = &Error{}
-  if err := p.Error.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Error), err)
-  }
   return nil
 }
 
-func (p *AccountResult_) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("AccountResult"); err != nil {
+func (p *Fee) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("Fee"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
-    if err := p.writeField3(oprot); err != nil { return err }
-    if err := p.writeField4(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -5525,102 +5471,84 @@ func (p *AccountResult_) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *AccountResult_) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("jsonrpc", thrift.STRING, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:jsonrpc: ", p), err) }
-  if err := oprot.WriteString(string(p.Jsonrpc)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.jsonrpc (1) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:jsonrpc: ", p), err) }
-  return err
-}
-
-func (p *AccountResult_) writeField2(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("id", thrift.I32, 2); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:id: ", p), err) }
-  if err := oprot.WriteI32(int32(p.ID)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.id (2) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:id: ", p), err) }
-  return err
-}
-
-func (p *AccountResult_) writeField3(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("result", thrift.STRUCT, 3); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:result: ", p), err) }
-  if err := p.Result_.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Result_), err)
-  }
+func (p *Fee) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("thetawei", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:thetawei: ", p), err) }
+  if err := oprot.WriteString(string(p.Thetawei)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.thetawei (1) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:result: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:thetawei: ", p), err) }
   return err
 }
 
-func (p *AccountResult_) writeField4(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("error", thrift.STRUCT, 4); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:error: ", p), err) }
-  if err := p.Error.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Error), err)
-  }
+func (p *Fee) writeField2(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("tfuelwei", thrift.STRING, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:tfuelwei: ", p), err) }
+  if err := oprot.WriteString(string(p.Tfuelwei)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.tfuelwei (2) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 4:error: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:tfuelwei: ", p), err) }
   return err
 }
 
-func (p *AccountResult_) String() string {
+func (p *Fee) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("AccountResult_(%+v)", *p)
+  return fmt.Sprintf("Fee(%+v)", *p)
 }
 
 // Attributes:
-//  - Jsonrpc
-//  - ID
-//  - Result_
-//  - Error
-type BroadcastRawTransactionAsyncResult_ struct {
-  Jsonrpc string `thrift:"jsonrpc,1" db:"jsonrpc" json:"jsonrpc"`
-  ID int32 `thrift:"id,2" db:"id" json:"id"`
-  Result_ *BroadcastRawTransactionAsync `thrift:"result,3" db:"result" json:"result"`
-  Error *Error `thrift:"error,4" db:"error" json:"error"`
+//  - Chain
+//  - FromAddress
+//  - GasPrice
+//  - GasLimit
+//  - Data
+//  - Seq
+//  - ToAddress
+type SmartContract struct {
+  Chain string `thrift:"chain,1" db:"chain" json:"chain"`
+  FromAddress string `thrift:"from_address,2" db:"from_address" json:"from_address"`
+  GasPrice string `thrift:"gas_price,3" db:"gas_price" json:"gas_price"`
+  GasLimit string `thrift:"gas_limit,4" db:"gas_limit" json:"gas_limit"`
+  Data string `thrift:"data,5" db:"data" json:"data"`
+  Seq string `thrift:"seq,6" db:"seq" json:"seq"`
+  ToAddress string `thrift:"to_address,7" db:"to_address" json:"to_address"`
 }
 
-func NewBroadcastRawTransactionAsyncResult_() *BroadcastRawTransactionAsyncResult_ {
-  return &BroadcastRawTransactionAsyncResult_{}
+func NewSmartContract() *SmartContract {
+  return &SmartContract{}
 }
 
 
-func (p *BroadcastRawTransactionAsyncResult_) GetJsonrpc() string {
-  return p.Jsonrpc
+func (p *SmartContract) GetChain() string {
+  return p.Chain
 }
 
-func (p *BroadcastRawTransactionAsyncResult_) GetID() int32 {
-  return p.ID
+func (p *SmartContract) GetFromAddress() string {
+  return p.FromAddress
 }
-var BroadcastRawTransactionAsyncResult__Result__DEFAULT *BroadcastRawTransactionAsync
-func (p *BroadcastRawTransactionAsyncResult_) GetResult_() *BroadcastRawTransactionAsync {
-  if !p.IsSetResult_() {
-    return BroadcastRawTransactionAsyncResult__Result__DEFAULT
-  }
-return p.Result_
+
+func (p *SmartContract) GetGasPrice() string {
+  return p.GasPrice
 }
-var BroadcastRawTransactionAsyncResult__Error_DEFAULT *Error
-func (p *BroadcastRawTransactionAsyncResult_) GetError() *Error {
-  if !p.IsSetError() {
-    return BroadcastRawTransactionAsyncResult__Error_DEFAULT
-  }
-return p.Error
+
+func (p *SmartContract) GetGasLimit() string {
+  return p.GasLimit
 }
-func (p *BroadcastRawTransactionAsyncResult_) IsSetResult_() bool {
-  return p.Result_ != nil
+
+func (p *SmartContract) GetData() string {
+  return p.Data
 }
 
-func (p *BroadcastRawTransactionAsyncResult_) IsSetError() bool {
-  return p.Error != nil
+func (p *SmartContract) GetSeq() string {
+  return p.Seq
 }
 
-func (p *BroadcastRawTransactionAsyncResult_) Read(iprot thrift.TProtocol) error {
+func (p *SmartContract) GetToAddress() string {
+  return p.ToAddress
+}
+func (p *SmartContract) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -5644,7 +5572,7 @@ func (p *BroadcastRawTransactionAsyncResult_) Read(iprot thrift.TProtocol) error
         }
       }
     case 2:
-      if fieldTypeId == thrift.I32 {
+      if fieldTypeId == thrift.STRING {
         if err := p.ReadField2(iprot); err != nil {
           return err
         }
@@ -5654,7 +5582,7 @@ func (p *BroadcastRawTransactionAsyncResult_) Read(iprot thrift.TProtocol) error
         }
       }
     case 3:
-      if fieldTypeId == thrift.STRUCT {
+      if fieldTypeId == thrift.STRING {
         if err := p.ReadField3(iprot); err != nil {
           return err
         }
@@ -5664,7 +5592,7 @@ func (p *BroadcastRawTransactionAsyncResult_) Read(iprot thrift.TProtocol) error
         }
       }
     case 4:
-      if fieldTypeId == thrift.STRUCT {
+      if fieldTypeId == thrift.STRING {
         if err := p.ReadField4(iprot); err != nil {
           return err
         }
@@ -5673,6 +5601,36 @@ func (p *BroadcastRawTransactionAsyncResult_) Read(iprot thrift.TProtocol) error
           return err
         }
       }
+    case 5:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField5(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 6:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField6(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 7:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField7(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -5688,48 +5646,80 @@ func (p *BroadcastRawTransactionAsyncResult_) Read(iprot thrift.TProtocol) error
   return nil
 }
 
-func (p *BroadcastRawTransactionAsyncResult_)  ReadField1(iprot thrift.TProtocol) error {
+func (p *SmartContract)  ReadField1(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 1: ", err)
 } else {
-  p.Jsonrpc = v
+  p.Chain = v
 }
   return nil
 }
 
-func (p *BroadcastRawTransactionAsyncResult_)  ReadField2(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI32(); err != nil {
+func (p *SmartContract)  ReadField2(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 2: ", err)
 } else {
-  p.ID = v
+  p.FromAddress = v
 }
   return nil
 }
 
-func (p *BroadcastRawTransactionAsyncResult_)  ReadField3(iprot thrift.TProtocol) error {
-  p.Result_ = &BroadcastRawTransactionAsync{}
-  if err := p.Result_.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Result_), err)
-  }
+func (p *SmartContract)  ReadField3(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 3: ", err)
+} else {
+  p.GasPrice = v
+}
   return nil
 }
 
-func (p *BroadcastRawTransactionAsyncResult_)  ReadField4(iprot thrift.TProtocol) error {
-  p.Error = &Error{}
-  if err := p.Error.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Error), err)
-  }
+func (p *SmartContract)  ReadField4(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 4: ", err)
+} else {
+  p.GasLimit = v
+}
   return nil
 }
 
-func (p *BroadcastRawTransactionAsyncResult_) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("BroadcastRawTransactionAsyncResult"); err != nil {
+func (p *SmartContract)  ReadField5(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 5: ", err)
+} else {
+  p.Data = v
+}
+  return nil
+}
+
+func (p *SmartContract)  ReadField6(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 6: ", err)
+} else {
+  p.Seq = v
+}
+  return nil
+}
+
+func (p *SmartContract)  ReadField7(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 7: ", err)
+} else {
+  p.ToAddress = v
+}
+  return nil
+}
+
+func (p *SmartContract) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("SmartContract"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
     if err := p.writeField4(oprot); err != nil { return err }
+    if err := p.writeField5(oprot); err != nil { return err }
+    if err := p.writeField6(oprot); err != nil { return err }
+    if err := p.writeField7(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -5738,209 +5728,158 @@ func (p *BroadcastRawTransactionAsyncResult_) Write(oprot thrift.TProtocol) erro
   return nil
 }
 
-func (p *BroadcastRawTransactionAsyncResult_) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("jsonrpc", thrift.STRING, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:jsonrpc: ", p), err) }
-  if err := oprot.WriteString(string(p.Jsonrpc)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.jsonrpc (1) field write error: ", p), err) }
+func (p *SmartContract) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("chain", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:chain: ", p), err) }
+  if err := oprot.WriteString(string(p.Chain)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.chain (1) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:jsonrpc: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:chain: ", p), err) }
   return err
 }
 
-func (p *BroadcastRawTransactionAsyncResult_) writeField2(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("id", thrift.I32, 2); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:id: ", p), err) }
-  if err := oprot.WriteI32(int32(p.ID)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.id (2) field write error: ", p), err) }
+func (p *SmartContract) writeField2(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("from_address", thrift.STRING, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:from_address: ", p), err) }
+  if err := oprot.WriteString(string(p.FromAddress)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.from_address (2) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:id: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:from_address: ", p), err) }
   return err
 }
 
-func (p *BroadcastRawTransactionAsyncResult_) writeField3(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("result", thrift.STRUCT, 3); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:result: ", p), err) }
-  if err := p.Result_.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Result_), err)
-  }
+func (p *SmartContract) writeField3(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("gas_price", thrift.STRING, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:gas_price: ", p), err) }
+  if err := oprot.WriteString(string(p.GasPrice)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.gas_price (3) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:result: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:gas_price: ", p), err) }
   return err
 }
 
-func (p *BroadcastRawTransactionAsyncResult_) writeField4(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("error", thrift.STRUCT, 4); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:error: ", p), err) }
-  if err := p.Error.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Error), err)
-  }
+func (p *SmartContract) writeField4(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("gas_limit", thrift.STRING, 4); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:gas_limit: ", p), err) }
+  if err := oprot.WriteString(string(p.GasLimit)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.gas_limit (4) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 4:error: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 4:gas_limit: ", p), err) }
   return err
 }
 
-func (p *BroadcastRawTransactionAsyncResult_) String() string {
-  if p == nil {
-    return "<nil>"
-  }
-  return fmt.Sprintf("BroadcastRawTransactionAsyncResult_(%+v)", *p)
+func (p *SmartContract) writeField5(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("data", thrift.STRING, 5); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:data: ", p), err) }
+  if err := oprot.WriteString(string(p.Data)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.data (5) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 5:data: ", p), err) }
+  return err
 }
 
-// Attributes:
-//  - Code
-//  - Message
-type Error struct {
-  Code int32 `thrift:"code,1" db:"code" json:"code"`
-  Message string `thrift:"message,2" db:"message" json:"message"`
+func (p *SmartContract) writeField6(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("seq", thrift.STRING, 6); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:seq: ", p), err) }
+  if err := oprot.WriteString(string(p.Seq)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.seq (6) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 6:seq: ", p), err) }
+  return err
 }
 
-func NewError() *Error {
-  return &Error{}
+func (p *SmartContract) writeField7(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("to_address", thrift.STRING, 7); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:to_address: ", p), err) }
+  if err := oprot.WriteString(string(p.ToAddress)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.to_address (7) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 7:to_address: ", p), err) }
+  return err
 }
 
-
-func (p *Error) GetCode() int32 {
-  return p.Code
+func (p *SmartContract) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("SmartContract(%+v)", *p)
 }
 
-func (p *Error) GetMessage() string {
-  return p.Message
+// Attributes:
+//  - Address
+//  - ChainID
+//  - PeerID
+//  - LatestFinalizedBlockHash
+//  - LatestFinalizedBlockHeight
+//  - LatestFinalizedBlockTime
+//  - LatestFinalizedBlockEpoch
+//  - CurrentEpoch
+//  - CurrentHeight
+//  - CurrentTime
+//  - Syncing
+type Status struct {
+  Address string `thrift:"address,1" db:"address" json:"address"`
+  ChainID string `thrift:"chain_id,2" db:"chain_id" json:"chain_id"`
+  PeerID string `thrift:"peer_id,3" db:"peer_id" json:"peer_id"`
+  LatestFinalizedBlockHash string `thrift:"latest_finalized_block_hash,4" db:"latest_finalized_block_hash" json:"latest_finalized_block_hash"`
+  LatestFinalizedBlockHeight string `thrift:"latest_finalized_block_height,5" db:"latest_finalized_block_height" json:"latest_finalized_block_height"`
+  LatestFinalizedBlockTime string `thrift:"latest_finalized_block_time,6" db:"latest_finalized_block_time" json:"latest_finalized_block_time"`
+  LatestFinalizedBlockEpoch string `thrift:"latest_finalized_block_epoch,7" db:"latest_finalized_block_epoch" json:"latest_finalized_block_epoch"`
+  CurrentEpoch string `thrift:"current_epoch,8" db:"current_epoch" json:"current_epoch"`
+  CurrentHeight string `thrift:"current_height,9" db:"current_height" json:"current_height"`
+  CurrentTime string `thrift:"current_time,10" db:"current_time" json:"current_time"`
+  Syncing bool `thrift:"syncing,11" db:"syncing" json:"syncing"`
 }
-func (p *Error) Read(iprot thrift.TProtocol) error {
-  if _, err := iprot.ReadStructBegin(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
-  }
-
 
-  for {
-    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
-    if err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
-    }
-    if fieldTypeId == thrift.STOP { break; }
-    switch fieldId {
-    case 1:
-      if fieldTypeId == thrift.I32 {
-        if err := p.ReadField1(iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(fieldTypeId); err != nil {
-          return err
-        }
-      }
-    case 2:
-      if fieldTypeId == thrift.STRING {
-        if err := p.ReadField2(iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(fieldTypeId); err != nil {
-          return err
-        }
-      }
-    default:
-      if err := iprot.Skip(fieldTypeId); err != nil {
-        return err
-      }
-    }
-    if err := iprot.ReadFieldEnd(); err != nil {
-      return err
-    }
-  }
-  if err := iprot.ReadStructEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-  }
-  return nil
+func NewStatus() *Status {
+  return &Status{}
 }
 
-func (p *Error)  ReadField1(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI32(); err != nil {
-  return thrift.PrependError("error reading field 1: ", err)
-} else {
-  p.Code = v
-}
-  return nil
-}
 
-func (p *Error)  ReadField2(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 2: ", err)
-} else {
-  p.Message = v
-}
-  return nil
+func (p *Status) GetAddress() string {
+  return p.Address
 }
 
-func (p *Error) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("Error"); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
-  if p != nil {
-    if err := p.writeField1(oprot); err != nil { return err }
-    if err := p.writeField2(oprot); err != nil { return err }
-  }
-  if err := oprot.WriteFieldStop(); err != nil {
-    return thrift.PrependError("write field stop error: ", err) }
-  if err := oprot.WriteStructEnd(); err != nil {
-    return thrift.PrependError("write struct stop error: ", err) }
-  return nil
+func (p *Status) GetChainID() string {
+  return p.ChainID
 }
 
-func (p *Error) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:code: ", p), err) }
-  if err := oprot.WriteI32(int32(p.Code)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.code (1) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:code: ", p), err) }
-  return err
+func (p *Status) GetPeerID() string {
+  return p.PeerID
 }
 
-func (p *Error) writeField2(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:message: ", p), err) }
-  if err := oprot.WriteString(string(p.Message)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.message (2) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:message: ", p), err) }
-  return err
+func (p *Status) GetLatestFinalizedBlockHash() string {
+  return p.LatestFinalizedBlockHash
 }
 
-func (p *Error) String() string {
-  if p == nil {
-    return "<nil>"
-  }
-  return fmt.Sprintf("Error(%+v)", *p)
+func (p *Status) GetLatestFinalizedBlockHeight() string {
+  return p.LatestFinalizedBlockHeight
 }
 
-// Attributes:
-//  - PrivateKey
-//  - To
-//  - Amount
-type SendToken struct {
-  PrivateKey string `thrift:"private_key,1" db:"private_key" json:"private_key"`
-  To string `thrift:"to,2" db:"to" json:"to"`
-  Amount int32 `thrift:"amount,3" db:"amount" json:"amount"`
+func (p *Status) GetLatestFinalizedBlockTime() string {
+  return p.LatestFinalizedBlockTime
 }
 
-func NewSendToken() *SendToken {
-  return &SendToken{}
+func (p *Status) GetLatestFinalizedBlockEpoch() string {
+  return p.LatestFinalizedBlockEpoch
 }
 
+func (p *Status) GetCurrentEpoch() string {
+  return p.CurrentEpoch
+}
 
-func (p *SendToken) GetPrivateKey() string {
-  return p.PrivateKey
+func (p *Status) GetCurrentHeight() string {
+  return p.CurrentHeight
 }
 
-func (p *SendToken) GetTo() string {
-  return p.To
+func (p *Status) GetCurrentTime() string {
+  return p.CurrentTime
 }
 
-func (p *SendToken) GetAmount() int32 {
-  return p.Amount
+func (p *Status) GetSyncing() bool {
+  return p.Syncing
 }
-func (p *SendToken) Read(iprot thrift.TProtocol) error {
+func (p *Status) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -5974,7 +5913,7 @@ func (p *SendToken) Read(iprot thrift.TProtocol) error {
         }
       }
     case 3:
-      if fieldTypeId == thrift.I32 {
+      if fieldTypeId == thrift.STRING {
         if err := p.ReadField3(iprot); err != nil {
           return err
         }
@@ -5983,9 +5922,89 @@ func (p *SendToken) Read(iprot thrift.TProtocol) error {
           return err
         }
       }
-    default:
-      if err := iprot.Skip(fieldTypeId); err != nil {
-        return err
+    case 4:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField4(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 5:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField5(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 6:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField6(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 7:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField7(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 8:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField8(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 9:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField9(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 10:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField10(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 11:
+      if fieldTypeId == thrift.BOOL {
+        if err := p.ReadField11(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
       }
     }
     if err := iprot.ReadFieldEnd(); err != nil {
@@ -5998,40 +6017,120 @@ func (p *SendToken) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *SendToken)  ReadField1(iprot thrift.TProtocol) error {
+func (p *Status)  ReadField1(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 1: ", err)
 } else {
-  p.PrivateKey = v
+  p.Address = v
 }
   return nil
 }
 
-func (p *SendToken)  ReadField2(iprot thrift.TProtocol) error {
+func (p *Status)  ReadField2(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 2: ", err)
 } else {
-  p.To = v
+  p.ChainID = v
 }
   return nil
 }
 
-func (p *SendToken)  ReadField3(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI32(); err != nil {
+func (p *Status)  ReadField3(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 3: ", err)
 } else {
-  p.Amount = v
+  p.PeerID = v
 }
   return nil
 }
 
-func (p *SendToken) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("SendToken"); err != nil {
+func (p *Status)  ReadField4(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 4: ", err)
+} else {
+  p.LatestFinalizedBlockHash = v
+}
+  return nil
+}
+
+func (p *Status)  ReadField5(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 5: ", err)
+} else {
+  p.LatestFinalizedBlockHeight = v
+}
+  return nil
+}
+
+func (p *Status)  ReadField6(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 6: ", err)
+} else {
+  p.LatestFinalizedBlockTime = v
+}
+  return nil
+}
+
+func (p *Status)  ReadField7(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 7: ", err)
+} else {
+  p.LatestFinalizedBlockEpoch = v
+}
+  return nil
+}
+
+func (p *Status)  ReadField8(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 8: ", err)
+} else {
+  p.CurrentEpoch = v
+}
+  return nil
+}
+
+func (p *Status)  ReadField9(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 9: ", err)
+} else {
+  p.CurrentHeight = v
+}
+  return nil
+}
+
+func (p *Status)  ReadField10(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 10: ", err)
+} else {
+  p.CurrentTime = v
+}
+  return nil
+}
+
+func (p *Status)  ReadField11(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(); err != nil {
+  return thrift.PrependError("error reading field 11: ", err)
+} else {
+  p.Syncing = v
+}
+  return nil
+}
+
+func (p *Status) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("Status"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
+    if err := p.writeField4(oprot); err != nil { return err }
+    if err := p.writeField5(oprot); err != nil { return err }
+    if err := p.writeField6(oprot); err != nil { return err }
+    if err := p.writeField7(oprot); err != nil { return err }
+    if err := p.writeField8(oprot); err != nil { return err }
+    if err := p.writeField9(oprot); err != nil { return err }
+    if err := p.writeField10(oprot); err != nil { return err }
+    if err := p.writeField11(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -6040,76 +6139,156 @@ func (p *SendToken) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *SendToken) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("private_key", thrift.STRING, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:private_key: ", p), err) }
-  if err := oprot.WriteString(string(p.PrivateKey)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.private_key (1) field write error: ", p), err) }
+func (p *Status) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("address", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:address: ", p), err) }
+  if err := oprot.WriteString(string(p.Address)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.address (1) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:private_key: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:address: ", p), err) }
   return err
 }
 
-func (p *SendToken) writeField2(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("to", thrift.STRING, 2); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:to: ", p), err) }
-  if err := oprot.WriteString(string(p.To)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.to (2) field write error: ", p), err) }
+func (p *Status) writeField2(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("chain_id", thrift.STRING, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:chain_id: ", p), err) }
+  if err := oprot.WriteString(string(p.ChainID)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.chain_id (2) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:to: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:chain_id: ", p), err) }
   return err
 }
 
-func (p *SendToken) writeField3(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("amount", thrift.I32, 3); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:amount: ", p), err) }
-  if err := oprot.WriteI32(int32(p.Amount)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.amount (3) field write error: ", p), err) }
+func (p *Status) writeField3(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("peer_id", thrift.STRING, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:peer_id: ", p), err) }
+  if err := oprot.WriteString(string(p.PeerID)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.peer_id (3) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:amount: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:peer_id: ", p), err) }
   return err
 }
 
-func (p *SendToken) String() string {
+func (p *Status) writeField4(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("latest_finalized_block_hash", thrift.STRING, 4); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:latest_finalized_block_hash: ", p), err) }
+  if err := oprot.WriteString(string(p.LatestFinalizedBlockHash)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.latest_finalized_block_hash (4) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 4:latest_finalized_block_hash: ", p), err) }
+  return err
+}
+
+func (p *Status) writeField5(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("latest_finalized_block_height", thrift.STRING, 5); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:latest_finalized_block_height: ", p), err) }
+  if err := oprot.WriteString(string(p.LatestFinalizedBlockHeight)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.latest_finalized_block_height (5) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 5:latest_finalized_block_height: ", p), err) }
+  return err
+}
+
+func (p *Status) writeField6(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("latest_finalized_block_time", thrift.STRING, 6); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:latest_finalized_block_time: ", p), err) }
+  if err := oprot.WriteString(string(p.LatestFinalizedBlockTime)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.latest_finalized_block_time (6) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 6:latest_finalized_block_time: ", p), err) }
+  return err
+}
+
+func (p *Status) writeField7(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("latest_finalized_block_epoch", thrift.STRING, 7); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:latest_finalized_block_epoch: ", p), err) }
+  if err := oprot.WriteString(string(p.LatestFinalizedBlockEpoch)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.latest_finalized_block_epoch (7) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 7:latest_finalized_block_epoch: ", p), err) }
+  return err
+}
+
+func (p *Status) writeField8(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("current_epoch", thrift.STRING, 8); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:current_epoch: ", p), err) }
+  if err := oprot.WriteString(string(p.CurrentEpoch)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.current_epoch (8) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 8:current_epoch: ", p), err) }
+  return err
+}
+
+func (p *Status) writeField9(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("current_height", thrift.STRING, 9); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 9:current_height: ", p), err) }
+  if err := oprot.WriteString(string(p.CurrentHeight)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.current_height (9) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 9:current_height: ", p), err) }
+  return err
+}
+
+func (p *Status) writeField10(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("current_time", thrift.STRING, 10); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 10:current_time: ", p), err) }
+  if err := oprot.WriteString(string(p.CurrentTime)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.current_time (10) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 10:current_time: ", p), err) }
+  return err
+}
+
+func (p *Status) writeField11(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("syncing", thrift.BOOL, 11); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 11:syncing: ", p), err) }
+  if err := oprot.WriteBool(bool(p.Syncing)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.syncing (11) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 11:syncing: ", p), err) }
+  return err
+}
+
+func (p *Status) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("SendToken(%+v)", *p)
+  return fmt.Sprintf("Status(%+v)", *p)
 }
 
 // Attributes:
-//  - ContractAddress
-//  - GasUsed
-//  - VMError
-//  - VMReturn
-type SmartContractCall struct {
-  ContractAddress string `thrift:"contract_address,1" db:"contract_address" json:"contract_address"`
-  GasUsed string `thrift:"gas_used,2" db:"gas_used" json:"gas_used"`
-  VMError string `thrift:"vm_error,3" db:"vm_error" json:"vm_error"`
-  VMReturn string `thrift:"vm_return,4" db:"vm_return" json:"vm_return"`
+//  - Source
+//  - Amount
+//  - Withdrawn
+//  - ReturnHeight
+type Stake struct {
+  Source string `thrift:"source,1" db:"source" json:"source"`
+  Amount string `thrift:"amount,2" db:"amount" json:"amount"`
+  Withdrawn bool `thrift:"withdrawn,3" db:"withdrawn" json:"withdrawn"`
+  ReturnHeight string `thrift:"return_height,4" db:"return_height" json:"return_height"`
 }
 
-func NewSmartContractCall() *SmartContractCall {
-  return &SmartContractCall{}
+func NewStake() *Stake {
+  return &Stake{}
 }
 
 
-func (p *SmartContractCall) GetContractAddress() string {
-  return p.ContractAddress
+func (p *Stake) GetSource() string {
+  return p.Source
 }
 
-func (p *SmartContractCall) GetGasUsed() string {
-  return p.GasUsed
+func (p *Stake) GetAmount() string {
+  return p.Amount
 }
 
-func (p *SmartContractCall) GetVMError() string {
-  return p.VMError
+func (p *Stake) GetWithdrawn() bool {
+  return p.Withdrawn
 }
 
-func (p *SmartContractCall) GetVMReturn() string {
-  return p.VMReturn
+func (p *Stake) GetReturnHeight() string {
+  return p.ReturnHeight
 }
-func (p *SmartContractCall) Read(iprot thrift.TProtocol) error {
+func (p *Stake) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -6143,7 +6322,7 @@ func (p *SmartContractCall) Read(iprot thrift.TProtocol) error {
         }
       }
     case 3:
-      if fieldTypeId == thrift.STRING {
+      if fieldTypeId == thrift.BOOL {
         if err := p.ReadField3(iprot); err != nil {
           return err
         }
@@ -6177,44 +6356,44 @@ func (p *SmartContractCall) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *SmartContractCall)  ReadField1(iprot thrift.TProtocol) error {
+func (p *Stake)  ReadField1(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 1: ", err)
 } else {
-  p.ContractAddress = v
+  p.Source = v
 }
   return nil
 }
 
-func (p *SmartContractCall)  ReadField2(iprot thrift.TProtocol) error {
+func (p *Stake)  ReadField2(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 2: ", err)
 } else {
-  p.GasUsed = v
+  p.Amount = v
 }
   return nil
 }
 
-func (p *SmartContractCall)  ReadField3(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
+func (p *Stake)  ReadField3(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(); err != nil {
   return thrift.PrependError("error reading field 3: ", err)
 } else {
-  p.VMError = v
+  p.Withdrawn = v
 }
   return nil
 }
 
-func (p *SmartContractCall)  ReadField4(iprot thrift.TProtocol) error {
+func (p *Stake)  ReadField4(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 4: ", err)
 } else {
-  p.VMReturn = v
+  p.ReturnHeight = v
 }
   return nil
 }
 
-func (p *SmartContractCall) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("SmartContractCall"); err != nil {
+func (p *Stake) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("Stake"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -6229,100 +6408,227 @@ func (p *SmartContractCall) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *SmartContractCall) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("contract_address", thrift.STRING, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:contract_address: ", p), err) }
-  if err := oprot.WriteString(string(p.ContractAddress)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.contract_address (1) field write error: ", p), err) }
+func (p *Stake) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("source", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:source: ", p), err) }
+  if err := oprot.WriteString(string(p.Source)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.source (1) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:contract_address: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:source: ", p), err) }
   return err
 }
 
-func (p *SmartContractCall) writeField2(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("gas_used", thrift.STRING, 2); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:gas_used: ", p), err) }
-  if err := oprot.WriteString(string(p.GasUsed)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.gas_used (2) field write error: ", p), err) }
+func (p *Stake) writeField2(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("amount", thrift.STRING, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:amount: ", p), err) }
+  if err := oprot.WriteString(string(p.Amount)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.amount (2) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:gas_used: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:amount: ", p), err) }
   return err
 }
 
-func (p *SmartContractCall) writeField3(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("vm_error", thrift.STRING, 3); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:vm_error: ", p), err) }
-  if err := oprot.WriteString(string(p.VMError)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.vm_error (3) field write error: ", p), err) }
+func (p *Stake) writeField3(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("withdrawn", thrift.BOOL, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:withdrawn: ", p), err) }
+  if err := oprot.WriteBool(bool(p.Withdrawn)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.withdrawn (3) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:vm_error: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:withdrawn: ", p), err) }
   return err
 }
 
-func (p *SmartContractCall) writeField4(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("vm_return", thrift.STRING, 4); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:vm_return: ", p), err) }
-  if err := oprot.WriteString(string(p.VMReturn)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.vm_return (4) field write error: ", p), err) }
+func (p *Stake) writeField4(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("return_height", thrift.STRING, 4); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:return_height: ", p), err) }
+  if err := oprot.WriteString(string(p.ReturnHeight)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.return_height (4) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 4:vm_return: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 4:return_height: ", p), err) }
   return err
 }
 
-func (p *SmartContractCall) String() string {
+func (p *Stake) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("SmartContractCall(%+v)", *p)
+  return fmt.Sprintf("Stake(%+v)", *p)
 }
 
 // Attributes:
-//  - Jsonrpc
-//  - ID
-//  - Result_
-//  - Error
-type BlockResult_ struct {
-  Jsonrpc string `thrift:"jsonrpc,1" db:"jsonrpc" json:"jsonrpc"`
-  ID int32 `thrift:"id,2" db:"id" json:"id"`
-  Result_ *Block `thrift:"result,3" db:"result" json:"result"`
-  Error *Error `thrift:"error,4" db:"error" json:"error"`
+//  - Holder
+//  - Stakes
+type StakeHolder struct {
+  Holder string `thrift:"holder,1" db:"holder" json:"holder"`
+  Stakes []*Stake `thrift:"stakes,2" db:"stakes" json:"stakes"`
 }
 
-func NewBlockResult_() *BlockResult_ {
-  return &BlockResult_{}
+func NewStakeHolder() *StakeHolder {
+  return &StakeHolder{}
 }
 
 
-func (p *BlockResult_) GetJsonrpc() string {
-  return p.Jsonrpc
+func (p *StakeHolder) GetHolder() string {
+  return p.Holder
 }
 
-func (p *BlockResult_) GetID() int32 {
-  return p.ID
+func (p *StakeHolder) GetStakes() []*Stake {
+  return p.Stakes
 }
-var BlockResult__Result__DEFAULT *Block
-func (p *BlockResult_) GetResult_() *Block {
-  if !p.IsSetResult_() {
-    return BlockResult__Result__DEFAULT
+func (p *StakeHolder) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
-return p.Result_
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 2:
+      if fieldTypeId == thrift.LIST {
+        if err := p.ReadField2(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
 }
-var BlockResult__Error_DEFAULT *Error
-func (p *BlockResult_) GetError() *Error {
-  if !p.IsSetError() {
-    return BlockResult__Error_DEFAULT
+
+func (p *StakeHolder)  ReadField1(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.Holder = v
+}
+  return nil
+}
+
+func (p *StakeHolder)  ReadField2(iprot thrift.TProtocol) error {
+  _, size, err := iprot.ReadListBegin()
+  if err != nil {
+    return thrift.PrependError("error reading list begin: ", err)
   }
-return p.Error
+  tSlice := make([]*Stake, 0, size)
+  p.Stakes =  tSlice
+  for i := 0; i < size; i ++ {
+    _elem10 := &Stake{}
+    if err := _elem10.Read(iprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem10), err)
+    }
+    p.Stakes = append(p.Stakes, _elem10)
+  }
+  if err := iprot.ReadListEnd(); err != nil {
+    return thrift.PrependError("error reading list end: ", err)
+  }
+  return nil
 }
-func (p *BlockResult_) IsSetResult_() bool {
-  return p.Result_ != nil
+
+func (p *StakeHolder) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("StakeHolder"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
 }
 
-func (p *BlockResult_) IsSetError() bool {
-  return p.Error != nil
+func (p *StakeHolder) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("holder", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:holder: ", p), err) }
+  if err := oprot.WriteString(string(p.Holder)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.holder (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:holder: ", p), err) }
+  return err
 }
 
-func (p *BlockResult_) Read(iprot thrift.TProtocol) error {
+func (p *StakeHolder) writeField2(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("stakes", thrift.LIST, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:stakes: ", p), err) }
+  if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Stakes)); err != nil {
+    return thrift.PrependError("error writing list begin: ", err)
+  }
+  for _, v := range p.Stakes {
+    if err := v.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+    }
+  }
+  if err := oprot.WriteListEnd(); err != nil {
+    return thrift.PrependError("error writing list end: ", err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:stakes: ", p), err) }
+  return err
+}
+
+func (p *StakeHolder) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("StakeHolder(%+v)", *p)
+}
+
+// Attributes:
+//  - BlockHash
+//  - Candidates
+//  - StakeTxHeights
+type BlockHashVcpPair struct {
+  BlockHash string `thrift:"block_hash,1" db:"block_hash" json:"block_hash"`
+  Candidates []*StakeHolder `thrift:"candidates,2" db:"candidates" json:"candidates"`
+  StakeTxHeights []string `thrift:"stake_tx_heights,3" db:"stake_tx_heights" json:"stake_tx_heights"`
+}
+
+func NewBlockHashVcpPair() *BlockHashVcpPair {
+  return &BlockHashVcpPair{}
+}
+
+
+func (p *BlockHashVcpPair) GetBlockHash() string {
+  return p.BlockHash
+}
+
+func (p *BlockHashVcpPair) GetCandidates() []*StakeHolder {
+  return p.Candidates
+}
+
+func (p *BlockHashVcpPair) GetStakeTxHeights() []string {
+  return p.StakeTxHeights
+}
+func (p *BlockHashVcpPair) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -6346,7 +6652,7 @@ func (p *BlockResult_) Read(iprot thrift.TProtocol) error {
         }
       }
     case 2:
-      if fieldTypeId == thrift.I32 {
+      if fieldTypeId == thrift.LIST {
         if err := p.ReadField2(iprot); err != nil {
           return err
         }
@@ -6356,7 +6662,7 @@ func (p *BlockResult_) Read(iprot thrift.TProtocol) error {
         }
       }
     case 3:
-      if fieldTypeId == thrift.STRUCT {
+      if fieldTypeId == thrift.LIST {
         if err := p.ReadField3(iprot); err != nil {
           return err
         }
@@ -6365,16 +6671,6 @@ func (p *BlockResult_) Read(iprot thrift.TProtocol) error {
           return err
         }
       }
-    case 4:
-      if fieldTypeId == thrift.STRUCT {
-        if err := p.ReadField4(iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(fieldTypeId); err != nil {
-          return err
-        }
-      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -6390,48 +6686,64 @@ func (p *BlockResult_) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *BlockResult_)  ReadField1(iprot thrift.TProtocol) error {
+func (p *BlockHashVcpPair)  ReadField1(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 1: ", err)
 } else {
-  p.Jsonrpc = v
+  p.BlockHash = v
 }
   return nil
 }
 
-func (p *BlockResult_)  ReadField2(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI32(); err != nil {
-  return thrift.PrependError("error reading field 2: ", err)
-} else {
-  p.ID = v
-}
+func (p *BlockHashVcpPair)  ReadField2(iprot thrift.TProtocol) error {
+  _, size, err := iprot.ReadListBegin()
+  if err != nil {
+    return thrift.PrependError("error reading list begin: ", err)
+  }
+  tSlice := make([]*StakeHolder, 0, size)
+  p.Candidates =  tSlice
+  for i := 0; i < size; i ++ {
+    _elem11 := &StakeHolder{}
+    if err := _elem11.Read(iprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem11), err)
+    }
+    p.Candidates = append(p.Candidates, _elem11)
+  }
+  if err := iprot.ReadListEnd(); err != nil {
+    return thrift.PrependError("error reading list end: ", err)
+  }
   return nil
 }
 
-func (p *BlockResult_)  ReadField3(iprot thrift.TProtocol) error {
-  p.Result_ = &Block{}
-  if err := p.Result_.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Result_), err)
+func (p *BlockHashVcpPair)  ReadField3(iprot thrift.TProtocol) error {
+  _, size, err := iprot.ReadListBegin()
+  if err != nil {
+    return thrift.PrependError("error reading list begin: ", err)
   }
-  return nil
+  tSlice := make([]string, 0, size)
+  p.StakeTxHeights =  tSlice
+  for i := 0; i < size; i ++ {
+var _elem12 string
+    if v, err := iprot.ReadString(); err != nil {
+    return thrift.PrependError("error reading field 0: ", err)
+} else {
+    _elem12 = v
 }
-
-func (p *BlockResult_)  ReadField4(iprot thrift.TProtocol) error {
-  p.Error = &Error{}
-  if err := p.Error.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Error), err)
+    p.StakeTxHeights = append(p.StakeTxHeights, _elem12)
+  }
+  if err := iprot.ReadListEnd(); err != nil {
+    return thrift.PrependError("error reading list end: ", err)
   }
   return nil
 }
 
-func (p *BlockResult_) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("BlockResult"); err != nil {
+func (p *BlockHashVcpPair) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("BlockHashVcpPair"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
     if err := p.writeField2(oprot); err != nil { return err }
     if err := p.writeField3(oprot); err != nil { return err }
-    if err := p.writeField4(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -6440,660 +6752,10637 @@ func (p *BlockResult_) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *BlockResult_) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("jsonrpc", thrift.STRING, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:jsonrpc: ", p), err) }
-  if err := oprot.WriteString(string(p.Jsonrpc)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.jsonrpc (1) field write error: ", p), err) }
+func (p *BlockHashVcpPair) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("block_hash", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:block_hash: ", p), err) }
+  if err := oprot.WriteString(string(p.BlockHash)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.block_hash (1) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:jsonrpc: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:block_hash: ", p), err) }
   return err
 }
 
-func (p *BlockResult_) writeField2(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("id", thrift.I32, 2); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:id: ", p), err) }
-  if err := oprot.WriteI32(int32(p.ID)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.id (2) field write error: ", p), err) }
+func (p *BlockHashVcpPair) writeField2(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("candidates", thrift.LIST, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:candidates: ", p), err) }
+  if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Candidates)); err != nil {
+    return thrift.PrependError("error writing list begin: ", err)
+  }
+  for _, v := range p.Candidates {
+    if err := v.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+    }
+  }
+  if err := oprot.WriteListEnd(); err != nil {
+    return thrift.PrependError("error writing list end: ", err)
+  }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:id: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:candidates: ", p), err) }
   return err
 }
 
-func (p *BlockResult_) writeField3(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("result", thrift.STRUCT, 3); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:result: ", p), err) }
-  if err := p.Result_.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Result_), err)
+func (p *BlockHashVcpPair) writeField3(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("stake_tx_heights", thrift.LIST, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:stake_tx_heights: ", p), err) }
+  if err := oprot.WriteListBegin(thrift.STRING, len(p.StakeTxHeights)); err != nil {
+    return thrift.PrependError("error writing list begin: ", err)
+  }
+  for _, v := range p.StakeTxHeights {
+    if err := oprot.WriteString(string(v)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err) }
+  }
+  if err := oprot.WriteListEnd(); err != nil {
+    return thrift.PrependError("error writing list end: ", err)
   }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:result: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:stake_tx_heights: ", p), err) }
   return err
 }
 
-func (p *BlockResult_) writeField4(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("error", thrift.STRUCT, 4); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:error: ", p), err) }
-  if err := p.Error.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Error), err)
-  }
-  if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 4:error: ", p), err) }
-  return err
-}
-
-func (p *BlockResult_) String() string {
+func (p *BlockHashVcpPair) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("BlockResult_(%+v)", *p)
+  return fmt.Sprintf("BlockHashVcpPair(%+v)", *p)
 }
 
-type ThetaService interface {
-  // Parameters:
-  //  - Account
-  GetAccount(ctx context.Context, account string) (r *Account, err error)
-  // Parameters:
-  //  - Send
-  SendTx(ctx context.Context, send *Send) (r *BroadcastRawTransactionAsync, err error)
-  // Parameters:
-  //  - Address
-  //  - ContractAddress
-  //  - PrivateKey
-  GetTokenBalance(ctx context.Context, address string, contract_address string, private_key string) (r int64, err error)
-  // Parameters:
-  //  - Send
-  SendToken(ctx context.Context, send *SendToken) (r *BroadcastRawTransactionAsync, err error)
-  // Parameters:
-  //  - Hash
-  GetBlock(ctx context.Context, hash string) (r *Block, err error)
-  // Parameters:
-  //  - Height
-  GetBlockByHeight(ctx context.Context, height int64) (r *Block, err error)
-  // Parameters:
-  //  - Hash
-  GetBlockHeader(ctx context.Context, hash string) (r *BlockHeader, err error)
-  // Parameters:
-  //  - Height
-  GetBlockHeaderByHeight(ctx context.Context, height int64) (r *BlockHeader, err error)
+// Attributes:
+//  - BlockHash
+//  - Guardians
+type BlockHashGcpPair struct {
+  BlockHash string `thrift:"block_hash,1" db:"block_hash" json:"block_hash"`
+  Guardians []*StakeHolder `thrift:"guardians,2" db:"guardians" json:"guardians"`
 }
 
-type ThetaServiceClient struct {
-  c thrift.TClient
+func NewBlockHashGcpPair() *BlockHashGcpPair {
+  return &BlockHashGcpPair{}
 }
 
-func NewThetaServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *ThetaServiceClient {
-  return &ThetaServiceClient{
-    c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
-  }
+
+func (p *BlockHashGcpPair) GetBlockHash() string {
+  return p.BlockHash
 }
 
-func NewThetaServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *ThetaServiceClient {
-  return &ThetaServiceClient{
-    c: thrift.NewTStandardClient(iprot, oprot),
-  }
+func (p *BlockHashGcpPair) GetGuardians() []*StakeHolder {
+  return p.Guardians
 }
+func (p *BlockHashGcpPair) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
 
-func NewThetaServiceClient(c thrift.TClient) *ThetaServiceClient {
-  return &ThetaServiceClient{
-    c: c,
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 2:
+      if fieldTypeId == thrift.LIST {
+        if err := p.ReadField2(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
   }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
 }
 
-func (p *ThetaServiceClient) Client_() thrift.TClient {
-  return p.c
+func (p *BlockHashGcpPair)  ReadField1(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.BlockHash = v
 }
-// Parameters:
-//  - Account
-func (p *ThetaServiceClient) GetAccount(ctx context.Context, account string) (r *Account, err error) {
-  var _args9 ThetaServiceGetAccountArgs
-  _args9.Account = account
-  var _result10 ThetaServiceGetAccountResult
-  if err = p.Client_().Call(ctx, "getAccount", &_args9, &_result10); err != nil {
-    return
-  }
-  return _result10.GetSuccess(), nil
+  return nil
 }
 
-// Parameters:
-//  - Send
-func (p *ThetaServiceClient) SendTx(ctx context.Context, send *Send) (r *BroadcastRawTransactionAsync, err error) {
-  var _args11 ThetaServiceSendTxArgs
-  _args11.Send = send
-  var _result12 ThetaServiceSendTxResult
-  if err = p.Client_().Call(ctx, "sendTx", &_args11, &_result12); err != nil {
-    return
+func (p *BlockHashGcpPair)  ReadField2(iprot thrift.TProtocol) error {
+  _, size, err := iprot.ReadListBegin()
+  if err != nil {
+    return thrift.PrependError("error reading list begin: ", err)
   }
-  return _result12.GetSuccess(), nil
-}
-
-// Parameters:
-//  - Address
-//  - ContractAddress
-//  - PrivateKey
-func (p *ThetaServiceClient) GetTokenBalance(ctx context.Context, address string, contract_address string, private_key string) (r int64, err error) {
-  var _args13 ThetaServiceGetTokenBalanceArgs
-  _args13.Address = address
-  _args13.ContractAddress = contract_address
-  _args13.PrivateKey = private_key
-  var _result14 ThetaServiceGetTokenBalanceResult
-  if err = p.Client_().Call(ctx, "getTokenBalance", &_args13, &_result14); err != nil {
-    return
+  tSlice := make([]*StakeHolder, 0, size)
+  p.Guardians =  tSlice
+  for i := 0; i < size; i ++ {
+    _elem13 := &StakeHolder{}
+    if err := _elem13.Read(iprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem13), err)
+    }
+    p.Guardians = append(p.Guardians, _elem13)
   }
-  return _result14.GetSuccess(), nil
-}
-
-// Parameters:
-//  - Send
-func (p *ThetaServiceClient) SendToken(ctx context.Context, send *SendToken) (r *BroadcastRawTransactionAsync, err error) {
-  var _args15 ThetaServiceSendTokenArgs
-  _args15.Send = send
-  var _result16 ThetaServiceSendTokenResult
-  if err = p.Client_().Call(ctx, "sendToken", &_args15, &_result16); err != nil {
-    return
+  if err := iprot.ReadListEnd(); err != nil {
+    return thrift.PrependError("error reading list end: ", err)
   }
-  return _result16.GetSuccess(), nil
+  return nil
 }
 
-// Parameters:
-//  - Hash
-func (p *ThetaServiceClient) GetBlock(ctx context.Context, hash string) (r *Block, err error) {
-  var _args17 ThetaServiceGetBlockArgs
-  _args17.Hash = hash
-  var _result18 ThetaServiceGetBlockResult
-  if err = p.Client_().Call(ctx, "GetBlock", &_args17, &_result18); err != nil {
-    return
+func (p *BlockHashGcpPair) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("BlockHashGcpPair"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
   }
-  return _result18.GetSuccess(), nil
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
 }
 
-// Parameters:
-//  - Height
-func (p *ThetaServiceClient) GetBlockByHeight(ctx context.Context, height int64) (r *Block, err error) {
-  var _args19 ThetaServiceGetBlockByHeightArgs
-  _args19.Height = height
-  var _result20 ThetaServiceGetBlockByHeightResult
-  if err = p.Client_().Call(ctx, "GetBlockByHeight", &_args19, &_result20); err != nil {
-    return
-  }
-  return _result20.GetSuccess(), nil
+func (p *BlockHashGcpPair) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("block_hash", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:block_hash: ", p), err) }
+  if err := oprot.WriteString(string(p.BlockHash)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.block_hash (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:block_hash: ", p), err) }
+  return err
 }
 
-// Parameters:
-//  - Hash
-func (p *ThetaServiceClient) GetBlockHeader(ctx context.Context, hash string) (r *BlockHeader, err error) {
-  var _args21 ThetaServiceGetBlockHeaderArgs
-  _args21.Hash = hash
-  var _result22 ThetaServiceGetBlockHeaderResult
-  if err = p.Client_().Call(ctx, "GetBlockHeader", &_args21, &_result22); err != nil {
-    return
+func (p *BlockHashGcpPair) writeField2(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("guardians", thrift.LIST, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:guardians: ", p), err) }
+  if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Guardians)); err != nil {
+    return thrift.PrependError("error writing list begin: ", err)
   }
-  return _result22.GetSuccess(), nil
+  for _, v := range p.Guardians {
+    if err := v.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+    }
+  }
+  if err := oprot.WriteListEnd(); err != nil {
+    return thrift.PrependError("error writing list end: ", err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:guardians: ", p), err) }
+  return err
 }
 
-// Parameters:
-//  - Height
-func (p *ThetaServiceClient) GetBlockHeaderByHeight(ctx context.Context, height int64) (r *BlockHeader, err error) {
-  var _args23 ThetaServiceGetBlockHeaderByHeightArgs
-  _args23.Height = height
-  var _result24 ThetaServiceGetBlockHeaderByHeightResult
-  if err = p.Client_().Call(ctx, "GetBlockHeaderByHeight", &_args23, &_result24); err != nil {
-    return
+func (p *BlockHashGcpPair) String() string {
+  if p == nil {
+    return "<nil>"
   }
-  return _result24.GetSuccess(), nil
+  return fmt.Sprintf("BlockHashGcpPair(%+v)", *p)
 }
 
-type ThetaServiceProcessor struct {
-  processorMap map[string]thrift.TProcessorFunction
-  handler ThetaService
+// Attributes:
+//  - BlsPubkey
+//  - BlsPop
+//  - Address
+//  - Signature
+type GuardianInfo struct {
+  BlsPubkey string `thrift:"bls_pubkey,1" db:"bls_pubkey" json:"bls_pubkey"`
+  BlsPop string `thrift:"bls_pop,2" db:"bls_pop" json:"bls_pop"`
+  Address string `thrift:"address,3" db:"address" json:"address"`
+  Signature string `thrift:"signature,4" db:"signature" json:"signature"`
 }
 
-func (p *ThetaServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
-  p.processorMap[key] = processor
+func NewGuardianInfo() *GuardianInfo {
+  return &GuardianInfo{}
 }
 
-func (p *ThetaServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
-  processor, ok = p.processorMap[key]
-  return processor, ok
-}
 
-func (p *ThetaServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
-  return p.processorMap
+func (p *GuardianInfo) GetBlsPubkey() string {
+  return p.BlsPubkey
 }
 
-func NewThetaServiceProcessor(handler ThetaService) *ThetaServiceProcessor {
-
-  self25 := &ThetaServiceProcessor{handler:handler, processorMap:make(map[string]thrift.TProcessorFunction)}
-  self25.processorMap["getAccount"] = &thetaServiceProcessorGetAccount{handler:handler}
-  self25.processorMap["sendTx"] = &thetaServiceProcessorSendTx{handler:handler}
-  self25.processorMap["getTokenBalance"] = &thetaServiceProcessorGetTokenBalance{handler:handler}
-  self25.processorMap["sendToken"] = &thetaServiceProcessorSendToken{handler:handler}
-  self25.processorMap["GetBlock"] = &thetaServiceProcessorGetBlock{handler:handler}
-  self25.processorMap["GetBlockByHeight"] = &thetaServiceProcessorGetBlockByHeight{handler:handler}
-  self25.processorMap["GetBlockHeader"] = &thetaServiceProcessorGetBlockHeader{handler:handler}
-  self25.processorMap["GetBlockHeaderByHeight"] = &thetaServiceProcessorGetBlockHeaderByHeight{handler:handler}
-return self25
+func (p *GuardianInfo) GetBlsPop() string {
+  return p.BlsPop
 }
 
-func (p *ThetaServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  name, _, seqId, err := iprot.ReadMessageBegin()
-  if err != nil { return false, err }
-  if processor, ok := p.GetProcessorFunction(name); ok {
-    return processor.Process(ctx, seqId, iprot, oprot)
-  }
-  iprot.Skip(thrift.STRUCT)
-  iprot.ReadMessageEnd()
-  x26 := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function " + name)
-  oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
-  x26.Write(oprot)
-  oprot.WriteMessageEnd()
-  oprot.Flush(ctx)
-  return false, x26
-
+func (p *GuardianInfo) GetAddress() string {
+  return p.Address
 }
 
-type thetaServiceProcessorGetAccount struct {
-  handler ThetaService
+func (p *GuardianInfo) GetSignature() string {
+  return p.Signature
 }
-
-func (p *thetaServiceProcessorGetAccount) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := ThetaServiceGetAccountArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("getAccount", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush(ctx)
-    return false, err
+func (p *GuardianInfo) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
 
-  iprot.ReadMessageEnd()
-  result := ThetaServiceGetAccountResult{}
-var retval *Account
-  var err2 error
-  if retval, err2 = p.handler.GetAccount(ctx, args.Account); err2 != nil {
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getAccount: " + err2.Error())
-    oprot.WriteMessageBegin("getAccount", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush(ctx)
-    return true, err2
-  } else {
-    result.Success = retval
-}
-  if err2 = oprot.WriteMessageBegin("getAccount", thrift.REPLY, seqId); err2 != nil {
-    err = err2
-  }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
-  }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
-  }
-  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-    err = err2
-  }
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 2:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField2(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 3:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField3(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 4:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField4(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *GuardianInfo)  ReadField1(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.BlsPubkey = v
+}
+  return nil
+}
+
+func (p *GuardianInfo)  ReadField2(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.BlsPop = v
+}
+  return nil
+}
+
+func (p *GuardianInfo)  ReadField3(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 3: ", err)
+} else {
+  p.Address = v
+}
+  return nil
+}
+
+func (p *GuardianInfo)  ReadField4(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 4: ", err)
+} else {
+  p.Signature = v
+}
+  return nil
+}
+
+func (p *GuardianInfo) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GuardianInfo"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+    if err := p.writeField4(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *GuardianInfo) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("bls_pubkey", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:bls_pubkey: ", p), err) }
+  if err := oprot.WriteString(string(p.BlsPubkey)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.bls_pubkey (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:bls_pubkey: ", p), err) }
+  return err
+}
+
+func (p *GuardianInfo) writeField2(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("bls_pop", thrift.STRING, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:bls_pop: ", p), err) }
+  if err := oprot.WriteString(string(p.BlsPop)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.bls_pop (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:bls_pop: ", p), err) }
+  return err
+}
+
+func (p *GuardianInfo) writeField3(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("address", thrift.STRING, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:address: ", p), err) }
+  if err := oprot.WriteString(string(p.Address)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.address (3) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:address: ", p), err) }
+  return err
+}
+
+func (p *GuardianInfo) writeField4(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("signature", thrift.STRING, 4); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:signature: ", p), err) }
+  if err := oprot.WriteString(string(p.Signature)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.signature (4) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 4:signature: ", p), err) }
+  return err
+}
+
+func (p *GuardianInfo) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("GuardianInfo(%+v)", *p)
+}
+
+// Attributes:
+//  - Address
+type NewKey_ struct {
+  Address string `thrift:"address,1" db:"address" json:"address"`
+}
+
+func NewNewKey_() *NewKey_ {
+  return &NewKey_{}
+}
+
+
+func (p *NewKey_) GetAddress() string {
+  return p.Address
+}
+func (p *NewKey_) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *NewKey_)  ReadField1(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.Address = v
+}
+  return nil
+}
+
+func (p *NewKey_) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("NewKey"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *NewKey_) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("address", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:address: ", p), err) }
+  if err := oprot.WriteString(string(p.Address)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.address (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:address: ", p), err) }
+  return err
+}
+
+func (p *NewKey_) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("NewKey_(%+v)", *p)
+}
+
+// Attributes:
+//  - Addresses
+type ListKeys struct {
+  Addresses []string `thrift:"addresses,1" db:"addresses" json:"addresses"`
+}
+
+func NewListKeys() *ListKeys {
+  return &ListKeys{}
+}
+
+
+func (p *ListKeys) GetAddresses() []string {
+  return p.Addresses
+}
+func (p *ListKeys) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.LIST {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ListKeys)  ReadField1(iprot thrift.TProtocol) error {
+  _, size, err := iprot.ReadListBegin()
+  if err != nil {
+    return thrift.PrependError("error reading list begin: ", err)
+  }
+  tSlice := make([]string, 0, size)
+  p.Addresses =  tSlice
+  for i := 0; i < size; i ++ {
+var _elem14 string
+    if v, err := iprot.ReadString(); err != nil {
+    return thrift.PrependError("error reading field 0: ", err)
+} else {
+    _elem14 = v
+}
+    p.Addresses = append(p.Addresses, _elem14)
+  }
+  if err := iprot.ReadListEnd(); err != nil {
+    return thrift.PrependError("error reading list end: ", err)
+  }
+  return nil
+}
+
+func (p *ListKeys) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ListKeys"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ListKeys) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("addresses", thrift.LIST, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:addresses: ", p), err) }
+  if err := oprot.WriteListBegin(thrift.STRING, len(p.Addresses)); err != nil {
+    return thrift.PrependError("error writing list begin: ", err)
+  }
+  for _, v := range p.Addresses {
+    if err := oprot.WriteString(string(v)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T. (0) field write error: ", p), err) }
+  }
+  if err := oprot.WriteListEnd(); err != nil {
+    return thrift.PrependError("error writing list end: ", err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:addresses: ", p), err) }
+  return err
+}
+
+func (p *ListKeys) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ListKeys(%+v)", *p)
+}
+
+// Attributes:
+//  - Unlocked
+type StatusKey struct {
+  Unlocked bool `thrift:"unlocked,1" db:"unlocked" json:"unlocked"`
+}
+
+func NewStatusKey() *StatusKey {
+  return &StatusKey{}
+}
+
+
+func (p *StatusKey) GetUnlocked() bool {
+  return p.Unlocked
+}
+func (p *StatusKey) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.BOOL {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *StatusKey)  ReadField1(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadBool(); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.Unlocked = v
+}
+  return nil
+}
+
+func (p *StatusKey) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("StatusKey"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *StatusKey) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("unlocked", thrift.BOOL, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:unlocked: ", p), err) }
+  if err := oprot.WriteBool(bool(p.Unlocked)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.unlocked (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:unlocked: ", p), err) }
+  return err
+}
+
+func (p *StatusKey) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("StatusKey(%+v)", *p)
+}
+
+// Attributes:
+//  - PrivateKey
+//  - To
+//  - Thetawei
+//  - Tfuelwei
+//  - Fee
+//  - FromAddress
+type Send struct {
+  PrivateKey *string `thrift:"private_key,1" db:"private_key" json:"private_key,omitempty"`
+  To string `thrift:"to,2" db:"to" json:"to"`
+  Thetawei string `thrift:"thetawei,3" db:"thetawei" json:"thetawei"`
+  Tfuelwei string `thrift:"tfuelwei,4" db:"tfuelwei" json:"tfuelwei"`
+  Fee string `thrift:"fee,5" db:"fee" json:"fee"`
+  FromAddress string `thrift:"from_address,6" db:"from_address" json:"from_address"`
+}
+
+func NewSend() *Send {
+  return &Send{}
+}
+
+
+var Send_PrivateKey_DEFAULT string
+func (p *Send) GetPrivateKey() string {
+  if !p.IsSetPrivateKey() {
+    return Send_PrivateKey_DEFAULT
+  }
+return *p.PrivateKey
+}
+
+func (p *Send) GetTo() string {
+  return p.To
+}
+
+func (p *Send) GetThetawei() string {
+  return p.Thetawei
+}
+
+func (p *Send) GetTfuelwei() string {
+  return p.Tfuelwei
+}
+
+func (p *Send) GetFee() string {
+  return p.Fee
+}
+
+func (p *Send) GetFromAddress() string {
+  return p.FromAddress
+}
+func (p *Send) IsSetPrivateKey() bool {
+  return p.PrivateKey != nil
+}
+
+func (p *Send) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 2:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField2(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 3:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField3(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 4:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField4(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 5:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField5(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 6:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField6(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *Send)  ReadField1(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.PrivateKey = &v
+}
+  return nil
+}
+
+func (p *Send)  ReadField2(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.To = v
+}
+  return nil
+}
+
+func (p *Send)  ReadField3(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 3: ", err)
+} else {
+  p.Thetawei = v
+}
+  return nil
+}
+
+func (p *Send)  ReadField4(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 4: ", err)
+} else {
+  p.Tfuelwei = v
+}
+  return nil
+}
+
+func (p *Send)  ReadField5(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 5: ", err)
+} else {
+  p.Fee = v
+}
+  return nil
+}
+
+func (p *Send)  ReadField6(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 6: ", err)
+} else {
+  p.FromAddress = v
+}
+  return nil
+}
+
+func (p *Send) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("Send"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+    if err := p.writeField4(oprot); err != nil { return err }
+    if err := p.writeField5(oprot); err != nil { return err }
+    if err := p.writeField6(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *Send) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetPrivateKey() {
+    if err := oprot.WriteFieldBegin("private_key", thrift.STRING, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:private_key: ", p), err) }
+    if err := oprot.WriteString(string(*p.PrivateKey)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.private_key (1) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:private_key: ", p), err) }
+  }
+  return err
+}
+
+func (p *Send) writeField2(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("to", thrift.STRING, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:to: ", p), err) }
+  if err := oprot.WriteString(string(p.To)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.to (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:to: ", p), err) }
+  return err
+}
+
+func (p *Send) writeField3(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("thetawei", thrift.STRING, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:thetawei: ", p), err) }
+  if err := oprot.WriteString(string(p.Thetawei)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.thetawei (3) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:thetawei: ", p), err) }
+  return err
+}
+
+func (p *Send) writeField4(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("tfuelwei", thrift.STRING, 4); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:tfuelwei: ", p), err) }
+  if err := oprot.WriteString(string(p.Tfuelwei)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.tfuelwei (4) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 4:tfuelwei: ", p), err) }
+  return err
+}
+
+func (p *Send) writeField5(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("fee", thrift.STRING, 5); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:fee: ", p), err) }
+  if err := oprot.WriteString(string(p.Fee)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.fee (5) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 5:fee: ", p), err) }
+  return err
+}
+
+func (p *Send) writeField6(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("from_address", thrift.STRING, 6); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:from_address: ", p), err) }
+  if err := oprot.WriteString(string(p.FromAddress)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.from_address (6) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 6:from_address: ", p), err) }
+  return err
+}
+
+func (p *Send) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("Send(%+v)", *p)
+}
+
+// Attributes:
+//  - Jsonrpc
+//  - ID
+//  - Result_
+//  - Error
+type AccountResult_ struct {
+  Jsonrpc string `thrift:"jsonrpc,1" db:"jsonrpc" json:"jsonrpc"`
+  ID int32 `thrift:"id,2" db:"id" json:"id"`
+  Result_ *Account `thrift:"result,3" db:"result" json:"result"`
+  Error *Error `thrift:"error,4" db:"error" json:"error"`
+}
+
+func NewAccountResult_() *AccountResult_ {
+  return &AccountResult_{}
+}
+
+
+func (p *AccountResult_) GetJsonrpc() string {
+  return p.Jsonrpc
+}
+
+func (p *AccountResult_) GetID() int32 {
+  return p.ID
+}
+var AccountResult__Result__DEFAULT *Account
+func (p *AccountResult_) GetResult_() *Account {
+  if !p.IsSetResult_() {
+    return AccountResult__Result__DEFAULT
+  }
+return p.Result_
+}
+var AccountResult__Error_DEFAULT *Error
+func (p *AccountResult_) GetError() *Error {
+  if !p.IsSetError() {
+    return AccountResult__Error_DEFAULT
+  }
+return p.Error
+}
+func (p *AccountResult_) IsSetResult_() bool {
+  return p.Result_ != nil
+}
+
+func (p *AccountResult_) IsSetError() bool {
+  return p.Error != nil
+}
+
+func (p *AccountResult_) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 2:
+      if fieldTypeId == thrift.I32 {
+        if err := p.ReadField2(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 3:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField3(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 4:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField4(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *AccountResult_)  ReadField1(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.Jsonrpc = v
+}
+  return nil
+}
+
+func (p *AccountResult_)  ReadField2(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.ID = v
+}
+  return nil
+}
+
+func (p *AccountResult_)  ReadField3(iprot thrift.TProtocol) error {
+  p.Result_ = &Account{}
+  if err := p.Result_.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Result_), err)
+  }
+  return nil
+}
+
+func (p *AccountResult_)  ReadField4(iprot thrift.TProtocol) error {
+  p.Error = &Error{}
+  if err := p.Error.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Error), err)
+  }
+  return nil
+}
+
+func (p *AccountResult_) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("AccountResult"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+    if err := p.writeField4(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *AccountResult_) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("jsonrpc", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:jsonrpc: ", p), err) }
+  if err := oprot.WriteString(string(p.Jsonrpc)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.jsonrpc (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:jsonrpc: ", p), err) }
+  return err
+}
+
+func (p *AccountResult_) writeField2(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("id", thrift.I32, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:id: ", p), err) }
+  if err := oprot.WriteI32(int32(p.ID)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.id (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:id: ", p), err) }
+  return err
+}
+
+func (p *AccountResult_) writeField3(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("result", thrift.STRUCT, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:result: ", p), err) }
+  if err := p.Result_.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Result_), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:result: ", p), err) }
+  return err
+}
+
+func (p *AccountResult_) writeField4(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("error", thrift.STRUCT, 4); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:error: ", p), err) }
+  if err := p.Error.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Error), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 4:error: ", p), err) }
+  return err
+}
+
+func (p *AccountResult_) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("AccountResult_(%+v)", *p)
+}
+
+// Attributes:
+//  - Jsonrpc
+//  - ID
+//  - Result_
+//  - Error
+type BroadcastRawTransactionAsyncResult_ struct {
+  Jsonrpc string `thrift:"jsonrpc,1" db:"jsonrpc" json:"jsonrpc"`
+  ID int32 `thrift:"id,2" db:"id" json:"id"`
+  Result_ *BroadcastRawTransactionAsync `thrift:"result,3" db:"result" json:"result"`
+  Error *Error `thrift:"error,4" db:"error" json:"error"`
+}
+
+func NewBroadcastRawTransactionAsyncResult_() *BroadcastRawTransactionAsyncResult_ {
+  return &BroadcastRawTransactionAsyncResult_{}
+}
+
+
+func (p *BroadcastRawTransactionAsyncResult_) GetJsonrpc() string {
+  return p.Jsonrpc
+}
+
+func (p *BroadcastRawTransactionAsyncResult_) GetID() int32 {
+  return p.ID
+}
+var BroadcastRawTransactionAsyncResult__Result__DEFAULT *BroadcastRawTransactionAsync
+func (p *BroadcastRawTransactionAsyncResult_) GetResult_() *BroadcastRawTransactionAsync {
+  if !p.IsSetResult_() {
+    return BroadcastRawTransactionAsyncResult__Result__DEFAULT
+  }
+return p.Result_
+}
+var BroadcastRawTransactionAsyncResult__Error_DEFAULT *Error
+func (p *BroadcastRawTransactionAsyncResult_) GetError() *Error {
+  if !p.IsSetError() {
+    return BroadcastRawTransactionAsyncResult__Error_DEFAULT
+  }
+return p.Error
+}
+func (p *BroadcastRawTransactionAsyncResult_) IsSetResult_() bool {
+  return p.Result_ != nil
+}
+
+func (p *BroadcastRawTransactionAsyncResult_) IsSetError() bool {
+  return p.Error != nil
+}
+
+func (p *BroadcastRawTransactionAsyncResult_) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 2:
+      if fieldTypeId == thrift.I32 {
+        if err := p.ReadField2(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 3:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField3(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 4:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField4(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *BroadcastRawTransactionAsyncResult_)  ReadField1(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.Jsonrpc = v
+}
+  return nil
+}
+
+func (p *BroadcastRawTransactionAsyncResult_)  ReadField2(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.ID = v
+}
+  return nil
+}
+
+func (p *BroadcastRawTransactionAsyncResult_)  ReadField3(iprot thrift.TProtocol) error {
+  p.Result_ = &BroadcastRawTransactionAsync{}
+  if err := p.Result_.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Result_), err)
+  }
+  return nil
+}
+
+func (p *BroadcastRawTransactionAsyncResult_)  ReadField4(iprot thrift.TProtocol) error {
+  p.Error = &Error{}
+  if err := p.Error.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Error), err)
+  }
+  return nil
+}
+
+func (p *BroadcastRawTransactionAsyncResult_) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("BroadcastRawTransactionAsyncResult"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+    if err := p.writeField4(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *BroadcastRawTransactionAsyncResult_) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("jsonrpc", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:jsonrpc: ", p), err) }
+  if err := oprot.WriteString(string(p.Jsonrpc)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.jsonrpc (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:jsonrpc: ", p), err) }
+  return err
+}
+
+func (p *BroadcastRawTransactionAsyncResult_) writeField2(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("id", thrift.I32, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:id: ", p), err) }
+  if err := oprot.WriteI32(int32(p.ID)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.id (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:id: ", p), err) }
+  return err
+}
+
+func (p *BroadcastRawTransactionAsyncResult_) writeField3(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("result", thrift.STRUCT, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:result: ", p), err) }
+  if err := p.Result_.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Result_), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:result: ", p), err) }
+  return err
+}
+
+func (p *BroadcastRawTransactionAsyncResult_) writeField4(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("error", thrift.STRUCT, 4); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:error: ", p), err) }
+  if err := p.Error.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Error), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 4:error: ", p), err) }
+  return err
+}
+
+func (p *BroadcastRawTransactionAsyncResult_) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("BroadcastRawTransactionAsyncResult_(%+v)", *p)
+}
+
+// Attributes:
+//  - Code
+//  - Message
+type Error struct {
+  Code int32 `thrift:"code,1" db:"code" json:"code"`
+  Message string `thrift:"message,2" db:"message" json:"message"`
+}
+
+func NewError() *Error {
+  return &Error{}
+}
+
+
+func (p *Error) GetCode() int32 {
+  return p.Code
+}
+
+func (p *Error) GetMessage() string {
+  return p.Message
+}
+func (p *Error) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.I32 {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 2:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField2(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *Error)  ReadField1(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.Code = v
+}
+  return nil
+}
+
+func (p *Error)  ReadField2(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.Message = v
+}
+  return nil
+}
+
+func (p *Error) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("Error"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *Error) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("code", thrift.I32, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:code: ", p), err) }
+  if err := oprot.WriteI32(int32(p.Code)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.code (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:code: ", p), err) }
+  return err
+}
+
+func (p *Error) writeField2(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("message", thrift.STRING, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:message: ", p), err) }
+  if err := oprot.WriteString(string(p.Message)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.message (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:message: ", p), err) }
+  return err
+}
+
+func (p *Error) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("Error(%+v)", *p)
+}
+
+// Attributes:
+//  - PrivateKey
+//  - To
+//  - DeprecatedAmount
+//  - ContractAddress
+//  - GasLimit
+//  - GasPrice
+//  - FromAddress
+//  - Amount
+type SendToken struct {
+  PrivateKey *string `thrift:"private_key,1" db:"private_key" json:"private_key,omitempty"`
+  To string `thrift:"to,2" db:"to" json:"to"`
+  DeprecatedAmount *int32 `thrift:"deprecated_amount,3" db:"deprecated_amount" json:"deprecated_amount,omitempty"`
+  ContractAddress string `thrift:"contract_address,4" db:"contract_address" json:"contract_address"`
+  GasLimit string `thrift:"gas_limit,5" db:"gas_limit" json:"gas_limit"`
+  GasPrice string `thrift:"gas_price,6" db:"gas_price" json:"gas_price"`
+  FromAddress string `thrift:"from_address,7" db:"from_address" json:"from_address"`
+  Amount string `thrift:"amount,8" db:"amount" json:"amount"`
+}
+
+func NewSendToken() *SendToken {
+  return &SendToken{}
+}
+
+
+var SendToken_PrivateKey_DEFAULT string
+func (p *SendToken) GetPrivateKey() string {
+  if !p.IsSetPrivateKey() {
+    return SendToken_PrivateKey_DEFAULT
+  }
+return *p.PrivateKey
+}
+
+func (p *SendToken) GetTo() string {
+  return p.To
+}
+var SendToken_DeprecatedAmount_DEFAULT int32
+func (p *SendToken) GetDeprecatedAmount() int32 {
+  if !p.IsSetDeprecatedAmount() {
+    return SendToken_DeprecatedAmount_DEFAULT
+  }
+return *p.DeprecatedAmount
+}
+
+func (p *SendToken) GetContractAddress() string {
+  return p.ContractAddress
+}
+
+func (p *SendToken) GetGasLimit() string {
+  return p.GasLimit
+}
+
+func (p *SendToken) GetGasPrice() string {
+  return p.GasPrice
+}
+
+func (p *SendToken) GetFromAddress() string {
+  return p.FromAddress
+}
+
+func (p *SendToken) GetAmount() string {
+  return p.Amount
+}
+func (p *SendToken) IsSetPrivateKey() bool {
+  return p.PrivateKey != nil
+}
+
+func (p *SendToken) IsSetDeprecatedAmount() bool {
+  return p.DeprecatedAmount != nil
+}
+
+func (p *SendToken) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 2:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField2(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 3:
+      if fieldTypeId == thrift.I32 {
+        if err := p.ReadField3(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 4:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField4(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 5:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField5(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 6:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField6(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 7:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField7(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 8:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField8(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *SendToken)  ReadField1(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.PrivateKey = &v
+}
+  return nil
+}
+
+func (p *SendToken)  ReadField2(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.To = v
+}
+  return nil
+}
+
+func (p *SendToken)  ReadField3(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 3: ", err)
+} else {
+  p.DeprecatedAmount = &v
+}
+  return nil
+}
+
+func (p *SendToken)  ReadField4(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 4: ", err)
+} else {
+  p.ContractAddress = v
+}
+  return nil
+}
+
+func (p *SendToken)  ReadField5(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 5: ", err)
+} else {
+  p.GasLimit = v
+}
+  return nil
+}
+
+func (p *SendToken)  ReadField6(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 6: ", err)
+} else {
+  p.GasPrice = v
+}
+  return nil
+}
+
+func (p *SendToken)  ReadField7(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 7: ", err)
+} else {
+  p.FromAddress = v
+}
+  return nil
+}
+
+func (p *SendToken)  ReadField8(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 8: ", err)
+} else {
+  p.Amount = v
+}
+  return nil
+}
+
+func (p *SendToken) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("SendToken"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+    if err := p.writeField4(oprot); err != nil { return err }
+    if err := p.writeField5(oprot); err != nil { return err }
+    if err := p.writeField6(oprot); err != nil { return err }
+    if err := p.writeField7(oprot); err != nil { return err }
+    if err := p.writeField8(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *SendToken) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetPrivateKey() {
+    if err := oprot.WriteFieldBegin("private_key", thrift.STRING, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:private_key: ", p), err) }
+    if err := oprot.WriteString(string(*p.PrivateKey)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.private_key (1) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:private_key: ", p), err) }
+  }
+  return err
+}
+
+func (p *SendToken) writeField2(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("to", thrift.STRING, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:to: ", p), err) }
+  if err := oprot.WriteString(string(p.To)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.to (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:to: ", p), err) }
+  return err
+}
+
+func (p *SendToken) writeField3(oprot thrift.TProtocol) (err error) {
+  if p.IsSetDeprecatedAmount() {
+    if err := oprot.WriteFieldBegin("deprecated_amount", thrift.I32, 3); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:deprecated_amount: ", p), err) }
+    if err := oprot.WriteI32(int32(*p.DeprecatedAmount)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.deprecated_amount (3) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 3:deprecated_amount: ", p), err) }
+  }
+  return err
+}
+
+func (p *SendToken) writeField4(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("contract_address", thrift.STRING, 4); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:contract_address: ", p), err) }
+  if err := oprot.WriteString(string(p.ContractAddress)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.contract_address (4) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 4:contract_address: ", p), err) }
+  return err
+}
+
+func (p *SendToken) writeField5(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("gas_limit", thrift.STRING, 5); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:gas_limit: ", p), err) }
+  if err := oprot.WriteString(string(p.GasLimit)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.gas_limit (5) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 5:gas_limit: ", p), err) }
+  return err
+}
+
+func (p *SendToken) writeField6(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("gas_price", thrift.STRING, 6); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:gas_price: ", p), err) }
+  if err := oprot.WriteString(string(p.GasPrice)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.gas_price (6) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 6:gas_price: ", p), err) }
+  return err
+}
+
+func (p *SendToken) writeField7(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("from_address", thrift.STRING, 7); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:from_address: ", p), err) }
+  if err := oprot.WriteString(string(p.FromAddress)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.from_address (7) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 7:from_address: ", p), err) }
+  return err
+}
+
+func (p *SendToken) writeField8(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("amount", thrift.STRING, 8); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 8:amount: ", p), err) }
+  if err := oprot.WriteString(string(p.Amount)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.amount (8) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 8:amount: ", p), err) }
+  return err
+}
+
+func (p *SendToken) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("SendToken(%+v)", *p)
+}
+
+// Attributes:
+//  - FromAddress
+//  - Spender
+//  - Amount
+//  - ContractAddress
+//  - GasLimit
+//  - GasPrice
+type ApproveToken struct {
+  FromAddress string `thrift:"from_address,1" db:"from_address" json:"from_address"`
+  Spender string `thrift:"spender,2" db:"spender" json:"spender"`
+  Amount string `thrift:"amount,3" db:"amount" json:"amount"`
+  ContractAddress string `thrift:"contract_address,4" db:"contract_address" json:"contract_address"`
+  GasLimit string `thrift:"gas_limit,5" db:"gas_limit" json:"gas_limit"`
+  GasPrice string `thrift:"gas_price,6" db:"gas_price" json:"gas_price"`
+}
+
+func NewApproveToken() *ApproveToken {
+  return &ApproveToken{}
+}
+
+
+func (p *ApproveToken) GetFromAddress() string {
+  return p.FromAddress
+}
+
+func (p *ApproveToken) GetSpender() string {
+  return p.Spender
+}
+
+func (p *ApproveToken) GetAmount() string {
+  return p.Amount
+}
+
+func (p *ApproveToken) GetContractAddress() string {
+  return p.ContractAddress
+}
+
+func (p *ApproveToken) GetGasLimit() string {
+  return p.GasLimit
+}
+
+func (p *ApproveToken) GetGasPrice() string {
+  return p.GasPrice
+}
+func (p *ApproveToken) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 2:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField2(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 3:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField3(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 4:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField4(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 5:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField5(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 6:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField6(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ApproveToken)  ReadField1(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.FromAddress = v
+}
+  return nil
+}
+
+func (p *ApproveToken)  ReadField2(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.Spender = v
+}
+  return nil
+}
+
+func (p *ApproveToken)  ReadField3(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 3: ", err)
+} else {
+  p.Amount = v
+}
+  return nil
+}
+
+func (p *ApproveToken)  ReadField4(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 4: ", err)
+} else {
+  p.ContractAddress = v
+}
+  return nil
+}
+
+func (p *ApproveToken)  ReadField5(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 5: ", err)
+} else {
+  p.GasLimit = v
+}
+  return nil
+}
+
+func (p *ApproveToken)  ReadField6(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 6: ", err)
+} else {
+  p.GasPrice = v
+}
+  return nil
+}
+
+func (p *ApproveToken) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ApproveToken"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+    if err := p.writeField4(oprot); err != nil { return err }
+    if err := p.writeField5(oprot); err != nil { return err }
+    if err := p.writeField6(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ApproveToken) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("from_address", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:from_address: ", p), err) }
+  if err := oprot.WriteString(string(p.FromAddress)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.from_address (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:from_address: ", p), err) }
+  return err
+}
+
+func (p *ApproveToken) writeField2(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("spender", thrift.STRING, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:spender: ", p), err) }
+  if err := oprot.WriteString(string(p.Spender)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.spender (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:spender: ", p), err) }
+  return err
+}
+
+func (p *ApproveToken) writeField3(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("amount", thrift.STRING, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:amount: ", p), err) }
+  if err := oprot.WriteString(string(p.Amount)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.amount (3) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:amount: ", p), err) }
+  return err
+}
+
+func (p *ApproveToken) writeField4(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("contract_address", thrift.STRING, 4); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:contract_address: ", p), err) }
+  if err := oprot.WriteString(string(p.ContractAddress)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.contract_address (4) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 4:contract_address: ", p), err) }
+  return err
+}
+
+func (p *ApproveToken) writeField5(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("gas_limit", thrift.STRING, 5); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:gas_limit: ", p), err) }
+  if err := oprot.WriteString(string(p.GasLimit)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.gas_limit (5) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 5:gas_limit: ", p), err) }
+  return err
+}
+
+func (p *ApproveToken) writeField6(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("gas_price", thrift.STRING, 6); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:gas_price: ", p), err) }
+  if err := oprot.WriteString(string(p.GasPrice)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.gas_price (6) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 6:gas_price: ", p), err) }
+  return err
+}
+
+func (p *ApproveToken) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ApproveToken(%+v)", *p)
+}
+
+// Attributes:
+//  - FromAddress
+//  - Owner
+//  - To
+//  - Amount
+//  - ContractAddress
+//  - GasLimit
+//  - GasPrice
+type TransferTokenFrom struct {
+  FromAddress string `thrift:"from_address,1" db:"from_address" json:"from_address"`
+  Owner string `thrift:"owner,2" db:"owner" json:"owner"`
+  To string `thrift:"to,3" db:"to" json:"to"`
+  Amount string `thrift:"amount,4" db:"amount" json:"amount"`
+  ContractAddress string `thrift:"contract_address,5" db:"contract_address" json:"contract_address"`
+  GasLimit string `thrift:"gas_limit,6" db:"gas_limit" json:"gas_limit"`
+  GasPrice string `thrift:"gas_price,7" db:"gas_price" json:"gas_price"`
+}
+
+func NewTransferTokenFrom() *TransferTokenFrom {
+  return &TransferTokenFrom{}
+}
+
+
+func (p *TransferTokenFrom) GetFromAddress() string {
+  return p.FromAddress
+}
+
+func (p *TransferTokenFrom) GetOwner() string {
+  return p.Owner
+}
+
+func (p *TransferTokenFrom) GetTo() string {
+  return p.To
+}
+
+func (p *TransferTokenFrom) GetAmount() string {
+  return p.Amount
+}
+
+func (p *TransferTokenFrom) GetContractAddress() string {
+  return p.ContractAddress
+}
+
+func (p *TransferTokenFrom) GetGasLimit() string {
+  return p.GasLimit
+}
+
+func (p *TransferTokenFrom) GetGasPrice() string {
+  return p.GasPrice
+}
+func (p *TransferTokenFrom) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 2:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField2(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 3:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField3(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 4:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField4(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 5:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField5(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 6:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField6(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 7:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField7(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *TransferTokenFrom)  ReadField1(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.FromAddress = v
+}
+  return nil
+}
+
+func (p *TransferTokenFrom)  ReadField2(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.Owner = v
+}
+  return nil
+}
+
+func (p *TransferTokenFrom)  ReadField3(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 3: ", err)
+} else {
+  p.To = v
+}
+  return nil
+}
+
+func (p *TransferTokenFrom)  ReadField4(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 4: ", err)
+} else {
+  p.Amount = v
+}
+  return nil
+}
+
+func (p *TransferTokenFrom)  ReadField5(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 5: ", err)
+} else {
+  p.ContractAddress = v
+}
+  return nil
+}
+
+func (p *TransferTokenFrom)  ReadField6(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 6: ", err)
+} else {
+  p.GasLimit = v
+}
+  return nil
+}
+
+func (p *TransferTokenFrom)  ReadField7(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 7: ", err)
+} else {
+  p.GasPrice = v
+}
+  return nil
+}
+
+func (p *TransferTokenFrom) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("TransferTokenFrom"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+    if err := p.writeField4(oprot); err != nil { return err }
+    if err := p.writeField5(oprot); err != nil { return err }
+    if err := p.writeField6(oprot); err != nil { return err }
+    if err := p.writeField7(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *TransferTokenFrom) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("from_address", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:from_address: ", p), err) }
+  if err := oprot.WriteString(string(p.FromAddress)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.from_address (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:from_address: ", p), err) }
+  return err
+}
+
+func (p *TransferTokenFrom) writeField2(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("owner", thrift.STRING, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:owner: ", p), err) }
+  if err := oprot.WriteString(string(p.Owner)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.owner (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:owner: ", p), err) }
+  return err
+}
+
+func (p *TransferTokenFrom) writeField3(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("to", thrift.STRING, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:to: ", p), err) }
+  if err := oprot.WriteString(string(p.To)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.to (3) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:to: ", p), err) }
+  return err
+}
+
+func (p *TransferTokenFrom) writeField4(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("amount", thrift.STRING, 4); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:amount: ", p), err) }
+  if err := oprot.WriteString(string(p.Amount)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.amount (4) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 4:amount: ", p), err) }
+  return err
+}
+
+func (p *TransferTokenFrom) writeField5(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("contract_address", thrift.STRING, 5); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:contract_address: ", p), err) }
+  if err := oprot.WriteString(string(p.ContractAddress)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.contract_address (5) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 5:contract_address: ", p), err) }
+  return err
+}
+
+func (p *TransferTokenFrom) writeField6(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("gas_limit", thrift.STRING, 6); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:gas_limit: ", p), err) }
+  if err := oprot.WriteString(string(p.GasLimit)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.gas_limit (6) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 6:gas_limit: ", p), err) }
+  return err
+}
+
+func (p *TransferTokenFrom) writeField7(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("gas_price", thrift.STRING, 7); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 7:gas_price: ", p), err) }
+  if err := oprot.WriteString(string(p.GasPrice)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.gas_price (7) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 7:gas_price: ", p), err) }
+  return err
+}
+
+func (p *TransferTokenFrom) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("TransferTokenFrom(%+v)", *p)
+}
+
+// Attributes:
+//  - ContractAddress
+//  - Name
+//  - Symbol
+//  - Decimals
+//  - TotalSupply
+type TokenInfo struct {
+  ContractAddress string `thrift:"contract_address,1" db:"contract_address" json:"contract_address"`
+  Name string `thrift:"name,2" db:"name" json:"name"`
+  Symbol string `thrift:"symbol,3" db:"symbol" json:"symbol"`
+  Decimals int32 `thrift:"decimals,4" db:"decimals" json:"decimals"`
+  TotalSupply string `thrift:"total_supply,5" db:"total_supply" json:"total_supply"`
+}
+
+func NewTokenInfo() *TokenInfo {
+  return &TokenInfo{}
+}
+
+
+func (p *TokenInfo) GetContractAddress() string {
+  return p.ContractAddress
+}
+
+func (p *TokenInfo) GetName() string {
+  return p.Name
+}
+
+func (p *TokenInfo) GetSymbol() string {
+  return p.Symbol
+}
+
+func (p *TokenInfo) GetDecimals() int32 {
+  return p.Decimals
+}
+
+func (p *TokenInfo) GetTotalSupply() string {
+  return p.TotalSupply
+}
+func (p *TokenInfo) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 2:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField2(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 3:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField3(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 4:
+      if fieldTypeId == thrift.I32 {
+        if err := p.ReadField4(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 5:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField5(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *TokenInfo)  ReadField1(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.ContractAddress = v
+}
+  return nil
+}
+
+func (p *TokenInfo)  ReadField2(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.Name = v
+}
+  return nil
+}
+
+func (p *TokenInfo)  ReadField3(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 3: ", err)
+} else {
+  p.Symbol = v
+}
+  return nil
+}
+
+func (p *TokenInfo)  ReadField4(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 4: ", err)
+} else {
+  p.Decimals = v
+}
+  return nil
+}
+
+func (p *TokenInfo)  ReadField5(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 5: ", err)
+} else {
+  p.TotalSupply = v
+}
+  return nil
+}
+
+func (p *TokenInfo) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("TokenInfo"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+    if err := p.writeField4(oprot); err != nil { return err }
+    if err := p.writeField5(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *TokenInfo) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("contract_address", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:contract_address: ", p), err) }
+  if err := oprot.WriteString(string(p.ContractAddress)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.contract_address (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:contract_address: ", p), err) }
+  return err
+}
+
+func (p *TokenInfo) writeField2(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("name", thrift.STRING, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:name: ", p), err) }
+  if err := oprot.WriteString(string(p.Name)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.name (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:name: ", p), err) }
+  return err
+}
+
+func (p *TokenInfo) writeField3(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("symbol", thrift.STRING, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:symbol: ", p), err) }
+  if err := oprot.WriteString(string(p.Symbol)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.symbol (3) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:symbol: ", p), err) }
+  return err
+}
+
+func (p *TokenInfo) writeField4(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("decimals", thrift.I32, 4); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:decimals: ", p), err) }
+  if err := oprot.WriteI32(int32(p.Decimals)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.decimals (4) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 4:decimals: ", p), err) }
+  return err
+}
+
+func (p *TokenInfo) writeField5(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("total_supply", thrift.STRING, 5); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:total_supply: ", p), err) }
+  if err := oprot.WriteString(string(p.TotalSupply)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.total_supply (5) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 5:total_supply: ", p), err) }
+  return err
+}
+
+func (p *TokenInfo) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("TokenInfo(%+v)", *p)
+}
+
+// Attributes:
+//  - PrivateKey
+//  - Holder
+//  - Stake
+//  - Purpose
+//  - Fee
+//  - Source
+type DepositStake struct {
+  PrivateKey *string `thrift:"private_key,1" db:"private_key" json:"private_key,omitempty"`
+  Holder string `thrift:"holder,2" db:"holder" json:"holder"`
+  Stake string `thrift:"stake,3" db:"stake" json:"stake"`
+  Purpose int32 `thrift:"purpose,4" db:"purpose" json:"purpose"`
+  Fee string `thrift:"fee,5" db:"fee" json:"fee"`
+  Source string `thrift:"source,6" db:"source" json:"source"`
+}
+
+func NewDepositStake() *DepositStake {
+  return &DepositStake{}
+}
+
+
+var DepositStake_PrivateKey_DEFAULT string
+func (p *DepositStake) GetPrivateKey() string {
+  if !p.IsSetPrivateKey() {
+    return DepositStake_PrivateKey_DEFAULT
+  }
+return *p.PrivateKey
+}
+
+func (p *DepositStake) GetHolder() string {
+  return p.Holder
+}
+
+func (p *DepositStake) GetStake() string {
+  return p.Stake
+}
+
+func (p *DepositStake) GetPurpose() int32 {
+  return p.Purpose
+}
+
+func (p *DepositStake) GetFee() string {
+  return p.Fee
+}
+
+func (p *DepositStake) GetSource() string {
+  return p.Source
+}
+func (p *DepositStake) IsSetPrivateKey() bool {
+  return p.PrivateKey != nil
+}
+
+func (p *DepositStake) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 2:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField2(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 3:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField3(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 4:
+      if fieldTypeId == thrift.I32 {
+        if err := p.ReadField4(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 5:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField5(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 6:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField6(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *DepositStake)  ReadField1(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.PrivateKey = &v
+}
+  return nil
+}
+
+func (p *DepositStake)  ReadField2(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.Holder = v
+}
+  return nil
+}
+
+func (p *DepositStake)  ReadField3(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 3: ", err)
+} else {
+  p.Stake = v
+}
+  return nil
+}
+
+func (p *DepositStake)  ReadField4(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 4: ", err)
+} else {
+  p.Purpose = v
+}
+  return nil
+}
+
+func (p *DepositStake)  ReadField5(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 5: ", err)
+} else {
+  p.Fee = v
+}
+  return nil
+}
+
+func (p *DepositStake)  ReadField6(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 6: ", err)
+} else {
+  p.Source = v
+}
+  return nil
+}
+
+func (p *DepositStake) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("DepositStake"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+    if err := p.writeField4(oprot); err != nil { return err }
+    if err := p.writeField5(oprot); err != nil { return err }
+    if err := p.writeField6(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *DepositStake) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetPrivateKey() {
+    if err := oprot.WriteFieldBegin("private_key", thrift.STRING, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:private_key: ", p), err) }
+    if err := oprot.WriteString(string(*p.PrivateKey)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.private_key (1) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:private_key: ", p), err) }
+  }
+  return err
+}
+
+func (p *DepositStake) writeField2(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("holder", thrift.STRING, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:holder: ", p), err) }
+  if err := oprot.WriteString(string(p.Holder)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.holder (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:holder: ", p), err) }
+  return err
+}
+
+func (p *DepositStake) writeField3(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("stake", thrift.STRING, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:stake: ", p), err) }
+  if err := oprot.WriteString(string(p.Stake)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.stake (3) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:stake: ", p), err) }
+  return err
+}
+
+func (p *DepositStake) writeField4(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("purpose", thrift.I32, 4); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:purpose: ", p), err) }
+  if err := oprot.WriteI32(int32(p.Purpose)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.purpose (4) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 4:purpose: ", p), err) }
+  return err
+}
+
+func (p *DepositStake) writeField5(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("fee", thrift.STRING, 5); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:fee: ", p), err) }
+  if err := oprot.WriteString(string(p.Fee)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.fee (5) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 5:fee: ", p), err) }
+  return err
+}
+
+func (p *DepositStake) writeField6(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("source", thrift.STRING, 6); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 6:source: ", p), err) }
+  if err := oprot.WriteString(string(p.Source)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.source (6) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 6:source: ", p), err) }
+  return err
+}
+
+func (p *DepositStake) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("DepositStake(%+v)", *p)
+}
+
+// Attributes:
+//  - PrivateKey
+//  - Holder
+//  - Purpose
+//  - Fee
+//  - Source
+type WithdrawStake struct {
+  PrivateKey *string `thrift:"private_key,1" db:"private_key" json:"private_key,omitempty"`
+  Holder string `thrift:"holder,2" db:"holder" json:"holder"`
+  Purpose int32 `thrift:"purpose,3" db:"purpose" json:"purpose"`
+  Fee string `thrift:"fee,4" db:"fee" json:"fee"`
+  Source string `thrift:"source,5" db:"source" json:"source"`
+}
+
+func NewWithdrawStake() *WithdrawStake {
+  return &WithdrawStake{}
+}
+
+
+var WithdrawStake_PrivateKey_DEFAULT string
+func (p *WithdrawStake) GetPrivateKey() string {
+  if !p.IsSetPrivateKey() {
+    return WithdrawStake_PrivateKey_DEFAULT
+  }
+return *p.PrivateKey
+}
+
+func (p *WithdrawStake) GetHolder() string {
+  return p.Holder
+}
+
+func (p *WithdrawStake) GetPurpose() int32 {
+  return p.Purpose
+}
+
+func (p *WithdrawStake) GetFee() string {
+  return p.Fee
+}
+
+func (p *WithdrawStake) GetSource() string {
+  return p.Source
+}
+func (p *WithdrawStake) IsSetPrivateKey() bool {
+  return p.PrivateKey != nil
+}
+
+func (p *WithdrawStake) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 2:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField2(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 3:
+      if fieldTypeId == thrift.I32 {
+        if err := p.ReadField3(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 4:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField4(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 5:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField5(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *WithdrawStake)  ReadField1(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.PrivateKey = &v
+}
+  return nil
+}
+
+func (p *WithdrawStake)  ReadField2(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.Holder = v
+}
+  return nil
+}
+
+func (p *WithdrawStake)  ReadField3(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 3: ", err)
+} else {
+  p.Purpose = v
+}
+  return nil
+}
+
+func (p *WithdrawStake)  ReadField4(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 4: ", err)
+} else {
+  p.Fee = v
+}
+  return nil
+}
+
+func (p *WithdrawStake)  ReadField5(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 5: ", err)
+} else {
+  p.Source = v
+}
+  return nil
+}
+
+func (p *WithdrawStake) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("WithdrawStake"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+    if err := p.writeField4(oprot); err != nil { return err }
+    if err := p.writeField5(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *WithdrawStake) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetPrivateKey() {
+    if err := oprot.WriteFieldBegin("private_key", thrift.STRING, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:private_key: ", p), err) }
+    if err := oprot.WriteString(string(*p.PrivateKey)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.private_key (1) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:private_key: ", p), err) }
+  }
+  return err
+}
+
+func (p *WithdrawStake) writeField2(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("holder", thrift.STRING, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:holder: ", p), err) }
+  if err := oprot.WriteString(string(p.Holder)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.holder (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:holder: ", p), err) }
+  return err
+}
+
+func (p *WithdrawStake) writeField3(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("purpose", thrift.I32, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:purpose: ", p), err) }
+  if err := oprot.WriteI32(int32(p.Purpose)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.purpose (3) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:purpose: ", p), err) }
+  return err
+}
+
+func (p *WithdrawStake) writeField4(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("fee", thrift.STRING, 4); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:fee: ", p), err) }
+  if err := oprot.WriteString(string(p.Fee)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.fee (4) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 4:fee: ", p), err) }
+  return err
+}
+
+func (p *WithdrawStake) writeField5(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("source", thrift.STRING, 5); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 5:source: ", p), err) }
+  if err := oprot.WriteString(string(p.Source)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.source (5) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 5:source: ", p), err) }
+  return err
+}
+
+func (p *WithdrawStake) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("WithdrawStake(%+v)", *p)
+}
+
+// Attributes:
+//  - ContractAddress
+//  - GasUsed
+//  - VMError
+//  - VMReturn
+type SmartContractCall struct {
+  ContractAddress string `thrift:"contract_address,1" db:"contract_address" json:"contract_address"`
+  GasUsed string `thrift:"gas_used,2" db:"gas_used" json:"gas_used"`
+  VMError string `thrift:"vm_error,3" db:"vm_error" json:"vm_error"`
+  VMReturn string `thrift:"vm_return,4" db:"vm_return" json:"vm_return"`
+}
+
+func NewSmartContractCall() *SmartContractCall {
+  return &SmartContractCall{}
+}
+
+
+func (p *SmartContractCall) GetContractAddress() string {
+  return p.ContractAddress
+}
+
+func (p *SmartContractCall) GetGasUsed() string {
+  return p.GasUsed
+}
+
+func (p *SmartContractCall) GetVMError() string {
+  return p.VMError
+}
+
+func (p *SmartContractCall) GetVMReturn() string {
+  return p.VMReturn
+}
+func (p *SmartContractCall) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 2:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField2(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 3:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField3(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 4:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField4(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *SmartContractCall)  ReadField1(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.ContractAddress = v
+}
+  return nil
+}
+
+func (p *SmartContractCall)  ReadField2(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.GasUsed = v
+}
+  return nil
+}
+
+func (p *SmartContractCall)  ReadField3(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 3: ", err)
+} else {
+  p.VMError = v
+}
+  return nil
+}
+
+func (p *SmartContractCall)  ReadField4(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 4: ", err)
+} else {
+  p.VMReturn = v
+}
+  return nil
+}
+
+func (p *SmartContractCall) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("SmartContractCall"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+    if err := p.writeField4(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *SmartContractCall) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("contract_address", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:contract_address: ", p), err) }
+  if err := oprot.WriteString(string(p.ContractAddress)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.contract_address (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:contract_address: ", p), err) }
+  return err
+}
+
+func (p *SmartContractCall) writeField2(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("gas_used", thrift.STRING, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:gas_used: ", p), err) }
+  if err := oprot.WriteString(string(p.GasUsed)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.gas_used (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:gas_used: ", p), err) }
+  return err
+}
+
+func (p *SmartContractCall) writeField3(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("vm_error", thrift.STRING, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:vm_error: ", p), err) }
+  if err := oprot.WriteString(string(p.VMError)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.vm_error (3) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:vm_error: ", p), err) }
+  return err
+}
+
+func (p *SmartContractCall) writeField4(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("vm_return", thrift.STRING, 4); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:vm_return: ", p), err) }
+  if err := oprot.WriteString(string(p.VMReturn)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.vm_return (4) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 4:vm_return: ", p), err) }
+  return err
+}
+
+func (p *SmartContractCall) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("SmartContractCall(%+v)", *p)
+}
+
+// Attributes:
+//  - Jsonrpc
+//  - ID
+//  - Result_
+//  - Error
+type BlockResult_ struct {
+  Jsonrpc string `thrift:"jsonrpc,1" db:"jsonrpc" json:"jsonrpc"`
+  ID int32 `thrift:"id,2" db:"id" json:"id"`
+  Result_ *Block `thrift:"result,3" db:"result" json:"result"`
+  Error *Error `thrift:"error,4" db:"error" json:"error"`
+}
+
+func NewBlockResult_() *BlockResult_ {
+  return &BlockResult_{}
+}
+
+
+func (p *BlockResult_) GetJsonrpc() string {
+  return p.Jsonrpc
+}
+
+func (p *BlockResult_) GetID() int32 {
+  return p.ID
+}
+var BlockResult__Result__DEFAULT *Block
+func (p *BlockResult_) GetResult_() *Block {
+  if !p.IsSetResult_() {
+    return BlockResult__Result__DEFAULT
+  }
+return p.Result_
+}
+var BlockResult__Error_DEFAULT *Error
+func (p *BlockResult_) GetError() *Error {
+  if !p.IsSetError() {
+    return BlockResult__Error_DEFAULT
+  }
+return p.Error
+}
+func (p *BlockResult_) IsSetResult_() bool {
+  return p.Result_ != nil
+}
+
+func (p *BlockResult_) IsSetError() bool {
+  return p.Error != nil
+}
+
+func (p *BlockResult_) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 2:
+      if fieldTypeId == thrift.I32 {
+        if err := p.ReadField2(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 3:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField3(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 4:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField4(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *BlockResult_)  ReadField1(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.Jsonrpc = v
+}
+  return nil
+}
+
+func (p *BlockResult_)  ReadField2(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI32(); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.ID = v
+}
+  return nil
+}
+
+func (p *BlockResult_)  ReadField3(iprot thrift.TProtocol) error {
+  p.Result_ = &Block{}
+  if err := p.Result_.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Result_), err)
+  }
+  return nil
+}
+
+func (p *BlockResult_)  ReadField4(iprot thrift.TProtocol) error {
+  p.Error = &Error{}
+  if err := p.Error.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Error), err)
+  }
+  return nil
+}
+
+func (p *BlockResult_) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("BlockResult"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+    if err := p.writeField4(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *BlockResult_) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("jsonrpc", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:jsonrpc: ", p), err) }
+  if err := oprot.WriteString(string(p.Jsonrpc)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.jsonrpc (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:jsonrpc: ", p), err) }
+  return err
+}
+
+func (p *BlockResult_) writeField2(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("id", thrift.I32, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:id: ", p), err) }
+  if err := oprot.WriteI32(int32(p.ID)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.id (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:id: ", p), err) }
+  return err
+}
+
+func (p *BlockResult_) writeField3(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("result", thrift.STRUCT, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:result: ", p), err) }
+  if err := p.Result_.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Result_), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:result: ", p), err) }
+  return err
+}
+
+func (p *BlockResult_) writeField4(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("error", thrift.STRUCT, 4); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 4:error: ", p), err) }
+  if err := p.Error.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Error), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 4:error: ", p), err) }
+  return err
+}
+
+func (p *BlockResult_) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("BlockResult_(%+v)", *p)
+}
+
+type ThetaService interface {
+  // Parameters:
+  //  - Account
+  GetAccount(ctx context.Context, account string) (r *Account, err error)
+  // Parameters:
+  //  - Send
+  SendTx(ctx context.Context, send *Send) (r *BroadcastRawTransactionAsync, err error)
+  // Parameters:
+  //  - Address
+  //  - ContractAddress
+  //  - PrivateKey
+  GetTokenBalance(ctx context.Context, address string, contract_address string, private_key string) (r int64, err error)
+  // Parameters:
+  //  - Send
+  SendToken(ctx context.Context, send *SendToken) (r *BroadcastRawTransactionAsync, err error)
+  // Parameters:
+  //  - Hash
+  GetBlock(ctx context.Context, hash string) (r *Block, err error)
+  // Parameters:
+  //  - Height
+  GetBlockByHeight(ctx context.Context, height int64) (r *Block, err error)
+  // Parameters:
+  //  - Hash
+  GetBlockHeader(ctx context.Context, hash string) (r *BlockHeader, err error)
+  // Parameters:
+  //  - Height
+  GetBlockHeaderByHeight(ctx context.Context, height int64) (r *BlockHeader, err error)
+  // Parameters:
+  //  - Start
+  //  - End
+  GetBlocksByRange(ctx context.Context, start int64, end int64) (r []*Block, err error)
+  // Parameters:
+  //  - Hash
+  GetTransaction(ctx context.Context, hash string) (r *Transaction, err error)
+  GetPendingTransactions(ctx context.Context) (r *PendingTransaction, err error)
+  GetStatus(ctx context.Context) (r *Status, err error)
+  // Parameters:
+  //  - Height
+  GetVcpByHeight(ctx context.Context, height int64) (r []*BlockHashVcpPair, err error)
+  // Parameters:
+  //  - Height
+  GetGcpByHeight(ctx context.Context, height int64) (r []*BlockHashGcpPair, err error)
+  GetGuardianInfo(ctx context.Context) (r *GuardianInfo, err error)
+  // Parameters:
+  //  - Contract
+  CallSmartContract(ctx context.Context, contract *SmartContract) (r *SmartContractCall, err error)
+  // Parameters:
+  //  - Deposit
+  DepositStake(ctx context.Context, deposit *DepositStake) (r *BroadcastRawTransactionAsync, err error)
+  // Parameters:
+  //  - Withdraw
+  WithdrawStake(ctx context.Context, withdraw *WithdrawStake) (r *BroadcastRawTransactionAsync, err error)
+  // Parameters:
+  //  - TxBytes
+  BroadcastRawTransaction(ctx context.Context, tx_bytes string) (r *BroadcastRawTransactionAsync, err error)
+  // Parameters:
+  //  - Address
+  //  - Password
+  UnlockKey(ctx context.Context, address string, password string) (r *StatusKey, err error)
+  // Parameters:
+  //  - Address
+  LockKey(ctx context.Context, address string) (r *StatusKey, err error)
+  // Parameters:
+  //  - Address
+  IsKeyUnlocked(ctx context.Context, address string) (r *StatusKey, err error)
+  ListKeys(ctx context.Context) (r *ListKeys, err error)
+  // Parameters:
+  //  - ContractAddress
+  GetTokenInfo(ctx context.Context, contract_address string) (r *TokenInfo, err error)
+  // Parameters:
+  //  - ContractAddress
+  //  - Owner
+  //  - Spender
+  GetTokenAllowance(ctx context.Context, contract_address string, owner string, spender string) (r string, err error)
+  // Parameters:
+  //  - Approve
+  ApproveToken(ctx context.Context, approve *ApproveToken) (r *BroadcastRawTransactionAsync, err error)
+  // Parameters:
+  //  - Transfer
+  TransferTokenFrom(ctx context.Context, transfer *TransferTokenFrom) (r *BroadcastRawTransactionAsync, err error)
+  // Parameters:
+  //  - Address
+  //  - ContractAddress
+  GetTokenBalanceString(ctx context.Context, address string, contract_address string) (r string, err error)
+}
+
+type ThetaServiceClient struct {
+  c thrift.TClient
+}
+
+func NewThetaServiceClientFactory(t thrift.TTransport, f thrift.TProtocolFactory) *ThetaServiceClient {
+  return &ThetaServiceClient{
+    c: thrift.NewTStandardClient(f.GetProtocol(t), f.GetProtocol(t)),
+  }
+}
+
+func NewThetaServiceClientProtocol(t thrift.TTransport, iprot thrift.TProtocol, oprot thrift.TProtocol) *ThetaServiceClient {
+  return &ThetaServiceClient{
+    c: thrift.NewTStandardClient(iprot, oprot),
+  }
+}
+
+func NewThetaServiceClient(c thrift.TClient) *ThetaServiceClient {
+  return &ThetaServiceClient{
+    c: c,
+  }
+}
+
+func (p *ThetaServiceClient) Client_() thrift.TClient {
+  return p.c
+}
+// Parameters:
+//  - Account
+func (p *ThetaServiceClient) GetAccount(ctx context.Context, account string) (r *Account, err error) {
+  var _args15 ThetaServiceGetAccountArgs
+  _args15.Account = account
+  var _result16 ThetaServiceGetAccountResult
+  if err = p.Client_().Call(ctx, "getAccount", &_args15, &_result16); err != nil {
+    return
+  }
+  switch {
+  case _result16.Err!= nil:
+    return r, _result16.Err
+  }
+
+  return _result16.GetSuccess(), nil
+}
+
+// Parameters:
+//  - Send
+func (p *ThetaServiceClient) SendTx(ctx context.Context, send *Send) (r *BroadcastRawTransactionAsync, err error) {
+  var _args17 ThetaServiceSendTxArgs
+  _args17.Send = send
+  var _result18 ThetaServiceSendTxResult
+  if err = p.Client_().Call(ctx, "sendTx", &_args17, &_result18); err != nil {
+    return
+  }
+  switch {
+  case _result18.Err!= nil:
+    return r, _result18.Err
+  }
+
+  return _result18.GetSuccess(), nil
+}
+
+// Parameters:
+//  - Address
+//  - ContractAddress
+//  - PrivateKey
+func (p *ThetaServiceClient) GetTokenBalance(ctx context.Context, address string, contract_address string, private_key string) (r int64, err error) {
+  var _args19 ThetaServiceGetTokenBalanceArgs
+  _args19.Address = address
+  _args19.ContractAddress = contract_address
+  _args19.PrivateKey = private_key
+  var _result20 ThetaServiceGetTokenBalanceResult
+  if err = p.Client_().Call(ctx, "getTokenBalance", &_args19, &_result20); err != nil {
+    return
+  }
+  switch {
+  case _result20.Err!= nil:
+    return r, _result20.Err
+  }
+
+  return _result20.GetSuccess(), nil
+}
+
+// Parameters:
+//  - Send
+func (p *ThetaServiceClient) SendToken(ctx context.Context, send *SendToken) (r *BroadcastRawTransactionAsync, err error) {
+  var _args21 ThetaServiceSendTokenArgs
+  _args21.Send = send
+  var _result22 ThetaServiceSendTokenResult
+  if err = p.Client_().Call(ctx, "sendToken", &_args21, &_result22); err != nil {
+    return
+  }
+  switch {
+  case _result22.Err!= nil:
+    return r, _result22.Err
+  }
+
+  return _result22.GetSuccess(), nil
+}
+
+// Parameters:
+//  - Hash
+func (p *ThetaServiceClient) GetBlock(ctx context.Context, hash string) (r *Block, err error) {
+  var _args23 ThetaServiceGetBlockArgs
+  _args23.Hash = hash
+  var _result24 ThetaServiceGetBlockResult
+  if err = p.Client_().Call(ctx, "GetBlock", &_args23, &_result24); err != nil {
+    return
+  }
+  switch {
+  case _result24.Err!= nil:
+    return r, _result24.Err
+  }
+
+  return _result24.GetSuccess(), nil
+}
+
+// Parameters:
+//  - Height
+func (p *ThetaServiceClient) GetBlockByHeight(ctx context.Context, height int64) (r *Block, err error) {
+  var _args25 ThetaServiceGetBlockByHeightArgs
+  _args25.Height = height
+  var _result26 ThetaServiceGetBlockByHeightResult
+  if err = p.Client_().Call(ctx, "GetBlockByHeight", &_args25, &_result26); err != nil {
+    return
+  }
+  switch {
+  case _result26.Err!= nil:
+    return r, _result26.Err
+  }
+
+  return _result26.GetSuccess(), nil
+}
+
+// Parameters:
+//  - Hash
+func (p *ThetaServiceClient) GetBlockHeader(ctx context.Context, hash string) (r *BlockHeader, err error) {
+  var _args27 ThetaServiceGetBlockHeaderArgs
+  _args27.Hash = hash
+  var _result28 ThetaServiceGetBlockHeaderResult
+  if err = p.Client_().Call(ctx, "GetBlockHeader", &_args27, &_result28); err != nil {
+    return
+  }
+  switch {
+  case _result28.Err!= nil:
+    return r, _result28.Err
+  }
+
+  return _result28.GetSuccess(), nil
+}
+
+// Parameters:
+//  - Height
+func (p *ThetaServiceClient) GetBlockHeaderByHeight(ctx context.Context, height int64) (r *BlockHeader, err error) {
+  var _args29 ThetaServiceGetBlockHeaderByHeightArgs
+  _args29.Height = height
+  var _result30 ThetaServiceGetBlockHeaderByHeightResult
+  if err = p.Client_().Call(ctx, "GetBlockHeaderByHeight", &_args29, &_result30); err != nil {
+    return
+  }
+  switch {
+  case _result30.Err!= nil:
+    return r, _result30.Err
+  }
+
+  return _result30.GetSuccess(), nil
+}
+
+// Parameters:
+//  - Start
+//  - End
+func (p *ThetaServiceClient) GetBlocksByRange(ctx context.Context, start int64, end int64) (r []*Block, err error) {
+  var _args31 ThetaServiceGetBlocksByRangeArgs
+  _args31.Start = start
+  _args31.End = end
+  var _result32 ThetaServiceGetBlocksByRangeResult
+  if err = p.Client_().Call(ctx, "GetBlocksByRange", &_args31, &_result32); err != nil {
+    return
+  }
+  switch {
+  case _result32.Err!= nil:
+    return r, _result32.Err
+  }
+
+  return _result32.GetSuccess(), nil
+}
+
+// Parameters:
+//  - Hash
+func (p *ThetaServiceClient) GetTransaction(ctx context.Context, hash string) (r *Transaction, err error) {
+  var _args33 ThetaServiceGetTransactionArgs
+  _args33.Hash = hash
+  var _result34 ThetaServiceGetTransactionResult
+  if err = p.Client_().Call(ctx, "GetTransaction", &_args33, &_result34); err != nil {
+    return
+  }
+  switch {
+  case _result34.Err!= nil:
+    return r, _result34.Err
+  }
+
+  return _result34.GetSuccess(), nil
+}
+
+func (p *ThetaServiceClient) GetPendingTransactions(ctx context.Context) (r *PendingTransaction, err error) {
+  var _args35 ThetaServiceGetPendingTransactionsArgs
+  var _result36 ThetaServiceGetPendingTransactionsResult
+  if err = p.Client_().Call(ctx, "GetPendingTransactions", &_args35, &_result36); err != nil {
+    return
+  }
+  switch {
+  case _result36.Err!= nil:
+    return r, _result36.Err
+  }
+
+  return _result36.GetSuccess(), nil
+}
+
+func (p *ThetaServiceClient) GetStatus(ctx context.Context) (r *Status, err error) {
+  var _args37 ThetaServiceGetStatusArgs
+  var _result38 ThetaServiceGetStatusResult
+  if err = p.Client_().Call(ctx, "GetStatus", &_args37, &_result38); err != nil {
+    return
+  }
+  switch {
+  case _result38.Err!= nil:
+    return r, _result38.Err
+  }
+
+  return _result38.GetSuccess(), nil
+}
+
+// Parameters:
+//  - Height
+func (p *ThetaServiceClient) GetVcpByHeight(ctx context.Context, height int64) (r []*BlockHashVcpPair, err error) {
+  var _args39 ThetaServiceGetVcpByHeightArgs
+  _args39.Height = height
+  var _result40 ThetaServiceGetVcpByHeightResult
+  if err = p.Client_().Call(ctx, "GetVcpByHeight", &_args39, &_result40); err != nil {
+    return
+  }
+  switch {
+  case _result40.Err!= nil:
+    return r, _result40.Err
+  }
+
+  return _result40.GetSuccess(), nil
+}
+
+// Parameters:
+//  - Height
+func (p *ThetaServiceClient) GetGcpByHeight(ctx context.Context, height int64) (r []*BlockHashGcpPair, err error) {
+  var _args41 ThetaServiceGetGcpByHeightArgs
+  _args41.Height = height
+  var _result42 ThetaServiceGetGcpByHeightResult
+  if err = p.Client_().Call(ctx, "GetGcpByHeight", &_args41, &_result42); err != nil {
+    return
+  }
+  switch {
+  case _result42.Err!= nil:
+    return r, _result42.Err
+  }
+
+  return _result42.GetSuccess(), nil
+}
+
+func (p *ThetaServiceClient) GetGuardianInfo(ctx context.Context) (r *GuardianInfo, err error) {
+  var _args43 ThetaServiceGetGuardianInfoArgs
+  var _result44 ThetaServiceGetGuardianInfoResult
+  if err = p.Client_().Call(ctx, "GetGuardianInfo", &_args43, &_result44); err != nil {
+    return
+  }
+  switch {
+  case _result44.Err!= nil:
+    return r, _result44.Err
+  }
+
+  return _result44.GetSuccess(), nil
+}
+
+// Parameters:
+//  - Contract
+func (p *ThetaServiceClient) CallSmartContract(ctx context.Context, contract *SmartContract) (r *SmartContractCall, err error) {
+  var _args45 ThetaServiceCallSmartContractArgs
+  _args45.Contract = contract
+  var _result46 ThetaServiceCallSmartContractResult
+  if err = p.Client_().Call(ctx, "CallSmartContract", &_args45, &_result46); err != nil {
+    return
+  }
+  switch {
+  case _result46.Err!= nil:
+    return r, _result46.Err
+  }
+
+  return _result46.GetSuccess(), nil
+}
+
+// Parameters:
+//  - Deposit
+func (p *ThetaServiceClient) DepositStake(ctx context.Context, deposit *DepositStake) (r *BroadcastRawTransactionAsync, err error) {
+  var _args47 ThetaServiceDepositStakeArgs
+  _args47.Deposit = deposit
+  var _result48 ThetaServiceDepositStakeResult
+  if err = p.Client_().Call(ctx, "DepositStake", &_args47, &_result48); err != nil {
+    return
+  }
+  switch {
+  case _result48.Err!= nil:
+    return r, _result48.Err
+  }
+
+  return _result48.GetSuccess(), nil
+}
+
+// Parameters:
+//  - Withdraw
+func (p *ThetaServiceClient) WithdrawStake(ctx context.Context, withdraw *WithdrawStake) (r *BroadcastRawTransactionAsync, err error) {
+  var _args49 ThetaServiceWithdrawStakeArgs
+  _args49.Withdraw = withdraw
+  var _result50 ThetaServiceWithdrawStakeResult
+  if err = p.Client_().Call(ctx, "WithdrawStake", &_args49, &_result50); err != nil {
+    return
+  }
+  switch {
+  case _result50.Err!= nil:
+    return r, _result50.Err
+  }
+
+  return _result50.GetSuccess(), nil
+}
+
+// Parameters:
+//  - TxBytes
+func (p *ThetaServiceClient) BroadcastRawTransaction(ctx context.Context, tx_bytes string) (r *BroadcastRawTransactionAsync, err error) {
+  var _args51 ThetaServiceBroadcastRawTransactionArgs
+  _args51.TxBytes = tx_bytes
+  var _result52 ThetaServiceBroadcastRawTransactionResult
+  if err = p.Client_().Call(ctx, "BroadcastRawTransaction", &_args51, &_result52); err != nil {
+    return
+  }
+  switch {
+  case _result52.Err!= nil:
+    return r, _result52.Err
+  }
+
+  return _result52.GetSuccess(), nil
+}
+
+// Parameters:
+//  - Address
+//  - Password
+func (p *ThetaServiceClient) UnlockKey(ctx context.Context, address string, password string) (r *StatusKey, err error) {
+  var _args53 ThetaServiceUnlockKeyArgs
+  _args53.Address = address
+  _args53.Password = password
+  var _result54 ThetaServiceUnlockKeyResult
+  if err = p.Client_().Call(ctx, "UnlockKey", &_args53, &_result54); err != nil {
+    return
+  }
+  switch {
+  case _result54.Err!= nil:
+    return r, _result54.Err
+  }
+
+  return _result54.GetSuccess(), nil
+}
+
+// Parameters:
+//  - Address
+func (p *ThetaServiceClient) LockKey(ctx context.Context, address string) (r *StatusKey, err error) {
+  var _args55 ThetaServiceLockKeyArgs
+  _args55.Address = address
+  var _result56 ThetaServiceLockKeyResult
+  if err = p.Client_().Call(ctx, "LockKey", &_args55, &_result56); err != nil {
+    return
+  }
+  switch {
+  case _result56.Err!= nil:
+    return r, _result56.Err
+  }
+
+  return _result56.GetSuccess(), nil
+}
+
+// Parameters:
+//  - Address
+func (p *ThetaServiceClient) IsKeyUnlocked(ctx context.Context, address string) (r *StatusKey, err error) {
+  var _args57 ThetaServiceIsKeyUnlockedArgs
+  _args57.Address = address
+  var _result58 ThetaServiceIsKeyUnlockedResult
+  if err = p.Client_().Call(ctx, "IsKeyUnlocked", &_args57, &_result58); err != nil {
+    return
+  }
+  switch {
+  case _result58.Err!= nil:
+    return r, _result58.Err
+  }
+
+  return _result58.GetSuccess(), nil
+}
+
+func (p *ThetaServiceClient) ListKeys(ctx context.Context) (r *ListKeys, err error) {
+  var _args59 ThetaServiceListKeysArgs
+  var _result60 ThetaServiceListKeysResult
+  if err = p.Client_().Call(ctx, "ListKeys", &_args59, &_result60); err != nil {
+    return
+  }
+  switch {
+  case _result60.Err!= nil:
+    return r, _result60.Err
+  }
+
+  return _result60.GetSuccess(), nil
+}
+
+// Parameters:
+//  - ContractAddress
+func (p *ThetaServiceClient) GetTokenInfo(ctx context.Context, contract_address string) (r *TokenInfo, err error) {
+  var _args61 ThetaServiceGetTokenInfoArgs
+  _args61.ContractAddress = contract_address
+  var _result62 ThetaServiceGetTokenInfoResult
+  if err = p.Client_().Call(ctx, "GetTokenInfo", &_args61, &_result62); err != nil {
+    return
+  }
+  switch {
+  case _result62.Err!= nil:
+    return r, _result62.Err
+  }
+
+  return _result62.GetSuccess(), nil
+}
+
+// Parameters:
+//  - ContractAddress
+//  - Owner
+//  - Spender
+func (p *ThetaServiceClient) GetTokenAllowance(ctx context.Context, contract_address string, owner string, spender string) (r string, err error) {
+  var _args63 ThetaServiceGetTokenAllowanceArgs
+  _args63.ContractAddress = contract_address
+  _args63.Owner = owner
+  _args63.Spender = spender
+  var _result64 ThetaServiceGetTokenAllowanceResult
+  if err = p.Client_().Call(ctx, "GetTokenAllowance", &_args63, &_result64); err != nil {
+    return
+  }
+  switch {
+  case _result64.Err!= nil:
+    return r, _result64.Err
+  }
+
+  return _result64.GetSuccess(), nil
+}
+
+// Parameters:
+//  - Approve
+func (p *ThetaServiceClient) ApproveToken(ctx context.Context, approve *ApproveToken) (r *BroadcastRawTransactionAsync, err error) {
+  var _args65 ThetaServiceApproveTokenArgs
+  _args65.Approve = approve
+  var _result66 ThetaServiceApproveTokenResult
+  if err = p.Client_().Call(ctx, "ApproveToken", &_args65, &_result66); err != nil {
+    return
+  }
+  switch {
+  case _result66.Err!= nil:
+    return r, _result66.Err
+  }
+
+  return _result66.GetSuccess(), nil
+}
+
+// Parameters:
+//  - Transfer
+func (p *ThetaServiceClient) TransferTokenFrom(ctx context.Context, transfer *TransferTokenFrom) (r *BroadcastRawTransactionAsync, err error) {
+  var _args67 ThetaServiceTransferTokenFromArgs
+  _args67.Transfer = transfer
+  var _result68 ThetaServiceTransferTokenFromResult
+  if err = p.Client_().Call(ctx, "TransferTokenFrom", &_args67, &_result68); err != nil {
+    return
+  }
+  switch {
+  case _result68.Err!= nil:
+    return r, _result68.Err
+  }
+
+  return _result68.GetSuccess(), nil
+}
+
+// Parameters:
+//  - Address
+//  - ContractAddress
+func (p *ThetaServiceClient) GetTokenBalanceString(ctx context.Context, address string, contract_address string) (r string, err error) {
+  var _args69 ThetaServiceGetTokenBalanceStringArgs
+  _args69.Address = address
+  _args69.ContractAddress = contract_address
+  var _result70 ThetaServiceGetTokenBalanceStringResult
+  if err = p.Client_().Call(ctx, "getTokenBalanceString", &_args69, &_result70); err != nil {
+    return
+  }
+  switch {
+  case _result70.Err!= nil:
+    return r, _result70.Err
+  }
+
+  return _result70.GetSuccess(), nil
+}
+
+type ThetaServiceProcessor struct {
+  processorMap map[string]thrift.TProcessorFunction
+  handler ThetaService
+}
+
+func (p *ThetaServiceProcessor) AddToProcessorMap(key string, processor thrift.TProcessorFunction) {
+  p.processorMap[key] = processor
+}
+
+func (p *ThetaServiceProcessor) GetProcessorFunction(key string) (processor thrift.TProcessorFunction, ok bool) {
+  processor, ok = p.processorMap[key]
+  return processor, ok
+}
+
+func (p *ThetaServiceProcessor) ProcessorMap() map[string]thrift.TProcessorFunction {
+  return p.processorMap
+}
+
+func NewThetaServiceProcessor(handler ThetaService) *ThetaServiceProcessor {
+
+  self71 := &ThetaServiceProcessor{handler:handler, processorMap:make(map[string]thrift.TProcessorFunction)}
+  self71.processorMap["getAccount"] = &thetaServiceProcessorGetAccount{handler:handler}
+  self71.processorMap["sendTx"] = &thetaServiceProcessorSendTx{handler:handler}
+  self71.processorMap["getTokenBalance"] = &thetaServiceProcessorGetTokenBalance{handler:handler}
+  self71.processorMap["sendToken"] = &thetaServiceProcessorSendToken{handler:handler}
+  self71.processorMap["GetBlock"] = &thetaServiceProcessorGetBlock{handler:handler}
+  self71.processorMap["GetBlockByHeight"] = &thetaServiceProcessorGetBlockByHeight{handler:handler}
+  self71.processorMap["GetBlockHeader"] = &thetaServiceProcessorGetBlockHeader{handler:handler}
+  self71.processorMap["GetBlockHeaderByHeight"] = &thetaServiceProcessorGetBlockHeaderByHeight{handler:handler}
+  self71.processorMap["GetBlocksByRange"] = &thetaServiceProcessorGetBlocksByRange{handler:handler}
+  self71.processorMap["GetTransaction"] = &thetaServiceProcessorGetTransaction{handler:handler}
+  self71.processorMap["GetPendingTransactions"] = &thetaServiceProcessorGetPendingTransactions{handler:handler}
+  self71.processorMap["GetStatus"] = &thetaServiceProcessorGetStatus{handler:handler}
+  self71.processorMap["GetVcpByHeight"] = &thetaServiceProcessorGetVcpByHeight{handler:handler}
+  self71.processorMap["GetGcpByHeight"] = &thetaServiceProcessorGetGcpByHeight{handler:handler}
+  self71.processorMap["GetGuardianInfo"] = &thetaServiceProcessorGetGuardianInfo{handler:handler}
+  self71.processorMap["CallSmartContract"] = &thetaServiceProcessorCallSmartContract{handler:handler}
+  self71.processorMap["DepositStake"] = &thetaServiceProcessorDepositStake{handler:handler}
+  self71.processorMap["WithdrawStake"] = &thetaServiceProcessorWithdrawStake{handler:handler}
+  self71.processorMap["BroadcastRawTransaction"] = &thetaServiceProcessorBroadcastRawTransaction{handler:handler}
+  self71.processorMap["UnlockKey"] = &thetaServiceProcessorUnlockKey{handler:handler}
+  self71.processorMap["LockKey"] = &thetaServiceProcessorLockKey{handler:handler}
+  self71.processorMap["IsKeyUnlocked"] = &thetaServiceProcessorIsKeyUnlocked{handler:handler}
+  self71.processorMap["ListKeys"] = &thetaServiceProcessorListKeys{handler:handler}
+  self71.processorMap["GetTokenInfo"] = &thetaServiceProcessorGetTokenInfo{handler:handler}
+  self71.processorMap["GetTokenAllowance"] = &thetaServiceProcessorGetTokenAllowance{handler:handler}
+  self71.processorMap["ApproveToken"] = &thetaServiceProcessorApproveToken{handler:handler}
+  self71.processorMap["TransferTokenFrom"] = &thetaServiceProcessorTransferTokenFrom{handler:handler}
+  self71.processorMap["getTokenBalanceString"] = &thetaServiceProcessorGetTokenBalanceString{handler:handler}
+return self71
+}
+
+func (p *ThetaServiceProcessor) Process(ctx context.Context, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  name, _, seqId, err := iprot.ReadMessageBegin()
+  if err != nil { return false, err }
+  if processor, ok := p.GetProcessorFunction(name); ok {
+    return processor.Process(ctx, seqId, iprot, oprot)
+  }
+  iprot.Skip(thrift.STRUCT)
+  iprot.ReadMessageEnd()
+  x70 := thrift.NewTApplicationException(thrift.UNKNOWN_METHOD, "Unknown function " + name)
+  oprot.WriteMessageBegin(name, thrift.EXCEPTION, seqId)
+  x70.Write(oprot)
+  oprot.WriteMessageEnd()
+  oprot.Flush(ctx)
+  return false, x70
+
+}
+
+type thetaServiceProcessorGetAccount struct {
+  handler ThetaService
+}
+
+func (p *thetaServiceProcessorGetAccount) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := ThetaServiceGetAccountArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("getAccount", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := ThetaServiceGetAccountResult{}
+var retval *Account
+  var err2 error
+  if retval, err2 = p.handler.GetAccount(ctx, args.Account); err2 != nil {
+  switch v := err2.(type) {
+    case *TThetaException:
+  result.Err = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getAccount: " + err2.Error())
+    oprot.WriteMessageBegin("getAccount", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("getAccount", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type thetaServiceProcessorSendTx struct {
+  handler ThetaService
+}
+
+func (p *thetaServiceProcessorSendTx) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := ThetaServiceSendTxArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("sendTx", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := ThetaServiceSendTxResult{}
+var retval *BroadcastRawTransactionAsync
+  var err2 error
+  if retval, err2 = p.handler.SendTx(ctx, args.Send); err2 != nil {
+  switch v := err2.(type) {
+    case *TThetaException:
+  result.Err = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing sendTx: " + err2.Error())
+    oprot.WriteMessageBegin("sendTx", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("sendTx", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type thetaServiceProcessorGetTokenBalance struct {
+  handler ThetaService
+}
+
+func (p *thetaServiceProcessorGetTokenBalance) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := ThetaServiceGetTokenBalanceArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("getTokenBalance", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := ThetaServiceGetTokenBalanceResult{}
+var retval int64
+  var err2 error
+  if retval, err2 = p.handler.GetTokenBalance(ctx, args.Address, args.ContractAddress, args.PrivateKey); err2 != nil {
+  switch v := err2.(type) {
+    case *TThetaException:
+  result.Err = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getTokenBalance: " + err2.Error())
+    oprot.WriteMessageBegin("getTokenBalance", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return true, err2
+  }
+  } else {
+    result.Success = &retval
+}
+  if err2 = oprot.WriteMessageBegin("getTokenBalance", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type thetaServiceProcessorSendToken struct {
+  handler ThetaService
+}
+
+func (p *thetaServiceProcessorSendToken) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := ThetaServiceSendTokenArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("sendToken", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := ThetaServiceSendTokenResult{}
+var retval *BroadcastRawTransactionAsync
+  var err2 error
+  if retval, err2 = p.handler.SendToken(ctx, args.Send); err2 != nil {
+  switch v := err2.(type) {
+    case *TThetaException:
+  result.Err = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing sendToken: " + err2.Error())
+    oprot.WriteMessageBegin("sendToken", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("sendToken", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type thetaServiceProcessorGetBlock struct {
+  handler ThetaService
+}
+
+func (p *thetaServiceProcessorGetBlock) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := ThetaServiceGetBlockArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("GetBlock", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := ThetaServiceGetBlockResult{}
+var retval *Block
+  var err2 error
+  if retval, err2 = p.handler.GetBlock(ctx, args.Hash); err2 != nil {
+  switch v := err2.(type) {
+    case *TThetaException:
+  result.Err = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetBlock: " + err2.Error())
+    oprot.WriteMessageBegin("GetBlock", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("GetBlock", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type thetaServiceProcessorGetBlockByHeight struct {
+  handler ThetaService
+}
+
+func (p *thetaServiceProcessorGetBlockByHeight) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := ThetaServiceGetBlockByHeightArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("GetBlockByHeight", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := ThetaServiceGetBlockByHeightResult{}
+var retval *Block
+  var err2 error
+  if retval, err2 = p.handler.GetBlockByHeight(ctx, args.Height); err2 != nil {
+  switch v := err2.(type) {
+    case *TThetaException:
+  result.Err = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetBlockByHeight: " + err2.Error())
+    oprot.WriteMessageBegin("GetBlockByHeight", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("GetBlockByHeight", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type thetaServiceProcessorGetBlockHeader struct {
+  handler ThetaService
+}
+
+func (p *thetaServiceProcessorGetBlockHeader) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := ThetaServiceGetBlockHeaderArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("GetBlockHeader", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := ThetaServiceGetBlockHeaderResult{}
+var retval *BlockHeader
+  var err2 error
+  if retval, err2 = p.handler.GetBlockHeader(ctx, args.Hash); err2 != nil {
+  switch v := err2.(type) {
+    case *TThetaException:
+  result.Err = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetBlockHeader: " + err2.Error())
+    oprot.WriteMessageBegin("GetBlockHeader", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("GetBlockHeader", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type thetaServiceProcessorGetBlockHeaderByHeight struct {
+  handler ThetaService
+}
+
+func (p *thetaServiceProcessorGetBlockHeaderByHeight) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := ThetaServiceGetBlockHeaderByHeightArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("GetBlockHeaderByHeight", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := ThetaServiceGetBlockHeaderByHeightResult{}
+var retval *BlockHeader
+  var err2 error
+  if retval, err2 = p.handler.GetBlockHeaderByHeight(ctx, args.Height); err2 != nil {
+  switch v := err2.(type) {
+    case *TThetaException:
+  result.Err = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetBlockHeaderByHeight: " + err2.Error())
+    oprot.WriteMessageBegin("GetBlockHeaderByHeight", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("GetBlockHeaderByHeight", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type thetaServiceProcessorGetBlocksByRange struct {
+  handler ThetaService
+}
+
+func (p *thetaServiceProcessorGetBlocksByRange) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := ThetaServiceGetBlocksByRangeArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("GetBlocksByRange", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := ThetaServiceGetBlocksByRangeResult{}
+var retval []*Block
+  var err2 error
+  if retval, err2 = p.handler.GetBlocksByRange(ctx, args.Start, args.End); err2 != nil {
+  switch v := err2.(type) {
+    case *TThetaException:
+  result.Err = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetBlocksByRange: " + err2.Error())
+    oprot.WriteMessageBegin("GetBlocksByRange", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("GetBlocksByRange", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type thetaServiceProcessorGetTransaction struct {
+  handler ThetaService
+}
+
+func (p *thetaServiceProcessorGetTransaction) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := ThetaServiceGetTransactionArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("GetTransaction", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := ThetaServiceGetTransactionResult{}
+var retval *Transaction
+  var err2 error
+  if retval, err2 = p.handler.GetTransaction(ctx, args.Hash); err2 != nil {
+  switch v := err2.(type) {
+    case *TThetaException:
+  result.Err = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetTransaction: " + err2.Error())
+    oprot.WriteMessageBegin("GetTransaction", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("GetTransaction", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type thetaServiceProcessorGetPendingTransactions struct {
+  handler ThetaService
+}
+
+func (p *thetaServiceProcessorGetPendingTransactions) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := ThetaServiceGetPendingTransactionsArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("GetPendingTransactions", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := ThetaServiceGetPendingTransactionsResult{}
+var retval *PendingTransaction
+  var err2 error
+  if retval, err2 = p.handler.GetPendingTransactions(ctx); err2 != nil {
+  switch v := err2.(type) {
+    case *TThetaException:
+  result.Err = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetPendingTransactions: " + err2.Error())
+    oprot.WriteMessageBegin("GetPendingTransactions", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("GetPendingTransactions", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type thetaServiceProcessorGetStatus struct {
+  handler ThetaService
+}
+
+func (p *thetaServiceProcessorGetStatus) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := ThetaServiceGetStatusArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("GetStatus", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := ThetaServiceGetStatusResult{}
+var retval *Status
+  var err2 error
+  if retval, err2 = p.handler.GetStatus(ctx); err2 != nil {
+  switch v := err2.(type) {
+    case *TThetaException:
+  result.Err = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetStatus: " + err2.Error())
+    oprot.WriteMessageBegin("GetStatus", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("GetStatus", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type thetaServiceProcessorGetVcpByHeight struct {
+  handler ThetaService
+}
+
+func (p *thetaServiceProcessorGetVcpByHeight) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := ThetaServiceGetVcpByHeightArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("GetVcpByHeight", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := ThetaServiceGetVcpByHeightResult{}
+var retval []*BlockHashVcpPair
+  var err2 error
+  if retval, err2 = p.handler.GetVcpByHeight(ctx, args.Height); err2 != nil {
+  switch v := err2.(type) {
+    case *TThetaException:
+  result.Err = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetVcpByHeight: " + err2.Error())
+    oprot.WriteMessageBegin("GetVcpByHeight", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("GetVcpByHeight", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type thetaServiceProcessorGetGcpByHeight struct {
+  handler ThetaService
+}
+
+func (p *thetaServiceProcessorGetGcpByHeight) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := ThetaServiceGetGcpByHeightArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("GetGcpByHeight", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := ThetaServiceGetGcpByHeightResult{}
+var retval []*BlockHashGcpPair
+  var err2 error
+  if retval, err2 = p.handler.GetGcpByHeight(ctx, args.Height); err2 != nil {
+  switch v := err2.(type) {
+    case *TThetaException:
+  result.Err = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetGcpByHeight: " + err2.Error())
+    oprot.WriteMessageBegin("GetGcpByHeight", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("GetGcpByHeight", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type thetaServiceProcessorGetGuardianInfo struct {
+  handler ThetaService
+}
+
+func (p *thetaServiceProcessorGetGuardianInfo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := ThetaServiceGetGuardianInfoArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("GetGuardianInfo", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := ThetaServiceGetGuardianInfoResult{}
+var retval *GuardianInfo
+  var err2 error
+  if retval, err2 = p.handler.GetGuardianInfo(ctx); err2 != nil {
+  switch v := err2.(type) {
+    case *TThetaException:
+  result.Err = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetGuardianInfo: " + err2.Error())
+    oprot.WriteMessageBegin("GetGuardianInfo", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("GetGuardianInfo", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type thetaServiceProcessorCallSmartContract struct {
+  handler ThetaService
+}
+
+func (p *thetaServiceProcessorCallSmartContract) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := ThetaServiceCallSmartContractArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("CallSmartContract", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := ThetaServiceCallSmartContractResult{}
+var retval *SmartContractCall
+  var err2 error
+  if retval, err2 = p.handler.CallSmartContract(ctx, args.Contract); err2 != nil {
+  switch v := err2.(type) {
+    case *TThetaException:
+  result.Err = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing CallSmartContract: " + err2.Error())
+    oprot.WriteMessageBegin("CallSmartContract", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("CallSmartContract", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type thetaServiceProcessorDepositStake struct {
+  handler ThetaService
+}
+
+func (p *thetaServiceProcessorDepositStake) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := ThetaServiceDepositStakeArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("DepositStake", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := ThetaServiceDepositStakeResult{}
+var retval *BroadcastRawTransactionAsync
+  var err2 error
+  if retval, err2 = p.handler.DepositStake(ctx, args.Deposit); err2 != nil {
+  switch v := err2.(type) {
+    case *TThetaException:
+  result.Err = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing DepositStake: " + err2.Error())
+    oprot.WriteMessageBegin("DepositStake", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("DepositStake", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type thetaServiceProcessorWithdrawStake struct {
+  handler ThetaService
+}
+
+func (p *thetaServiceProcessorWithdrawStake) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := ThetaServiceWithdrawStakeArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("WithdrawStake", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := ThetaServiceWithdrawStakeResult{}
+var retval *BroadcastRawTransactionAsync
+  var err2 error
+  if retval, err2 = p.handler.WithdrawStake(ctx, args.Withdraw); err2 != nil {
+  switch v := err2.(type) {
+    case *TThetaException:
+  result.Err = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing WithdrawStake: " + err2.Error())
+    oprot.WriteMessageBegin("WithdrawStake", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("WithdrawStake", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type thetaServiceProcessorBroadcastRawTransaction struct {
+  handler ThetaService
+}
+
+func (p *thetaServiceProcessorBroadcastRawTransaction) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := ThetaServiceBroadcastRawTransactionArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("BroadcastRawTransaction", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := ThetaServiceBroadcastRawTransactionResult{}
+var retval *BroadcastRawTransactionAsync
+  var err2 error
+  if retval, err2 = p.handler.BroadcastRawTransaction(ctx, args.TxBytes); err2 != nil {
+  switch v := err2.(type) {
+    case *TThetaException:
+  result.Err = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing BroadcastRawTransaction: " + err2.Error())
+    oprot.WriteMessageBegin("BroadcastRawTransaction", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("BroadcastRawTransaction", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type thetaServiceProcessorUnlockKey struct {
+  handler ThetaService
+}
+
+func (p *thetaServiceProcessorUnlockKey) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := ThetaServiceUnlockKeyArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("UnlockKey", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := ThetaServiceUnlockKeyResult{}
+var retval *StatusKey
+  var err2 error
+  if retval, err2 = p.handler.UnlockKey(ctx, args.Address, args.Password); err2 != nil {
+  switch v := err2.(type) {
+    case *TThetaException:
+  result.Err = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing UnlockKey: " + err2.Error())
+    oprot.WriteMessageBegin("UnlockKey", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("UnlockKey", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type thetaServiceProcessorLockKey struct {
+  handler ThetaService
+}
+
+func (p *thetaServiceProcessorLockKey) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := ThetaServiceLockKeyArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("LockKey", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := ThetaServiceLockKeyResult{}
+var retval *StatusKey
+  var err2 error
+  if retval, err2 = p.handler.LockKey(ctx, args.Address); err2 != nil {
+  switch v := err2.(type) {
+    case *TThetaException:
+  result.Err = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing LockKey: " + err2.Error())
+    oprot.WriteMessageBegin("LockKey", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("LockKey", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type thetaServiceProcessorIsKeyUnlocked struct {
+  handler ThetaService
+}
+
+func (p *thetaServiceProcessorIsKeyUnlocked) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := ThetaServiceIsKeyUnlockedArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("IsKeyUnlocked", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := ThetaServiceIsKeyUnlockedResult{}
+var retval *StatusKey
+  var err2 error
+  if retval, err2 = p.handler.IsKeyUnlocked(ctx, args.Address); err2 != nil {
+  switch v := err2.(type) {
+    case *TThetaException:
+  result.Err = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing IsKeyUnlocked: " + err2.Error())
+    oprot.WriteMessageBegin("IsKeyUnlocked", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("IsKeyUnlocked", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type thetaServiceProcessorListKeys struct {
+  handler ThetaService
+}
+
+func (p *thetaServiceProcessorListKeys) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := ThetaServiceListKeysArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("ListKeys", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := ThetaServiceListKeysResult{}
+var retval *ListKeys
+  var err2 error
+  if retval, err2 = p.handler.ListKeys(ctx); err2 != nil {
+  switch v := err2.(type) {
+    case *TThetaException:
+  result.Err = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ListKeys: " + err2.Error())
+    oprot.WriteMessageBegin("ListKeys", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("ListKeys", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type thetaServiceProcessorGetTokenInfo struct {
+  handler ThetaService
+}
+
+func (p *thetaServiceProcessorGetTokenInfo) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := ThetaServiceGetTokenInfoArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("GetTokenInfo", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := ThetaServiceGetTokenInfoResult{}
+var retval *TokenInfo
+  var err2 error
+  if retval, err2 = p.handler.GetTokenInfo(ctx, args.ContractAddress); err2 != nil {
+  switch v := err2.(type) {
+    case *TThetaException:
+  result.Err = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetTokenInfo: " + err2.Error())
+    oprot.WriteMessageBegin("GetTokenInfo", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("GetTokenInfo", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type thetaServiceProcessorGetTokenAllowance struct {
+  handler ThetaService
+}
+
+func (p *thetaServiceProcessorGetTokenAllowance) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := ThetaServiceGetTokenAllowanceArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("GetTokenAllowance", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := ThetaServiceGetTokenAllowanceResult{}
+var retval string
+  var err2 error
+  if retval, err2 = p.handler.GetTokenAllowance(ctx, args.ContractAddress, args.Owner, args.Spender); err2 != nil {
+  switch v := err2.(type) {
+    case *TThetaException:
+  result.Err = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetTokenAllowance: " + err2.Error())
+    oprot.WriteMessageBegin("GetTokenAllowance", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return true, err2
+  }
+  } else {
+    result.Success = &retval
+}
+  if err2 = oprot.WriteMessageBegin("GetTokenAllowance", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type thetaServiceProcessorApproveToken struct {
+  handler ThetaService
+}
+
+func (p *thetaServiceProcessorApproveToken) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := ThetaServiceApproveTokenArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("ApproveToken", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := ThetaServiceApproveTokenResult{}
+var retval *BroadcastRawTransactionAsync
+  var err2 error
+  if retval, err2 = p.handler.ApproveToken(ctx, args.Approve); err2 != nil {
+  switch v := err2.(type) {
+    case *TThetaException:
+  result.Err = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing ApproveToken: " + err2.Error())
+    oprot.WriteMessageBegin("ApproveToken", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("ApproveToken", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type thetaServiceProcessorTransferTokenFrom struct {
+  handler ThetaService
+}
+
+func (p *thetaServiceProcessorTransferTokenFrom) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := ThetaServiceTransferTokenFromArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("TransferTokenFrom", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := ThetaServiceTransferTokenFromResult{}
+var retval *BroadcastRawTransactionAsync
+  var err2 error
+  if retval, err2 = p.handler.TransferTokenFrom(ctx, args.Transfer); err2 != nil {
+  switch v := err2.(type) {
+    case *TThetaException:
+  result.Err = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing TransferTokenFrom: " + err2.Error())
+    oprot.WriteMessageBegin("TransferTokenFrom", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return true, err2
+  }
+  } else {
+    result.Success = retval
+}
+  if err2 = oprot.WriteMessageBegin("TransferTokenFrom", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+type thetaServiceProcessorGetTokenBalanceString struct {
+  handler ThetaService
+}
+
+func (p *thetaServiceProcessorGetTokenBalanceString) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
+  args := ThetaServiceGetTokenBalanceStringArgs{}
+  if err = args.Read(iprot); err != nil {
+    iprot.ReadMessageEnd()
+    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
+    oprot.WriteMessageBegin("getTokenBalanceString", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return false, err
+  }
+
+  iprot.ReadMessageEnd()
+  result := ThetaServiceGetTokenBalanceStringResult{}
+var retval string
+  var err2 error
+  if retval, err2 = p.handler.GetTokenBalanceString(ctx, args.Address, args.ContractAddress); err2 != nil {
+  switch v := err2.(type) {
+    case *TThetaException:
+  result.Err = v
+    default:
+    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getTokenBalanceString: " + err2.Error())
+    oprot.WriteMessageBegin("getTokenBalanceString", thrift.EXCEPTION, seqId)
+    x.Write(oprot)
+    oprot.WriteMessageEnd()
+    oprot.Flush(ctx)
+    return true, err2
+  }
+  } else {
+    result.Success = &retval
+}
+  if err2 = oprot.WriteMessageBegin("getTokenBalanceString", thrift.REPLY, seqId); err2 != nil {
+    err = err2
+  }
+  if err2 = result.Write(oprot); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
+    err = err2
+  }
+  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
+    err = err2
+  }
+  if err != nil {
+    return
+  }
+  return true, err
+}
+
+
+// HELPER FUNCTIONS AND STRUCTURES
+
+// Attributes:
+//  - Account
+type ThetaServiceGetAccountArgs struct {
+  Account string `thrift:"account,1" db:"account" json:"account"`
+}
+
+func NewThetaServiceGetAccountArgs() *ThetaServiceGetAccountArgs {
+  return &ThetaServiceGetAccountArgs{}
+}
+
+
+func (p *ThetaServiceGetAccountArgs) GetAccount() string {
+  return p.Account
+}
+func (p *ThetaServiceGetAccountArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetAccountArgs)  ReadField1(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.Account = v
+}
+  return nil
+}
+
+func (p *ThetaServiceGetAccountArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("getAccount_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ThetaServiceGetAccountArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("account", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:account: ", p), err) }
+  if err := oprot.WriteString(string(p.Account)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.account (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:account: ", p), err) }
+  return err
+}
+
+func (p *ThetaServiceGetAccountArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ThetaServiceGetAccountArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - Err
+type ThetaServiceGetAccountResult struct {
+  Success *Account `thrift:"success,0" db:"success" json:"success,omitempty"`
+  Err *TThetaException `thrift:"err,1" db:"err" json:"err,omitempty"`
+}
+
+func NewThetaServiceGetAccountResult() *ThetaServiceGetAccountResult {
+  return &ThetaServiceGetAccountResult{}
+}
+
+var ThetaServiceGetAccountResult_Success_DEFAULT *Account
+func (p *ThetaServiceGetAccountResult) GetSuccess() *Account {
+  if !p.IsSetSuccess() {
+    return ThetaServiceGetAccountResult_Success_DEFAULT
+  }
+return p.Success
+}
+var ThetaServiceGetAccountResult_Err_DEFAULT *TThetaException
+func (p *ThetaServiceGetAccountResult) GetErr() *TThetaException {
+  if !p.IsSetErr() {
+    return ThetaServiceGetAccountResult_Err_DEFAULT
+  }
+return p.Err
+}
+func (p *ThetaServiceGetAccountResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *ThetaServiceGetAccountResult) IsSetErr() bool {
+  return p.Err != nil
+}
+
+func (p *ThetaServiceGetAccountResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField0(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetAccountResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &Account{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetAccountResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.Err = &TThetaException{}
+  if err := p.Err.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetAccountResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("getAccount_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ThetaServiceGetAccountResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *ThetaServiceGetAccountResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetErr() {
+    if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err) }
+    if err := p.Err.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:err: ", p), err) }
+  }
+  return err
+}
+
+func (p *ThetaServiceGetAccountResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ThetaServiceGetAccountResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Send
+type ThetaServiceSendTxArgs struct {
+  Send *Send `thrift:"send,1" db:"send" json:"send"`
+}
+
+func NewThetaServiceSendTxArgs() *ThetaServiceSendTxArgs {
+  return &ThetaServiceSendTxArgs{}
+}
+
+var ThetaServiceSendTxArgs_Send_DEFAULT *Send
+func (p *ThetaServiceSendTxArgs) GetSend() *Send {
+  if !p.IsSetSend() {
+    return ThetaServiceSendTxArgs_Send_DEFAULT
+  }
+return p.Send
+}
+func (p *ThetaServiceSendTxArgs) IsSetSend() bool {
+  return p.Send != nil
+}
+
+func (p *ThetaServiceSendTxArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceSendTxArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.Send = &Send{}
+  if err := p.Send.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Send), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceSendTxArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("sendTx_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ThetaServiceSendTxArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("send", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:send: ", p), err) }
+  if err := p.Send.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Send), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:send: ", p), err) }
+  return err
+}
+
+func (p *ThetaServiceSendTxArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ThetaServiceSendTxArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - Err
+type ThetaServiceSendTxResult struct {
+  Success *BroadcastRawTransactionAsync `thrift:"success,0" db:"success" json:"success,omitempty"`
+  Err *TThetaException `thrift:"err,1" db:"err" json:"err,omitempty"`
+}
+
+func NewThetaServiceSendTxResult() *ThetaServiceSendTxResult {
+  return &ThetaServiceSendTxResult{}
+}
+
+var ThetaServiceSendTxResult_Success_DEFAULT *BroadcastRawTransactionAsync
+func (p *ThetaServiceSendTxResult) GetSuccess() *BroadcastRawTransactionAsync {
+  if !p.IsSetSuccess() {
+    return ThetaServiceSendTxResult_Success_DEFAULT
+  }
+return p.Success
+}
+var ThetaServiceSendTxResult_Err_DEFAULT *TThetaException
+func (p *ThetaServiceSendTxResult) GetErr() *TThetaException {
+  if !p.IsSetErr() {
+    return ThetaServiceSendTxResult_Err_DEFAULT
+  }
+return p.Err
+}
+func (p *ThetaServiceSendTxResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *ThetaServiceSendTxResult) IsSetErr() bool {
+  return p.Err != nil
+}
+
+func (p *ThetaServiceSendTxResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField0(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceSendTxResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &BroadcastRawTransactionAsync{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceSendTxResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.Err = &TThetaException{}
+  if err := p.Err.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceSendTxResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("sendTx_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ThetaServiceSendTxResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *ThetaServiceSendTxResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetErr() {
+    if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err) }
+    if err := p.Err.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:err: ", p), err) }
+  }
+  return err
+}
+
+func (p *ThetaServiceSendTxResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ThetaServiceSendTxResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Address
+//  - ContractAddress
+//  - PrivateKey
+type ThetaServiceGetTokenBalanceArgs struct {
+  Address string `thrift:"address,1" db:"address" json:"address"`
+  ContractAddress string `thrift:"contract_address,2" db:"contract_address" json:"contract_address"`
+  PrivateKey string `thrift:"private_key,3" db:"private_key" json:"private_key"`
+}
+
+func NewThetaServiceGetTokenBalanceArgs() *ThetaServiceGetTokenBalanceArgs {
+  return &ThetaServiceGetTokenBalanceArgs{}
+}
+
+
+func (p *ThetaServiceGetTokenBalanceArgs) GetAddress() string {
+  return p.Address
+}
+
+func (p *ThetaServiceGetTokenBalanceArgs) GetContractAddress() string {
+  return p.ContractAddress
+}
+
+func (p *ThetaServiceGetTokenBalanceArgs) GetPrivateKey() string {
+  return p.PrivateKey
+}
+func (p *ThetaServiceGetTokenBalanceArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 2:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField2(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 3:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField3(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetTokenBalanceArgs)  ReadField1(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.Address = v
+}
+  return nil
+}
+
+func (p *ThetaServiceGetTokenBalanceArgs)  ReadField2(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.ContractAddress = v
+}
+  return nil
+}
+
+func (p *ThetaServiceGetTokenBalanceArgs)  ReadField3(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 3: ", err)
+} else {
+  p.PrivateKey = v
+}
+  return nil
+}
+
+func (p *ThetaServiceGetTokenBalanceArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("getTokenBalance_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ThetaServiceGetTokenBalanceArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("address", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:address: ", p), err) }
+  if err := oprot.WriteString(string(p.Address)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.address (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:address: ", p), err) }
+  return err
+}
+
+func (p *ThetaServiceGetTokenBalanceArgs) writeField2(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("contract_address", thrift.STRING, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:contract_address: ", p), err) }
+  if err := oprot.WriteString(string(p.ContractAddress)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.contract_address (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:contract_address: ", p), err) }
+  return err
+}
+
+func (p *ThetaServiceGetTokenBalanceArgs) writeField3(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("private_key", thrift.STRING, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:private_key: ", p), err) }
+  if err := oprot.WriteString(string(p.PrivateKey)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.private_key (3) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:private_key: ", p), err) }
+  return err
+}
+
+func (p *ThetaServiceGetTokenBalanceArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ThetaServiceGetTokenBalanceArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - Err
+type ThetaServiceGetTokenBalanceResult struct {
+  Success *int64 `thrift:"success,0" db:"success" json:"success,omitempty"`
+  Err *TThetaException `thrift:"err,1" db:"err" json:"err,omitempty"`
+}
+
+func NewThetaServiceGetTokenBalanceResult() *ThetaServiceGetTokenBalanceResult {
+  return &ThetaServiceGetTokenBalanceResult{}
+}
+
+var ThetaServiceGetTokenBalanceResult_Success_DEFAULT int64
+func (p *ThetaServiceGetTokenBalanceResult) GetSuccess() int64 {
+  if !p.IsSetSuccess() {
+    return ThetaServiceGetTokenBalanceResult_Success_DEFAULT
+  }
+return *p.Success
+}
+var ThetaServiceGetTokenBalanceResult_Err_DEFAULT *TThetaException
+func (p *ThetaServiceGetTokenBalanceResult) GetErr() *TThetaException {
+  if !p.IsSetErr() {
+    return ThetaServiceGetTokenBalanceResult_Err_DEFAULT
+  }
+return p.Err
+}
+func (p *ThetaServiceGetTokenBalanceResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *ThetaServiceGetTokenBalanceResult) IsSetErr() bool {
+  return p.Err != nil
+}
+
+func (p *ThetaServiceGetTokenBalanceResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if fieldTypeId == thrift.I64 {
+        if err := p.ReadField0(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetTokenBalanceResult)  ReadField0(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 0: ", err)
+} else {
+  p.Success = &v
+}
+  return nil
+}
+
+func (p *ThetaServiceGetTokenBalanceResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.Err = &TThetaException{}
+  if err := p.Err.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetTokenBalanceResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("getTokenBalance_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ThetaServiceGetTokenBalanceResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.I64, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := oprot.WriteI64(int64(*p.Success)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.success (0) field write error: ", p), err) }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *ThetaServiceGetTokenBalanceResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetErr() {
+    if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err) }
+    if err := p.Err.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:err: ", p), err) }
+  }
+  return err
+}
+
+func (p *ThetaServiceGetTokenBalanceResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ThetaServiceGetTokenBalanceResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Send
+type ThetaServiceSendTokenArgs struct {
+  Send *SendToken `thrift:"send,1" db:"send" json:"send"`
+}
+
+func NewThetaServiceSendTokenArgs() *ThetaServiceSendTokenArgs {
+  return &ThetaServiceSendTokenArgs{}
+}
+
+var ThetaServiceSendTokenArgs_Send_DEFAULT *SendToken
+func (p *ThetaServiceSendTokenArgs) GetSend() *SendToken {
+  if !p.IsSetSend() {
+    return ThetaServiceSendTokenArgs_Send_DEFAULT
+  }
+return p.Send
+}
+func (p *ThetaServiceSendTokenArgs) IsSetSend() bool {
+  return p.Send != nil
+}
+
+func (p *ThetaServiceSendTokenArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceSendTokenArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.Send = &SendToken{}
+  if err := p.Send.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Send), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceSendTokenArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("sendToken_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ThetaServiceSendTokenArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("send", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:send: ", p), err) }
+  if err := p.Send.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Send), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:send: ", p), err) }
+  return err
+}
+
+func (p *ThetaServiceSendTokenArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ThetaServiceSendTokenArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - Err
+type ThetaServiceSendTokenResult struct {
+  Success *BroadcastRawTransactionAsync `thrift:"success,0" db:"success" json:"success,omitempty"`
+  Err *TThetaException `thrift:"err,1" db:"err" json:"err,omitempty"`
+}
+
+func NewThetaServiceSendTokenResult() *ThetaServiceSendTokenResult {
+  return &ThetaServiceSendTokenResult{}
+}
+
+var ThetaServiceSendTokenResult_Success_DEFAULT *BroadcastRawTransactionAsync
+func (p *ThetaServiceSendTokenResult) GetSuccess() *BroadcastRawTransactionAsync {
+  if !p.IsSetSuccess() {
+    return ThetaServiceSendTokenResult_Success_DEFAULT
+  }
+return p.Success
+}
+var ThetaServiceSendTokenResult_Err_DEFAULT *TThetaException
+func (p *ThetaServiceSendTokenResult) GetErr() *TThetaException {
+  if !p.IsSetErr() {
+    return ThetaServiceSendTokenResult_Err_DEFAULT
+  }
+return p.Err
+}
+func (p *ThetaServiceSendTokenResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *ThetaServiceSendTokenResult) IsSetErr() bool {
+  return p.Err != nil
+}
+
+func (p *ThetaServiceSendTokenResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField0(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceSendTokenResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &BroadcastRawTransactionAsync{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceSendTokenResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.Err = &TThetaException{}
+  if err := p.Err.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceSendTokenResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("sendToken_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ThetaServiceSendTokenResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *ThetaServiceSendTokenResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetErr() {
+    if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err) }
+    if err := p.Err.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:err: ", p), err) }
+  }
+  return err
+}
+
+func (p *ThetaServiceSendTokenResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ThetaServiceSendTokenResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Hash
+type ThetaServiceGetBlockArgs struct {
+  Hash string `thrift:"hash,1" db:"hash" json:"hash"`
+}
+
+func NewThetaServiceGetBlockArgs() *ThetaServiceGetBlockArgs {
+  return &ThetaServiceGetBlockArgs{}
+}
+
+
+func (p *ThetaServiceGetBlockArgs) GetHash() string {
+  return p.Hash
+}
+func (p *ThetaServiceGetBlockArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetBlockArgs)  ReadField1(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.Hash = v
+}
+  return nil
+}
+
+func (p *ThetaServiceGetBlockArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetBlock_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ThetaServiceGetBlockArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("hash", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:hash: ", p), err) }
+  if err := oprot.WriteString(string(p.Hash)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.hash (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:hash: ", p), err) }
+  return err
+}
+
+func (p *ThetaServiceGetBlockArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ThetaServiceGetBlockArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - Err
+type ThetaServiceGetBlockResult struct {
+  Success *Block `thrift:"success,0" db:"success" json:"success,omitempty"`
+  Err *TThetaException `thrift:"err,1" db:"err" json:"err,omitempty"`
+}
+
+func NewThetaServiceGetBlockResult() *ThetaServiceGetBlockResult {
+  return &ThetaServiceGetBlockResult{}
+}
+
+var ThetaServiceGetBlockResult_Success_DEFAULT *Block
+func (p *ThetaServiceGetBlockResult) GetSuccess() *Block {
+  if !p.IsSetSuccess() {
+    return ThetaServiceGetBlockResult_Success_DEFAULT
+  }
+return p.Success
+}
+var ThetaServiceGetBlockResult_Err_DEFAULT *TThetaException
+func (p *ThetaServiceGetBlockResult) GetErr() *TThetaException {
+  if !p.IsSetErr() {
+    return ThetaServiceGetBlockResult_Err_DEFAULT
+  }
+return p.Err
+}
+func (p *ThetaServiceGetBlockResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *ThetaServiceGetBlockResult) IsSetErr() bool {
+  return p.Err != nil
+}
+
+func (p *ThetaServiceGetBlockResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField0(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetBlockResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &Block{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetBlockResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.Err = &TThetaException{}
+  if err := p.Err.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetBlockResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetBlock_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ThetaServiceGetBlockResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *ThetaServiceGetBlockResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetErr() {
+    if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err) }
+    if err := p.Err.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:err: ", p), err) }
+  }
+  return err
+}
+
+func (p *ThetaServiceGetBlockResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ThetaServiceGetBlockResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Height
+type ThetaServiceGetBlockByHeightArgs struct {
+  Height int64 `thrift:"height,1" db:"height" json:"height"`
+}
+
+func NewThetaServiceGetBlockByHeightArgs() *ThetaServiceGetBlockByHeightArgs {
+  return &ThetaServiceGetBlockByHeightArgs{}
+}
+
+
+func (p *ThetaServiceGetBlockByHeightArgs) GetHeight() int64 {
+  return p.Height
+}
+func (p *ThetaServiceGetBlockByHeightArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.I64 {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetBlockByHeightArgs)  ReadField1(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.Height = v
+}
+  return nil
+}
+
+func (p *ThetaServiceGetBlockByHeightArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetBlockByHeight_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ThetaServiceGetBlockByHeightArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("height", thrift.I64, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:height: ", p), err) }
+  if err := oprot.WriteI64(int64(p.Height)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.height (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:height: ", p), err) }
+  return err
+}
+
+func (p *ThetaServiceGetBlockByHeightArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ThetaServiceGetBlockByHeightArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - Err
+type ThetaServiceGetBlockByHeightResult struct {
+  Success *Block `thrift:"success,0" db:"success" json:"success,omitempty"`
+  Err *TThetaException `thrift:"err,1" db:"err" json:"err,omitempty"`
+}
+
+func NewThetaServiceGetBlockByHeightResult() *ThetaServiceGetBlockByHeightResult {
+  return &ThetaServiceGetBlockByHeightResult{}
+}
+
+var ThetaServiceGetBlockByHeightResult_Success_DEFAULT *Block
+func (p *ThetaServiceGetBlockByHeightResult) GetSuccess() *Block {
+  if !p.IsSetSuccess() {
+    return ThetaServiceGetBlockByHeightResult_Success_DEFAULT
+  }
+return p.Success
+}
+var ThetaServiceGetBlockByHeightResult_Err_DEFAULT *TThetaException
+func (p *ThetaServiceGetBlockByHeightResult) GetErr() *TThetaException {
+  if !p.IsSetErr() {
+    return ThetaServiceGetBlockByHeightResult_Err_DEFAULT
+  }
+return p.Err
+}
+func (p *ThetaServiceGetBlockByHeightResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *ThetaServiceGetBlockByHeightResult) IsSetErr() bool {
+  return p.Err != nil
+}
+
+func (p *ThetaServiceGetBlockByHeightResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField0(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetBlockByHeightResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &Block{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetBlockByHeightResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.Err = &TThetaException{}
+  if err := p.Err.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetBlockByHeightResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetBlockByHeight_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ThetaServiceGetBlockByHeightResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *ThetaServiceGetBlockByHeightResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetErr() {
+    if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err) }
+    if err := p.Err.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:err: ", p), err) }
+  }
+  return err
+}
+
+func (p *ThetaServiceGetBlockByHeightResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ThetaServiceGetBlockByHeightResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Hash
+type ThetaServiceGetBlockHeaderArgs struct {
+  Hash string `thrift:"hash,1" db:"hash" json:"hash"`
+}
+
+func NewThetaServiceGetBlockHeaderArgs() *ThetaServiceGetBlockHeaderArgs {
+  return &ThetaServiceGetBlockHeaderArgs{}
+}
+
+
+func (p *ThetaServiceGetBlockHeaderArgs) GetHash() string {
+  return p.Hash
+}
+func (p *ThetaServiceGetBlockHeaderArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetBlockHeaderArgs)  ReadField1(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.Hash = v
+}
+  return nil
+}
+
+func (p *ThetaServiceGetBlockHeaderArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetBlockHeader_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ThetaServiceGetBlockHeaderArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("hash", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:hash: ", p), err) }
+  if err := oprot.WriteString(string(p.Hash)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.hash (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:hash: ", p), err) }
+  return err
+}
+
+func (p *ThetaServiceGetBlockHeaderArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ThetaServiceGetBlockHeaderArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - Err
+type ThetaServiceGetBlockHeaderResult struct {
+  Success *BlockHeader `thrift:"success,0" db:"success" json:"success,omitempty"`
+  Err *TThetaException `thrift:"err,1" db:"err" json:"err,omitempty"`
+}
+
+func NewThetaServiceGetBlockHeaderResult() *ThetaServiceGetBlockHeaderResult {
+  return &ThetaServiceGetBlockHeaderResult{}
+}
+
+var ThetaServiceGetBlockHeaderResult_Success_DEFAULT *BlockHeader
+func (p *ThetaServiceGetBlockHeaderResult) GetSuccess() *BlockHeader {
+  if !p.IsSetSuccess() {
+    return ThetaServiceGetBlockHeaderResult_Success_DEFAULT
+  }
+return p.Success
+}
+var ThetaServiceGetBlockHeaderResult_Err_DEFAULT *TThetaException
+func (p *ThetaServiceGetBlockHeaderResult) GetErr() *TThetaException {
+  if !p.IsSetErr() {
+    return ThetaServiceGetBlockHeaderResult_Err_DEFAULT
+  }
+return p.Err
+}
+func (p *ThetaServiceGetBlockHeaderResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *ThetaServiceGetBlockHeaderResult) IsSetErr() bool {
+  return p.Err != nil
+}
+
+func (p *ThetaServiceGetBlockHeaderResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField0(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetBlockHeaderResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &BlockHeader{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetBlockHeaderResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.Err = &TThetaException{}
+  if err := p.Err.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetBlockHeaderResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetBlockHeader_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ThetaServiceGetBlockHeaderResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *ThetaServiceGetBlockHeaderResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetErr() {
+    if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err) }
+    if err := p.Err.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:err: ", p), err) }
+  }
+  return err
+}
+
+func (p *ThetaServiceGetBlockHeaderResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ThetaServiceGetBlockHeaderResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Height
+type ThetaServiceGetBlockHeaderByHeightArgs struct {
+  Height int64 `thrift:"height,1" db:"height" json:"height"`
+}
+
+func NewThetaServiceGetBlockHeaderByHeightArgs() *ThetaServiceGetBlockHeaderByHeightArgs {
+  return &ThetaServiceGetBlockHeaderByHeightArgs{}
+}
+
+
+func (p *ThetaServiceGetBlockHeaderByHeightArgs) GetHeight() int64 {
+  return p.Height
+}
+func (p *ThetaServiceGetBlockHeaderByHeightArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.I64 {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetBlockHeaderByHeightArgs)  ReadField1(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.Height = v
+}
+  return nil
+}
+
+func (p *ThetaServiceGetBlockHeaderByHeightArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetBlockHeaderByHeight_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ThetaServiceGetBlockHeaderByHeightArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("height", thrift.I64, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:height: ", p), err) }
+  if err := oprot.WriteI64(int64(p.Height)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.height (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:height: ", p), err) }
+  return err
+}
+
+func (p *ThetaServiceGetBlockHeaderByHeightArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ThetaServiceGetBlockHeaderByHeightArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - Err
+type ThetaServiceGetBlockHeaderByHeightResult struct {
+  Success *BlockHeader `thrift:"success,0" db:"success" json:"success,omitempty"`
+  Err *TThetaException `thrift:"err,1" db:"err" json:"err,omitempty"`
+}
+
+func NewThetaServiceGetBlockHeaderByHeightResult() *ThetaServiceGetBlockHeaderByHeightResult {
+  return &ThetaServiceGetBlockHeaderByHeightResult{}
+}
+
+var ThetaServiceGetBlockHeaderByHeightResult_Success_DEFAULT *BlockHeader
+func (p *ThetaServiceGetBlockHeaderByHeightResult) GetSuccess() *BlockHeader {
+  if !p.IsSetSuccess() {
+    return ThetaServiceGetBlockHeaderByHeightResult_Success_DEFAULT
+  }
+return p.Success
+}
+var ThetaServiceGetBlockHeaderByHeightResult_Err_DEFAULT *TThetaException
+func (p *ThetaServiceGetBlockHeaderByHeightResult) GetErr() *TThetaException {
+  if !p.IsSetErr() {
+    return ThetaServiceGetBlockHeaderByHeightResult_Err_DEFAULT
+  }
+return p.Err
+}
+func (p *ThetaServiceGetBlockHeaderByHeightResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *ThetaServiceGetBlockHeaderByHeightResult) IsSetErr() bool {
+  return p.Err != nil
+}
+
+func (p *ThetaServiceGetBlockHeaderByHeightResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField0(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetBlockHeaderByHeightResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &BlockHeader{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetBlockHeaderByHeightResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.Err = &TThetaException{}
+  if err := p.Err.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetBlockHeaderByHeightResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetBlockHeaderByHeight_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ThetaServiceGetBlockHeaderByHeightResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *ThetaServiceGetBlockHeaderByHeightResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetErr() {
+    if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err) }
+    if err := p.Err.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:err: ", p), err) }
+  }
+  return err
+}
+
+func (p *ThetaServiceGetBlockHeaderByHeightResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ThetaServiceGetBlockHeaderByHeightResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Start
+//  - End
+type ThetaServiceGetBlocksByRangeArgs struct {
+  Start int64 `thrift:"start,1" db:"start" json:"start"`
+  End int64 `thrift:"end,2" db:"end" json:"end"`
+}
+
+func NewThetaServiceGetBlocksByRangeArgs() *ThetaServiceGetBlocksByRangeArgs {
+  return &ThetaServiceGetBlocksByRangeArgs{}
+}
+
+
+func (p *ThetaServiceGetBlocksByRangeArgs) GetStart() int64 {
+  return p.Start
+}
+
+func (p *ThetaServiceGetBlocksByRangeArgs) GetEnd() int64 {
+  return p.End
+}
+func (p *ThetaServiceGetBlocksByRangeArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.I64 {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 2:
+      if fieldTypeId == thrift.I64 {
+        if err := p.ReadField2(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetBlocksByRangeArgs)  ReadField1(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.Start = v
+}
+  return nil
+}
+
+func (p *ThetaServiceGetBlocksByRangeArgs)  ReadField2(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.End = v
+}
+  return nil
+}
+
+func (p *ThetaServiceGetBlocksByRangeArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetBlocksByRange_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ThetaServiceGetBlocksByRangeArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("start", thrift.I64, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:start: ", p), err) }
+  if err := oprot.WriteI64(int64(p.Start)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.start (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:start: ", p), err) }
+  return err
+}
+
+func (p *ThetaServiceGetBlocksByRangeArgs) writeField2(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("end", thrift.I64, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:end: ", p), err) }
+  if err := oprot.WriteI64(int64(p.End)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.end (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:end: ", p), err) }
+  return err
+}
+
+func (p *ThetaServiceGetBlocksByRangeArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ThetaServiceGetBlocksByRangeArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - Err
+type ThetaServiceGetBlocksByRangeResult struct {
+  Success []*Block `thrift:"success,0" db:"success" json:"success,omitempty"`
+  Err *TThetaException `thrift:"err,1" db:"err" json:"err,omitempty"`
+}
+
+func NewThetaServiceGetBlocksByRangeResult() *ThetaServiceGetBlocksByRangeResult {
+  return &ThetaServiceGetBlocksByRangeResult{}
+}
+
+
+func (p *ThetaServiceGetBlocksByRangeResult) GetSuccess() []*Block {
+  return p.Success
+}
+var ThetaServiceGetBlocksByRangeResult_Err_DEFAULT *TThetaException
+func (p *ThetaServiceGetBlocksByRangeResult) GetErr() *TThetaException {
+  if !p.IsSetErr() {
+    return ThetaServiceGetBlocksByRangeResult_Err_DEFAULT
+  }
+return p.Err
+}
+func (p *ThetaServiceGetBlocksByRangeResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *ThetaServiceGetBlocksByRangeResult) IsSetErr() bool {
+  return p.Err != nil
+}
+
+func (p *ThetaServiceGetBlocksByRangeResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if fieldTypeId == thrift.LIST {
+        if err := p.ReadField0(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetBlocksByRangeResult)  ReadField0(iprot thrift.TProtocol) error {
+  _, size, err := iprot.ReadListBegin()
+  if err != nil {
+    return thrift.PrependError("error reading list begin: ", err)
+  }
+  tSlice := make([]*Block, 0, size)
+  p.Success =  tSlice
+  for i := 0; i < size; i ++ {
+    _elem71 := &Block{}
+    if err := _elem71.Read(iprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem71), err)
+    }
+    p.Success = append(p.Success, _elem71)
+  }
+  if err := iprot.ReadListEnd(); err != nil {
+    return thrift.PrependError("error reading list end: ", err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetBlocksByRangeResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.Err = &TThetaException{}
+  if err := p.Err.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetBlocksByRangeResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetBlocksByRange_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ThetaServiceGetBlocksByRangeResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.LIST, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Success)); err != nil {
+      return thrift.PrependError("error writing list begin: ", err)
+    }
+    for _, v := range p.Success {
+      if err := v.Write(oprot); err != nil {
+        return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+      }
+    }
+    if err := oprot.WriteListEnd(); err != nil {
+      return thrift.PrependError("error writing list end: ", err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *ThetaServiceGetBlocksByRangeResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetErr() {
+    if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err) }
+    if err := p.Err.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:err: ", p), err) }
+  }
+  return err
+}
+
+func (p *ThetaServiceGetBlocksByRangeResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ThetaServiceGetBlocksByRangeResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Hash
+type ThetaServiceGetTransactionArgs struct {
+  Hash string `thrift:"hash,1" db:"hash" json:"hash"`
+}
+
+func NewThetaServiceGetTransactionArgs() *ThetaServiceGetTransactionArgs {
+  return &ThetaServiceGetTransactionArgs{}
+}
+
+
+func (p *ThetaServiceGetTransactionArgs) GetHash() string {
+  return p.Hash
+}
+func (p *ThetaServiceGetTransactionArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetTransactionArgs)  ReadField1(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.Hash = v
+}
+  return nil
+}
+
+func (p *ThetaServiceGetTransactionArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetTransaction_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ThetaServiceGetTransactionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("hash", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:hash: ", p), err) }
+  if err := oprot.WriteString(string(p.Hash)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.hash (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:hash: ", p), err) }
+  return err
+}
+
+func (p *ThetaServiceGetTransactionArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ThetaServiceGetTransactionArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - Err
+type ThetaServiceGetTransactionResult struct {
+  Success *Transaction `thrift:"success,0" db:"success" json:"success,omitempty"`
+  Err *TThetaException `thrift:"err,1" db:"err" json:"err,omitempty"`
+}
+
+func NewThetaServiceGetTransactionResult() *ThetaServiceGetTransactionResult {
+  return &ThetaServiceGetTransactionResult{}
+}
+
+var ThetaServiceGetTransactionResult_Success_DEFAULT *Transaction
+func (p *ThetaServiceGetTransactionResult) GetSuccess() *Transaction {
+  if !p.IsSetSuccess() {
+    return ThetaServiceGetTransactionResult_Success_DEFAULT
+  }
+return p.Success
+}
+var ThetaServiceGetTransactionResult_Err_DEFAULT *TThetaException
+func (p *ThetaServiceGetTransactionResult) GetErr() *TThetaException {
+  if !p.IsSetErr() {
+    return ThetaServiceGetTransactionResult_Err_DEFAULT
+  }
+return p.Err
+}
+func (p *ThetaServiceGetTransactionResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *ThetaServiceGetTransactionResult) IsSetErr() bool {
+  return p.Err != nil
+}
+
+func (p *ThetaServiceGetTransactionResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField0(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetTransactionResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &Transaction{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetTransactionResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.Err = &TThetaException{}
+  if err := p.Err.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetTransactionResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetTransaction_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ThetaServiceGetTransactionResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *ThetaServiceGetTransactionResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetErr() {
+    if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err) }
+    if err := p.Err.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:err: ", p), err) }
+  }
+  return err
+}
+
+func (p *ThetaServiceGetTransactionResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ThetaServiceGetTransactionResult(%+v)", *p)
+}
+
+type ThetaServiceGetPendingTransactionsArgs struct {
+}
+
+func NewThetaServiceGetPendingTransactionsArgs() *ThetaServiceGetPendingTransactionsArgs {
+  return &ThetaServiceGetPendingTransactionsArgs{}
+}
+
+func (p *ThetaServiceGetPendingTransactionsArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    if err := iprot.Skip(fieldTypeId); err != nil {
+      return err
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetPendingTransactionsArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetPendingTransactions_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ThetaServiceGetPendingTransactionsArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ThetaServiceGetPendingTransactionsArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - Err
+type ThetaServiceGetPendingTransactionsResult struct {
+  Success *PendingTransaction `thrift:"success,0" db:"success" json:"success,omitempty"`
+  Err *TThetaException `thrift:"err,1" db:"err" json:"err,omitempty"`
+}
+
+func NewThetaServiceGetPendingTransactionsResult() *ThetaServiceGetPendingTransactionsResult {
+  return &ThetaServiceGetPendingTransactionsResult{}
+}
+
+var ThetaServiceGetPendingTransactionsResult_Success_DEFAULT *PendingTransaction
+func (p *ThetaServiceGetPendingTransactionsResult) GetSuccess() *PendingTransaction {
+  if !p.IsSetSuccess() {
+    return ThetaServiceGetPendingTransactionsResult_Success_DEFAULT
+  }
+return p.Success
+}
+var ThetaServiceGetPendingTransactionsResult_Err_DEFAULT *TThetaException
+func (p *ThetaServiceGetPendingTransactionsResult) GetErr() *TThetaException {
+  if !p.IsSetErr() {
+    return ThetaServiceGetPendingTransactionsResult_Err_DEFAULT
+  }
+return p.Err
+}
+func (p *ThetaServiceGetPendingTransactionsResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *ThetaServiceGetPendingTransactionsResult) IsSetErr() bool {
+  return p.Err != nil
+}
+
+func (p *ThetaServiceGetPendingTransactionsResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField0(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetPendingTransactionsResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &PendingTransaction{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetPendingTransactionsResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.Err = &TThetaException{}
+  if err := p.Err.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetPendingTransactionsResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetPendingTransactions_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ThetaServiceGetPendingTransactionsResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *ThetaServiceGetPendingTransactionsResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetErr() {
+    if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err) }
+    if err := p.Err.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:err: ", p), err) }
+  }
+  return err
+}
+
+func (p *ThetaServiceGetPendingTransactionsResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ThetaServiceGetPendingTransactionsResult(%+v)", *p)
+}
+
+type ThetaServiceGetStatusArgs struct {
+}
+
+func NewThetaServiceGetStatusArgs() *ThetaServiceGetStatusArgs {
+  return &ThetaServiceGetStatusArgs{}
+}
+
+func (p *ThetaServiceGetStatusArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    if err := iprot.Skip(fieldTypeId); err != nil {
+      return err
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetStatusArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetStatus_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ThetaServiceGetStatusArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ThetaServiceGetStatusArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - Err
+type ThetaServiceGetStatusResult struct {
+  Success *Status `thrift:"success,0" db:"success" json:"success,omitempty"`
+  Err *TThetaException `thrift:"err,1" db:"err" json:"err,omitempty"`
+}
+
+func NewThetaServiceGetStatusResult() *ThetaServiceGetStatusResult {
+  return &ThetaServiceGetStatusResult{}
+}
+
+var ThetaServiceGetStatusResult_Success_DEFAULT *Status
+func (p *ThetaServiceGetStatusResult) GetSuccess() *Status {
+  if !p.IsSetSuccess() {
+    return ThetaServiceGetStatusResult_Success_DEFAULT
+  }
+return p.Success
+}
+var ThetaServiceGetStatusResult_Err_DEFAULT *TThetaException
+func (p *ThetaServiceGetStatusResult) GetErr() *TThetaException {
+  if !p.IsSetErr() {
+    return ThetaServiceGetStatusResult_Err_DEFAULT
+  }
+return p.Err
+}
+func (p *ThetaServiceGetStatusResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *ThetaServiceGetStatusResult) IsSetErr() bool {
+  return p.Err != nil
+}
+
+func (p *ThetaServiceGetStatusResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField0(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetStatusResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &Status{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetStatusResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.Err = &TThetaException{}
+  if err := p.Err.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetStatusResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetStatus_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ThetaServiceGetStatusResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *ThetaServiceGetStatusResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetErr() {
+    if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err) }
+    if err := p.Err.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:err: ", p), err) }
+  }
+  return err
+}
+
+func (p *ThetaServiceGetStatusResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ThetaServiceGetStatusResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Height
+type ThetaServiceGetVcpByHeightArgs struct {
+  Height int64 `thrift:"height,1" db:"height" json:"height"`
+}
+
+func NewThetaServiceGetVcpByHeightArgs() *ThetaServiceGetVcpByHeightArgs {
+  return &ThetaServiceGetVcpByHeightArgs{}
+}
+
+
+func (p *ThetaServiceGetVcpByHeightArgs) GetHeight() int64 {
+  return p.Height
+}
+func (p *ThetaServiceGetVcpByHeightArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.I64 {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetVcpByHeightArgs)  ReadField1(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.Height = v
+}
+  return nil
+}
+
+func (p *ThetaServiceGetVcpByHeightArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetVcpByHeight_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ThetaServiceGetVcpByHeightArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("height", thrift.I64, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:height: ", p), err) }
+  if err := oprot.WriteI64(int64(p.Height)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.height (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:height: ", p), err) }
+  return err
+}
+
+func (p *ThetaServiceGetVcpByHeightArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ThetaServiceGetVcpByHeightArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - Err
+type ThetaServiceGetVcpByHeightResult struct {
+  Success []*BlockHashVcpPair `thrift:"success,0" db:"success" json:"success,omitempty"`
+  Err *TThetaException `thrift:"err,1" db:"err" json:"err,omitempty"`
+}
+
+func NewThetaServiceGetVcpByHeightResult() *ThetaServiceGetVcpByHeightResult {
+  return &ThetaServiceGetVcpByHeightResult{}
+}
+
+
+func (p *ThetaServiceGetVcpByHeightResult) GetSuccess() []*BlockHashVcpPair {
+  return p.Success
+}
+var ThetaServiceGetVcpByHeightResult_Err_DEFAULT *TThetaException
+func (p *ThetaServiceGetVcpByHeightResult) GetErr() *TThetaException {
+  if !p.IsSetErr() {
+    return ThetaServiceGetVcpByHeightResult_Err_DEFAULT
+  }
+return p.Err
+}
+func (p *ThetaServiceGetVcpByHeightResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *ThetaServiceGetVcpByHeightResult) IsSetErr() bool {
+  return p.Err != nil
+}
+
+func (p *ThetaServiceGetVcpByHeightResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if fieldTypeId == thrift.LIST {
+        if err := p.ReadField0(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetVcpByHeightResult)  ReadField0(iprot thrift.TProtocol) error {
+  _, size, err := iprot.ReadListBegin()
+  if err != nil {
+    return thrift.PrependError("error reading list begin: ", err)
+  }
+  tSlice := make([]*BlockHashVcpPair, 0, size)
+  p.Success =  tSlice
+  for i := 0; i < size; i ++ {
+    _elem72 := &BlockHashVcpPair{}
+    if err := _elem72.Read(iprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem72), err)
+    }
+    p.Success = append(p.Success, _elem72)
+  }
+  if err := iprot.ReadListEnd(); err != nil {
+    return thrift.PrependError("error reading list end: ", err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetVcpByHeightResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.Err = &TThetaException{}
+  if err := p.Err.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetVcpByHeightResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetVcpByHeight_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ThetaServiceGetVcpByHeightResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.LIST, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Success)); err != nil {
+      return thrift.PrependError("error writing list begin: ", err)
+    }
+    for _, v := range p.Success {
+      if err := v.Write(oprot); err != nil {
+        return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+      }
+    }
+    if err := oprot.WriteListEnd(); err != nil {
+      return thrift.PrependError("error writing list end: ", err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *ThetaServiceGetVcpByHeightResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetErr() {
+    if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err) }
+    if err := p.Err.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:err: ", p), err) }
+  }
+  return err
+}
+
+func (p *ThetaServiceGetVcpByHeightResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ThetaServiceGetVcpByHeightResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Height
+type ThetaServiceGetGcpByHeightArgs struct {
+  Height int64 `thrift:"height,1" db:"height" json:"height"`
+}
+
+func NewThetaServiceGetGcpByHeightArgs() *ThetaServiceGetGcpByHeightArgs {
+  return &ThetaServiceGetGcpByHeightArgs{}
+}
+
+
+func (p *ThetaServiceGetGcpByHeightArgs) GetHeight() int64 {
+  return p.Height
+}
+func (p *ThetaServiceGetGcpByHeightArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.I64 {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetGcpByHeightArgs)  ReadField1(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadI64(); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.Height = v
+}
+  return nil
+}
+
+func (p *ThetaServiceGetGcpByHeightArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetGcpByHeight_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ThetaServiceGetGcpByHeightArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("height", thrift.I64, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:height: ", p), err) }
+  if err := oprot.WriteI64(int64(p.Height)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.height (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:height: ", p), err) }
+  return err
+}
+
+func (p *ThetaServiceGetGcpByHeightArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ThetaServiceGetGcpByHeightArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - Err
+type ThetaServiceGetGcpByHeightResult struct {
+  Success []*BlockHashGcpPair `thrift:"success,0" db:"success" json:"success,omitempty"`
+  Err *TThetaException `thrift:"err,1" db:"err" json:"err,omitempty"`
+}
+
+func NewThetaServiceGetGcpByHeightResult() *ThetaServiceGetGcpByHeightResult {
+  return &ThetaServiceGetGcpByHeightResult{}
+}
+
+
+func (p *ThetaServiceGetGcpByHeightResult) GetSuccess() []*BlockHashGcpPair {
+  return p.Success
+}
+var ThetaServiceGetGcpByHeightResult_Err_DEFAULT *TThetaException
+func (p *ThetaServiceGetGcpByHeightResult) GetErr() *TThetaException {
+  if !p.IsSetErr() {
+    return ThetaServiceGetGcpByHeightResult_Err_DEFAULT
+  }
+return p.Err
+}
+func (p *ThetaServiceGetGcpByHeightResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *ThetaServiceGetGcpByHeightResult) IsSetErr() bool {
+  return p.Err != nil
+}
+
+func (p *ThetaServiceGetGcpByHeightResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if fieldTypeId == thrift.LIST {
+        if err := p.ReadField0(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetGcpByHeightResult)  ReadField0(iprot thrift.TProtocol) error {
+  _, size, err := iprot.ReadListBegin()
   if err != nil {
-    return
+    return thrift.PrependError("error reading list begin: ", err)
+  }
+  tSlice := make([]*BlockHashGcpPair, 0, size)
+  p.Success =  tSlice
+  for i := 0; i < size; i ++ {
+    _elem73 := &BlockHashGcpPair{}
+    if err := _elem73.Read(iprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", _elem73), err)
+    }
+    p.Success = append(p.Success, _elem73)
+  }
+  if err := iprot.ReadListEnd(); err != nil {
+    return thrift.PrependError("error reading list end: ", err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetGcpByHeightResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.Err = &TThetaException{}
+  if err := p.Err.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetGcpByHeightResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetGcpByHeight_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ThetaServiceGetGcpByHeightResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.LIST, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := oprot.WriteListBegin(thrift.STRUCT, len(p.Success)); err != nil {
+      return thrift.PrependError("error writing list begin: ", err)
+    }
+    for _, v := range p.Success {
+      if err := v.Write(oprot); err != nil {
+        return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", v), err)
+      }
+    }
+    if err := oprot.WriteListEnd(); err != nil {
+      return thrift.PrependError("error writing list end: ", err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *ThetaServiceGetGcpByHeightResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetErr() {
+    if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err) }
+    if err := p.Err.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:err: ", p), err) }
+  }
+  return err
+}
+
+func (p *ThetaServiceGetGcpByHeightResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ThetaServiceGetGcpByHeightResult(%+v)", *p)
+}
+
+type ThetaServiceGetGuardianInfoArgs struct {
+}
+
+func NewThetaServiceGetGuardianInfoArgs() *ThetaServiceGetGuardianInfoArgs {
+  return &ThetaServiceGetGuardianInfoArgs{}
+}
+
+func (p *ThetaServiceGetGuardianInfoArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    if err := iprot.Skip(fieldTypeId); err != nil {
+      return err
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetGuardianInfoArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetGuardianInfo_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ThetaServiceGetGuardianInfoArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ThetaServiceGetGuardianInfoArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - Err
+type ThetaServiceGetGuardianInfoResult struct {
+  Success *GuardianInfo `thrift:"success,0" db:"success" json:"success,omitempty"`
+  Err *TThetaException `thrift:"err,1" db:"err" json:"err,omitempty"`
+}
+
+func NewThetaServiceGetGuardianInfoResult() *ThetaServiceGetGuardianInfoResult {
+  return &ThetaServiceGetGuardianInfoResult{}
+}
+
+var ThetaServiceGetGuardianInfoResult_Success_DEFAULT *GuardianInfo
+func (p *ThetaServiceGetGuardianInfoResult) GetSuccess() *GuardianInfo {
+  if !p.IsSetSuccess() {
+    return ThetaServiceGetGuardianInfoResult_Success_DEFAULT
+  }
+return p.Success
+}
+var ThetaServiceGetGuardianInfoResult_Err_DEFAULT *TThetaException
+func (p *ThetaServiceGetGuardianInfoResult) GetErr() *TThetaException {
+  if !p.IsSetErr() {
+    return ThetaServiceGetGuardianInfoResult_Err_DEFAULT
+  }
+return p.Err
+}
+func (p *ThetaServiceGetGuardianInfoResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *ThetaServiceGetGuardianInfoResult) IsSetErr() bool {
+  return p.Err != nil
+}
+
+func (p *ThetaServiceGetGuardianInfoResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField0(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetGuardianInfoResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &GuardianInfo{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetGuardianInfoResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.Err = &TThetaException{}
+  if err := p.Err.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetGuardianInfoResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetGuardianInfo_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ThetaServiceGetGuardianInfoResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *ThetaServiceGetGuardianInfoResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetErr() {
+    if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err) }
+    if err := p.Err.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:err: ", p), err) }
+  }
+  return err
+}
+
+func (p *ThetaServiceGetGuardianInfoResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ThetaServiceGetGuardianInfoResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Contract
+type ThetaServiceCallSmartContractArgs struct {
+  Contract *SmartContract `thrift:"contract,1" db:"contract" json:"contract"`
+}
+
+func NewThetaServiceCallSmartContractArgs() *ThetaServiceCallSmartContractArgs {
+  return &ThetaServiceCallSmartContractArgs{}
+}
+
+var ThetaServiceCallSmartContractArgs_Contract_DEFAULT *SmartContract
+func (p *ThetaServiceCallSmartContractArgs) GetContract() *SmartContract {
+  if !p.IsSetContract() {
+    return ThetaServiceCallSmartContractArgs_Contract_DEFAULT
+  }
+return p.Contract
+}
+func (p *ThetaServiceCallSmartContractArgs) IsSetContract() bool {
+  return p.Contract != nil
+}
+
+func (p *ThetaServiceCallSmartContractArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceCallSmartContractArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.Contract = &SmartContract{}
+  if err := p.Contract.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Contract), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceCallSmartContractArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("CallSmartContract_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ThetaServiceCallSmartContractArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("contract", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:contract: ", p), err) }
+  if err := p.Contract.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Contract), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:contract: ", p), err) }
+  return err
+}
+
+func (p *ThetaServiceCallSmartContractArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ThetaServiceCallSmartContractArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - Err
+type ThetaServiceCallSmartContractResult struct {
+  Success *SmartContractCall `thrift:"success,0" db:"success" json:"success,omitempty"`
+  Err *TThetaException `thrift:"err,1" db:"err" json:"err,omitempty"`
+}
+
+func NewThetaServiceCallSmartContractResult() *ThetaServiceCallSmartContractResult {
+  return &ThetaServiceCallSmartContractResult{}
+}
+
+var ThetaServiceCallSmartContractResult_Success_DEFAULT *SmartContractCall
+func (p *ThetaServiceCallSmartContractResult) GetSuccess() *SmartContractCall {
+  if !p.IsSetSuccess() {
+    return ThetaServiceCallSmartContractResult_Success_DEFAULT
+  }
+return p.Success
+}
+var ThetaServiceCallSmartContractResult_Err_DEFAULT *TThetaException
+func (p *ThetaServiceCallSmartContractResult) GetErr() *TThetaException {
+  if !p.IsSetErr() {
+    return ThetaServiceCallSmartContractResult_Err_DEFAULT
+  }
+return p.Err
+}
+func (p *ThetaServiceCallSmartContractResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *ThetaServiceCallSmartContractResult) IsSetErr() bool {
+  return p.Err != nil
+}
+
+func (p *ThetaServiceCallSmartContractResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField0(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceCallSmartContractResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &SmartContractCall{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceCallSmartContractResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.Err = &TThetaException{}
+  if err := p.Err.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceCallSmartContractResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("CallSmartContract_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ThetaServiceCallSmartContractResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *ThetaServiceCallSmartContractResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetErr() {
+    if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err) }
+    if err := p.Err.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:err: ", p), err) }
+  }
+  return err
+}
+
+func (p *ThetaServiceCallSmartContractResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ThetaServiceCallSmartContractResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Deposit
+type ThetaServiceDepositStakeArgs struct {
+  Deposit *DepositStake `thrift:"deposit,1" db:"deposit" json:"deposit"`
+}
+
+func NewThetaServiceDepositStakeArgs() *ThetaServiceDepositStakeArgs {
+  return &ThetaServiceDepositStakeArgs{}
+}
+
+var ThetaServiceDepositStakeArgs_Deposit_DEFAULT *DepositStake
+func (p *ThetaServiceDepositStakeArgs) GetDeposit() *DepositStake {
+  if !p.IsSetDeposit() {
+    return ThetaServiceDepositStakeArgs_Deposit_DEFAULT
+  }
+return p.Deposit
+}
+func (p *ThetaServiceDepositStakeArgs) IsSetDeposit() bool {
+  return p.Deposit != nil
+}
+
+func (p *ThetaServiceDepositStakeArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceDepositStakeArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.Deposit = &DepositStake{}
+  if err := p.Deposit.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Deposit), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceDepositStakeArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("DepositStake_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ThetaServiceDepositStakeArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("deposit", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:deposit: ", p), err) }
+  if err := p.Deposit.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Deposit), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:deposit: ", p), err) }
+  return err
+}
+
+func (p *ThetaServiceDepositStakeArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ThetaServiceDepositStakeArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - Err
+type ThetaServiceDepositStakeResult struct {
+  Success *BroadcastRawTransactionAsync `thrift:"success,0" db:"success" json:"success,omitempty"`
+  Err *TThetaException `thrift:"err,1" db:"err" json:"err,omitempty"`
+}
+
+func NewThetaServiceDepositStakeResult() *ThetaServiceDepositStakeResult {
+  return &ThetaServiceDepositStakeResult{}
+}
+
+var ThetaServiceDepositStakeResult_Success_DEFAULT *BroadcastRawTransactionAsync
+func (p *ThetaServiceDepositStakeResult) GetSuccess() *BroadcastRawTransactionAsync {
+  if !p.IsSetSuccess() {
+    return ThetaServiceDepositStakeResult_Success_DEFAULT
+  }
+return p.Success
+}
+var ThetaServiceDepositStakeResult_Err_DEFAULT *TThetaException
+func (p *ThetaServiceDepositStakeResult) GetErr() *TThetaException {
+  if !p.IsSetErr() {
+    return ThetaServiceDepositStakeResult_Err_DEFAULT
+  }
+return p.Err
+}
+func (p *ThetaServiceDepositStakeResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *ThetaServiceDepositStakeResult) IsSetErr() bool {
+  return p.Err != nil
+}
+
+func (p *ThetaServiceDepositStakeResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField0(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceDepositStakeResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &BroadcastRawTransactionAsync{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceDepositStakeResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.Err = &TThetaException{}
+  if err := p.Err.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceDepositStakeResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("DepositStake_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ThetaServiceDepositStakeResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *ThetaServiceDepositStakeResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetErr() {
+    if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err) }
+    if err := p.Err.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:err: ", p), err) }
+  }
+  return err
+}
+
+func (p *ThetaServiceDepositStakeResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ThetaServiceDepositStakeResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Withdraw
+type ThetaServiceWithdrawStakeArgs struct {
+  Withdraw *WithdrawStake `thrift:"withdraw,1" db:"withdraw" json:"withdraw"`
+}
+
+func NewThetaServiceWithdrawStakeArgs() *ThetaServiceWithdrawStakeArgs {
+  return &ThetaServiceWithdrawStakeArgs{}
+}
+
+var ThetaServiceWithdrawStakeArgs_Withdraw_DEFAULT *WithdrawStake
+func (p *ThetaServiceWithdrawStakeArgs) GetWithdraw() *WithdrawStake {
+  if !p.IsSetWithdraw() {
+    return ThetaServiceWithdrawStakeArgs_Withdraw_DEFAULT
+  }
+return p.Withdraw
+}
+func (p *ThetaServiceWithdrawStakeArgs) IsSetWithdraw() bool {
+  return p.Withdraw != nil
+}
+
+func (p *ThetaServiceWithdrawStakeArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceWithdrawStakeArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.Withdraw = &WithdrawStake{}
+  if err := p.Withdraw.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Withdraw), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceWithdrawStakeArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("WithdrawStake_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ThetaServiceWithdrawStakeArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("withdraw", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:withdraw: ", p), err) }
+  if err := p.Withdraw.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Withdraw), err)
+  }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:withdraw: ", p), err) }
+  return err
+}
+
+func (p *ThetaServiceWithdrawStakeArgs) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ThetaServiceWithdrawStakeArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - Err
+type ThetaServiceWithdrawStakeResult struct {
+  Success *BroadcastRawTransactionAsync `thrift:"success,0" db:"success" json:"success,omitempty"`
+  Err *TThetaException `thrift:"err,1" db:"err" json:"err,omitempty"`
+}
+
+func NewThetaServiceWithdrawStakeResult() *ThetaServiceWithdrawStakeResult {
+  return &ThetaServiceWithdrawStakeResult{}
+}
+
+var ThetaServiceWithdrawStakeResult_Success_DEFAULT *BroadcastRawTransactionAsync
+func (p *ThetaServiceWithdrawStakeResult) GetSuccess() *BroadcastRawTransactionAsync {
+  if !p.IsSetSuccess() {
+    return ThetaServiceWithdrawStakeResult_Success_DEFAULT
+  }
+return p.Success
+}
+var ThetaServiceWithdrawStakeResult_Err_DEFAULT *TThetaException
+func (p *ThetaServiceWithdrawStakeResult) GetErr() *TThetaException {
+  if !p.IsSetErr() {
+    return ThetaServiceWithdrawStakeResult_Err_DEFAULT
+  }
+return p.Err
+}
+func (p *ThetaServiceWithdrawStakeResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *ThetaServiceWithdrawStakeResult) IsSetErr() bool {
+  return p.Err != nil
+}
+
+func (p *ThetaServiceWithdrawStakeResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
-  return true, err
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField0(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
+  }
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
 }
 
-type thetaServiceProcessorSendTx struct {
-  handler ThetaService
+func (p *ThetaServiceWithdrawStakeResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &BroadcastRawTransactionAsync{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
 }
 
-func (p *thetaServiceProcessorSendTx) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := ThetaServiceSendTxArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("sendTx", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush(ctx)
-    return false, err
+func (p *ThetaServiceWithdrawStakeResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.Err = &TThetaException{}
+  if err := p.Err.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
   }
+  return nil
+}
 
-  iprot.ReadMessageEnd()
-  result := ThetaServiceSendTxResult{}
-var retval *BroadcastRawTransactionAsync
-  var err2 error
-  if retval, err2 = p.handler.SendTx(ctx, args.Send); err2 != nil {
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing sendTx: " + err2.Error())
-    oprot.WriteMessageBegin("sendTx", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush(ctx)
-    return true, err2
-  } else {
-    result.Success = retval
+func (p *ThetaServiceWithdrawStakeResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("WithdrawStake_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
+  }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
 }
-  if err2 = oprot.WriteMessageBegin("sendTx", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+
+func (p *ThetaServiceWithdrawStakeResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  return err
+}
+
+func (p *ThetaServiceWithdrawStakeResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetErr() {
+    if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err) }
+    if err := p.Err.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:err: ", p), err) }
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  return err
+}
+
+func (p *ThetaServiceWithdrawStakeResult) String() string {
+  if p == nil {
+    return "<nil>"
   }
-  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-    err = err2
+  return fmt.Sprintf("ThetaServiceWithdrawStakeResult(%+v)", *p)
+}
+
+// Attributes:
+//  - TxBytes
+type ThetaServiceBroadcastRawTransactionArgs struct {
+  TxBytes string `thrift:"tx_bytes,1" db:"tx_bytes" json:"tx_bytes"`
+}
+
+func NewThetaServiceBroadcastRawTransactionArgs() *ThetaServiceBroadcastRawTransactionArgs {
+  return &ThetaServiceBroadcastRawTransactionArgs{}
+}
+
+
+func (p *ThetaServiceBroadcastRawTransactionArgs) GetTxBytes() string {
+  return p.TxBytes
+}
+func (p *ThetaServiceBroadcastRawTransactionArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
-  if err != nil {
-    return
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
   }
-  return true, err
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
 }
 
-type thetaServiceProcessorGetTokenBalance struct {
-  handler ThetaService
+func (p *ThetaServiceBroadcastRawTransactionArgs)  ReadField1(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.TxBytes = v
+}
+  return nil
 }
 
-func (p *thetaServiceProcessorGetTokenBalance) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := ThetaServiceGetTokenBalanceArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("getTokenBalance", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush(ctx)
-    return false, err
+func (p *ThetaServiceBroadcastRawTransactionArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("BroadcastRawTransaction_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
   }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
 
-  iprot.ReadMessageEnd()
-  result := ThetaServiceGetTokenBalanceResult{}
-var retval int64
-  var err2 error
-  if retval, err2 = p.handler.GetTokenBalance(ctx, args.Address, args.ContractAddress, args.PrivateKey); err2 != nil {
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing getTokenBalance: " + err2.Error())
-    oprot.WriteMessageBegin("getTokenBalance", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush(ctx)
-    return true, err2
-  } else {
-    result.Success = &retval
+func (p *ThetaServiceBroadcastRawTransactionArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("tx_bytes", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:tx_bytes: ", p), err) }
+  if err := oprot.WriteString(string(p.TxBytes)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.tx_bytes (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:tx_bytes: ", p), err) }
+  return err
 }
-  if err2 = oprot.WriteMessageBegin("getTokenBalance", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+
+func (p *ThetaServiceBroadcastRawTransactionArgs) String() string {
+  if p == nil {
+    return "<nil>"
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  return fmt.Sprintf("ThetaServiceBroadcastRawTransactionArgs(%+v)", *p)
+}
+
+// Attributes:
+//  - Success
+//  - Err
+type ThetaServiceBroadcastRawTransactionResult struct {
+  Success *BroadcastRawTransactionAsync `thrift:"success,0" db:"success" json:"success,omitempty"`
+  Err *TThetaException `thrift:"err,1" db:"err" json:"err,omitempty"`
+}
+
+func NewThetaServiceBroadcastRawTransactionResult() *ThetaServiceBroadcastRawTransactionResult {
+  return &ThetaServiceBroadcastRawTransactionResult{}
+}
+
+var ThetaServiceBroadcastRawTransactionResult_Success_DEFAULT *BroadcastRawTransactionAsync
+func (p *ThetaServiceBroadcastRawTransactionResult) GetSuccess() *BroadcastRawTransactionAsync {
+  if !p.IsSetSuccess() {
+    return ThetaServiceBroadcastRawTransactionResult_Success_DEFAULT
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+return p.Success
+}
+var ThetaServiceBroadcastRawTransactionResult_Err_DEFAULT *TThetaException
+func (p *ThetaServiceBroadcastRawTransactionResult) GetErr() *TThetaException {
+  if !p.IsSetErr() {
+    return ThetaServiceBroadcastRawTransactionResult_Err_DEFAULT
   }
-  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-    err = err2
+return p.Err
+}
+func (p *ThetaServiceBroadcastRawTransactionResult) IsSetSuccess() bool {
+  return p.Success != nil
+}
+
+func (p *ThetaServiceBroadcastRawTransactionResult) IsSetErr() bool {
+  return p.Err != nil
+}
+
+func (p *ThetaServiceBroadcastRawTransactionResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
+  }
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField0(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
   }
-  if err != nil {
-    return
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
   }
-  return true, err
+  return nil
 }
 
-type thetaServiceProcessorSendToken struct {
-  handler ThetaService
+func (p *ThetaServiceBroadcastRawTransactionResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &BroadcastRawTransactionAsync{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
 }
 
-func (p *thetaServiceProcessorSendToken) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := ThetaServiceSendTokenArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("sendToken", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush(ctx)
-    return false, err
+func (p *ThetaServiceBroadcastRawTransactionResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.Err = &TThetaException{}
+  if err := p.Err.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
   }
-
-  iprot.ReadMessageEnd()
-  result := ThetaServiceSendTokenResult{}
-var retval *BroadcastRawTransactionAsync
-  var err2 error
-  if retval, err2 = p.handler.SendToken(ctx, args.Send); err2 != nil {
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing sendToken: " + err2.Error())
-    oprot.WriteMessageBegin("sendToken", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush(ctx)
-    return true, err2
-  } else {
-    result.Success = retval
+  return nil
 }
-  if err2 = oprot.WriteMessageBegin("sendToken", thrift.REPLY, seqId); err2 != nil {
-    err = err2
-  }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+
+func (p *ThetaServiceBroadcastRawTransactionResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("BroadcastRawTransaction_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
+
+func (p *ThetaServiceBroadcastRawTransactionResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
   }
-  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-    err = err2
+  return err
+}
+
+func (p *ThetaServiceBroadcastRawTransactionResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetErr() {
+    if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err) }
+    if err := p.Err.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:err: ", p), err) }
   }
-  if err != nil {
-    return
+  return err
+}
+
+func (p *ThetaServiceBroadcastRawTransactionResult) String() string {
+  if p == nil {
+    return "<nil>"
   }
-  return true, err
+  return fmt.Sprintf("ThetaServiceBroadcastRawTransactionResult(%+v)", *p)
 }
 
-type thetaServiceProcessorGetBlock struct {
-  handler ThetaService
+// Attributes:
+//  - Address
+//  - Password
+type ThetaServiceUnlockKeyArgs struct {
+  Address string `thrift:"address,1" db:"address" json:"address"`
+  Password string `thrift:"password,2" db:"password" json:"password"`
 }
 
-func (p *thetaServiceProcessorGetBlock) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := ThetaServiceGetBlockArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("GetBlock", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush(ctx)
-    return false, err
-  }
+func NewThetaServiceUnlockKeyArgs() *ThetaServiceUnlockKeyArgs {
+  return &ThetaServiceUnlockKeyArgs{}
+}
 
-  iprot.ReadMessageEnd()
-  result := ThetaServiceGetBlockResult{}
-var retval *Block
-  var err2 error
-  if retval, err2 = p.handler.GetBlock(ctx, args.Hash); err2 != nil {
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetBlock: " + err2.Error())
-    oprot.WriteMessageBegin("GetBlock", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush(ctx)
-    return true, err2
-  } else {
-    result.Success = retval
+
+func (p *ThetaServiceUnlockKeyArgs) GetAddress() string {
+  return p.Address
 }
-  if err2 = oprot.WriteMessageBegin("GetBlock", thrift.REPLY, seqId); err2 != nil {
-    err = err2
-  }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
-  }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+
+func (p *ThetaServiceUnlockKeyArgs) GetPassword() string {
+  return p.Password
+}
+func (p *ThetaServiceUnlockKeyArgs) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
-  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-    err = err2
+
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 1:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 2:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField2(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
   }
-  if err != nil {
-    return
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
   }
-  return true, err
+  return nil
 }
 
-type thetaServiceProcessorGetBlockByHeight struct {
-  handler ThetaService
+func (p *ThetaServiceUnlockKeyArgs)  ReadField1(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.Address = v
+}
+  return nil
 }
 
-func (p *thetaServiceProcessorGetBlockByHeight) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := ThetaServiceGetBlockByHeightArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("GetBlockByHeight", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush(ctx)
-    return false, err
+func (p *ThetaServiceUnlockKeyArgs)  ReadField2(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.Password = v
+}
+  return nil
+}
+
+func (p *ThetaServiceUnlockKeyArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("UnlockKey_args"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
   }
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
+}
 
-  iprot.ReadMessageEnd()
-  result := ThetaServiceGetBlockByHeightResult{}
-var retval *Block
-  var err2 error
-  if retval, err2 = p.handler.GetBlockByHeight(ctx, args.Height); err2 != nil {
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetBlockByHeight: " + err2.Error())
-    oprot.WriteMessageBegin("GetBlockByHeight", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush(ctx)
-    return true, err2
-  } else {
-    result.Success = retval
+func (p *ThetaServiceUnlockKeyArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("address", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:address: ", p), err) }
+  if err := oprot.WriteString(string(p.Address)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.address (1) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:address: ", p), err) }
+  return err
 }
-  if err2 = oprot.WriteMessageBegin("GetBlockByHeight", thrift.REPLY, seqId); err2 != nil {
-    err = err2
-  }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
-  }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
-  }
-  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-    err = err2
-  }
-  if err != nil {
-    return
+
+func (p *ThetaServiceUnlockKeyArgs) writeField2(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("password", thrift.STRING, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:password: ", p), err) }
+  if err := oprot.WriteString(string(p.Password)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.password (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:password: ", p), err) }
+  return err
+}
+
+func (p *ThetaServiceUnlockKeyArgs) String() string {
+  if p == nil {
+    return "<nil>"
   }
-  return true, err
+  return fmt.Sprintf("ThetaServiceUnlockKeyArgs(%+v)", *p)
 }
 
-type thetaServiceProcessorGetBlockHeader struct {
-  handler ThetaService
+// Attributes:
+//  - Success
+//  - Err
+type ThetaServiceUnlockKeyResult struct {
+  Success *StatusKey `thrift:"success,0" db:"success" json:"success,omitempty"`
+  Err *TThetaException `thrift:"err,1" db:"err" json:"err,omitempty"`
 }
 
-func (p *thetaServiceProcessorGetBlockHeader) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := ThetaServiceGetBlockHeaderArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("GetBlockHeader", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush(ctx)
-    return false, err
-  }
-
-  iprot.ReadMessageEnd()
-  result := ThetaServiceGetBlockHeaderResult{}
-var retval *BlockHeader
-  var err2 error
-  if retval, err2 = p.handler.GetBlockHeader(ctx, args.Hash); err2 != nil {
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetBlockHeader: " + err2.Error())
-    oprot.WriteMessageBegin("GetBlockHeader", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush(ctx)
-    return true, err2
-  } else {
-    result.Success = retval
+func NewThetaServiceUnlockKeyResult() *ThetaServiceUnlockKeyResult {
+  return &ThetaServiceUnlockKeyResult{}
 }
-  if err2 = oprot.WriteMessageBegin("GetBlockHeader", thrift.REPLY, seqId); err2 != nil {
-    err = err2
-  }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
-  }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
-  }
-  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-    err = err2
+
+var ThetaServiceUnlockKeyResult_Success_DEFAULT *StatusKey
+func (p *ThetaServiceUnlockKeyResult) GetSuccess() *StatusKey {
+  if !p.IsSetSuccess() {
+    return ThetaServiceUnlockKeyResult_Success_DEFAULT
   }
-  if err != nil {
-    return
+return p.Success
+}
+var ThetaServiceUnlockKeyResult_Err_DEFAULT *TThetaException
+func (p *ThetaServiceUnlockKeyResult) GetErr() *TThetaException {
+  if !p.IsSetErr() {
+    return ThetaServiceUnlockKeyResult_Err_DEFAULT
   }
-  return true, err
+return p.Err
+}
+func (p *ThetaServiceUnlockKeyResult) IsSetSuccess() bool {
+  return p.Success != nil
 }
 
-type thetaServiceProcessorGetBlockHeaderByHeight struct {
-  handler ThetaService
+func (p *ThetaServiceUnlockKeyResult) IsSetErr() bool {
+  return p.Err != nil
 }
 
-func (p *thetaServiceProcessorGetBlockHeaderByHeight) Process(ctx context.Context, seqId int32, iprot, oprot thrift.TProtocol) (success bool, err thrift.TException) {
-  args := ThetaServiceGetBlockHeaderByHeightArgs{}
-  if err = args.Read(iprot); err != nil {
-    iprot.ReadMessageEnd()
-    x := thrift.NewTApplicationException(thrift.PROTOCOL_ERROR, err.Error())
-    oprot.WriteMessageBegin("GetBlockHeaderByHeight", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush(ctx)
-    return false, err
+func (p *ThetaServiceUnlockKeyResult) Read(iprot thrift.TProtocol) error {
+  if _, err := iprot.ReadStructBegin(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
 
-  iprot.ReadMessageEnd()
-  result := ThetaServiceGetBlockHeaderByHeightResult{}
-var retval *BlockHeader
-  var err2 error
-  if retval, err2 = p.handler.GetBlockHeaderByHeight(ctx, args.Height); err2 != nil {
-    x := thrift.NewTApplicationException(thrift.INTERNAL_ERROR, "Internal error processing GetBlockHeaderByHeight: " + err2.Error())
-    oprot.WriteMessageBegin("GetBlockHeaderByHeight", thrift.EXCEPTION, seqId)
-    x.Write(oprot)
-    oprot.WriteMessageEnd()
-    oprot.Flush(ctx)
-    return true, err2
-  } else {
-    result.Success = retval
-}
-  if err2 = oprot.WriteMessageBegin("GetBlockHeaderByHeight", thrift.REPLY, seqId); err2 != nil {
-    err = err2
+
+  for {
+    _, fieldTypeId, fieldId, err := iprot.ReadFieldBegin()
+    if err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
+    }
+    if fieldTypeId == thrift.STOP { break; }
+    switch fieldId {
+    case 0:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField0(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    default:
+      if err := iprot.Skip(fieldTypeId); err != nil {
+        return err
+      }
+    }
+    if err := iprot.ReadFieldEnd(); err != nil {
+      return err
+    }
   }
-  if err2 = result.Write(oprot); err == nil && err2 != nil {
-    err = err2
+  if err := iprot.ReadStructEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
   }
-  if err2 = oprot.WriteMessageEnd(); err == nil && err2 != nil {
-    err = err2
+  return nil
+}
+
+func (p *ThetaServiceUnlockKeyResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &StatusKey{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
   }
-  if err2 = oprot.Flush(ctx); err == nil && err2 != nil {
-    err = err2
+  return nil
+}
+
+func (p *ThetaServiceUnlockKeyResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.Err = &TThetaException{}
+  if err := p.Err.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
   }
-  if err != nil {
-    return
+  return nil
+}
+
+func (p *ThetaServiceUnlockKeyResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("UnlockKey_result"); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
+  if p != nil {
+    if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
   }
-  return true, err
+  if err := oprot.WriteFieldStop(); err != nil {
+    return thrift.PrependError("write field stop error: ", err) }
+  if err := oprot.WriteStructEnd(); err != nil {
+    return thrift.PrependError("write struct stop error: ", err) }
+  return nil
 }
 
+func (p *ThetaServiceUnlockKeyResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
 
-// HELPER FUNCTIONS AND STRUCTURES
+func (p *ThetaServiceUnlockKeyResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetErr() {
+    if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err) }
+    if err := p.Err.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:err: ", p), err) }
+  }
+  return err
+}
+
+func (p *ThetaServiceUnlockKeyResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ThetaServiceUnlockKeyResult(%+v)", *p)
+}
 
 // Attributes:
-//  - Account
-type ThetaServiceGetAccountArgs struct {
-  Account string `thrift:"account,1" db:"account" json:"account"`
+//  - Address
+type ThetaServiceLockKeyArgs struct {
+  Address string `thrift:"address,1" db:"address" json:"address"`
 }
 
-func NewThetaServiceGetAccountArgs() *ThetaServiceGetAccountArgs {
-  return &ThetaServiceGetAccountArgs{}
+func NewThetaServiceLockKeyArgs() *ThetaServiceLockKeyArgs {
+  return &ThetaServiceLockKeyArgs{}
 }
 
 
-func (p *ThetaServiceGetAccountArgs) GetAccount() string {
-  return p.Account
+func (p *ThetaServiceLockKeyArgs) GetAddress() string {
+  return p.Address
 }
-func (p *ThetaServiceGetAccountArgs) Read(iprot thrift.TProtocol) error {
+func (p *ThetaServiceLockKeyArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -7131,17 +17420,17 @@ func (p *ThetaServiceGetAccountArgs) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *ThetaServiceGetAccountArgs)  ReadField1(iprot thrift.TProtocol) error {
+func (p *ThetaServiceLockKeyArgs)  ReadField1(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 1: ", err)
 } else {
-  p.Account = v
+  p.Address = v
 }
   return nil
 }
 
-func (p *ThetaServiceGetAccountArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("getAccount_args"); err != nil {
+func (p *ThetaServiceLockKeyArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("LockKey_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -7153,45 +17442,58 @@ func (p *ThetaServiceGetAccountArgs) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *ThetaServiceGetAccountArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("account", thrift.STRING, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:account: ", p), err) }
-  if err := oprot.WriteString(string(p.Account)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.account (1) field write error: ", p), err) }
+func (p *ThetaServiceLockKeyArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("address", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:address: ", p), err) }
+  if err := oprot.WriteString(string(p.Address)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.address (1) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:account: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:address: ", p), err) }
   return err
 }
 
-func (p *ThetaServiceGetAccountArgs) String() string {
+func (p *ThetaServiceLockKeyArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("ThetaServiceGetAccountArgs(%+v)", *p)
+  return fmt.Sprintf("ThetaServiceLockKeyArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Success
-type ThetaServiceGetAccountResult struct {
-  Success *Account `thrift:"success,0" db:"success" json:"success,omitempty"`
+//  - Err
+type ThetaServiceLockKeyResult struct {
+  Success *StatusKey `thrift:"success,0" db:"success" json:"success,omitempty"`
+  Err *TThetaException `thrift:"err,1" db:"err" json:"err,omitempty"`
 }
 
-func NewThetaServiceGetAccountResult() *ThetaServiceGetAccountResult {
-  return &ThetaServiceGetAccountResult{}
+func NewThetaServiceLockKeyResult() *ThetaServiceLockKeyResult {
+  return &ThetaServiceLockKeyResult{}
 }
 
-var ThetaServiceGetAccountResult_Success_DEFAULT *Account
-func (p *ThetaServiceGetAccountResult) GetSuccess() *Account {
+var ThetaServiceLockKeyResult_Success_DEFAULT *StatusKey
+func (p *ThetaServiceLockKeyResult) GetSuccess() *StatusKey {
   if !p.IsSetSuccess() {
-    return ThetaServiceGetAccountResult_Success_DEFAULT
+    return ThetaServiceLockKeyResult_Success_DEFAULT
   }
 return p.Success
 }
-func (p *ThetaServiceGetAccountResult) IsSetSuccess() bool {
+var ThetaServiceLockKeyResult_Err_DEFAULT *TThetaException
+func (p *ThetaServiceLockKeyResult) GetErr() *TThetaException {
+  if !p.IsSetErr() {
+    return ThetaServiceLockKeyResult_Err_DEFAULT
+  }
+return p.Err
+}
+func (p *ThetaServiceLockKeyResult) IsSetSuccess() bool {
   return p.Success != nil
 }
 
-func (p *ThetaServiceGetAccountResult) Read(iprot thrift.TProtocol) error {
+func (p *ThetaServiceLockKeyResult) IsSetErr() bool {
+  return p.Err != nil
+}
+
+func (p *ThetaServiceLockKeyResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -7214,6 +17516,16 @@ func (p *ThetaServiceGetAccountResult) Read(iprot thrift.TProtocol) error {
           return err
         }
       }
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -7229,19 +17541,28 @@ func (p *ThetaServiceGetAccountResult) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *ThetaServiceGetAccountResult)  ReadField0(iprot thrift.TProtocol) error {
-  p.Success = &Account{}
+func (p *ThetaServiceLockKeyResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &StatusKey{}
   if err := p.Success.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
   }
   return nil
 }
 
-func (p *ThetaServiceGetAccountResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("getAccount_result"); err != nil {
+func (p *ThetaServiceLockKeyResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.Err = &TThetaException{}
+  if err := p.Err.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceLockKeyResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("LockKey_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -7250,7 +17571,7 @@ func (p *ThetaServiceGetAccountResult) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *ThetaServiceGetAccountResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ThetaServiceLockKeyResult) writeField0(oprot thrift.TProtocol) (err error) {
   if p.IsSetSuccess() {
     if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
@@ -7263,35 +17584,41 @@ func (p *ThetaServiceGetAccountResult) writeField0(oprot thrift.TProtocol) (err
   return err
 }
 
-func (p *ThetaServiceGetAccountResult) String() string {
-  if p == nil {
-    return "<nil>"
+func (p *ThetaServiceLockKeyResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetErr() {
+    if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err) }
+    if err := p.Err.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:err: ", p), err) }
   }
-  return fmt.Sprintf("ThetaServiceGetAccountResult(%+v)", *p)
-}
-
-// Attributes:
-//  - Send
-type ThetaServiceSendTxArgs struct {
-  Send *Send `thrift:"send,1" db:"send" json:"send"`
-}
-
-func NewThetaServiceSendTxArgs() *ThetaServiceSendTxArgs {
-  return &ThetaServiceSendTxArgs{}
+  return err
 }
 
-var ThetaServiceSendTxArgs_Send_DEFAULT *Send
-func (p *ThetaServiceSendTxArgs) GetSend() *Send {
-  if !p.IsSetSend() {
-    return ThetaServiceSendTxArgs_Send_DEFAULT
+func (p *ThetaServiceLockKeyResult) String() string {
+  if p == nil {
+    return "<nil>"
   }
-return p.Send
+  return fmt.Sprintf("ThetaServiceLockKeyResult(%+v)", *p)
+}
+
+// Attributes:
+//  - Address
+type ThetaServiceIsKeyUnlockedArgs struct {
+  Address string `thrift:"address,1" db:"address" json:"address"`
 }
-func (p *ThetaServiceSendTxArgs) IsSetSend() bool {
-  return p.Send != nil
+
+func NewThetaServiceIsKeyUnlockedArgs() *ThetaServiceIsKeyUnlockedArgs {
+  return &ThetaServiceIsKeyUnlockedArgs{}
 }
 
-func (p *ThetaServiceSendTxArgs) Read(iprot thrift.TProtocol) error {
+
+func (p *ThetaServiceIsKeyUnlockedArgs) GetAddress() string {
+  return p.Address
+}
+func (p *ThetaServiceIsKeyUnlockedArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -7305,7 +17632,7 @@ func (p *ThetaServiceSendTxArgs) Read(iprot thrift.TProtocol) error {
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
     case 1:
-      if fieldTypeId == thrift.STRUCT {
+      if fieldTypeId == thrift.STRING {
         if err := p.ReadField1(iprot); err != nil {
           return err
         }
@@ -7329,16 +17656,17 @@ func (p *ThetaServiceSendTxArgs) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *ThetaServiceSendTxArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.Send = &Send{}
-  if err := p.Send.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Send), err)
-  }
+func (p *ThetaServiceIsKeyUnlockedArgs)  ReadField1(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.Address = v
+}
   return nil
 }
 
-func (p *ThetaServiceSendTxArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("sendTx_args"); err != nil {
+func (p *ThetaServiceIsKeyUnlockedArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("IsKeyUnlocked_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -7350,46 +17678,58 @@ func (p *ThetaServiceSendTxArgs) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *ThetaServiceSendTxArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("send", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:send: ", p), err) }
-  if err := p.Send.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Send), err)
-  }
+func (p *ThetaServiceIsKeyUnlockedArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("address", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:address: ", p), err) }
+  if err := oprot.WriteString(string(p.Address)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.address (1) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:send: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:address: ", p), err) }
   return err
 }
 
-func (p *ThetaServiceSendTxArgs) String() string {
+func (p *ThetaServiceIsKeyUnlockedArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("ThetaServiceSendTxArgs(%+v)", *p)
+  return fmt.Sprintf("ThetaServiceIsKeyUnlockedArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Success
-type ThetaServiceSendTxResult struct {
-  Success *BroadcastRawTransactionAsync `thrift:"success,0" db:"success" json:"success,omitempty"`
+//  - Err
+type ThetaServiceIsKeyUnlockedResult struct {
+  Success *StatusKey `thrift:"success,0" db:"success" json:"success,omitempty"`
+  Err *TThetaException `thrift:"err,1" db:"err" json:"err,omitempty"`
 }
 
-func NewThetaServiceSendTxResult() *ThetaServiceSendTxResult {
-  return &ThetaServiceSendTxResult{}
+func NewThetaServiceIsKeyUnlockedResult() *ThetaServiceIsKeyUnlockedResult {
+  return &ThetaServiceIsKeyUnlockedResult{}
 }
 
-var ThetaServiceSendTxResult_Success_DEFAULT *BroadcastRawTransactionAsync
-func (p *ThetaServiceSendTxResult) GetSuccess() *BroadcastRawTransactionAsync {
+var ThetaServiceIsKeyUnlockedResult_Success_DEFAULT *StatusKey
+func (p *ThetaServiceIsKeyUnlockedResult) GetSuccess() *StatusKey {
   if !p.IsSetSuccess() {
-    return ThetaServiceSendTxResult_Success_DEFAULT
+    return ThetaServiceIsKeyUnlockedResult_Success_DEFAULT
   }
 return p.Success
 }
-func (p *ThetaServiceSendTxResult) IsSetSuccess() bool {
+var ThetaServiceIsKeyUnlockedResult_Err_DEFAULT *TThetaException
+func (p *ThetaServiceIsKeyUnlockedResult) GetErr() *TThetaException {
+  if !p.IsSetErr() {
+    return ThetaServiceIsKeyUnlockedResult_Err_DEFAULT
+  }
+return p.Err
+}
+func (p *ThetaServiceIsKeyUnlockedResult) IsSetSuccess() bool {
   return p.Success != nil
 }
 
-func (p *ThetaServiceSendTxResult) Read(iprot thrift.TProtocol) error {
+func (p *ThetaServiceIsKeyUnlockedResult) IsSetErr() bool {
+  return p.Err != nil
+}
+
+func (p *ThetaServiceIsKeyUnlockedResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -7412,6 +17752,16 @@ func (p *ThetaServiceSendTxResult) Read(iprot thrift.TProtocol) error {
           return err
         }
       }
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -7427,19 +17777,28 @@ func (p *ThetaServiceSendTxResult) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *ThetaServiceSendTxResult)  ReadField0(iprot thrift.TProtocol) error {
-  p.Success = &BroadcastRawTransactionAsync{}
+func (p *ThetaServiceIsKeyUnlockedResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &StatusKey{}
   if err := p.Success.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
   }
   return nil
 }
 
-func (p *ThetaServiceSendTxResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("sendTx_result"); err != nil {
+func (p *ThetaServiceIsKeyUnlockedResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.Err = &TThetaException{}
+  if err := p.Err.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceIsKeyUnlockedResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("IsKeyUnlocked_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -7448,7 +17807,7 @@ func (p *ThetaServiceSendTxResult) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *ThetaServiceSendTxResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ThetaServiceIsKeyUnlockedResult) writeField0(oprot thrift.TProtocol) (err error) {
   if p.IsSetSuccess() {
     if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
@@ -7461,40 +17820,34 @@ func (p *ThetaServiceSendTxResult) writeField0(oprot thrift.TProtocol) (err erro
   return err
 }
 
-func (p *ThetaServiceSendTxResult) String() string {
-  if p == nil {
-    return "<nil>"
+func (p *ThetaServiceIsKeyUnlockedResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetErr() {
+    if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err) }
+    if err := p.Err.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:err: ", p), err) }
   }
-  return fmt.Sprintf("ThetaServiceSendTxResult(%+v)", *p)
-}
-
-// Attributes:
-//  - Address
-//  - ContractAddress
-//  - PrivateKey
-type ThetaServiceGetTokenBalanceArgs struct {
-  Address string `thrift:"address,1" db:"address" json:"address"`
-  ContractAddress string `thrift:"contract_address,2" db:"contract_address" json:"contract_address"`
-  PrivateKey string `thrift:"private_key,3" db:"private_key" json:"private_key"`
+  return err
 }
 
-func NewThetaServiceGetTokenBalanceArgs() *ThetaServiceGetTokenBalanceArgs {
-  return &ThetaServiceGetTokenBalanceArgs{}
+func (p *ThetaServiceIsKeyUnlockedResult) String() string {
+  if p == nil {
+    return "<nil>"
+  }
+  return fmt.Sprintf("ThetaServiceIsKeyUnlockedResult(%+v)", *p)
 }
 
-
-func (p *ThetaServiceGetTokenBalanceArgs) GetAddress() string {
-  return p.Address
+type ThetaServiceListKeysArgs struct {
 }
 
-func (p *ThetaServiceGetTokenBalanceArgs) GetContractAddress() string {
-  return p.ContractAddress
+func NewThetaServiceListKeysArgs() *ThetaServiceListKeysArgs {
+  return &ThetaServiceListKeysArgs{}
 }
 
-func (p *ThetaServiceGetTokenBalanceArgs) GetPrivateKey() string {
-  return p.PrivateKey
-}
-func (p *ThetaServiceGetTokenBalanceArgs) Read(iprot thrift.TProtocol) error {
+func (p *ThetaServiceListKeysArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -7506,41 +17859,8 @@ func (p *ThetaServiceGetTokenBalanceArgs) Read(iprot thrift.TProtocol) error {
       return thrift.PrependError(fmt.Sprintf("%T field %d read error: ", p, fieldId), err)
     }
     if fieldTypeId == thrift.STOP { break; }
-    switch fieldId {
-    case 1:
-      if fieldTypeId == thrift.STRING {
-        if err := p.ReadField1(iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(fieldTypeId); err != nil {
-          return err
-        }
-      }
-    case 2:
-      if fieldTypeId == thrift.STRING {
-        if err := p.ReadField2(iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(fieldTypeId); err != nil {
-          return err
-        }
-      }
-    case 3:
-      if fieldTypeId == thrift.STRING {
-        if err := p.ReadField3(iprot); err != nil {
-          return err
-        }
-      } else {
-        if err := iprot.Skip(fieldTypeId); err != nil {
-          return err
-        }
-      }
-    default:
-      if err := iprot.Skip(fieldTypeId); err != nil {
-        return err
-      }
+    if err := iprot.Skip(fieldTypeId); err != nil {
+      return err
     }
     if err := iprot.ReadFieldEnd(); err != nil {
       return err
@@ -7552,40 +17872,10 @@ func (p *ThetaServiceGetTokenBalanceArgs) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *ThetaServiceGetTokenBalanceArgs)  ReadField1(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 1: ", err)
-} else {
-  p.Address = v
-}
-  return nil
-}
-
-func (p *ThetaServiceGetTokenBalanceArgs)  ReadField2(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 2: ", err)
-} else {
-  p.ContractAddress = v
-}
-  return nil
-}
-
-func (p *ThetaServiceGetTokenBalanceArgs)  ReadField3(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 3: ", err)
-} else {
-  p.PrivateKey = v
-}
-  return nil
-}
-
-func (p *ThetaServiceGetTokenBalanceArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("getTokenBalance_args"); err != nil {
+func (p *ThetaServiceListKeysArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ListKeys_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
-    if err := p.writeField1(oprot); err != nil { return err }
-    if err := p.writeField2(oprot); err != nil { return err }
-    if err := p.writeField3(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -7594,65 +17884,48 @@ func (p *ThetaServiceGetTokenBalanceArgs) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *ThetaServiceGetTokenBalanceArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("address", thrift.STRING, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:address: ", p), err) }
-  if err := oprot.WriteString(string(p.Address)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.address (1) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:address: ", p), err) }
-  return err
-}
-
-func (p *ThetaServiceGetTokenBalanceArgs) writeField2(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("contract_address", thrift.STRING, 2); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:contract_address: ", p), err) }
-  if err := oprot.WriteString(string(p.ContractAddress)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.contract_address (2) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:contract_address: ", p), err) }
-  return err
-}
-
-func (p *ThetaServiceGetTokenBalanceArgs) writeField3(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("private_key", thrift.STRING, 3); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:private_key: ", p), err) }
-  if err := oprot.WriteString(string(p.PrivateKey)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.private_key (3) field write error: ", p), err) }
-  if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:private_key: ", p), err) }
-  return err
-}
-
-func (p *ThetaServiceGetTokenBalanceArgs) String() string {
+func (p *ThetaServiceListKeysArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("ThetaServiceGetTokenBalanceArgs(%+v)", *p)
+  return fmt.Sprintf("ThetaServiceListKeysArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Success
-type ThetaServiceGetTokenBalanceResult struct {
-  Success *int64 `thrift:"success,0" db:"success" json:"success,omitempty"`
+//  - Err
+type ThetaServiceListKeysResult struct {
+  Success *ListKeys `thrift:"success,0" db:"success" json:"success,omitempty"`
+  Err *TThetaException `thrift:"err,1" db:"err" json:"err,omitempty"`
 }
 
-func NewThetaServiceGetTokenBalanceResult() *ThetaServiceGetTokenBalanceResult {
-  return &ThetaServiceGetTokenBalanceResult{}
+func NewThetaServiceListKeysResult() *ThetaServiceListKeysResult {
+  return &ThetaServiceListKeysResult{}
 }
 
-var ThetaServiceGetTokenBalanceResult_Success_DEFAULT int64
-func (p *ThetaServiceGetTokenBalanceResult) GetSuccess() int64 {
+var ThetaServiceListKeysResult_Success_DEFAULT *ListKeys
+func (p *ThetaServiceListKeysResult) GetSuccess() *ListKeys {
   if !p.IsSetSuccess() {
-    return ThetaServiceGetTokenBalanceResult_Success_DEFAULT
+    return ThetaServiceListKeysResult_Success_DEFAULT
   }
-return *p.Success
+return p.Success
 }
-func (p *ThetaServiceGetTokenBalanceResult) IsSetSuccess() bool {
+var ThetaServiceListKeysResult_Err_DEFAULT *TThetaException
+func (p *ThetaServiceListKeysResult) GetErr() *TThetaException {
+  if !p.IsSetErr() {
+    return ThetaServiceListKeysResult_Err_DEFAULT
+  }
+return p.Err
+}
+func (p *ThetaServiceListKeysResult) IsSetSuccess() bool {
   return p.Success != nil
 }
 
-func (p *ThetaServiceGetTokenBalanceResult) Read(iprot thrift.TProtocol) error {
+func (p *ThetaServiceListKeysResult) IsSetErr() bool {
+  return p.Err != nil
+}
+
+func (p *ThetaServiceListKeysResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -7666,7 +17939,7 @@ func (p *ThetaServiceGetTokenBalanceResult) Read(iprot thrift.TProtocol) error {
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
     case 0:
-      if fieldTypeId == thrift.I64 {
+      if fieldTypeId == thrift.STRUCT {
         if err := p.ReadField0(iprot); err != nil {
           return err
         }
@@ -7675,6 +17948,16 @@ func (p *ThetaServiceGetTokenBalanceResult) Read(iprot thrift.TProtocol) error {
           return err
         }
       }
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -7690,20 +17973,28 @@ func (p *ThetaServiceGetTokenBalanceResult) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *ThetaServiceGetTokenBalanceResult)  ReadField0(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI64(); err != nil {
-  return thrift.PrependError("error reading field 0: ", err)
-} else {
-  p.Success = &v
+func (p *ThetaServiceListKeysResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &ListKeys{}
+  if err := p.Success.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+  }
+  return nil
 }
+
+func (p *ThetaServiceListKeysResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.Err = &TThetaException{}
+  if err := p.Err.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
+  }
   return nil
 }
 
-func (p *ThetaServiceGetTokenBalanceResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("getTokenBalance_result"); err != nil {
+func (p *ThetaServiceListKeysResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ListKeys_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -7712,47 +18003,54 @@ func (p *ThetaServiceGetTokenBalanceResult) Write(oprot thrift.TProtocol) error
   return nil
 }
 
-func (p *ThetaServiceGetTokenBalanceResult) writeField0(oprot thrift.TProtocol) (err error) {
-  if p.IsSetSuccess() {
-    if err := oprot.WriteFieldBegin("success", thrift.I64, 0); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
-    if err := oprot.WriteI64(int64(*p.Success)); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T.success (0) field write error: ", p), err) }
+func (p *ThetaServiceListKeysResult) writeField0(oprot thrift.TProtocol) (err error) {
+  if p.IsSetSuccess() {
+    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
+    if err := p.Success.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+  }
+  return err
+}
+
+func (p *ThetaServiceListKeysResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetErr() {
+    if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err) }
+    if err := p.Err.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
+    }
     if err := oprot.WriteFieldEnd(); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:err: ", p), err) }
   }
   return err
 }
 
-func (p *ThetaServiceGetTokenBalanceResult) String() string {
+func (p *ThetaServiceListKeysResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("ThetaServiceGetTokenBalanceResult(%+v)", *p)
+  return fmt.Sprintf("ThetaServiceListKeysResult(%+v)", *p)
 }
 
 // Attributes:
-//  - Send
-type ThetaServiceSendTokenArgs struct {
-  Send *SendToken `thrift:"send,1" db:"send" json:"send"`
+//  - ContractAddress
+type ThetaServiceGetTokenInfoArgs struct {
+  ContractAddress string `thrift:"contract_address,1" db:"contract_address" json:"contract_address"`
 }
 
-func NewThetaServiceSendTokenArgs() *ThetaServiceSendTokenArgs {
-  return &ThetaServiceSendTokenArgs{}
+func NewThetaServiceGetTokenInfoArgs() *ThetaServiceGetTokenInfoArgs {
+  return &ThetaServiceGetTokenInfoArgs{}
 }
 
-var ThetaServiceSendTokenArgs_Send_DEFAULT *SendToken
-func (p *ThetaServiceSendTokenArgs) GetSend() *SendToken {
-  if !p.IsSetSend() {
-    return ThetaServiceSendTokenArgs_Send_DEFAULT
-  }
-return p.Send
-}
-func (p *ThetaServiceSendTokenArgs) IsSetSend() bool {
-  return p.Send != nil
-}
 
-func (p *ThetaServiceSendTokenArgs) Read(iprot thrift.TProtocol) error {
+func (p *ThetaServiceGetTokenInfoArgs) GetContractAddress() string {
+  return p.ContractAddress
+}
+func (p *ThetaServiceGetTokenInfoArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -7766,7 +18064,7 @@ func (p *ThetaServiceSendTokenArgs) Read(iprot thrift.TProtocol) error {
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
     case 1:
-      if fieldTypeId == thrift.STRUCT {
+      if fieldTypeId == thrift.STRING {
         if err := p.ReadField1(iprot); err != nil {
           return err
         }
@@ -7790,16 +18088,17 @@ func (p *ThetaServiceSendTokenArgs) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *ThetaServiceSendTokenArgs)  ReadField1(iprot thrift.TProtocol) error {
-  p.Send = &SendToken{}
-  if err := p.Send.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Send), err)
-  }
+func (p *ThetaServiceGetTokenInfoArgs)  ReadField1(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 1: ", err)
+} else {
+  p.ContractAddress = v
+}
   return nil
 }
 
-func (p *ThetaServiceSendTokenArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("sendToken_args"); err != nil {
+func (p *ThetaServiceGetTokenInfoArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetTokenInfo_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -7811,46 +18110,58 @@ func (p *ThetaServiceSendTokenArgs) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *ThetaServiceSendTokenArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("send", thrift.STRUCT, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:send: ", p), err) }
-  if err := p.Send.Write(oprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Send), err)
-  }
+func (p *ThetaServiceGetTokenInfoArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("contract_address", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:contract_address: ", p), err) }
+  if err := oprot.WriteString(string(p.ContractAddress)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.contract_address (1) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:send: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:contract_address: ", p), err) }
   return err
 }
 
-func (p *ThetaServiceSendTokenArgs) String() string {
+func (p *ThetaServiceGetTokenInfoArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("ThetaServiceSendTokenArgs(%+v)", *p)
+  return fmt.Sprintf("ThetaServiceGetTokenInfoArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Success
-type ThetaServiceSendTokenResult struct {
-  Success *BroadcastRawTransactionAsync `thrift:"success,0" db:"success" json:"success,omitempty"`
+//  - Err
+type ThetaServiceGetTokenInfoResult struct {
+  Success *TokenInfo `thrift:"success,0" db:"success" json:"success,omitempty"`
+  Err *TThetaException `thrift:"err,1" db:"err" json:"err,omitempty"`
 }
 
-func NewThetaServiceSendTokenResult() *ThetaServiceSendTokenResult {
-  return &ThetaServiceSendTokenResult{}
+func NewThetaServiceGetTokenInfoResult() *ThetaServiceGetTokenInfoResult {
+  return &ThetaServiceGetTokenInfoResult{}
 }
 
-var ThetaServiceSendTokenResult_Success_DEFAULT *BroadcastRawTransactionAsync
-func (p *ThetaServiceSendTokenResult) GetSuccess() *BroadcastRawTransactionAsync {
+var ThetaServiceGetTokenInfoResult_Success_DEFAULT *TokenInfo
+func (p *ThetaServiceGetTokenInfoResult) GetSuccess() *TokenInfo {
   if !p.IsSetSuccess() {
-    return ThetaServiceSendTokenResult_Success_DEFAULT
+    return ThetaServiceGetTokenInfoResult_Success_DEFAULT
   }
 return p.Success
 }
-func (p *ThetaServiceSendTokenResult) IsSetSuccess() bool {
+var ThetaServiceGetTokenInfoResult_Err_DEFAULT *TThetaException
+func (p *ThetaServiceGetTokenInfoResult) GetErr() *TThetaException {
+  if !p.IsSetErr() {
+    return ThetaServiceGetTokenInfoResult_Err_DEFAULT
+  }
+return p.Err
+}
+func (p *ThetaServiceGetTokenInfoResult) IsSetSuccess() bool {
   return p.Success != nil
 }
 
-func (p *ThetaServiceSendTokenResult) Read(iprot thrift.TProtocol) error {
+func (p *ThetaServiceGetTokenInfoResult) IsSetErr() bool {
+  return p.Err != nil
+}
+
+func (p *ThetaServiceGetTokenInfoResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -7873,6 +18184,16 @@ func (p *ThetaServiceSendTokenResult) Read(iprot thrift.TProtocol) error {
           return err
         }
       }
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -7888,19 +18209,28 @@ func (p *ThetaServiceSendTokenResult) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *ThetaServiceSendTokenResult)  ReadField0(iprot thrift.TProtocol) error {
-  p.Success = &BroadcastRawTransactionAsync{}
+func (p *ThetaServiceGetTokenInfoResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &TokenInfo{}
   if err := p.Success.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
   }
   return nil
 }
 
-func (p *ThetaServiceSendTokenResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("sendToken_result"); err != nil {
+func (p *ThetaServiceGetTokenInfoResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.Err = &TThetaException{}
+  if err := p.Err.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceGetTokenInfoResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetTokenInfo_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -7909,7 +18239,7 @@ func (p *ThetaServiceSendTokenResult) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *ThetaServiceSendTokenResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ThetaServiceGetTokenInfoResult) writeField0(oprot thrift.TProtocol) (err error) {
   if p.IsSetSuccess() {
     if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
@@ -7922,28 +18252,53 @@ func (p *ThetaServiceSendTokenResult) writeField0(oprot thrift.TProtocol) (err e
   return err
 }
 
-func (p *ThetaServiceSendTokenResult) String() string {
+func (p *ThetaServiceGetTokenInfoResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetErr() {
+    if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err) }
+    if err := p.Err.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:err: ", p), err) }
+  }
+  return err
+}
+
+func (p *ThetaServiceGetTokenInfoResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("ThetaServiceSendTokenResult(%+v)", *p)
+  return fmt.Sprintf("ThetaServiceGetTokenInfoResult(%+v)", *p)
 }
 
 // Attributes:
-//  - Hash
-type ThetaServiceGetBlockArgs struct {
-  Hash string `thrift:"hash,1" db:"hash" json:"hash"`
+//  - ContractAddress
+//  - Owner
+//  - Spender
+type ThetaServiceGetTokenAllowanceArgs struct {
+  ContractAddress string `thrift:"contract_address,1" db:"contract_address" json:"contract_address"`
+  Owner string `thrift:"owner,2" db:"owner" json:"owner"`
+  Spender string `thrift:"spender,3" db:"spender" json:"spender"`
 }
 
-func NewThetaServiceGetBlockArgs() *ThetaServiceGetBlockArgs {
-  return &ThetaServiceGetBlockArgs{}
+func NewThetaServiceGetTokenAllowanceArgs() *ThetaServiceGetTokenAllowanceArgs {
+  return &ThetaServiceGetTokenAllowanceArgs{}
 }
 
 
-func (p *ThetaServiceGetBlockArgs) GetHash() string {
-  return p.Hash
+func (p *ThetaServiceGetTokenAllowanceArgs) GetContractAddress() string {
+  return p.ContractAddress
 }
-func (p *ThetaServiceGetBlockArgs) Read(iprot thrift.TProtocol) error {
+
+func (p *ThetaServiceGetTokenAllowanceArgs) GetOwner() string {
+  return p.Owner
+}
+
+func (p *ThetaServiceGetTokenAllowanceArgs) GetSpender() string {
+  return p.Spender
+}
+func (p *ThetaServiceGetTokenAllowanceArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -7966,6 +18321,26 @@ func (p *ThetaServiceGetBlockArgs) Read(iprot thrift.TProtocol) error {
           return err
         }
       }
+    case 2:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField2(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
+    case 3:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField3(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -7981,20 +18356,40 @@ func (p *ThetaServiceGetBlockArgs) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *ThetaServiceGetBlockArgs)  ReadField1(iprot thrift.TProtocol) error {
+func (p *ThetaServiceGetTokenAllowanceArgs)  ReadField1(iprot thrift.TProtocol) error {
   if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 1: ", err)
 } else {
-  p.Hash = v
+  p.ContractAddress = v
 }
   return nil
 }
 
-func (p *ThetaServiceGetBlockArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("GetBlock_args"); err != nil {
+func (p *ThetaServiceGetTokenAllowanceArgs)  ReadField2(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.Owner = v
+}
+  return nil
+}
+
+func (p *ThetaServiceGetTokenAllowanceArgs)  ReadField3(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 3: ", err)
+} else {
+  p.Spender = v
+}
+  return nil
+}
+
+func (p *ThetaServiceGetTokenAllowanceArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetTokenAllowance_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
+    if err := p.writeField3(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -8003,45 +18398,78 @@ func (p *ThetaServiceGetBlockArgs) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *ThetaServiceGetBlockArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("hash", thrift.STRING, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:hash: ", p), err) }
-  if err := oprot.WriteString(string(p.Hash)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.hash (1) field write error: ", p), err) }
+func (p *ThetaServiceGetTokenAllowanceArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("contract_address", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:contract_address: ", p), err) }
+  if err := oprot.WriteString(string(p.ContractAddress)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.contract_address (1) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:hash: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:contract_address: ", p), err) }
   return err
 }
 
-func (p *ThetaServiceGetBlockArgs) String() string {
+func (p *ThetaServiceGetTokenAllowanceArgs) writeField2(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("owner", thrift.STRING, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:owner: ", p), err) }
+  if err := oprot.WriteString(string(p.Owner)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.owner (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:owner: ", p), err) }
+  return err
+}
+
+func (p *ThetaServiceGetTokenAllowanceArgs) writeField3(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("spender", thrift.STRING, 3); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 3:spender: ", p), err) }
+  if err := oprot.WriteString(string(p.Spender)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.spender (3) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 3:spender: ", p), err) }
+  return err
+}
+
+func (p *ThetaServiceGetTokenAllowanceArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("ThetaServiceGetBlockArgs(%+v)", *p)
+  return fmt.Sprintf("ThetaServiceGetTokenAllowanceArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Success
-type ThetaServiceGetBlockResult struct {
-  Success *Block `thrift:"success,0" db:"success" json:"success,omitempty"`
+//  - Err
+type ThetaServiceGetTokenAllowanceResult struct {
+  Success *string `thrift:"success,0" db:"success" json:"success,omitempty"`
+  Err *TThetaException `thrift:"err,1" db:"err" json:"err,omitempty"`
 }
 
-func NewThetaServiceGetBlockResult() *ThetaServiceGetBlockResult {
-  return &ThetaServiceGetBlockResult{}
+func NewThetaServiceGetTokenAllowanceResult() *ThetaServiceGetTokenAllowanceResult {
+  return &ThetaServiceGetTokenAllowanceResult{}
 }
 
-var ThetaServiceGetBlockResult_Success_DEFAULT *Block
-func (p *ThetaServiceGetBlockResult) GetSuccess() *Block {
+var ThetaServiceGetTokenAllowanceResult_Success_DEFAULT string
+func (p *ThetaServiceGetTokenAllowanceResult) GetSuccess() string {
   if !p.IsSetSuccess() {
-    return ThetaServiceGetBlockResult_Success_DEFAULT
+    return ThetaServiceGetTokenAllowanceResult_Success_DEFAULT
   }
-return p.Success
+return *p.Success
 }
-func (p *ThetaServiceGetBlockResult) IsSetSuccess() bool {
+var ThetaServiceGetTokenAllowanceResult_Err_DEFAULT *TThetaException
+func (p *ThetaServiceGetTokenAllowanceResult) GetErr() *TThetaException {
+  if !p.IsSetErr() {
+    return ThetaServiceGetTokenAllowanceResult_Err_DEFAULT
+  }
+return p.Err
+}
+func (p *ThetaServiceGetTokenAllowanceResult) IsSetSuccess() bool {
   return p.Success != nil
 }
 
-func (p *ThetaServiceGetBlockResult) Read(iprot thrift.TProtocol) error {
+func (p *ThetaServiceGetTokenAllowanceResult) IsSetErr() bool {
+  return p.Err != nil
+}
+
+func (p *ThetaServiceGetTokenAllowanceResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -8055,7 +18483,7 @@ func (p *ThetaServiceGetBlockResult) Read(iprot thrift.TProtocol) error {
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
     case 0:
-      if fieldTypeId == thrift.STRUCT {
+      if fieldTypeId == thrift.STRING {
         if err := p.ReadField0(iprot); err != nil {
           return err
         }
@@ -8064,6 +18492,16 @@ func (p *ThetaServiceGetBlockResult) Read(iprot thrift.TProtocol) error {
           return err
         }
       }
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -8079,19 +18517,29 @@ func (p *ThetaServiceGetBlockResult) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *ThetaServiceGetBlockResult)  ReadField0(iprot thrift.TProtocol) error {
-  p.Success = &Block{}
-  if err := p.Success.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+func (p *ThetaServiceGetTokenAllowanceResult)  ReadField0(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 0: ", err)
+} else {
+  p.Success = &v
+}
+  return nil
+}
+
+func (p *ThetaServiceGetTokenAllowanceResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.Err = &TThetaException{}
+  if err := p.Err.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
   }
   return nil
 }
 
-func (p *ThetaServiceGetBlockResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("GetBlock_result"); err != nil {
+func (p *ThetaServiceGetTokenAllowanceResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("GetTokenAllowance_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -8100,41 +18548,60 @@ func (p *ThetaServiceGetBlockResult) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *ThetaServiceGetBlockResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ThetaServiceGetTokenAllowanceResult) writeField0(oprot thrift.TProtocol) (err error) {
   if p.IsSetSuccess() {
-    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+    if err := oprot.WriteFieldBegin("success", thrift.STRING, 0); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
-    if err := p.Success.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
-    }
+    if err := oprot.WriteString(string(*p.Success)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.success (0) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
   }
   return err
 }
 
-func (p *ThetaServiceGetBlockResult) String() string {
+func (p *ThetaServiceGetTokenAllowanceResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetErr() {
+    if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err) }
+    if err := p.Err.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:err: ", p), err) }
+  }
+  return err
+}
+
+func (p *ThetaServiceGetTokenAllowanceResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("ThetaServiceGetBlockResult(%+v)", *p)
+  return fmt.Sprintf("ThetaServiceGetTokenAllowanceResult(%+v)", *p)
 }
 
 // Attributes:
-//  - Height
-type ThetaServiceGetBlockByHeightArgs struct {
-  Height int64 `thrift:"height,1" db:"height" json:"height"`
+//  - Approve
+type ThetaServiceApproveTokenArgs struct {
+  Approve *ApproveToken `thrift:"approve,1" db:"approve" json:"approve"`
 }
 
-func NewThetaServiceGetBlockByHeightArgs() *ThetaServiceGetBlockByHeightArgs {
-  return &ThetaServiceGetBlockByHeightArgs{}
+func NewThetaServiceApproveTokenArgs() *ThetaServiceApproveTokenArgs {
+  return &ThetaServiceApproveTokenArgs{}
 }
 
-
-func (p *ThetaServiceGetBlockByHeightArgs) GetHeight() int64 {
-  return p.Height
+var ThetaServiceApproveTokenArgs_Approve_DEFAULT *ApproveToken
+func (p *ThetaServiceApproveTokenArgs) GetApprove() *ApproveToken {
+  if !p.IsSetApprove() {
+    return ThetaServiceApproveTokenArgs_Approve_DEFAULT
+  }
+return p.Approve
 }
-func (p *ThetaServiceGetBlockByHeightArgs) Read(iprot thrift.TProtocol) error {
+func (p *ThetaServiceApproveTokenArgs) IsSetApprove() bool {
+  return p.Approve != nil
+}
+
+func (p *ThetaServiceApproveTokenArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -8148,7 +18615,7 @@ func (p *ThetaServiceGetBlockByHeightArgs) Read(iprot thrift.TProtocol) error {
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
     case 1:
-      if fieldTypeId == thrift.I64 {
+      if fieldTypeId == thrift.STRUCT {
         if err := p.ReadField1(iprot); err != nil {
           return err
         }
@@ -8167,22 +18634,21 @@ func (p *ThetaServiceGetBlockByHeightArgs) Read(iprot thrift.TProtocol) error {
     }
   }
   if err := iprot.ReadStructEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
-  }
-  return nil
-}
-
-func (p *ThetaServiceGetBlockByHeightArgs)  ReadField1(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI64(); err != nil {
-  return thrift.PrependError("error reading field 1: ", err)
-} else {
-  p.Height = v
+    return thrift.PrependError(fmt.Sprintf("%T read struct end error: ", p), err)
+  }
+  return nil
 }
+
+func (p *ThetaServiceApproveTokenArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.Approve = &ApproveToken{}
+  if err := p.Approve.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Approve), err)
+  }
   return nil
 }
 
-func (p *ThetaServiceGetBlockByHeightArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("GetBlockByHeight_args"); err != nil {
+func (p *ThetaServiceApproveTokenArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ApproveToken_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -8194,45 +18660,59 @@ func (p *ThetaServiceGetBlockByHeightArgs) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *ThetaServiceGetBlockByHeightArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("height", thrift.I64, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:height: ", p), err) }
-  if err := oprot.WriteI64(int64(p.Height)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.height (1) field write error: ", p), err) }
+func (p *ThetaServiceApproveTokenArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("approve", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:approve: ", p), err) }
+  if err := p.Approve.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Approve), err)
+  }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:height: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:approve: ", p), err) }
   return err
 }
 
-func (p *ThetaServiceGetBlockByHeightArgs) String() string {
+func (p *ThetaServiceApproveTokenArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("ThetaServiceGetBlockByHeightArgs(%+v)", *p)
+  return fmt.Sprintf("ThetaServiceApproveTokenArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Success
-type ThetaServiceGetBlockByHeightResult struct {
-  Success *Block `thrift:"success,0" db:"success" json:"success,omitempty"`
+//  - Err
+type ThetaServiceApproveTokenResult struct {
+  Success *BroadcastRawTransactionAsync `thrift:"success,0" db:"success" json:"success,omitempty"`
+  Err *TThetaException `thrift:"err,1" db:"err" json:"err,omitempty"`
 }
 
-func NewThetaServiceGetBlockByHeightResult() *ThetaServiceGetBlockByHeightResult {
-  return &ThetaServiceGetBlockByHeightResult{}
+func NewThetaServiceApproveTokenResult() *ThetaServiceApproveTokenResult {
+  return &ThetaServiceApproveTokenResult{}
 }
 
-var ThetaServiceGetBlockByHeightResult_Success_DEFAULT *Block
-func (p *ThetaServiceGetBlockByHeightResult) GetSuccess() *Block {
+var ThetaServiceApproveTokenResult_Success_DEFAULT *BroadcastRawTransactionAsync
+func (p *ThetaServiceApproveTokenResult) GetSuccess() *BroadcastRawTransactionAsync {
   if !p.IsSetSuccess() {
-    return ThetaServiceGetBlockByHeightResult_Success_DEFAULT
+    return ThetaServiceApproveTokenResult_Success_DEFAULT
   }
 return p.Success
 }
-func (p *ThetaServiceGetBlockByHeightResult) IsSetSuccess() bool {
+var ThetaServiceApproveTokenResult_Err_DEFAULT *TThetaException
+func (p *ThetaServiceApproveTokenResult) GetErr() *TThetaException {
+  if !p.IsSetErr() {
+    return ThetaServiceApproveTokenResult_Err_DEFAULT
+  }
+return p.Err
+}
+func (p *ThetaServiceApproveTokenResult) IsSetSuccess() bool {
   return p.Success != nil
 }
 
-func (p *ThetaServiceGetBlockByHeightResult) Read(iprot thrift.TProtocol) error {
+func (p *ThetaServiceApproveTokenResult) IsSetErr() bool {
+  return p.Err != nil
+}
+
+func (p *ThetaServiceApproveTokenResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -8255,6 +18735,16 @@ func (p *ThetaServiceGetBlockByHeightResult) Read(iprot thrift.TProtocol) error
           return err
         }
       }
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -8270,19 +18760,28 @@ func (p *ThetaServiceGetBlockByHeightResult) Read(iprot thrift.TProtocol) error
   return nil
 }
 
-func (p *ThetaServiceGetBlockByHeightResult)  ReadField0(iprot thrift.TProtocol) error {
-  p.Success = &Block{}
+func (p *ThetaServiceApproveTokenResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &BroadcastRawTransactionAsync{}
   if err := p.Success.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
   }
   return nil
 }
 
-func (p *ThetaServiceGetBlockByHeightResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("GetBlockByHeight_result"); err != nil {
+func (p *ThetaServiceApproveTokenResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.Err = &TThetaException{}
+  if err := p.Err.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceApproveTokenResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("ApproveToken_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -8291,7 +18790,7 @@ func (p *ThetaServiceGetBlockByHeightResult) Write(oprot thrift.TProtocol) error
   return nil
 }
 
-func (p *ThetaServiceGetBlockByHeightResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ThetaServiceApproveTokenResult) writeField0(oprot thrift.TProtocol) (err error) {
   if p.IsSetSuccess() {
     if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
@@ -8304,28 +18803,48 @@ func (p *ThetaServiceGetBlockByHeightResult) writeField0(oprot thrift.TProtocol)
   return err
 }
 
-func (p *ThetaServiceGetBlockByHeightResult) String() string {
+func (p *ThetaServiceApproveTokenResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetErr() {
+    if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err) }
+    if err := p.Err.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:err: ", p), err) }
+  }
+  return err
+}
+
+func (p *ThetaServiceApproveTokenResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("ThetaServiceGetBlockByHeightResult(%+v)", *p)
+  return fmt.Sprintf("ThetaServiceApproveTokenResult(%+v)", *p)
 }
 
 // Attributes:
-//  - Hash
-type ThetaServiceGetBlockHeaderArgs struct {
-  Hash string `thrift:"hash,1" db:"hash" json:"hash"`
+//  - Transfer
+type ThetaServiceTransferTokenFromArgs struct {
+  Transfer *TransferTokenFrom `thrift:"transfer,1" db:"transfer" json:"transfer"`
 }
 
-func NewThetaServiceGetBlockHeaderArgs() *ThetaServiceGetBlockHeaderArgs {
-  return &ThetaServiceGetBlockHeaderArgs{}
+func NewThetaServiceTransferTokenFromArgs() *ThetaServiceTransferTokenFromArgs {
+  return &ThetaServiceTransferTokenFromArgs{}
 }
 
-
-func (p *ThetaServiceGetBlockHeaderArgs) GetHash() string {
-  return p.Hash
+var ThetaServiceTransferTokenFromArgs_Transfer_DEFAULT *TransferTokenFrom
+func (p *ThetaServiceTransferTokenFromArgs) GetTransfer() *TransferTokenFrom {
+  if !p.IsSetTransfer() {
+    return ThetaServiceTransferTokenFromArgs_Transfer_DEFAULT
+  }
+return p.Transfer
 }
-func (p *ThetaServiceGetBlockHeaderArgs) Read(iprot thrift.TProtocol) error {
+func (p *ThetaServiceTransferTokenFromArgs) IsSetTransfer() bool {
+  return p.Transfer != nil
+}
+
+func (p *ThetaServiceTransferTokenFromArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -8339,7 +18858,7 @@ func (p *ThetaServiceGetBlockHeaderArgs) Read(iprot thrift.TProtocol) error {
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
     case 1:
-      if fieldTypeId == thrift.STRING {
+      if fieldTypeId == thrift.STRUCT {
         if err := p.ReadField1(iprot); err != nil {
           return err
         }
@@ -8363,17 +18882,16 @@ func (p *ThetaServiceGetBlockHeaderArgs) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *ThetaServiceGetBlockHeaderArgs)  ReadField1(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadString(); err != nil {
-  return thrift.PrependError("error reading field 1: ", err)
-} else {
-  p.Hash = v
-}
+func (p *ThetaServiceTransferTokenFromArgs)  ReadField1(iprot thrift.TProtocol) error {
+  p.Transfer = &TransferTokenFrom{}
+  if err := p.Transfer.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Transfer), err)
+  }
   return nil
 }
 
-func (p *ThetaServiceGetBlockHeaderArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("GetBlockHeader_args"); err != nil {
+func (p *ThetaServiceTransferTokenFromArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("TransferTokenFrom_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
@@ -8385,45 +18903,59 @@ func (p *ThetaServiceGetBlockHeaderArgs) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *ThetaServiceGetBlockHeaderArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("hash", thrift.STRING, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:hash: ", p), err) }
-  if err := oprot.WriteString(string(p.Hash)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.hash (1) field write error: ", p), err) }
+func (p *ThetaServiceTransferTokenFromArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("transfer", thrift.STRUCT, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:transfer: ", p), err) }
+  if err := p.Transfer.Write(oprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Transfer), err)
+  }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:hash: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:transfer: ", p), err) }
   return err
 }
 
-func (p *ThetaServiceGetBlockHeaderArgs) String() string {
+func (p *ThetaServiceTransferTokenFromArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("ThetaServiceGetBlockHeaderArgs(%+v)", *p)
+  return fmt.Sprintf("ThetaServiceTransferTokenFromArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Success
-type ThetaServiceGetBlockHeaderResult struct {
-  Success *BlockHeader `thrift:"success,0" db:"success" json:"success,omitempty"`
+//  - Err
+type ThetaServiceTransferTokenFromResult struct {
+  Success *BroadcastRawTransactionAsync `thrift:"success,0" db:"success" json:"success,omitempty"`
+  Err *TThetaException `thrift:"err,1" db:"err" json:"err,omitempty"`
 }
 
-func NewThetaServiceGetBlockHeaderResult() *ThetaServiceGetBlockHeaderResult {
-  return &ThetaServiceGetBlockHeaderResult{}
+func NewThetaServiceTransferTokenFromResult() *ThetaServiceTransferTokenFromResult {
+  return &ThetaServiceTransferTokenFromResult{}
 }
 
-var ThetaServiceGetBlockHeaderResult_Success_DEFAULT *BlockHeader
-func (p *ThetaServiceGetBlockHeaderResult) GetSuccess() *BlockHeader {
+var ThetaServiceTransferTokenFromResult_Success_DEFAULT *BroadcastRawTransactionAsync
+func (p *ThetaServiceTransferTokenFromResult) GetSuccess() *BroadcastRawTransactionAsync {
   if !p.IsSetSuccess() {
-    return ThetaServiceGetBlockHeaderResult_Success_DEFAULT
+    return ThetaServiceTransferTokenFromResult_Success_DEFAULT
   }
 return p.Success
 }
-func (p *ThetaServiceGetBlockHeaderResult) IsSetSuccess() bool {
+var ThetaServiceTransferTokenFromResult_Err_DEFAULT *TThetaException
+func (p *ThetaServiceTransferTokenFromResult) GetErr() *TThetaException {
+  if !p.IsSetErr() {
+    return ThetaServiceTransferTokenFromResult_Err_DEFAULT
+  }
+return p.Err
+}
+func (p *ThetaServiceTransferTokenFromResult) IsSetSuccess() bool {
   return p.Success != nil
 }
 
-func (p *ThetaServiceGetBlockHeaderResult) Read(iprot thrift.TProtocol) error {
+func (p *ThetaServiceTransferTokenFromResult) IsSetErr() bool {
+  return p.Err != nil
+}
+
+func (p *ThetaServiceTransferTokenFromResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -8446,6 +18978,16 @@ func (p *ThetaServiceGetBlockHeaderResult) Read(iprot thrift.TProtocol) error {
           return err
         }
       }
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -8461,19 +19003,28 @@ func (p *ThetaServiceGetBlockHeaderResult) Read(iprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *ThetaServiceGetBlockHeaderResult)  ReadField0(iprot thrift.TProtocol) error {
-  p.Success = &BlockHeader{}
+func (p *ThetaServiceTransferTokenFromResult)  ReadField0(iprot thrift.TProtocol) error {
+  p.Success = &BroadcastRawTransactionAsync{}
   if err := p.Success.Read(iprot); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
   }
   return nil
 }
 
-func (p *ThetaServiceGetBlockHeaderResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("GetBlockHeader_result"); err != nil {
+func (p *ThetaServiceTransferTokenFromResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.Err = &TThetaException{}
+  if err := p.Err.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
+  }
+  return nil
+}
+
+func (p *ThetaServiceTransferTokenFromResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("TransferTokenFrom_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -8482,7 +19033,7 @@ func (p *ThetaServiceGetBlockHeaderResult) Write(oprot thrift.TProtocol) error {
   return nil
 }
 
-func (p *ThetaServiceGetBlockHeaderResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ThetaServiceTransferTokenFromResult) writeField0(oprot thrift.TProtocol) (err error) {
   if p.IsSetSuccess() {
     if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
@@ -8495,28 +19046,47 @@ func (p *ThetaServiceGetBlockHeaderResult) writeField0(oprot thrift.TProtocol) (
   return err
 }
 
-func (p *ThetaServiceGetBlockHeaderResult) String() string {
+func (p *ThetaServiceTransferTokenFromResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetErr() {
+    if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err) }
+    if err := p.Err.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:err: ", p), err) }
+  }
+  return err
+}
+
+func (p *ThetaServiceTransferTokenFromResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("ThetaServiceGetBlockHeaderResult(%+v)", *p)
+  return fmt.Sprintf("ThetaServiceTransferTokenFromResult(%+v)", *p)
 }
 
 // Attributes:
-//  - Height
-type ThetaServiceGetBlockHeaderByHeightArgs struct {
-  Height int64 `thrift:"height,1" db:"height" json:"height"`
+//  - Address
+//  - ContractAddress
+type ThetaServiceGetTokenBalanceStringArgs struct {
+  Address string `thrift:"address,1" db:"address" json:"address"`
+  ContractAddress string `thrift:"contract_address,2" db:"contract_address" json:"contract_address"`
 }
 
-func NewThetaServiceGetBlockHeaderByHeightArgs() *ThetaServiceGetBlockHeaderByHeightArgs {
-  return &ThetaServiceGetBlockHeaderByHeightArgs{}
+func NewThetaServiceGetTokenBalanceStringArgs() *ThetaServiceGetTokenBalanceStringArgs {
+  return &ThetaServiceGetTokenBalanceStringArgs{}
 }
 
 
-func (p *ThetaServiceGetBlockHeaderByHeightArgs) GetHeight() int64 {
-  return p.Height
+func (p *ThetaServiceGetTokenBalanceStringArgs) GetAddress() string {
+  return p.Address
 }
-func (p *ThetaServiceGetBlockHeaderByHeightArgs) Read(iprot thrift.TProtocol) error {
+
+func (p *ThetaServiceGetTokenBalanceStringArgs) GetContractAddress() string {
+  return p.ContractAddress
+}
+func (p *ThetaServiceGetTokenBalanceStringArgs) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -8530,7 +19100,7 @@ func (p *ThetaServiceGetBlockHeaderByHeightArgs) Read(iprot thrift.TProtocol) er
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
     case 1:
-      if fieldTypeId == thrift.I64 {
+      if fieldTypeId == thrift.STRING {
         if err := p.ReadField1(iprot); err != nil {
           return err
         }
@@ -8539,6 +19109,16 @@ func (p *ThetaServiceGetBlockHeaderByHeightArgs) Read(iprot thrift.TProtocol) er
           return err
         }
       }
+    case 2:
+      if fieldTypeId == thrift.STRING {
+        if err := p.ReadField2(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -8554,20 +19134,30 @@ func (p *ThetaServiceGetBlockHeaderByHeightArgs) Read(iprot thrift.TProtocol) er
   return nil
 }
 
-func (p *ThetaServiceGetBlockHeaderByHeightArgs)  ReadField1(iprot thrift.TProtocol) error {
-  if v, err := iprot.ReadI64(); err != nil {
+func (p *ThetaServiceGetTokenBalanceStringArgs)  ReadField1(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
   return thrift.PrependError("error reading field 1: ", err)
 } else {
-  p.Height = v
+  p.Address = v
 }
   return nil
 }
 
-func (p *ThetaServiceGetBlockHeaderByHeightArgs) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("GetBlockHeaderByHeight_args"); err != nil {
+func (p *ThetaServiceGetTokenBalanceStringArgs)  ReadField2(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 2: ", err)
+} else {
+  p.ContractAddress = v
+}
+  return nil
+}
+
+func (p *ThetaServiceGetTokenBalanceStringArgs) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("getTokenBalanceString_args"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField1(oprot); err != nil { return err }
+    if err := p.writeField2(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -8576,45 +19166,68 @@ func (p *ThetaServiceGetBlockHeaderByHeightArgs) Write(oprot thrift.TProtocol) e
   return nil
 }
 
-func (p *ThetaServiceGetBlockHeaderByHeightArgs) writeField1(oprot thrift.TProtocol) (err error) {
-  if err := oprot.WriteFieldBegin("height", thrift.I64, 1); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:height: ", p), err) }
-  if err := oprot.WriteI64(int64(p.Height)); err != nil {
-  return thrift.PrependError(fmt.Sprintf("%T.height (1) field write error: ", p), err) }
+func (p *ThetaServiceGetTokenBalanceStringArgs) writeField1(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("address", thrift.STRING, 1); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:address: ", p), err) }
+  if err := oprot.WriteString(string(p.Address)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.address (1) field write error: ", p), err) }
   if err := oprot.WriteFieldEnd(); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:height: ", p), err) }
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 1:address: ", p), err) }
   return err
 }
 
-func (p *ThetaServiceGetBlockHeaderByHeightArgs) String() string {
+func (p *ThetaServiceGetTokenBalanceStringArgs) writeField2(oprot thrift.TProtocol) (err error) {
+  if err := oprot.WriteFieldBegin("contract_address", thrift.STRING, 2); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field begin error 2:contract_address: ", p), err) }
+  if err := oprot.WriteString(string(p.ContractAddress)); err != nil {
+  return thrift.PrependError(fmt.Sprintf("%T.contract_address (2) field write error: ", p), err) }
+  if err := oprot.WriteFieldEnd(); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T write field end error 2:contract_address: ", p), err) }
+  return err
+}
+
+func (p *ThetaServiceGetTokenBalanceStringArgs) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("ThetaServiceGetBlockHeaderByHeightArgs(%+v)", *p)
+  return fmt.Sprintf("ThetaServiceGetTokenBalanceStringArgs(%+v)", *p)
 }
 
 // Attributes:
 //  - Success
-type ThetaServiceGetBlockHeaderByHeightResult struct {
-  Success *BlockHeader `thrift:"success,0" db:"success" json:"success,omitempty"`
+//  - Err
+type ThetaServiceGetTokenBalanceStringResult struct {
+  Success *string `thrift:"success,0" db:"success" json:"success,omitempty"`
+  Err *TThetaException `thrift:"err,1" db:"err" json:"err,omitempty"`
 }
 
-func NewThetaServiceGetBlockHeaderByHeightResult() *ThetaServiceGetBlockHeaderByHeightResult {
-  return &ThetaServiceGetBlockHeaderByHeightResult{}
+func NewThetaServiceGetTokenBalanceStringResult() *ThetaServiceGetTokenBalanceStringResult {
+  return &ThetaServiceGetTokenBalanceStringResult{}
 }
 
-var ThetaServiceGetBlockHeaderByHeightResult_Success_DEFAULT *BlockHeader
-func (p *ThetaServiceGetBlockHeaderByHeightResult) GetSuccess() *BlockHeader {
+var ThetaServiceGetTokenBalanceStringResult_Success_DEFAULT string
+func (p *ThetaServiceGetTokenBalanceStringResult) GetSuccess() string {
   if !p.IsSetSuccess() {
-    return ThetaServiceGetBlockHeaderByHeightResult_Success_DEFAULT
+    return ThetaServiceGetTokenBalanceStringResult_Success_DEFAULT
   }
-return p.Success
+return *p.Success
 }
-func (p *ThetaServiceGetBlockHeaderByHeightResult) IsSetSuccess() bool {
+var ThetaServiceGetTokenBalanceStringResult_Err_DEFAULT *TThetaException
+func (p *ThetaServiceGetTokenBalanceStringResult) GetErr() *TThetaException {
+  if !p.IsSetErr() {
+    return ThetaServiceGetTokenBalanceStringResult_Err_DEFAULT
+  }
+return p.Err
+}
+func (p *ThetaServiceGetTokenBalanceStringResult) IsSetSuccess() bool {
   return p.Success != nil
 }
 
-func (p *ThetaServiceGetBlockHeaderByHeightResult) Read(iprot thrift.TProtocol) error {
+func (p *ThetaServiceGetTokenBalanceStringResult) IsSetErr() bool {
+  return p.Err != nil
+}
+
+func (p *ThetaServiceGetTokenBalanceStringResult) Read(iprot thrift.TProtocol) error {
   if _, err := iprot.ReadStructBegin(); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T read error: ", p), err)
   }
@@ -8628,7 +19241,7 @@ func (p *ThetaServiceGetBlockHeaderByHeightResult) Read(iprot thrift.TProtocol)
     if fieldTypeId == thrift.STOP { break; }
     switch fieldId {
     case 0:
-      if fieldTypeId == thrift.STRUCT {
+      if fieldTypeId == thrift.STRING {
         if err := p.ReadField0(iprot); err != nil {
           return err
         }
@@ -8637,6 +19250,16 @@ func (p *ThetaServiceGetBlockHeaderByHeightResult) Read(iprot thrift.TProtocol)
           return err
         }
       }
+    case 1:
+      if fieldTypeId == thrift.STRUCT {
+        if err := p.ReadField1(iprot); err != nil {
+          return err
+        }
+      } else {
+        if err := iprot.Skip(fieldTypeId); err != nil {
+          return err
+        }
+      }
     default:
       if err := iprot.Skip(fieldTypeId); err != nil {
         return err
@@ -8652,19 +19275,29 @@ func (p *ThetaServiceGetBlockHeaderByHeightResult) Read(iprot thrift.TProtocol)
   return nil
 }
 
-func (p *ThetaServiceGetBlockHeaderByHeightResult)  ReadField0(iprot thrift.TProtocol) error {
-  p.Success = &BlockHeader{}
-  if err := p.Success.Read(iprot); err != nil {
-    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Success), err)
+func (p *ThetaServiceGetTokenBalanceStringResult)  ReadField0(iprot thrift.TProtocol) error {
+  if v, err := iprot.ReadString(); err != nil {
+  return thrift.PrependError("error reading field 0: ", err)
+} else {
+  p.Success = &v
+}
+  return nil
+}
+
+func (p *ThetaServiceGetTokenBalanceStringResult)  ReadField1(iprot thrift.TProtocol) error {
+  p.Err = &TThetaException{}
+  if err := p.Err.Read(iprot); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T error reading struct: ", p.Err), err)
   }
   return nil
 }
 
-func (p *ThetaServiceGetBlockHeaderByHeightResult) Write(oprot thrift.TProtocol) error {
-  if err := oprot.WriteStructBegin("GetBlockHeaderByHeight_result"); err != nil {
+func (p *ThetaServiceGetTokenBalanceStringResult) Write(oprot thrift.TProtocol) error {
+  if err := oprot.WriteStructBegin("getTokenBalanceString_result"); err != nil {
     return thrift.PrependError(fmt.Sprintf("%T write struct begin error: ", p), err) }
   if p != nil {
     if err := p.writeField0(oprot); err != nil { return err }
+    if err := p.writeField1(oprot); err != nil { return err }
   }
   if err := oprot.WriteFieldStop(); err != nil {
     return thrift.PrependError("write field stop error: ", err) }
@@ -8673,24 +19306,36 @@ func (p *ThetaServiceGetBlockHeaderByHeightResult) Write(oprot thrift.TProtocol)
   return nil
 }
 
-func (p *ThetaServiceGetBlockHeaderByHeightResult) writeField0(oprot thrift.TProtocol) (err error) {
+func (p *ThetaServiceGetTokenBalanceStringResult) writeField0(oprot thrift.TProtocol) (err error) {
   if p.IsSetSuccess() {
-    if err := oprot.WriteFieldBegin("success", thrift.STRUCT, 0); err != nil {
+    if err := oprot.WriteFieldBegin("success", thrift.STRING, 0); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field begin error 0:success: ", p), err) }
-    if err := p.Success.Write(oprot); err != nil {
-      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Success), err)
-    }
+    if err := oprot.WriteString(string(*p.Success)); err != nil {
+    return thrift.PrependError(fmt.Sprintf("%T.success (0) field write error: ", p), err) }
     if err := oprot.WriteFieldEnd(); err != nil {
       return thrift.PrependError(fmt.Sprintf("%T write field end error 0:success: ", p), err) }
   }
   return err
 }
 
-func (p *ThetaServiceGetBlockHeaderByHeightResult) String() string {
+func (p *ThetaServiceGetTokenBalanceStringResult) writeField1(oprot thrift.TProtocol) (err error) {
+  if p.IsSetErr() {
+    if err := oprot.WriteFieldBegin("err", thrift.STRUCT, 1); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field begin error 1:err: ", p), err) }
+    if err := p.Err.Write(oprot); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T error writing struct: ", p.Err), err)
+    }
+    if err := oprot.WriteFieldEnd(); err != nil {
+      return thrift.PrependError(fmt.Sprintf("%T write field end error 1:err: ", p), err) }
+  }
+  return err
+}
+
+func (p *ThetaServiceGetTokenBalanceStringResult) String() string {
   if p == nil {
     return "<nil>"
   }
-  return fmt.Sprintf("ThetaServiceGetBlockHeaderByHeightResult(%+v)", *p)
+  return fmt.Sprintf("ThetaServiceGetTokenBalanceStringResult(%+v)", *p)
 }
 
 
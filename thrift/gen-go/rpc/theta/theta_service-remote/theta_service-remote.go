@@ -14,7 +14,7 @@ import (
 	"strconv"
 	"strings"
 	"github.com/apache/thrift/lib/go/thrift"
-	"rpc/theta"
+	"theta/thrift/gen-go/rpc/theta"
 )
 
 var _ = theta.GoUnusedProtection__
@@ -31,6 +31,26 @@ func Usage() {
   fmt.Fprintln(os.Stderr, "  Block GetBlockByHeight(i64 height)")
   fmt.Fprintln(os.Stderr, "  BlockHeader GetBlockHeader(string hash)")
   fmt.Fprintln(os.Stderr, "  BlockHeader GetBlockHeaderByHeight(i64 height)")
+  fmt.Fprintln(os.Stderr, "  list<Block> GetBlocksByRange(i64 start, i64 end)")
+  fmt.Fprintln(os.Stderr, "  Transaction GetTransaction(string hash)")
+  fmt.Fprintln(os.Stderr, "  PendingTransaction GetPendingTransactions()")
+  fmt.Fprintln(os.Stderr, "  Status GetStatus()")
+  fmt.Fprintln(os.Stderr, "  list<BlockHashVcpPair> GetVcpByHeight(i64 height)")
+  fmt.Fprintln(os.Stderr, "  list<BlockHashGcpPair> GetGcpByHeight(i64 height)")
+  fmt.Fprintln(os.Stderr, "  GuardianInfo GetGuardianInfo()")
+  fmt.Fprintln(os.Stderr, "  SmartContractCall CallSmartContract(SmartContract contract)")
+  fmt.Fprintln(os.Stderr, "  BroadcastRawTransactionAsync DepositStake(DepositStake deposit)")
+  fmt.Fprintln(os.Stderr, "  BroadcastRawTransactionAsync WithdrawStake(WithdrawStake withdraw)")
+  fmt.Fprintln(os.Stderr, "  BroadcastRawTransactionAsync BroadcastRawTransaction(string tx_bytes)")
+  fmt.Fprintln(os.Stderr, "  StatusKey UnlockKey(string address, string password)")
+  fmt.Fprintln(os.Stderr, "  StatusKey LockKey(string address)")
+  fmt.Fprintln(os.Stderr, "  StatusKey IsKeyUnlocked(string address)")
+  fmt.Fprintln(os.Stderr, "  ListKeys ListKeys()")
+  fmt.Fprintln(os.Stderr, "  TokenInfo GetTokenInfo(string contract_address)")
+  fmt.Fprintln(os.Stderr, "  string GetTokenAllowance(string contract_address, string owner, string spender)")
+  fmt.Fprintln(os.Stderr, "  BroadcastRawTransactionAsync ApproveToken(ApproveToken approve)")
+  fmt.Fprintln(os.Stderr, "  BroadcastRawTransactionAsync TransferTokenFrom(TransferTokenFrom transfer)")
+  fmt.Fprintln(os.Stderr, "  string getTokenBalanceString(string address, string contract_address)")
   fmt.Fprintln(os.Stderr)
   os.Exit(0)
 }
@@ -167,19 +187,19 @@ func main() {
       fmt.Fprintln(os.Stderr, "SendTx requires 1 args")
       flag.Usage()
     }
-    arg28 := flag.Arg(1)
-    mbTrans29 := thrift.NewTMemoryBufferLen(len(arg28))
-    defer mbTrans29.Close()
-    _, err30 := mbTrans29.WriteString(arg28)
-    if err30 != nil {
+    arg75 := flag.Arg(1)
+    mbTrans76 := thrift.NewTMemoryBufferLen(len(arg75))
+    defer mbTrans76.Close()
+    _, err77 := mbTrans76.WriteString(arg75)
+    if err77 != nil {
       Usage()
       return
     }
-    factory31 := thrift.NewTJSONProtocolFactory()
-    jsProt32 := factory31.GetProtocol(mbTrans29)
+    factory78 := thrift.NewTJSONProtocolFactory()
+    jsProt79 := factory78.GetProtocol(mbTrans76)
     argvalue0 := theta.NewSend()
-    err33 := argvalue0.Read(jsProt32)
-    if err33 != nil {
+    err80 := argvalue0.Read(jsProt79)
+    if err80 != nil {
       Usage()
       return
     }
@@ -206,19 +226,19 @@ func main() {
       fmt.Fprintln(os.Stderr, "SendToken requires 1 args")
       flag.Usage()
     }
-    arg37 := flag.Arg(1)
-    mbTrans38 := thrift.NewTMemoryBufferLen(len(arg37))
-    defer mbTrans38.Close()
-    _, err39 := mbTrans38.WriteString(arg37)
-    if err39 != nil {
+    arg83 := flag.Arg(1)
+    mbTrans84 := thrift.NewTMemoryBufferLen(len(arg83))
+    defer mbTrans84.Close()
+    _, err85 := mbTrans84.WriteString(arg83)
+    if err85 != nil {
       Usage()
       return
     }
-    factory40 := thrift.NewTJSONProtocolFactory()
-    jsProt41 := factory40.GetProtocol(mbTrans38)
+    factory86 := thrift.NewTJSONProtocolFactory()
+    jsProt87 := factory86.GetProtocol(mbTrans84)
     argvalue0 := theta.NewSendToken()
-    err42 := argvalue0.Read(jsProt41)
-    if err42 != nil {
+    err88 := argvalue0.Read(jsProt87)
+    if err88 != nil {
       Usage()
       return
     }
@@ -241,8 +261,8 @@ func main() {
       fmt.Fprintln(os.Stderr, "GetBlockByHeight requires 1 args")
       flag.Usage()
     }
-    argvalue0, err44 := (strconv.ParseInt(flag.Arg(1), 10, 64))
-    if err44 != nil {
+    argvalue0, err90 := (strconv.ParseInt(flag.Arg(1), 10, 64))
+    if err90 != nil {
       Usage()
       return
     }
@@ -265,8 +285,8 @@ func main() {
       fmt.Fprintln(os.Stderr, "GetBlockHeaderByHeight requires 1 args")
       flag.Usage()
     }
-    argvalue0, err46 := (strconv.ParseInt(flag.Arg(1), 10, 64))
-    if err46 != nil {
+    argvalue0, err92 := (strconv.ParseInt(flag.Arg(1), 10, 64))
+    if err92 != nil {
       Usage()
       return
     }
@@ -274,6 +294,299 @@ func main() {
     fmt.Print(client.GetBlockHeaderByHeight(context.Background(), value0))
     fmt.Print("\n")
     break
+  case "GetBlocksByRange":
+    if flag.NArg() - 1 != 2 {
+      fmt.Fprintln(os.Stderr, "GetBlocksByRange requires 2 args")
+      flag.Usage()
+    }
+    argvalue0, err93 := (strconv.ParseInt(flag.Arg(1), 10, 64))
+    if err93 != nil {
+      Usage()
+      return
+    }
+    value0 := argvalue0
+    argvalue1, err94 := (strconv.ParseInt(flag.Arg(2), 10, 64))
+    if err94 != nil {
+      Usage()
+      return
+    }
+    value1 := argvalue1
+    fmt.Print(client.GetBlocksByRange(context.Background(), value0, value1))
+    fmt.Print("\n")
+    break
+  case "GetTransaction":
+    if flag.NArg() - 1 != 1 {
+      fmt.Fprintln(os.Stderr, "GetTransaction requires 1 args")
+      flag.Usage()
+    }
+    argvalue0 := flag.Arg(1)
+    value0 := argvalue0
+    fmt.Print(client.GetTransaction(context.Background(), value0))
+    fmt.Print("\n")
+    break
+  case "GetPendingTransactions":
+    if flag.NArg() - 1 != 0 {
+      fmt.Fprintln(os.Stderr, "GetPendingTransactions requires 0 args")
+      flag.Usage()
+    }
+    fmt.Print(client.GetPendingTransactions(context.Background()))
+    fmt.Print("\n")
+    break
+  case "GetStatus":
+    if flag.NArg() - 1 != 0 {
+      fmt.Fprintln(os.Stderr, "GetStatus requires 0 args")
+      flag.Usage()
+    }
+    fmt.Print(client.GetStatus(context.Background()))
+    fmt.Print("\n")
+    break
+  case "GetVcpByHeight":
+    if flag.NArg() - 1 != 1 {
+      fmt.Fprintln(os.Stderr, "GetVcpByHeight requires 1 args")
+      flag.Usage()
+    }
+    argvalue0, err96 := (strconv.ParseInt(flag.Arg(1), 10, 64))
+    if err96 != nil {
+      Usage()
+      return
+    }
+    value0 := argvalue0
+    fmt.Print(client.GetVcpByHeight(context.Background(), value0))
+    fmt.Print("\n")
+    break
+  case "GetGcpByHeight":
+    if flag.NArg() - 1 != 1 {
+      fmt.Fprintln(os.Stderr, "GetGcpByHeight requires 1 args")
+      flag.Usage()
+    }
+    argvalue0, err97 := (strconv.ParseInt(flag.Arg(1), 10, 64))
+    if err97 != nil {
+      Usage()
+      return
+    }
+    value0 := argvalue0
+    fmt.Print(client.GetGcpByHeight(context.Background(), value0))
+    fmt.Print("\n")
+    break
+  case "GetGuardianInfo":
+    if flag.NArg() - 1 != 0 {
+      fmt.Fprintln(os.Stderr, "GetGuardianInfo requires 0 args")
+      flag.Usage()
+    }
+    fmt.Print(client.GetGuardianInfo(context.Background()))
+    fmt.Print("\n")
+    break
+  case "CallSmartContract":
+    if flag.NArg() - 1 != 1 {
+      fmt.Fprintln(os.Stderr, "CallSmartContract requires 1 args")
+      flag.Usage()
+    }
+    arg98 := flag.Arg(1)
+    mbTrans99 := thrift.NewTMemoryBufferLen(len(arg98))
+    defer mbTrans99.Close()
+    _, err100 := mbTrans99.WriteString(arg98)
+    if err100 != nil {
+      Usage()
+      return
+    }
+    factory101 := thrift.NewTJSONProtocolFactory()
+    jsProt102 := factory101.GetProtocol(mbTrans99)
+    argvalue0 := theta.NewSmartContract()
+    err103 := argvalue0.Read(jsProt102)
+    if err103 != nil {
+      Usage()
+      return
+    }
+    value0 := argvalue0
+    fmt.Print(client.CallSmartContract(context.Background(), value0))
+    fmt.Print("\n")
+    break
+  case "DepositStake":
+    if flag.NArg() - 1 != 1 {
+      fmt.Fprintln(os.Stderr, "DepositStake requires 1 args")
+      flag.Usage()
+    }
+    arg104 := flag.Arg(1)
+    mbTrans105 := thrift.NewTMemoryBufferLen(len(arg104))
+    defer mbTrans105.Close()
+    _, err106 := mbTrans105.WriteString(arg104)
+    if err106 != nil {
+      Usage()
+      return
+    }
+    factory107 := thrift.NewTJSONProtocolFactory()
+    jsProt108 := factory107.GetProtocol(mbTrans105)
+    argvalue0 := theta.NewDepositStake()
+    err109 := argvalue0.Read(jsProt108)
+    if err109 != nil {
+      Usage()
+      return
+    }
+    value0 := argvalue0
+    fmt.Print(client.DepositStake(context.Background(), value0))
+    fmt.Print("\n")
+    break
+  case "WithdrawStake":
+    if flag.NArg() - 1 != 1 {
+      fmt.Fprintln(os.Stderr, "WithdrawStake requires 1 args")
+      flag.Usage()
+    }
+    arg110 := flag.Arg(1)
+    mbTrans111 := thrift.NewTMemoryBufferLen(len(arg110))
+    defer mbTrans111.Close()
+    _, err112 := mbTrans111.WriteString(arg110)
+    if err112 != nil {
+      Usage()
+      return
+    }
+    factory113 := thrift.NewTJSONProtocolFactory()
+    jsProt114 := factory113.GetProtocol(mbTrans111)
+    argvalue0 := theta.NewWithdrawStake()
+    err115 := argvalue0.Read(jsProt114)
+    if err115 != nil {
+      Usage()
+      return
+    }
+    value0 := argvalue0
+    fmt.Print(client.WithdrawStake(context.Background(), value0))
+    fmt.Print("\n")
+    break
+  case "BroadcastRawTransaction":
+    if flag.NArg() - 1 != 1 {
+      fmt.Fprintln(os.Stderr, "BroadcastRawTransaction requires 1 args")
+      flag.Usage()
+    }
+    argvalue0 := flag.Arg(1)
+    value0 := argvalue0
+    fmt.Print(client.BroadcastRawTransaction(context.Background(), value0))
+    fmt.Print("\n")
+    break
+  case "UnlockKey":
+    if flag.NArg() - 1 != 2 {
+      fmt.Fprintln(os.Stderr, "UnlockKey requires 2 args")
+      flag.Usage()
+    }
+    argvalue0 := flag.Arg(1)
+    value0 := argvalue0
+    argvalue1 := flag.Arg(2)
+    value1 := argvalue1
+    fmt.Print(client.UnlockKey(context.Background(), value0, value1))
+    fmt.Print("\n")
+    break
+  case "LockKey":
+    if flag.NArg() - 1 != 1 {
+      fmt.Fprintln(os.Stderr, "LockKey requires 1 args")
+      flag.Usage()
+    }
+    argvalue0 := flag.Arg(1)
+    value0 := argvalue0
+    fmt.Print(client.LockKey(context.Background(), value0))
+    fmt.Print("\n")
+    break
+  case "IsKeyUnlocked":
+    if flag.NArg() - 1 != 1 {
+      fmt.Fprintln(os.Stderr, "IsKeyUnlocked requires 1 args")
+      flag.Usage()
+    }
+    argvalue0 := flag.Arg(1)
+    value0 := argvalue0
+    fmt.Print(client.IsKeyUnlocked(context.Background(), value0))
+    fmt.Print("\n")
+    break
+  case "ListKeys":
+    if flag.NArg() - 1 != 0 {
+      fmt.Fprintln(os.Stderr, "ListKeys requires 0 args")
+      flag.Usage()
+    }
+    fmt.Print(client.ListKeys(context.Background()))
+    fmt.Print("\n")
+    break
+  case "GetTokenInfo":
+    if flag.NArg() - 1 != 1 {
+      fmt.Fprintln(os.Stderr, "GetTokenInfo requires 1 args")
+      flag.Usage()
+    }
+    argvalue0 := flag.Arg(1)
+    value0 := argvalue0
+    fmt.Print(client.GetTokenInfo(context.Background(), value0))
+    fmt.Print("\n")
+    break
+  case "GetTokenAllowance":
+    if flag.NArg() - 1 != 3 {
+      fmt.Fprintln(os.Stderr, "GetTokenAllowance requires 3 args")
+      flag.Usage()
+    }
+    argvalue0 := flag.Arg(1)
+    value0 := argvalue0
+    argvalue1 := flag.Arg(2)
+    value1 := argvalue1
+    argvalue2 := flag.Arg(3)
+    value2 := argvalue2
+    fmt.Print(client.GetTokenAllowance(context.Background(), value0, value1, value2))
+    fmt.Print("\n")
+    break
+  case "ApproveToken":
+    if flag.NArg() - 1 != 1 {
+      fmt.Fprintln(os.Stderr, "ApproveToken requires 1 args")
+      flag.Usage()
+    }
+    arg125 := flag.Arg(1)
+    mbTrans126 := thrift.NewTMemoryBufferLen(len(arg125))
+    defer mbTrans126.Close()
+    _, err127 := mbTrans126.WriteString(arg125)
+    if err127 != nil {
+      Usage()
+      return
+    }
+    factory128 := thrift.NewTJSONProtocolFactory()
+    jsProt129 := factory128.GetProtocol(mbTrans126)
+    argvalue0 := theta.NewApproveToken()
+    err130 := argvalue0.Read(jsProt129)
+    if err130 != nil {
+      Usage()
+      return
+    }
+    value0 := argvalue0
+    fmt.Print(client.ApproveToken(context.Background(), value0))
+    fmt.Print("\n")
+    break
+  case "TransferTokenFrom":
+    if flag.NArg() - 1 != 1 {
+      fmt.Fprintln(os.Stderr, "TransferTokenFrom requires 1 args")
+      flag.Usage()
+    }
+    arg131 := flag.Arg(1)
+    mbTrans132 := thrift.NewTMemoryBufferLen(len(arg131))
+    defer mbTrans132.Close()
+    _, err133 := mbTrans132.WriteString(arg131)
+    if err133 != nil {
+      Usage()
+      return
+    }
+    factory134 := thrift.NewTJSONProtocolFactory()
+    jsProt135 := factory134.GetProtocol(mbTrans132)
+    argvalue0 := theta.NewTransferTokenFrom()
+    err136 := argvalue0.Read(jsProt135)
+    if err136 != nil {
+      Usage()
+      return
+    }
+    value0 := argvalue0
+    fmt.Print(client.TransferTokenFrom(context.Background(), value0))
+    fmt.Print("\n")
+    break
+  case "getTokenBalanceString":
+    if flag.NArg() - 1 != 2 {
+      fmt.Fprintln(os.Stderr, "GetTokenBalanceString requires 2 args")
+      flag.Usage()
+    }
+    argvalue0 := flag.Arg(1)
+    value0 := argvalue0
+    argvalue1 := flag.Arg(2)
+    value1 := argvalue1
+    fmt.Print(client.GetTokenBalanceString(context.Background(), value0, value1))
+    fmt.Print("\n")
+    break
   case "":
     Usage()
     break
@@ -4,7 +4,15 @@ enum TErrorCode{
     EGood = 0,
     ENotFound = -1,
     EUnknown = -2 ,
-    EDataExisted = -3
+    EDataExisted = -3,
+    EInvalidArgument = -4,
+    EUnavailable = -5,
+    EKeyLocked = -6
+}
+
+exception TThetaException {
+    1: TErrorCode code,
+    2: string message
 }
 
 struct Version {
@@ -55,6 +63,32 @@ struct TransactionInBlock {
 	3: string hash
 }
 
+struct Log {
+	1: string address,
+	2: list<string> topics,
+	3: string data
+}
+
+struct TxReceipt {
+	1: string tx_hash,
+	2: list<Log> logs,
+	3: string evm_ret,
+	4: string contract_address,
+	5: string gas_used,
+	6: string evm_error
+}
+
+struct Transaction {
+	1: string block_hash,
+	2: string block_height,
+	3: string status,
+	4: string hash,
+	5: i32 type,
+	6: RawTransaction raw,
+	7: string json,
+	8: TxReceipt receipt
+}
+
 struct TransactionResult {
 	1: string block_hash,
 	2: string block_height,
@@ -164,6 +198,50 @@ struct SmartContract {
 	7: string to_address
 }
 
+struct Status {
+	1: string address,
+	2: string chain_id,
+	3: string peer_id,
+	4: string latest_finalized_block_hash,
+	5: string latest_finalized_block_height,
+	6: string latest_finalized_block_time,
+	7: string latest_finalized_block_epoch,
+	8: string current_epoch,
+	9: string current_height,
+	10: string current_time,
+	11: bool syncing
+}
+
+struct Stake {
+	1: string source,
+	2: string amount,
+	3: bool withdrawn,
+	4: string return_height
+}
+
+struct StakeHolder {
+	1: string holder,
+	2: list<Stake> stakes
+}
+
+struct BlockHashVcpPair {
+	1: string block_hash,
+	2: list<StakeHolder> candidates,
+	3: list<string> stake_tx_heights
+}
+
+struct BlockHashGcpPair {
+	1: string block_hash,
+	2: list<StakeHolder> guardians
+}
+
+struct GuardianInfo {
+	1: string bls_pubkey,
+	2: string bls_pop,
+	3: string address,
+	4: string signature
+}
+
 struct NewKey {
 	1: string address
 }
@@ -177,11 +255,13 @@ struct StatusKey {
 }
 
 struct Send {
-	1: string private_key,
+	// Deprecated: the transactions are signed with the unlocked key of from_address, requests setting it are rejected
+	1: optional string private_key,
 	2: string to,
 	3: string thetawei,
 	4: string tfuelwei,
 	5: string fee,
+	6: string from_address
 }
 
 struct AccountResult {
@@ -204,9 +284,62 @@ struct Error {
 }
 
 struct SendToken {
-    1: string private_key,
+    // Deprecated: the transactions are signed with the unlocked key of from_address, requests setting it are rejected
+    1: optional string private_key,
     2: string to,
-    3: i32 amount
+    // Deprecated: replaced by amount, which holds the full uint256 token amount, requests setting it are rejected
+    3: optional i32 deprecated_amount,
+    4: string contract_address,
+    5: string gas_limit,
+    6: string gas_price,
+    7: string from_address,
+    8: string amount
+}
+
+struct ApproveToken {
+    1: string from_address,
+    2: string spender,
+    3: string amount,
+    4: string contract_address,
+    5: string gas_limit,
+    6: string gas_price
+}
+
+struct TransferTokenFrom {
+    1: string from_address,
+    2: string owner,
+    3: string to,
+    4: string amount,
+    5: string contract_address,
+    6: string gas_limit,
+    7: string gas_price
+}
+
+struct TokenInfo {
+    1: string contract_address,
+    2: string name,
+    3: string symbol,
+    4: i32 decimals,
+    5: string total_supply
+}
+
+struct DepositStake {
+	// Deprecated: the transactions are signed with the unlocked key of source, requests setting it are rejected
+	1: optional string private_key,
+	2: string holder,
+	3: string stake,
+	4: i32 purpose,
+	5: string fee,
+	6: string source
+}
+
+struct WithdrawStake {
+	// Deprecated: the transactions are signed with the unlocked key of source, requests setting it are rejected
+	1: optional string private_key,
+	2: string holder,
+	3: i32 purpose,
+	4: string fee,
+	5: string source
 }
 
 struct SmartContractCall {
@@ -224,12 +357,33 @@ struct BlockResult {
 }
 
 service ThetaService{
-    Account getAccount(1: string account)
-	BroadcastRawTransactionAsync sendTx(1: Send send)
-	i64 getTokenBalance(1: string address, 2: string contract_address, 3: string private_key)
-	BroadcastRawTransactionAsync sendToken(1: SendToken send)
-	Block GetBlock(1: string hash)
-	Block GetBlockByHeight(1: i64 height)
-	BlockHeader GetBlockHeader(1: string hash)
-	BlockHeader GetBlockHeaderByHeight(1: i64 height)
+    Account getAccount(1: string account) throws (1: TThetaException err)
+	BroadcastRawTransactionAsync sendTx(1: Send send) throws (1: TThetaException err)
+	// Deprecated: the balance overflows i64 for most tokens and private_key is ignored, use getTokenBalanceString instead
+	i64 getTokenBalance(1: string address, 2: string contract_address, 3: string private_key) throws (1: TThetaException err)
+	BroadcastRawTransactionAsync sendToken(1: SendToken send) throws (1: TThetaException err)
+	Block GetBlock(1: string hash) throws (1: TThetaException err)
+	Block GetBlockByHeight(1: i64 height) throws (1: TThetaException err)
+	BlockHeader GetBlockHeader(1: string hash) throws (1: TThetaException err)
+	BlockHeader GetBlockHeaderByHeight(1: i64 height) throws (1: TThetaException err)
+	list<Block> GetBlocksByRange(1: i64 start, 2: i64 end) throws (1: TThetaException err)
+	Transaction GetTransaction(1: string hash) throws (1: TThetaException err)
+	PendingTransaction GetPendingTransactions() throws (1: TThetaException err)
+	Status GetStatus() throws (1: TThetaException err)
+	list<BlockHashVcpPair> GetVcpByHeight(1: i64 height) throws (1: TThetaException err)
+	list<BlockHashGcpPair> GetGcpByHeight(1: i64 height) throws (1: TThetaException err)
+	GuardianInfo GetGuardianInfo() throws (1: TThetaException err)
+	SmartContractCall CallSmartContract(1: SmartContract contract) throws (1: TThetaException err)
+	BroadcastRawTransactionAsync DepositStake(1: DepositStake deposit) throws (1: TThetaException err)
+	BroadcastRawTransactionAsync WithdrawStake(1: WithdrawStake withdraw) throws (1: TThetaException err)
+	BroadcastRawTransactionAsync BroadcastRawTransaction(1: string tx_bytes) throws (1: TThetaException err)
+	StatusKey UnlockKey(1: string address, 2: string password) throws (1: TThetaException err)
+	StatusKey LockKey(1: string address) throws (1: TThetaException err)
+	StatusKey IsKeyUnlocked(1: string address) throws (1: TThetaException err)
+	ListKeys ListKeys() throws (1: TThetaException err)
+	TokenInfo GetTokenInfo(1: string contract_address) throws (1: TThetaException err)
+	string GetTokenAllowance(1: string contract_address, 2: string owner, 3: string spender) throws (1: TThetaException err)
+	BroadcastRawTransactionAsync ApproveToken(1: ApproveToken approve) throws (1: TThetaException err)
+	BroadcastRawTransactionAsync TransferTokenFrom(1: TransferTokenFrom transfer) throws (1: TThetaException err)
+	string getTokenBalanceString(1: string address, 2: string contract_address) throws (1: TThetaException err)
 }
\ No newline at end of file
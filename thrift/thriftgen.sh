@@ -1 +1 @@
-thrift -gen go rpc.thrift
\ No newline at end of file
+thrift -gen go:package_prefix=theta/thrift/gen-go/ rpc.thrift
\ No newline at end of file
@@ -0,0 +1,262 @@
+package thriftrpcserver
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"strconv"
+
+	"theta/blockchain"
+	"theta/common/hexutil"
+	"theta/core"
+	"theta/ledger/types"
+	"theta/rpc"
+	"theta/thrift/gen-go/rpc/theta"
+)
+
+func toAccount(account *types.Account) *theta.Account {
+	reservedFunds := []string{}
+	for _, fund := range account.ReservedFunds {
+		raw, err := json.Marshal(fund)
+		if err != nil {
+			continue
+		}
+		reservedFunds = append(reservedFunds, string(raw))
+	}
+	return &theta.Account{
+		Sequence:               strconv.FormatUint(account.Sequence, 10),
+		Coins:                  toCoin(account.Balance),
+		ReservedFunds:          reservedFunds,
+		LastUpdatedBlockHeight: strconv.FormatUint(account.LastUpdatedBlockHeight, 10),
+		Root:                   account.Root.Hex(),
+		Code:                   account.CodeHash.Hex(),
+	}
+}
+
+func toCoin(coins types.Coins) *theta.Coin {
+	return &theta.Coin{
+		Thetawei: bigToString(coins.ThetaWei),
+		Tfuelwei: bigToString(coins.TFuelWei),
+	}
+}
+
+func toBlock(block *rpc.GetBlockResultInner) (*theta.Block, error) {
+	children := []string{}
+	for _, child := range block.Children {
+		children = append(children, child.Hex())
+	}
+	txs := []*theta.TransactionInBlock{}
+	for _, tx := range block.Txs {
+		raw, err := toRawTransaction(tx.Tx)
+		if err != nil {
+			return nil, err
+		}
+		txs = append(txs, &theta.TransactionInBlock{
+			Raw:  raw,
+			Type: int32(tx.Type),
+			Hash: tx.Hash.Hex(),
+		})
+	}
+	return &theta.Block{
+		ChainID:          block.ChainID,
+		Epoch:            strconv.FormatUint(uint64(block.Epoch), 10),
+		Height:           strconv.FormatUint(uint64(block.Height), 10),
+		Parent:           block.Parent.Hex(),
+		TransactionsHash: block.TxHash.Hex(),
+		StateHash:        block.StateHash.Hex(),
+		Timestamp:        bigToString((*big.Int)(block.Timestamp)),
+		Proposer:         block.Proposer.Hex(),
+		Children:         children,
+		Status:           int32(block.Status),
+		Hash:             block.Hash.Hex(),
+		Transactions:     txs,
+		Hcc:              toHCC(block.HCC),
+	}, nil
+}
+
+func toHCC(cc core.CommitCertificate) *theta.HCC {
+	votes := []*theta.Vote{}
+	if cc.Votes != nil {
+		for _, vote := range cc.Votes.Votes() {
+			v := &theta.Vote{
+				Block:  vote.Block.Hex(),
+				Epoch:  int32(vote.Epoch),
+				Height: int32(vote.Height),
+				ID:     vote.ID.Hex(),
+			}
+			if vote.Signature != nil {
+				v.Signature = hexutil.Encode(vote.Signature.ToBytes())
+			}
+			votes = append(votes, v)
+		}
+	}
+	return &theta.HCC{
+		Votes:     votes,
+		BlockHash: cc.BlockHash.Hex(),
+	}
+}
+
+func toBlockHeader(block *theta.Block) *theta.BlockHeader {
+	return &theta.BlockHeader{
+		ChainID:          block.ChainID,
+		Epoch:            block.Epoch,
+		Height:           block.Height,
+		Parent:           block.Parent,
+		TransactionsHash: block.TransactionsHash,
+		StateHash:        block.StateHash,
+		Timestamp:        block.Timestamp,
+		Proposer:         block.Proposer,
+		Children:         block.Children,
+		Status:           block.Status,
+		Hash:             block.Hash,
+		Hcc:              block.Hcc,
+	}
+}
+
+// toRawTransaction extracts the common fields of the different transaction types
+// from the JSON representation of the transaction.
+func toRawTransaction(tx types.Tx) (*theta.RawTransaction, error) {
+	raw, err := json.Marshal(tx)
+	if err != nil {
+		return nil, newThetaException(theta.TErrorCode_EUnknown, "Failed to encode transaction: %v", err)
+	}
+	result := &theta.RawTransaction{}
+	if err := json.Unmarshal(raw, result); err != nil {
+		return nil, newThetaException(theta.TErrorCode_EUnknown, "Failed to parse transaction: %v", err)
+	}
+	return result, nil
+}
+
+func toTransaction(res *rpc.GetTransactionResult) (*theta.Transaction, error) {
+	tx := &theta.Transaction{
+		BlockHash:   res.BlockHash.Hex(),
+		BlockHeight: strconv.FormatUint(uint64(res.BlockHeight), 10),
+		Status:      string(res.Status),
+		Hash:        res.TxHash.Hex(),
+		Type:        int32(res.Type),
+		Receipt:     toTxReceipt(res.Receipt),
+	}
+	if res.Tx != nil {
+		raw, err := json.Marshal(res.Tx)
+		if err != nil {
+			return nil, newThetaException(theta.TErrorCode_EUnknown, "Failed to encode transaction: %v", err)
+		}
+		tx.JSON = string(raw)
+		if tx.Raw, err = toRawTransaction(res.Tx); err != nil {
+			return nil, err
+		}
+	}
+	return tx, nil
+}
+
+func toTxReceipt(receipt *blockchain.TxReceiptEntry) *theta.TxReceipt {
+	if receipt == nil {
+		return nil
+	}
+	logs := []*theta.Log{}
+	for _, l := range receipt.Logs {
+		topics := []string{}
+		for _, topic := range l.Topics {
+			topics = append(topics, topic.Hex())
+		}
+		logs = append(logs, &theta.Log{
+			Address: l.Address.Hex(),
+			Topics:  topics,
+			Data:    hex.EncodeToString(l.Data),
+		})
+	}
+	return &theta.TxReceipt{
+		TxHash:          receipt.TxHash.Hex(),
+		Logs:            logs,
+		EvmRet:          hex.EncodeToString(receipt.EvmRet),
+		ContractAddress: receipt.ContractAddress.Hex(),
+		GasUsed:         strconv.FormatUint(receipt.GasUsed, 10),
+		EvmError:        receipt.EvmErr,
+	}
+}
+
+func toStatus(status *rpc.GetStatusResult) *theta.Status {
+	return &theta.Status{
+		Address:                    status.Address,
+		ChainID:                    status.ChainID,
+		PeerID:                     status.PeerID,
+		LatestFinalizedBlockHash:   status.LatestFinalizedBlockHash.Hex(),
+		LatestFinalizedBlockHeight: strconv.FormatUint(uint64(status.LatestFinalizedBlockHeight), 10),
+		LatestFinalizedBlockTime:   bigToString((*big.Int)(status.LatestFinalizedBlockTime)),
+		LatestFinalizedBlockEpoch:  strconv.FormatUint(uint64(status.LatestFinalizedBlockEpoch), 10),
+		CurrentEpoch:               strconv.FormatUint(uint64(status.CurrentEpoch), 10),
+		CurrentHeight:              strconv.FormatUint(uint64(status.CurrentHeight), 10),
+		CurrentTime:                bigToString((*big.Int)(status.CurrentTime)),
+		Syncing:                    status.Syncing,
+	}
+}
+
+func toStakeHolders(holders []*core.StakeHolder) []*theta.StakeHolder {
+	result := []*theta.StakeHolder{}
+	for _, holder := range holders {
+		stakes := []*theta.Stake{}
+		for _, stake := range holder.Stakes {
+			stakes = append(stakes, &theta.Stake{
+				Source:       stake.Source.Hex(),
+				Amount:       stake.Amount.String(),
+				Withdrawn:    stake.Withdrawn,
+				ReturnHeight: strconv.FormatUint(stake.ReturnHeight, 10),
+			})
+		}
+		result = append(result, &theta.StakeHolder{
+			Holder: holder.Holder.Hex(),
+			Stakes: stakes,
+		})
+	}
+	return result
+}
+
+func toVcpPairs(res *rpc.GetVcpResult) []*theta.BlockHashVcpPair {
+	pairs := []*theta.BlockHashVcpPair{}
+	for _, p := range res.BlockHashVcpPairs {
+		pair := &theta.BlockHashVcpPair{
+			BlockHash:      p.BlockHash.Hex(),
+			Candidates:     []*theta.StakeHolder{},
+			StakeTxHeights: []string{},
+		}
+		if p.Vcp != nil {
+			pair.Candidates = toStakeHolders(p.Vcp.SortedCandidates)
+		}
+		if p.HeightList != nil {
+			for _, height := range p.HeightList.Heights {
+				pair.StakeTxHeights = append(pair.StakeTxHeights, strconv.FormatUint(height, 10))
+			}
+		}
+		pairs = append(pairs, pair)
+	}
+	return pairs
+}
+
+func toGcpPairs(res *rpc.GetGcpResult) []*theta.BlockHashGcpPair {
+	pairs := []*theta.BlockHashGcpPair{}
+	for _, p := range res.BlockHashGcpPairs {
+		pair := &theta.BlockHashGcpPair{
+			BlockHash: p.BlockHash.Hex(),
+			Guardians: []*theta.StakeHolder{},
+		}
+		if p.Gcp != nil {
+			holders := []*core.StakeHolder{}
+			for _, g := range p.Gcp.SortedGuardians {
+				if g.StakeHolder == nil {
+					continue
+				}
+				holders = append(holders, g.StakeHolder)
+			}
+			pair.Guardians = toStakeHolders(holders)
+		}
+		pairs = append(pairs, pair)
+	}
+	return pairs
+}
+
+func bigToString(value *big.Int) string {
+	if value == nil {
+		return "0"
+	}
+	return value.String()
+}
@@ -0,0 +1,50 @@
+package thriftrpcserver
+
+import (
+	"fmt"
+	"strings"
+
+	"theta/thrift/gen-go/rpc/theta"
+)
+
+var errSigningDisabled = newThetaException(theta.TErrorCode_EUnavailable,
+	"Server-side signing is disabled, use BroadcastRawTransaction to submit signed transactions")
+
+var errPrivateKeyNotAccepted = newThetaException(theta.TErrorCode_EInvalidArgument,
+	"The private_key field is no longer accepted, unlock the key with UnlockKey and set the signer address instead")
+
+func newThetaException(code theta.TErrorCode, format string, a ...interface{}) *theta.TThetaException {
+	return &theta.TThetaException{
+		Code:    code,
+		Message: fmt.Sprintf(format, a...),
+	}
+}
+
+// toThetaException converts the error returned by the theta.* RPC methods into a TThetaException
+// so that thrift clients can tell the failure cases apart by error code.
+func toThetaException(err error) *theta.TThetaException {
+	if e, ok := err.(*theta.TThetaException); ok {
+		return e
+	}
+	return newThetaException(errorCodeFromMessage(err.Error()), "%s", err.Error())
+}
+
+// errorCodeFromMessage classifies the error messages returned by the theta.* RPC methods.
+func errorCodeFromMessage(msg string) theta.TErrorCode {
+	lower := strings.ToLower(msg)
+	switch {
+	case strings.Contains(lower, "not found"),
+		strings.Contains(lower, "not exist"):
+		return theta.TErrorCode_ENotFound
+	case strings.Contains(lower, "already"):
+		return theta.TErrorCode_EDataExisted
+	case strings.Contains(lower, "must be"),
+		strings.Contains(lower, "can't retrieve"),
+		strings.Contains(lower, "invalid"),
+		strings.Contains(lower, "failed to parse"),
+		strings.Contains(lower, "failed to decode"):
+		return theta.TErrorCode_EInvalidArgument
+	default:
+		return theta.TErrorCode_EUnknown
+	}
+}
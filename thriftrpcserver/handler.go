@@ -1,493 +1,638 @@
-package main
+package thriftrpcserver
 
 import (
 	"context"
 	"encoding/hex"
-	"encoding/json"
-	"errors"
-	"fmt"
-	"github.com/pborman/uuid"
-	"io/ioutil"
-	"log"
 	"math/big"
-	"net/http"
 	"strconv"
 	"strings"
-	"theta/cmd/thetacli/cmd/utils"
+
 	"theta/common"
+	"theta/core"
 	"theta/crypto"
+	"theta/crypto/bls"
 	"theta/ledger/types"
+	"theta/rpc"
 	"theta/thrift/gen-go/rpc/theta"
-	"theta/wallet/softwallet/keystore"
+	wt "theta/wallet/types"
+)
+
+const (
+	defaultGasLimit = 10000000
+	defaultGasPrice = 100000000
 )
 
+// Service is the subset of the theta.* RPC methods used by RpcHandler. It is
+// implemented by rpc.ThetaRPCService.
+type Service interface {
+	GetAccount(args *rpc.GetAccountArgs, result *rpc.GetAccountResult) error
+	GetBlock(args *rpc.GetBlockArgs, result *rpc.GetBlockResult) error
+	GetBlockByHeight(args *rpc.GetBlockByHeightArgs, result *rpc.GetBlockResult) error
+	GetBlocksByRange(args *rpc.GetBlocksByRangeArgs, result *rpc.GetBlocksResult) error
+	GetTransaction(args *rpc.GetTransactionArgs, result *rpc.GetTransactionResult) error
+	GetPendingTransactions(args *rpc.GetPendingTransactionsArgs, result *rpc.GetPendingTransactionsResult) error
+	GetStatus(args *rpc.GetStatusArgs, result *rpc.GetStatusResult) error
+	GetVcpByHeight(args *rpc.GetVcpByHeightArgs, result *rpc.GetVcpResult) error
+	GetGcpByHeight(args *rpc.GetGcpByHeightArgs, result *rpc.GetGcpResult) error
+	GetGuardianInfo(args *rpc.GetGuardianInfoArgs, result *rpc.GetGuardianInfoResult) error
+	CallSmartContract(args *rpc.CallSmartContractArgs, result *rpc.CallSmartContractResult) error
+	BroadcastRawTransactionAsync(args *rpc.BroadcastRawTransactionAsyncArgs, result *rpc.BroadcastRawTransactionAsyncResult) error
+	GetTokenInfo(args *rpc.GetTokenInfoArgs, result *rpc.GetTokenInfoResult) error
+	GetTokenBalance(args *rpc.GetTokenBalanceArgs, result *rpc.GetTokenBalanceResult) error
+	GetTokenAllowance(args *rpc.GetTokenAllowanceArgs, result *rpc.GetTokenAllowanceResult) error
+}
+
+var _ Service = (*rpc.ThetaRPCService)(nil)
+
+// RpcHandler implements theta.ThetaService by calling the theta.* RPC methods
+// of the node in-process. Transactions are signed with the unlocked keys of the
+// wallet. If the wallet is nil, only pre-signed transactions are accepted.
 type RpcHandler struct {
-	serverAddress string
-	chainID       string
+	service Service
+	wallet  wt.Wallet
+	chainID string
 }
 
-func (r *RpcHandler) GetBlockHeader(ctx context.Context, hash string) (*theta.BlockHeader, error) {
-	body, err := r.SendRpc("{\"jsonrpc\":\"2.0\",\"method\":\"theta.GetBlock\"," +
-		"\"params\":[" +
-		"{\"hash\":\"" + hash + "\"}]," +
-		"\"id\":1}")
-	if err != nil {
-		return nil, err
+// NewRpcHandler creates a new RpcHandler instance.
+func NewRpcHandler(service Service, wallet wt.Wallet, chainID string) *RpcHandler {
+	return &RpcHandler{
+		service: service,
+		wallet:  wallet,
+		chainID: chainID,
 	}
+}
+
+var _ theta.ThetaService = (*RpcHandler)(nil)
+
+// ------------------------------ Queries -----------------------------------
 
-	result := theta.BlockResult_{}
-	err = json.Unmarshal(body, &result)
+func (r *RpcHandler) GetAccount(ctx context.Context, address string) (*theta.Account, error) {
+	if address == "" {
+		return nil, newThetaException(theta.TErrorCode_EInvalidArgument, "Address must be specified")
+	}
+	account, err := r.getAccount(address)
 	if err != nil {
 		return nil, err
 	}
+	return toAccount(account), nil
+}
 
-	if result.Error != nil && result.Error.Code != 0 {
-		return nil, errors.New(result.Error.Message)
+func (r *RpcHandler) GetBlock(ctx context.Context, hash string) (*theta.Block, error) {
+	if hash == "" {
+		return nil, newThetaException(theta.TErrorCode_EInvalidArgument, "Block hash must be specified")
+	}
+	result := &rpc.GetBlockResult{}
+	if err := r.service.GetBlock(&rpc.GetBlockArgs{Hash: common.HexToHash(hash)}, result); err != nil {
+		return nil, toThetaException(err)
+	}
+	if result.GetBlockResultInner == nil {
+		return nil, newThetaException(theta.TErrorCode_ENotFound, "Block %v is not found", hash)
 	}
+	return toBlock(result.GetBlockResultInner)
+}
 
-	return &theta.BlockHeader{
-		ChainID:          result.Result_.ChainID,
-		Epoch:            result.Result_.Epoch,
-		Height:           result.Result_.Height,
-		Parent:           result.Result_.Parent,
-		TransactionsHash: result.Result_.TransactionsHash,
-		StateHash:        result.Result_.StateHash,
-		Timestamp:        result.Result_.Timestamp,
-		Proposer:         result.Result_.Proposer,
-		Children:         result.Result_.Children,
-		Status:           result.Result_.Status,
-		Hash:             result.Result_.Hash,
-		Hcc:              result.Result_.Hcc,
-	}, nil
+func (r *RpcHandler) GetBlockByHeight(ctx context.Context, height int64) (*theta.Block, error) {
+	if height < 0 {
+		return nil, newThetaException(theta.TErrorCode_EInvalidArgument, "Block height must not be negative")
+	}
+	result := &rpc.GetBlockResult{}
+	if err := r.service.GetBlockByHeight(&rpc.GetBlockByHeightArgs{Height: common.JSONUint64(height)}, result); err != nil {
+		return nil, toThetaException(err)
+	}
+	if result.GetBlockResultInner == nil {
+		return nil, newThetaException(theta.TErrorCode_ENotFound, "Block at height %v is not found", height)
+	}
+	return toBlock(result.GetBlockResultInner)
 }
 
-func (r *RpcHandler) GetBlockHeaderByHeight(ctx context.Context, height int64) (*theta.BlockHeader, error) {
-	body, err := r.SendRpc("{\"jsonrpc\":\"2.0\",\"method\":\"theta.GetBlockByHeight\"," +
-		"\"params\":[" +
-		"{\"height\":\"" + fmt.Sprint(height) + "\"}]," +
-		"\"id\":1}")
+func (r *RpcHandler) GetBlockHeader(ctx context.Context, hash string) (*theta.BlockHeader, error) {
+	block, err := r.GetBlock(ctx, hash)
 	if err != nil {
 		return nil, err
 	}
+	return toBlockHeader(block), nil
+}
 
-	result := theta.BlockResult_{}
-	err = json.Unmarshal(body, &result)
+func (r *RpcHandler) GetBlockHeaderByHeight(ctx context.Context, height int64) (*theta.BlockHeader, error) {
+	block, err := r.GetBlockByHeight(ctx, height)
 	if err != nil {
 		return nil, err
 	}
+	return toBlockHeader(block), nil
+}
 
-	if result.Error != nil && result.Error.Code != 0 {
-		return nil, errors.New(result.Error.Message)
+func (r *RpcHandler) GetBlocksByRange(ctx context.Context, start int64, end int64) ([]*theta.Block, error) {
+	if start < 0 || end < 0 {
+		return nil, newThetaException(theta.TErrorCode_EInvalidArgument, "Block heights must not be negative")
 	}
+	args := &rpc.GetBlocksByRangeArgs{
+		Start: common.JSONUint64(start),
+		End:   common.JSONUint64(end),
+	}
+	result := rpc.GetBlocksResult{}
+	if err := r.service.GetBlocksByRange(args, &result); err != nil {
+		return nil, toThetaException(err)
+	}
+	blocks := []*theta.Block{}
+	for _, b := range result {
+		block, err := toBlock(b)
+		if err != nil {
+			return nil, err
+		}
+		blocks = append(blocks, block)
+	}
+	return blocks, nil
+}
 
-	return &theta.BlockHeader{
-		ChainID:          result.Result_.ChainID,
-		Epoch:            result.Result_.Epoch,
-		Height:           result.Result_.Height,
-		Parent:           result.Result_.Parent,
-		TransactionsHash: result.Result_.TransactionsHash,
-		StateHash:        result.Result_.StateHash,
-		Timestamp:        result.Result_.Timestamp,
-		Proposer:         result.Result_.Proposer,
-		Children:         result.Result_.Children,
-		Status:           result.Result_.Status,
-		Hash:             result.Result_.Hash,
-		Hcc:              result.Result_.Hcc,
-	}, nil
+func (r *RpcHandler) GetTransaction(ctx context.Context, hash string) (*theta.Transaction, error) {
+	if hash == "" {
+		return nil, newThetaException(theta.TErrorCode_EInvalidArgument, "Transaction hash must be specified")
+	}
+	result := &rpc.GetTransactionResult{}
+	if err := r.service.GetTransaction(&rpc.GetTransactionArgs{Hash: hash}, result); err != nil {
+		return nil, toThetaException(err)
+	}
+	if result.Status == rpc.TxStatusNotFound {
+		return nil, newThetaException(theta.TErrorCode_ENotFound, "Transaction %v is not found", hash)
+	}
+	return toTransaction(result)
 }
 
-func (r *RpcHandler) GetBlock(ctx context.Context, hash string) (*theta.Block, error) {
-	body, err := r.SendRpc("{\"jsonrpc\":\"2.0\",\"method\":\"theta.GetBlock\"," +
-		"\"params\":[" +
-		"{\"hash\":\"" + hash + "\"}]," +
-		"\"id\":1}")
-	if err != nil {
-		return nil, err
+func (r *RpcHandler) GetPendingTransactions(ctx context.Context) (*theta.PendingTransaction, error) {
+	result := &rpc.GetPendingTransactionsResult{}
+	if err := r.service.GetPendingTransactions(&rpc.GetPendingTransactionsArgs{}, result); err != nil {
+		return nil, toThetaException(err)
 	}
+	return &theta.PendingTransaction{TxHashes: result.TxHashes}, nil
+}
 
-	result := theta.BlockResult_{}
-	err = json.Unmarshal(body, &result)
-	if err != nil {
-		return nil, err
+func (r *RpcHandler) GetStatus(ctx context.Context) (*theta.Status, error) {
+	result := &rpc.GetStatusResult{}
+	if err := r.service.GetStatus(&rpc.GetStatusArgs{}, result); err != nil {
+		return nil, toThetaException(err)
+	}
+	return toStatus(result), nil
+}
+
+func (r *RpcHandler) GetVcpByHeight(ctx context.Context, height int64) ([]*theta.BlockHashVcpPair, error) {
+	if height < 0 {
+		return nil, newThetaException(theta.TErrorCode_EInvalidArgument, "Block height must not be negative")
 	}
+	result := &rpc.GetVcpResult{}
+	if err := r.service.GetVcpByHeight(&rpc.GetVcpByHeightArgs{Height: common.JSONUint64(height)}, result); err != nil {
+		return nil, toThetaException(err)
+	}
+	return toVcpPairs(result), nil
+}
 
-	if result.Error != nil && result.Error.Code != 0 {
-		return nil, errors.New(result.Error.Message)
+func (r *RpcHandler) GetGcpByHeight(ctx context.Context, height int64) ([]*theta.BlockHashGcpPair, error) {
+	if height < 0 {
+		return nil, newThetaException(theta.TErrorCode_EInvalidArgument, "Block height must not be negative")
+	}
+	result := &rpc.GetGcpResult{}
+	if err := r.service.GetGcpByHeight(&rpc.GetGcpByHeightArgs{Height: common.JSONUint64(height)}, result); err != nil {
+		return nil, toThetaException(err)
 	}
+	return toGcpPairs(result), nil
+}
 
-	return result.Result_, nil
+func (r *RpcHandler) GetGuardianInfo(ctx context.Context) (*theta.GuardianInfo, error) {
+	result := &rpc.GetGuardianInfoResult{}
+	if err := r.service.GetGuardianInfo(&rpc.GetGuardianInfoArgs{}, result); err != nil {
+		return nil, toThetaException(err)
+	}
+	return &theta.GuardianInfo{
+		BlsPubkey: result.BLSPubkey,
+		BlsPop:    result.BLSPop,
+		Address:   result.Address,
+		Signature: result.Signature,
+	}, nil
 }
 
-func (r *RpcHandler) GetBlockByHeight(ctx context.Context, height int64) (*theta.Block, error) {
-	body, err := r.SendRpc("{\"jsonrpc\":\"2.0\",\"method\":\"theta.GetBlockByHeight\"," +
-		"\"params\":[" +
-		"{\"height\":\"" + fmt.Sprint(height) + "\"}]," +
-		"\"id\":1}")
+// ------------------------------ Smart contracts -----------------------------------
+
+func (r *RpcHandler) CallSmartContract(ctx context.Context, contract *theta.SmartContract) (*theta.SmartContractCall, error) {
+	if contract == nil {
+		return nil, newThetaException(theta.TErrorCode_EInvalidArgument, "Smart contract must be specified")
+	}
+	gasPrice, err := parseWei("gas price", contract.GasPrice, big.NewInt(defaultGasPrice))
 	if err != nil {
 		return nil, err
 	}
-
-	result := theta.BlockResult_{}
-	err = json.Unmarshal(body, &result)
+	gasLimit, err := parseGasLimit(contract.GasLimit)
 	if err != nil {
 		return nil, err
 	}
-
-	if result.Error != nil && result.Error.Code != 0 {
-		return nil, errors.New(result.Error.Message)
+	sequence := uint64(0)
+	if contract.Seq != "" {
+		if sequence, err = strconv.ParseUint(contract.Seq, 10, 64); err != nil {
+			return nil, newThetaException(theta.TErrorCode_EInvalidArgument, "Failed to parse sequence: %v", contract.Seq)
+		}
+	}
+	data, err := hex.DecodeString(strings.TrimPrefix(contract.Data, "0x"))
+	if err != nil {
+		return nil, newThetaException(theta.TErrorCode_EInvalidArgument, "Failed to decode data: %v", err)
 	}
 
-	return result.Result_, nil
+	sctx := &types.SmartContractTx{
+		From: types.TxInput{
+			Address:  common.HexToAddress(contract.FromAddress),
+			Coins:    types.NewCoins(0, 0),
+			Sequence: sequence,
+		},
+		To: types.TxOutput{
+			Address: common.HexToAddress(contract.ToAddress),
+		},
+		GasLimit: gasLimit,
+		GasPrice: gasPrice,
+		Data:     data,
+	}
+	return r.callSmartContract(sctx)
 }
 
-func (r *RpcHandler) GetAccount(ctx context.Context, address string) (*theta.Account, error) {
-	body, err := r.SendRpc("{\n" +
-		"\"jsonrpc\":\"2.0\",\n" +
-		"\"method\":\"theta.GetAccount\",\n" +
-		"\"params\":[{\"address\":\"" + address +"\"}],\n" +
-		"\"id\":1\n}")
-	result := theta.AccountResult_{}
-	err = json.Unmarshal(body, &result)
+// ------------------------------ Transactions -----------------------------------
+
+func (r *RpcHandler) SendTx(ctx context.Context, send *theta.Send) (*theta.BroadcastRawTransactionAsync, error) {
+	if send == nil {
+		return nil, newThetaException(theta.TErrorCode_EInvalidArgument, "Send must be specified")
+	}
+	if send.IsSetPrivateKey() {
+		return nil, errPrivateKeyNotAccepted
+	}
+	from, err := r.signerAddress(send.FromAddress)
 	if err != nil {
-		log.Println(err)
 		return nil, err
 	}
-	if result.Error != nil && result.Error.Code != 0 {
-		return nil, errors.New(result.Error.Message)
+	if err := validateRecipient(from, send.To); err != nil {
+		return nil, err
 	}
 
-	log.Println(result)
-
-	return result.Result_, nil
-}
-
-func (r *RpcHandler)SendToken(context context.Context, send *theta.SendToken) (*theta.BroadcastRawTransactionAsync, error) {
-	prefix := "a9059cbb"
-	decoded, err := hex.DecodeString(send.PrivateKey)
+	thetawei, err := parseWei("thetawei", send.Thetawei, nil)
 	if err != nil {
-		log.Println(err)
 		return nil, err
 	}
-
-	privateKey, err := crypto.PrivateKeyFromBytes(decoded)
+	tfuelwei, err := parseWei("tfuelwei", send.Tfuelwei, nil)
 	if err != nil {
-		log.Println("thriftrpcserver/handler.go:57", err)
-		log.Println(len(decoded))
 		return nil, err
 	}
-
-	if len(send.To) == 0 {
-		return nil, fmt.Errorf("The from and to address cannot be empty")
-	}
-	if privateKey.PublicKey().Address().String() == send.To {
-		return nil, fmt.Errorf("The from and to address cannot be identical")
-	}
-
-	data := prefix + "000000000000000000000000" + send.To + fmt.Sprintf("%640X", send.Amount)
-
-	dataHex, err := hex.DecodeString(data)
+	fee, err := parseWei("fee", send.Fee, nil)
 	if err != nil {
-		log.Println("thriftrpcserver/handler.go:71 ", err)
 		return nil, err
 	}
 
-	acc, err := r.GetAccount(context, privateKey.PublicKey().Address().String())
+	sequence, err := r.nextSequence(from.Hex())
 	if err != nil {
-		log.Println("thriftrpcserver/handler.go:77 ", err)
 		return nil, err
 	}
 
-	seq, err := strconv.ParseInt(acc.Sequence, 10, 64)
+	sendTx := &types.SendTx{
+		Fee: types.Coins{
+			ThetaWei: big.NewInt(0),
+			TFuelWei: fee,
+		},
+		Inputs: []types.TxInput{{
+			Address: from,
+			Coins: types.Coins{
+				TFuelWei: new(big.Int).Add(tfuelwei, fee),
+				ThetaWei: thetawei,
+			},
+			Sequence: sequence,
+		}},
+		Outputs: []types.TxOutput{{
+			Address: common.HexToAddress(send.To),
+			Coins: types.Coins{
+				TFuelWei: tfuelwei,
+				ThetaWei: thetawei,
+			},
+		}},
+	}
+
+	sig, err := r.sign(from, sendTx.SignBytes(r.chainID))
 	if err != nil {
-		log.Println("thriftrpcserver/handler.go:83", err)
 		return nil, err
 	}
+	sendTx.SetSignature(from, sig)
 
-	from := types.TxInput{
-		Address: privateKey.PublicKey().Address(),
-		Coins: types.Coins{
-			ThetaWei: new(big.Int).SetUint64(0),
-			TFuelWei: new(big.Int).SetUint64(0),
-		},
-		Sequence: uint64(seq + 1),
-	}
+	return r.broadcastTx(sendTx)
+}
 
-	to := types.TxOutput{
-		Address: common.HexToAddress(send.To),
+func (r *RpcHandler) DepositStake(ctx context.Context, deposit *theta.DepositStake) (*theta.BroadcastRawTransactionAsync, error) {
+	if deposit == nil {
+		return nil, newThetaException(theta.TErrorCode_EInvalidArgument, "Deposit must be specified")
 	}
-
-	smartContractTx := &types.SmartContractTx{
-		From:     from,
-		To:       to,
-		GasLimit: 10000000,
-		GasPrice: big.NewInt(100000000),
-		Data:     dataHex,
+	if deposit.IsSetPrivateKey() {
+		return nil, errPrivateKeyNotAccepted
 	}
-	
-	sig, err := privateKey.Sign(smartContractTx.SignBytes(r.chainID))
+	source, err := r.signerAddress(deposit.Source)
 	if err != nil {
 		return nil, err
 	}
 
-	smartContractTx.SetSignature(privateKey.PublicKey().Address(), sig)
-	raw, err := types.TxToBytes(smartContractTx)
+	stake, err := parseWei("stake", deposit.Stake, nil)
 	if err != nil {
-		utils.Error("Failed to encode transaction: %v\n", err)
+		return nil, err
 	}
-	signedTx := hex.EncodeToString(raw)
-	msg := "{" +
-		"\"jsonrpc\":\"2.0\"," +
-		"\"method\":\"theta.BroadcastRawTransactionAsync\"," +
-		"\"params\":[" +
-		"{\"tx_bytes\":\""+ signedTx + "\"}]" +
-		",\"id\":1}"
-
-	body, err := r.SendRpc(msg)
+	fee, err := parseWei("fee", deposit.Fee, r.minimumFee())
 	if err != nil {
-		log.Println(err)
 		return nil, err
 	}
-
-	result := theta.BroadcastRawTransactionAsyncResult_{}
-	err = json.Unmarshal(body, &result)
+	sequence, err := r.nextSequence(source.Hex())
 	if err != nil {
-		log.Println(err)
 		return nil, err
 	}
 
-	if result.Error != nil && result.Error.Code != 0 {
-		return nil, errors.New(result.Error.Message)
+	depositStakeTx := &types.DepositStakeTxV2{
+		Fee: types.Coins{
+			ThetaWei: big.NewInt(0),
+			TFuelWei: fee,
+		},
+		Source: types.TxInput{
+			Address: source,
+			Coins: types.Coins{
+				ThetaWei: stake,
+				TFuelWei: big.NewInt(0),
+			},
+			Sequence: sequence,
+		},
+		Purpose: uint8(deposit.Purpose),
+	}
+	if err := setStakeHolder(depositStakeTx, deposit.Holder); err != nil {
+		return nil, err
 	}
 
-	log.Println(result)
+	sig, err := r.sign(source, depositStakeTx.SignBytes(r.chainID))
+	if err != nil {
+		return nil, err
+	}
+	depositStakeTx.SetSignature(source, sig)
 
-	return result.Result_, nil
+	return r.broadcastTx(depositStakeTx)
 }
 
-func (r *RpcHandler) GetTokenBalance(context context.Context, address, contractAddress, fromAddress string) (int64, error) {
-	prefix := "70a08231"
-	data := prefix + "000000000000000000000000" + address
-	log.Println(data)
-
-	acc, err := r.GetAccount(context, fromAddress)
+func (r *RpcHandler) WithdrawStake(ctx context.Context, withdraw *theta.WithdrawStake) (*theta.BroadcastRawTransactionAsync, error) {
+	if withdraw == nil {
+		return nil, newThetaException(theta.TErrorCode_EInvalidArgument, "Withdraw must be specified")
+	}
+	if withdraw.IsSetPrivateKey() {
+		return nil, errPrivateKeyNotAccepted
+	}
+	source, err := r.signerAddress(withdraw.Source)
 	if err != nil {
-		log.Println("thriftrpcserver/handler.go:74 ", err)
-		return 0, err
+		return nil, err
 	}
 
-	seq, err := strconv.ParseInt(acc.Sequence, 10, 64)
+	fee, err := parseWei("fee", withdraw.Fee, r.minimumFee())
 	if err != nil {
-		log.Println("thriftrpcserver/handler.go:80", err)
-		return 0, err
+		return nil, err
 	}
-
-	from := types.TxInput{
-		Address: common.HexToAddress(fromAddress),
-		Coins: types.Coins{
-			ThetaWei: new(big.Int).SetUint64(0),
-			TFuelWei: new(big.Int).SetUint64(0),
-		},
-		Sequence: uint64(seq + 1),
+	sequence, err := r.nextSequence(source.Hex())
+	if err != nil {
+		return nil, err
 	}
 
-	to := types.TxOutput{
-		Address: common.HexToAddress(contractAddress),
+	withdrawStakeTx := &types.WithdrawStakeTx{
+		Fee: types.Coins{
+			ThetaWei: big.NewInt(0),
+			TFuelWei: fee,
+		},
+		Source: types.TxInput{
+			Address:  source,
+			Coins:    types.NewCoins(0, 0),
+			Sequence: sequence,
+		},
+		Holder: types.TxOutput{
+			Address: common.HexToAddress(withdraw.Holder),
+		},
+		Purpose: uint8(withdraw.Purpose),
 	}
 
-	dataHex, err := hex.DecodeString(data)
+	sig, err := r.sign(source, withdrawStakeTx.SignBytes(r.chainID))
 	if err != nil {
-		log.Println("thriftrpcserver/handler.go:87 ", err)
-		return 0, err
+		return nil, err
 	}
+	withdrawStakeTx.SetSignature(source, sig)
 
-	smartContractTx := &types.SmartContractTx{
-		From:     from,
-		To:       to,
-		GasLimit: 10000000,
-		GasPrice: big.NewInt(100000000),
-		Data:     dataHex,
-	}
+	return r.broadcastTx(withdrawStakeTx)
+}
 
-	raw, err := types.TxToBytes(smartContractTx)
-	if err != nil {
-		log.Println("thriftrpcserver/handler.go:116 ", err)
-		return 0, err
+func (r *RpcHandler) BroadcastRawTransaction(ctx context.Context, txBytes string) (*theta.BroadcastRawTransactionAsync, error) {
+	raw, err := hex.DecodeString(strings.TrimPrefix(txBytes, "0x"))
+	if err != nil || len(raw) == 0 {
+		return nil, newThetaException(theta.TErrorCode_EInvalidArgument, "Failed to decode transaction bytes: %v", txBytes)
 	}
-
-	signedTx := hex.EncodeToString(raw)
-	msg := "{" +
-		"\"jsonrpc\":\"2.0\"," +
-		"\"method\":\"theta.CallSmartContract\"," +
-		"\"params\":[" +
-		"{\"sctx_bytes\":\""+ signedTx + "\"}]" +
-		",\"id\":1}"
-	body, err := r.SendRpc(msg)
-	if err != nil {
-		log.Println("thriftrpcserver/handler.go:129", err)
-		return 0, err
+	if _, err := types.TxFromBytes(raw); err != nil {
+		return nil, newThetaException(theta.TErrorCode_EInvalidArgument, "Failed to decode transaction: %v", err)
 	}
+	return r.broadcastRawTx(raw)
+}
 
-	result := theta.SmartContractCall{}
-	err = json.Unmarshal(body, &result)
-
-	balance, _ := strconv.ParseInt(result.VMReturn, 10, 64)
+// ------------------------------ Keys -----------------------------------
 
-	return balance, nil
+func (r *RpcHandler) UnlockKey(ctx context.Context, address string, password string) (*theta.StatusKey, error) {
+	if r.wallet == nil {
+		return nil, errSigningDisabled
+	}
+	if address == "" {
+		return nil, newThetaException(theta.TErrorCode_EInvalidArgument, "Address must be specified")
+	}
+	addr := common.HexToAddress(address)
+	if err := r.wallet.Unlock(addr, password, nil); err != nil {
+		return nil, newThetaException(theta.TErrorCode_EInvalidArgument, "Failed to unlock key %v: %v", address, err)
+	}
+	return &theta.StatusKey{Unlocked: r.wallet.IsUnlocked(addr)}, nil
 }
 
-func (r *RpcHandler) SendTx(context context.Context, send *theta.Send) (*theta.BroadcastRawTransactionAsync, error) {
-	decoded, err := hex.DecodeString(send.PrivateKey)
-	if err != nil {
-		log.Println(err)
-		return nil, err
+func (r *RpcHandler) LockKey(ctx context.Context, address string) (*theta.StatusKey, error) {
+	if r.wallet == nil {
+		return nil, errSigningDisabled
+	}
+	addr := common.HexToAddress(address)
+	if err := r.wallet.Lock(addr); err != nil {
+		return nil, newThetaException(theta.TErrorCode_EUnknown, "Failed to lock key %v: %v", address, err)
 	}
+	return &theta.StatusKey{Unlocked: r.wallet.IsUnlocked(addr)}, nil
+}
 
-	privateKey, err := crypto.PrivateKeyFromBytes(decoded)
-	if err != nil {
-		log.Println("thriftrpcserver/handler.go:109", err)
-		log.Println(len(decoded))
-		return nil, err
+func (r *RpcHandler) IsKeyUnlocked(ctx context.Context, address string) (*theta.StatusKey, error) {
+	if r.wallet == nil {
+		return nil, errSigningDisabled
 	}
+	return &theta.StatusKey{Unlocked: r.wallet.IsUnlocked(common.HexToAddress(address))}, nil
+}
 
-	if len(send.To) == 0 {
-		return nil, fmt.Errorf("The from and to address cannot be empty")
+func (r *RpcHandler) ListKeys(ctx context.Context) (*theta.ListKeys, error) {
+	if r.wallet == nil {
+		return nil, errSigningDisabled
 	}
-	if privateKey.PublicKey().Address().String() == send.To {
-		return nil, fmt.Errorf("The from and to address cannot be identical")
+	addresses, err := r.wallet.List()
+	if err != nil {
+		return nil, newThetaException(theta.TErrorCode_EUnknown, "Failed to list keys: %v", err)
+	}
+	result := &theta.ListKeys{Addresses: []string{}}
+	for _, address := range addresses {
+		result.Addresses = append(result.Addresses, address.Hex())
 	}
+	return result, nil
+}
 
-	chainID := r.chainID
-	to := common.HexToAddress(send.To)
+// ------------------------------ Utils -----------------------------------
 
-	thetawei, ok := new(big.Int).SetString(send.Thetawei, 10)
-	if !ok {
-		return nil, fmt.Errorf("Failed to parse thetawei: %v", send.Thetawei)
+// signerAddress returns the address the transaction is signed by, which must have
+// been unlocked in the wallet.
+func (r *RpcHandler) signerAddress(address string) (common.Address, error) {
+	if r.wallet == nil {
+		return common.Address{}, errSigningDisabled
 	}
-	tfuelwei, ok := new(big.Int).SetString(send.Tfuelwei, 10)
-	if !ok {
-		return nil, fmt.Errorf("Failed to parse tfuelwei: %v", send.Tfuelwei)
+	if address == "" {
+		return common.Address{}, newThetaException(theta.TErrorCode_EInvalidArgument, "The from address cannot be empty")
 	}
-	fee, ok := new(big.Int).SetString(send.Fee, 10)
-	if !ok {
-		return nil, fmt.Errorf("Failed to parse fee: %v", send.Fee)
+	// NOTE: the input is not echoed in the errors, in case a client puts a private key in the address field
+	if !common.IsHexAddress(address) {
+		return common.Address{}, newThetaException(theta.TErrorCode_EInvalidArgument, "The from address is not a valid address")
 	}
-
-	acc, err := r.GetAccount(context, privateKey.PublicKey().Address().String())
-	if err != nil {
-		log.Println(err)
-		return nil, err
+	addr := common.HexToAddress(address)
+	if !r.wallet.IsUnlocked(addr) {
+		return common.Address{}, newThetaException(theta.TErrorCode_EKeyLocked, "The from address has not been unlocked yet")
 	}
+	return addr, nil
+}
 
-	seq, err := strconv.ParseInt(acc.Sequence, 10, 64)
+func (r *RpcHandler) sign(address common.Address, signBytes common.Bytes) (*crypto.Signature, error) {
+	sig, err := r.wallet.Sign(address, signBytes)
 	if err != nil {
-		log.Println("thriftrpcserver/handler.go:80", err)
-		return nil, err
+		return nil, newThetaException(theta.TErrorCode_EUnknown, "Failed to sign transaction: %v", err)
 	}
+	return sig, nil
+}
 
-	inputs := []types.TxInput{{
-		Address: privateKey.PublicKey().Address(),
-		Coins: types.Coins{
-			TFuelWei: new(big.Int).Add(tfuelwei, fee),
-			ThetaWei: thetawei,
-		},
-		Sequence: uint64(seq + 1),
-	}}
-	outputs := []types.TxOutput{{
-		Address: to,
-		Coins: types.Coins{
-			TFuelWei: tfuelwei,
-			ThetaWei: thetawei,
-		},
-	}}
-	sendTx := &types.SendTx{
-		Fee: types.Coins{
-			ThetaWei: new(big.Int).SetUint64(0),
-			TFuelWei: fee,
-		},
-		Inputs:  inputs,
-		Outputs: outputs,
+func (r *RpcHandler) callSmartContract(sctx *types.SmartContractTx) (*theta.SmartContractCall, error) {
+	raw, err := types.TxToBytes(sctx)
+	if err != nil {
+		return nil, newThetaException(theta.TErrorCode_EUnknown, "Failed to encode transaction: %v", err)
 	}
-
-	signBytes := sendTx.SignBytes(chainID)
-	key := &keystore.Key{
-		Id:         uuid.NewRandom(),
-		Address:    privateKey.PublicKey().Address(),
-		PrivateKey: privateKey,
+	result := &rpc.CallSmartContractResult{}
+	args := &rpc.CallSmartContractArgs{SctxBytes: hex.EncodeToString(raw)}
+	if err := r.service.CallSmartContract(args, result); err != nil {
+		return nil, toThetaException(err)
 	}
+	return &theta.SmartContractCall{
+		ContractAddress: result.ContractAddress.Hex(),
+		GasUsed:         strconv.FormatUint(uint64(result.GasUsed), 10),
+		VMError:         result.VmError,
+		VMReturn:        result.VmReturn,
+	}, nil
+}
 
-	sig, err := key.Sign(signBytes)
-	if err != nil {
-		log.Println(err)
-		return nil, err
+func (r *RpcHandler) getAccount(address string) (*types.Account, error) {
+	result := &rpc.GetAccountResult{}
+	if err := r.service.GetAccount(&rpc.GetAccountArgs{Address: address}, result); err != nil {
+		return nil, toThetaException(err)
 	}
+	return result.Account, nil
+}
 
-	sendTx.SetSignature(privateKey.PublicKey().Address(), sig)
-	raw, err := types.TxToBytes(sendTx)
+// nextSequence returns the sequence number the next transaction sent from the address should use.
+func (r *RpcHandler) nextSequence(address string) (uint64, error) {
+	account, err := r.getAccount(address)
 	if err != nil {
-		log.Println(err)
-		return nil, err
+		return 0, err
 	}
+	return account.Sequence + 1, nil
+}
 
-	signedTx := hex.EncodeToString(raw)
-	msg := "{" +
-		"\"jsonrpc\":\"2.0\"," +
-		"\"method\":\"theta.BroadcastRawTransactionAsync\"," +
-		"\"params\":[" +
-		"{\"tx_bytes\":\""+ signedTx + "\"}]" +
-		",\"id\":1}"
-	body, err := r.SendRpc(msg)
+func (r *RpcHandler) broadcastTx(tx types.Tx) (*theta.BroadcastRawTransactionAsync, error) {
+	raw, err := types.TxToBytes(tx)
 	if err != nil {
-		log.Println(err)
-		return nil, err
-	}
-	result := theta.BroadcastRawTransactionAsyncResult_{}
-	err = json.Unmarshal(body, &result)
-	if err != nil {
-		log.Println(err)
-		return nil, err
+		return nil, newThetaException(theta.TErrorCode_EUnknown, "Failed to encode transaction: %v", err)
 	}
+	return r.broadcastRawTx(raw)
+}
 
-	if result.Error != nil && result.Error.Code != 0 {
-		return nil, errors.New(result.Error.Message)
+func (r *RpcHandler) broadcastRawTx(raw common.Bytes) (*theta.BroadcastRawTransactionAsync, error) {
+	result := &rpc.BroadcastRawTransactionAsyncResult{}
+	args := &rpc.BroadcastRawTransactionAsyncArgs{TxBytes: hex.EncodeToString(raw)}
+	if err := r.service.BroadcastRawTransactionAsync(args, result); err != nil {
+		return nil, toThetaException(err)
 	}
+	return &theta.BroadcastRawTransactionAsync{Hash: result.TxHash}, nil
+}
 
-	log.Println(result)
-	log.Println(string(body))
+// minimumFee returns the minimum transaction fee of the chain, which is the default fee of the
+// stake transactions.
+func (r *RpcHandler) minimumFee() *big.Int {
+	return new(big.Int).SetUint64(core.GetChainConfig(r.chainID).MinimumTransactionFeeTFuelWei)
+}
 
-	return result.Result_, nil
+// parseWei parses a decimal wei amount. The default value is used if the amount is
+// empty and the default is not nil.
+func parseWei(name string, amount string, defaultValue *big.Int) (*big.Int, error) {
+	if amount == "" && defaultValue != nil {
+		return defaultValue, nil
+	}
+	value, ok := new(big.Int).SetString(amount, 10)
+	if !ok || value.Sign() < 0 {
+		return nil, newThetaException(theta.TErrorCode_EInvalidArgument, "Failed to parse %v: %v", name, amount)
+	}
+	return value, nil
 }
 
-func (r *RpcHandler) SendRpc(msg string) ([]byte, error) {
-	urlAddress := r.serverAddress
-	method := "POST"
+// parseGasLimit parses the gas limit, the default gas limit is used if it is empty.
+func parseGasLimit(gasLimit string) (uint64, error) {
+	if gasLimit == "" {
+		return defaultGasLimit, nil
+	}
+	value, err := strconv.ParseUint(gasLimit, 10, 64)
+	if err != nil {
+		return 0, newThetaException(theta.TErrorCode_EInvalidArgument, "Failed to parse gas limit: %v", gasLimit)
+	}
+	return value, nil
+}
 
-	payload := strings.NewReader(msg)
+func validateRecipient(from common.Address, to string) error {
+	if len(to) == 0 {
+		return newThetaException(theta.TErrorCode_EInvalidArgument, "The to address cannot be empty")
+	}
+	if from == common.HexToAddress(to) {
+		return newThetaException(theta.TErrorCode_EInvalidArgument, "The from and to address cannot be identical")
+	}
+	return nil
+}
 
-	client := &http.Client {}
-	req, err := http.NewRequest(method, urlAddress, payload)
+// setStakeHolder sets the stake holder of the deposit. For guardian stakes the holder
+// is the guardian key, i.e. the address followed by the BLS pubkey, BLS pop and the holder signature.
+func setStakeHolder(tx *types.DepositStakeTxV2, holder string) error {
+	holder = strings.TrimPrefix(holder, "0x")
+	if tx.Purpose == core.StakeForValidator {
+		if len(holder) != 40 {
+			return newThetaException(theta.TErrorCode_EInvalidArgument, "Holder must be a valid address")
+		}
+		tx.Holder = types.TxOutput{Address: common.HexToAddress(holder)}
+		return nil
+	}
 
+	if len(holder) != 458 {
+		return newThetaException(theta.TErrorCode_EInvalidArgument, "Holder must be a valid guardian key")
+	}
+	guardianKeyBytes, err := hex.DecodeString(holder)
 	if err != nil {
-		log.Println(err)
-		return nil, err
+		return newThetaException(theta.TErrorCode_EInvalidArgument, "Failed to decode guardian key: %v", err)
 	}
-	req.Header.Add("Content-Type", "application/json")
-
-	res, err := client.Do(req)
+	blsPubkey, err := bls.PublicKeyFromBytes(guardianKeyBytes[20:68])
 	if err != nil {
-		fmt.Println(err)
-		return nil, err
+		return newThetaException(theta.TErrorCode_EInvalidArgument, "Failed to decode BLS pubkey: %v", err)
 	}
-	defer res.Body.Close()
-
-	body, err := ioutil.ReadAll(res.Body)
+	blsPop, err := bls.SignatureFromBytes(guardianKeyBytes[68:164])
 	if err != nil {
-		fmt.Println(err)
-		return nil, err
+		return newThetaException(theta.TErrorCode_EInvalidArgument, "Failed to decode BLS pop: %v", err)
+	}
+	holderSig, err := crypto.SignatureFromBytes(guardianKeyBytes[164:])
+	if err != nil {
+		return newThetaException(theta.TErrorCode_EInvalidArgument, "Failed to decode holder signature: %v", err)
 	}
-	re := map[string]interface{}{}
-	_ = json.Unmarshal(body, &re)
-	b, _ := json.MarshalIndent(re, "", "    ")
 
-	log.Println(string(b))
-	return body, nil
+	tx.Holder = types.TxOutput{Address: common.BytesToAddress(guardianKeyBytes[:20])}
+	tx.BlsPubkey = blsPubkey
+	tx.BlsPop = blsPop
+	tx.HolderSig = holderSig
+	return nil
 }
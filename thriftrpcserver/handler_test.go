@@ -0,0 +1,329 @@
+package thriftrpcserver
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"theta/common"
+	"theta/core"
+	"theta/ledger/types"
+	"theta/rpc"
+	"theta/thrift/gen-go/rpc/theta"
+	sw "theta/wallet/softwallet"
+)
+
+var errNotImplemented = errors.New("not implemented")
+
+type mockService struct {
+	accounts map[string]*types.Account
+	txs      map[string]*rpc.GetTransactionResult
+	status   *rpc.GetStatusResult
+	balances map[string]*big.Int
+	txBytes  []string
+}
+
+func (s *mockService) GetAccount(args *rpc.GetAccountArgs, result *rpc.GetAccountResult) error {
+	account, ok := s.accounts[args.Address]
+	if !ok {
+		return errors.New("Account with address " + args.Address + " is not found")
+	}
+	result.Account = account
+	return nil
+}
+
+func (s *mockService) GetBlock(args *rpc.GetBlockArgs, result *rpc.GetBlockResult) error {
+	if args.Hash.IsEmpty() {
+		return errors.New("Block hash must be specified")
+	}
+	return errNotImplemented
+}
+
+func (s *mockService) GetBlockByHeight(args *rpc.GetBlockByHeightArgs, result *rpc.GetBlockResult) error {
+	return nil
+}
+
+func (s *mockService) GetBlocksByRange(args *rpc.GetBlocksByRangeArgs, result *rpc.GetBlocksResult) error {
+	return errNotImplemented
+}
+
+func (s *mockService) GetTransaction(args *rpc.GetTransactionArgs, result *rpc.GetTransactionResult) error {
+	tx, ok := s.txs[args.Hash]
+	if !ok {
+		result.Status = rpc.TxStatusNotFound
+		return nil
+	}
+	*result = *tx
+	return nil
+}
+
+func (s *mockService) GetPendingTransactions(args *rpc.GetPendingTransactionsArgs, result *rpc.GetPendingTransactionsResult) error {
+	return errNotImplemented
+}
+
+func (s *mockService) GetStatus(args *rpc.GetStatusArgs, result *rpc.GetStatusResult) error {
+	*result = *s.status
+	return nil
+}
+
+func (s *mockService) GetVcpByHeight(args *rpc.GetVcpByHeightArgs, result *rpc.GetVcpResult) error {
+	return errNotImplemented
+}
+
+func (s *mockService) GetGcpByHeight(args *rpc.GetGcpByHeightArgs, result *rpc.GetGcpResult) error {
+	return errNotImplemented
+}
+
+func (s *mockService) GetGuardianInfo(args *rpc.GetGuardianInfoArgs, result *rpc.GetGuardianInfoResult) error {
+	return errNotImplemented
+}
+
+func (s *mockService) CallSmartContract(args *rpc.CallSmartContractArgs, result *rpc.CallSmartContractResult) error {
+	return errNotImplemented
+}
+
+func (s *mockService) BroadcastRawTransactionAsync(args *rpc.BroadcastRawTransactionAsyncArgs, result *rpc.BroadcastRawTransactionAsyncResult) error {
+	s.txBytes = append(s.txBytes, args.TxBytes)
+	result.TxHash = "0x01"
+	return nil
+}
+
+func (s *mockService) GetTokenInfo(args *rpc.GetTokenInfoArgs, result *rpc.GetTokenInfoResult) error {
+	return errNotImplemented
+}
+
+func (s *mockService) GetTokenBalance(args *rpc.GetTokenBalanceArgs, result *rpc.GetTokenBalanceResult) error {
+	balance, ok := s.balances[args.Address]
+	if !ok {
+		return errors.New("Failed to call token contract " + args.ContractAddress + ": execution reverted")
+	}
+	result.Balance = (*common.JSONBig)(balance)
+	return nil
+}
+
+func (s *mockService) GetTokenAllowance(args *rpc.GetTokenAllowanceArgs, result *rpc.GetTokenAllowanceResult) error {
+	return errNotImplemented
+}
+
+func TestErrorCodeFromMessage(t *testing.T) {
+	assert := assert.New(t)
+
+	assert.Equal(theta.TErrorCode_ENotFound, errorCodeFromMessage("Account with address 0x01 is not found"))
+	assert.Equal(theta.TErrorCode_ENotFound, errorCodeFromMessage("the VCP for height 10 does not exists, it might have been pruned"))
+	assert.Equal(theta.TErrorCode_EDataExisted, errorCodeFromMessage("Transaction already seen"))
+	assert.Equal(theta.TErrorCode_EInvalidArgument, errorCodeFromMessage("Block hash must be specified"))
+	assert.Equal(theta.TErrorCode_EUnknown, errorCodeFromMessage("insufficient fund"))
+
+	assert.Equal(theta.TErrorCode_ENotFound, toThetaException(errors.New("key not found")).Code)
+	assert.Equal(theta.TErrorCode_EInvalidArgument, toThetaException(newThetaException(theta.TErrorCode_EInvalidArgument, "not found")).Code)
+}
+
+func TestRpcHandler(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	sendTx := &types.SendTx{
+		Fee: types.NewCoins(0, 1000000000000),
+		Inputs: []types.TxInput{{
+			Address:  common.HexToAddress("0x2e833968e5bb786ae419c4d13189fb081cc43bab"),
+			Coins:    types.NewCoins(0, 1000000000000),
+			Sequence: 3,
+		}},
+	}
+	service := &mockService{
+		accounts: map[string]*types.Account{},
+		txs: map[string]*rpc.GetTransactionResult{
+			"0x02": {
+				BlockHash:   common.HexToHash("0x01"),
+				BlockHeight: 10,
+				Status:      rpc.TxStatusFinalized,
+				TxHash:      common.HexToHash("0x02"),
+				Type:        2,
+				Tx:          sendTx,
+			},
+		},
+		status: &rpc.GetStatusResult{
+			ChainID:                    "privatenet",
+			LatestFinalizedBlockHeight: 12,
+			CurrentTime:                (*common.JSONBig)(big.NewInt(1600000000)),
+			Syncing:                    true,
+		},
+	}
+	handler := NewRpcHandler(service, nil, "privatenet")
+
+	tx, err := handler.GetTransaction(context.Background(), "0x02")
+	require.Nil(err)
+	assert.Equal("10", tx.BlockHeight)
+	assert.Equal(int32(2), tx.Type)
+	require.Equal(1, len(tx.Raw.Inputs))
+	assert.Equal("3", tx.Raw.Inputs[0].Sequence)
+	assert.Equal("1000000000000", tx.Raw.Fee.Tfuelwei)
+	assert.Contains(tx.JSON, `"sequence":"3"`)
+	assert.Nil(tx.Receipt)
+
+	status, err := handler.GetStatus(context.Background())
+	require.Nil(err)
+	assert.Equal("privatenet", status.ChainID)
+	assert.Equal("12", status.LatestFinalizedBlockHeight)
+	assert.Equal("0", status.LatestFinalizedBlockTime)
+	assert.Equal("1600000000", status.CurrentTime)
+	assert.True(status.Syncing)
+
+	_, err = handler.GetAccount(context.Background(), "0x01")
+	require.NotNil(err)
+	assert.Equal(theta.TErrorCode_ENotFound, err.(*theta.TThetaException).Code)
+
+	account := types.NewAccount(common.HexToAddress("0x01"))
+	account.Sequence = 7
+	service.accounts["0x01"] = account
+	acc, err := handler.GetAccount(context.Background(), "0x01")
+	require.Nil(err)
+	assert.Equal("7", acc.Sequence)
+	assert.Equal("0", acc.Coins.Thetawei)
+	sequence, err := handler.nextSequence("0x01")
+	require.Nil(err)
+	assert.Equal(uint64(8), sequence)
+
+	_, err = handler.GetBlock(context.Background(), "")
+	require.NotNil(err)
+	assert.Equal(theta.TErrorCode_EInvalidArgument, err.(*theta.TThetaException).Code)
+
+	_, err = handler.GetBlockByHeight(context.Background(), 5)
+	require.NotNil(err)
+	assert.Equal(theta.TErrorCode_ENotFound, err.(*theta.TThetaException).Code)
+
+	_, err = handler.GetTransaction(context.Background(), "0x03")
+	require.NotNil(err)
+	assert.Equal(theta.TErrorCode_ENotFound, err.(*theta.TThetaException).Code)
+}
+
+func TestRpcHandlerSigning(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	dir, err := ioutil.TempDir("", "theta-thrift-test")
+	require.Nil(err)
+	defer os.RemoveAll(dir)
+
+	wallet, err := sw.NewSoftWallet(dir, sw.KeystoreTypeEncrypted)
+	require.Nil(err)
+	from, err := wallet.NewKey("qwertyuiop")
+	require.Nil(err)
+	require.Nil(wallet.Lock(from))
+
+	service := &mockService{
+		accounts: map[string]*types.Account{from.Hex(): types.NewAccount(from)},
+	}
+	send := &theta.Send{
+		FromAddress: from.Hex(),
+		To:          "0x0d2fd67d573c8ecb4161510fc00754d64b401f86",
+		Thetawei:    "0",
+		Tfuelwei:    "10",
+		Fee:         "1000000000000",
+	}
+
+	// Server-side signing disabled
+	handler := NewRpcHandler(service, nil, "privatenet")
+	_, err = handler.SendTx(context.Background(), send)
+	require.NotNil(err)
+	assert.Equal(theta.TErrorCode_EUnavailable, err.(*theta.TThetaException).Code)
+
+	// Key not unlocked yet
+	handler = NewRpcHandler(service, wallet, "privatenet")
+	_, err = handler.SendTx(context.Background(), send)
+	require.NotNil(err)
+	assert.Equal(theta.TErrorCode_EKeyLocked, err.(*theta.TThetaException).Code)
+
+	// The private keys sent by the legacy clients are rejected, and never echoed
+	privateKey := "2e3c3a3e1f0d8a3d0c6b0e4c8bb2d1ad3b9f5d39c6ad6bd1f8e4c9b5f1b3a7c9"
+	legacySend := *send
+	legacySend.PrivateKey = &privateKey
+	_, err = handler.SendTx(context.Background(), &legacySend)
+	require.NotNil(err)
+	assert.Equal(errPrivateKeyNotAccepted, err)
+
+	legacySend = *send
+	legacySend.FromAddress = privateKey
+	_, err = handler.SendTx(context.Background(), &legacySend)
+	require.NotNil(err)
+	assert.Equal(theta.TErrorCode_EInvalidArgument, err.(*theta.TThetaException).Code)
+	assert.NotContains(strings.ToLower(err.Error()), privateKey[24:])
+
+	_, err = handler.DepositStake(context.Background(), &theta.DepositStake{PrivateKey: &privateKey})
+	assert.Equal(errPrivateKeyNotAccepted, err)
+	_, err = handler.WithdrawStake(context.Background(), &theta.WithdrawStake{PrivateKey: &privateKey})
+	assert.Equal(errPrivateKeyNotAccepted, err)
+
+	_, err = handler.UnlockKey(context.Background(), from.Hex(), "wrong password")
+	require.NotNil(err)
+	status, err := handler.UnlockKey(context.Background(), from.Hex(), "qwertyuiop")
+	require.Nil(err)
+	assert.True(status.Unlocked)
+
+	_, err = handler.SendTx(context.Background(), send)
+	require.Nil(err)
+	require.Equal(1, len(service.txBytes))
+	raw, err := hex.DecodeString(service.txBytes[0])
+	require.Nil(err)
+	tx, err := types.TxFromBytes(raw)
+	require.Nil(err)
+	sendTx := tx.(*types.SendTx)
+	assert.Equal(uint64(1), sendTx.Inputs[0].Sequence)
+	assert.True(sendTx.Inputs[0].Signature.Verify(sendTx.SignBytes("privatenet"), from))
+
+	// Pre-signed transactions are broadcast as is
+	_, err = handler.BroadcastRawTransaction(context.Background(), "0x"+service.txBytes[0])
+	require.Nil(err)
+	require.Equal(2, len(service.txBytes))
+	assert.Equal(service.txBytes[0], service.txBytes[1])
+
+	_, err = handler.BroadcastRawTransaction(context.Background(), "0x0102")
+	require.NotNil(err)
+	assert.Equal(theta.TErrorCode_EInvalidArgument, err.(*theta.TThetaException).Code)
+
+	// The stake transactions default to the minimum fee of the chain
+	chainConfig := core.MainnetChainConfig()
+	chainConfig.ChainID = "thrift_fee_test_chain"
+	chainConfig.MinimumTransactionFeeTFuelWei = 5e12
+	require.Nil(core.RegisterChainConfig(chainConfig))
+	feeHandler := NewRpcHandler(service, wallet, chainConfig.ChainID)
+	_, err = feeHandler.DepositStake(context.Background(), &theta.DepositStake{
+		Source:  from.Hex(),
+		Holder:  "0x0d2fd67d573c8ecb4161510fc00754d64b401f86",
+		Stake:   "1000",
+		Purpose: int32(core.StakeForValidator),
+	})
+	require.Nil(err)
+	_, err = feeHandler.WithdrawStake(context.Background(), &theta.WithdrawStake{
+		Source:  from.Hex(),
+		Holder:  "0x0d2fd67d573c8ecb4161510fc00754d64b401f86",
+		Purpose: int32(core.StakeForValidator),
+	})
+	require.Nil(err)
+	require.Equal(4, len(service.txBytes))
+	for _, txBytes := range service.txBytes[2:] {
+		raw, err := hex.DecodeString(txBytes)
+		require.Nil(err)
+		tx, err := types.TxFromBytes(raw)
+		require.Nil(err)
+		switch tx := tx.(type) {
+		case *types.DepositStakeTxV2:
+			assert.Equal(int64(5e12), tx.Fee.TFuelWei.Int64())
+		case *types.WithdrawStakeTx:
+			assert.Equal(int64(5e12), tx.Fee.TFuelWei.Int64())
+		default:
+			t.Fatalf("unexpected tx type %T", tx)
+		}
+	}
+
+	status, err = handler.LockKey(context.Background(), from.Hex())
+	require.Nil(err)
+	assert.False(status.Unlocked)
+}
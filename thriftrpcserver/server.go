@@ -0,0 +1,226 @@
+package thriftrpcserver
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/apache/thrift/lib/go/thrift"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+	"theta/common"
+	"theta/common/util"
+	"theta/thrift/gen-go/rpc/theta"
+	sw "theta/wallet/softwallet"
+	wt "theta/wallet/types"
+)
+
+var logger *log.Entry = log.WithFields(log.Fields{"prefix": "thrift"})
+
+const (
+	ProtocolBinary  = "binary"
+	ProtocolCompact = "compact"
+
+	ServerTypeSimple   = "simple"
+	ServerTypeThreaded = "threaded"
+
+	SignerKeystore = "keystore"
+	SignerNone     = "none"
+)
+
+// ThriftServer serves the theta.ThetaService Thrift API inside the node.
+type ThriftServer struct {
+	handler    *RpcHandler
+	protocol   thrift.TProtocolFactory
+	serverType string
+	server     *thrift.TSimpleServer
+
+	// Life cycle
+	wg      *sync.WaitGroup
+	ctx     context.Context
+	cancel  context.CancelFunc
+	stopped bool
+}
+
+// NewThriftServer creates a new instance of ThriftServer. The protocol, server type
+// and signer are read from the config.
+func NewThriftServer(service Service, chainID string) (*ThriftServer, error) {
+	protocol, err := newProtocolFactory(viper.GetString(common.CfgThriftProtocol))
+	if err != nil {
+		return nil, err
+	}
+
+	serverType := viper.GetString(common.CfgThriftServerType)
+	if serverType != ServerTypeSimple && serverType != ServerTypeThreaded {
+		return nil, fmt.Errorf("Unsupported thrift server type: %v", serverType)
+	}
+
+	wallet, err := openWallet(viper.GetString(common.CfgThriftSigner), viper.GetString(common.CfgThriftAddress))
+	if err != nil {
+		return nil, err
+	}
+
+	logger = util.GetLoggerForModule("thrift")
+
+	return &ThriftServer{
+		handler:    NewRpcHandler(service, wallet, chainID),
+		protocol:   protocol,
+		serverType: serverType,
+		wg:         &sync.WaitGroup{},
+	}, nil
+}
+
+// Start creates the main goroutine.
+func (t *ThriftServer) Start(ctx context.Context) {
+	c, cancel := context.WithCancel(ctx)
+	t.ctx = c
+	t.cancel = cancel
+
+	address := net.JoinHostPort(viper.GetString(common.CfgThriftAddress), viper.GetString(common.CfgThriftPort))
+	timeout := viper.GetDuration(common.CfgThriftTimeoutSecs) * time.Second
+	socket, err := thrift.NewTServerSocketTimeout(address, timeout)
+	if err != nil {
+		logger.WithFields(log.Fields{"error": err}).Fatal("Failed to create thrift server socket")
+	}
+	var serverTransport thrift.TServerTransport = socket
+	if t.serverType == ServerTypeSimple {
+		serverTransport = newSingleConnServerTransport(socket)
+	}
+
+	var transportFactory thrift.TTransportFactory
+	transportFactory = thrift.NewTBufferedTransportFactory(8192)
+	transportFactory = thrift.NewTFramedTransportFactory(transportFactory)
+	processor := theta.NewThetaServiceProcessor(t.handler)
+	t.server = thrift.NewTSimpleServer4(processor, serverTransport, transportFactory, t.protocol)
+	if err := t.server.Listen(); err != nil {
+		logger.WithFields(log.Fields{"error": err}).Fatal("Failed to listen")
+	}
+	logger.WithFields(log.Fields{"address": address}).Info("Thrift server started")
+
+	t.wg.Add(1)
+	go t.mainLoop()
+}
+
+func (t *ThriftServer) mainLoop() {
+	defer t.wg.Done()
+
+	go t.server.AcceptLoop()
+
+	<-t.ctx.Done()
+	t.stopped = true
+	t.server.Stop()
+}
+
+// Stop notifies all goroutines to stop without blocking.
+func (t *ThriftServer) Stop() {
+	t.cancel()
+}
+
+// Wait blocks until all goroutines stop.
+func (t *ThriftServer) Wait() {
+	t.wg.Wait()
+}
+
+func newProtocolFactory(protocol string) (thrift.TProtocolFactory, error) {
+	switch protocol {
+	case ProtocolBinary:
+		return thrift.NewTBinaryProtocolFactoryDefault(), nil
+	case ProtocolCompact:
+		return thrift.NewTCompactProtocolFactory(), nil
+	default:
+		return nil, fmt.Errorf("Unsupported thrift protocol: %v", protocol)
+	}
+}
+
+// openWallet opens the keystore used to sign transactions and unlocks the configured
+// addresses. It returns nil if server-side signing is disabled. Since the key management
+// calls are not authenticated, the keystore is only opened if the server binds to a
+// loopback address.
+func openWallet(signer string, bindAddress string) (wt.Wallet, error) {
+	switch signer {
+	case SignerNone:
+		return nil, nil
+	case SignerKeystore:
+	default:
+		return nil, fmt.Errorf("Unsupported thrift signer: %v", signer)
+	}
+
+	if !isLoopbackAddress(bindAddress) {
+		return nil, fmt.Errorf("The %v signer exposes the unlocked keys, so the thrift address must be a loopback address, got %v",
+			signer, bindAddress)
+	}
+
+	keysDirPath := viper.GetString(common.CfgThriftKeystorePath)
+	if keysDirPath == "" {
+		return nil, fmt.Errorf("The keystore path must be specified for the %v signer", signer)
+	}
+	wallet, err := sw.NewSoftWallet(keysDirPath, sw.KeystoreTypeEncrypted)
+	if err != nil {
+		return nil, err
+	}
+	password := viper.GetString(common.CfgThriftKeystorePassword)
+	for _, address := range viper.GetStringSlice(common.CfgThriftUnlockAddresses) {
+		if err := wallet.Unlock(common.HexToAddress(address), password, nil); err != nil {
+			return nil, fmt.Errorf("Failed to unlock key %v: %v", address, err)
+		}
+	}
+	return wallet, nil
+}
+
+// isLoopbackAddress returns whether the given host only accepts connections from the local machine.
+func isLoopbackAddress(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// singleConnServerTransport accepts a new connection only after the previous one is closed.
+type singleConnServerTransport struct {
+	thrift.TServerTransport
+	sem         chan struct{}
+	interrupted chan struct{}
+	once        sync.Once
+}
+
+func newSingleConnServerTransport(transport thrift.TServerTransport) *singleConnServerTransport {
+	return &singleConnServerTransport{
+		TServerTransport: transport,
+		sem:              make(chan struct{}, 1),
+		interrupted:      make(chan struct{}),
+	}
+}
+
+func (s *singleConnServerTransport) Accept() (thrift.TTransport, error) {
+	select {
+	case s.sem <- struct{}{}:
+	case <-s.interrupted:
+		return nil, nil
+	}
+	client, err := s.TServerTransport.Accept()
+	if err != nil || client == nil {
+		<-s.sem
+		return client, err
+	}
+	return &releasingTransport{TTransport: client, sem: s.sem}, nil
+}
+
+func (s *singleConnServerTransport) Interrupt() error {
+	s.once.Do(func() { close(s.interrupted) })
+	return s.TServerTransport.Interrupt()
+}
+
+// releasingTransport frees the connection slot of the server transport once closed.
+type releasingTransport struct {
+	thrift.TTransport
+	sem  chan struct{}
+	once sync.Once
+}
+
+func (t *releasingTransport) Close() error {
+	t.once.Do(func() { <-t.sem })
+	return t.TTransport.Close()
+}
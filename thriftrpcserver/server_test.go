@@ -0,0 +1,39 @@
+package thriftrpcserver
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"theta/common"
+)
+
+func TestOpenWalletRequiresLoopbackAddress(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	dir, err := ioutil.TempDir("", "theta-thrift-test")
+	require.Nil(err)
+	defer os.RemoveAll(dir)
+	viper.Set(common.CfgThriftKeystorePath, dir)
+	defer viper.Set(common.CfgThriftKeystorePath, "")
+
+	for _, address := range []string{"127.0.0.1", "::1", "localhost"} {
+		wallet, err := openWallet(SignerKeystore, address)
+		assert.Nil(err, address)
+		assert.NotNil(wallet, address)
+	}
+
+	for _, address := range []string{"0.0.0.0", "", "::", "192.168.1.10"} {
+		_, err := openWallet(SignerKeystore, address)
+		assert.NotNil(err, address)
+	}
+
+	// Without the keystore signer no keys are exposed
+	wallet, err := openWallet(SignerNone, "0.0.0.0")
+	assert.Nil(err)
+	assert.Nil(wallet)
+}
@@ -0,0 +1,208 @@
+package thriftrpcserver
+
+import (
+	"context"
+	"math/big"
+
+	"theta/common"
+	"theta/ledger/types"
+	"theta/rpc"
+	"theta/rpc/tnt20"
+	"theta/thrift/gen-go/rpc/theta"
+)
+
+// ------------------------------ Token queries -----------------------------------
+
+func (r *RpcHandler) GetTokenInfo(ctx context.Context, contractAddress string) (*theta.TokenInfo, error) {
+	result := &rpc.GetTokenInfoResult{}
+	if err := r.service.GetTokenInfo(&rpc.GetTokenInfoArgs{ContractAddress: contractAddress}, result); err != nil {
+		return nil, toThetaException(err)
+	}
+	return &theta.TokenInfo{
+		ContractAddress: result.ContractAddress.Hex(),
+		Name:            result.Name,
+		Symbol:          result.Symbol,
+		Decimals:        int32(result.Decimals),
+		TotalSupply:     bigToString((*big.Int)(result.TotalSupply)),
+	}, nil
+}
+
+func (r *RpcHandler) GetTokenBalanceString(ctx context.Context, address, contractAddress string) (string, error) {
+	balance, err := r.getTokenBalance(address, contractAddress)
+	if err != nil {
+		return "", err
+	}
+	return bigToString(balance), nil
+}
+
+// GetTokenBalance is kept for the legacy clients. It fails if the balance overflows int64, and the
+// private key is ignored since the balance query needs no sender.
+func (r *RpcHandler) GetTokenBalance(ctx context.Context, address, contractAddress, privateKey string) (int64, error) {
+	balance, err := r.getTokenBalance(address, contractAddress)
+	if err != nil {
+		return 0, err
+	}
+	if !balance.IsInt64() {
+		return 0, newThetaException(theta.TErrorCode_EInvalidArgument,
+			"Token balance %v overflows i64, use getTokenBalanceString instead", balance)
+	}
+	return balance.Int64(), nil
+}
+
+func (r *RpcHandler) getTokenBalance(address, contractAddress string) (*big.Int, error) {
+	result := &rpc.GetTokenBalanceResult{}
+	args := &rpc.GetTokenBalanceArgs{
+		ContractAddress: contractAddress,
+		Address:         address,
+	}
+	if err := r.service.GetTokenBalance(args, result); err != nil {
+		return nil, toThetaException(err)
+	}
+	if result.Balance == nil {
+		return big.NewInt(0), nil
+	}
+	return (*big.Int)(result.Balance), nil
+}
+
+func (r *RpcHandler) GetTokenAllowance(ctx context.Context, contractAddress, owner, spender string) (string, error) {
+	result := &rpc.GetTokenAllowanceResult{}
+	args := &rpc.GetTokenAllowanceArgs{
+		ContractAddress: contractAddress,
+		Owner:           owner,
+		Spender:         spender,
+	}
+	if err := r.service.GetTokenAllowance(args, result); err != nil {
+		return "", toThetaException(err)
+	}
+	return bigToString((*big.Int)(result.Allowance)), nil
+}
+
+// ------------------------------ Token transactions -----------------------------------
+
+func (r *RpcHandler) SendToken(ctx context.Context, send *theta.SendToken) (*theta.BroadcastRawTransactionAsync, error) {
+	if send == nil {
+		return nil, newThetaException(theta.TErrorCode_EInvalidArgument, "SendToken must be specified")
+	}
+	if send.IsSetPrivateKey() {
+		return nil, errPrivateKeyNotAccepted
+	}
+	if send.IsSetDeprecatedAmount() {
+		return nil, newThetaException(theta.TErrorCode_EInvalidArgument, "The deprecated_amount field is no longer accepted, set amount instead")
+	}
+	from, err := r.signerAddress(send.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateRecipient(from, send.To); err != nil {
+		return nil, err
+	}
+	amount, err := parseTokenAmount(send.Amount)
+	if err != nil {
+		return nil, err
+	}
+	data, err := tnt20.PackTransfer(common.HexToAddress(send.To), amount)
+	if err != nil {
+		return nil, newThetaException(theta.TErrorCode_EInvalidArgument, "%v", err)
+	}
+	return r.sendTokenTx(from, send.ContractAddress, data, send.GasLimit, send.GasPrice)
+}
+
+func (r *RpcHandler) ApproveToken(ctx context.Context, approve *theta.ApproveToken) (*theta.BroadcastRawTransactionAsync, error) {
+	if approve == nil {
+		return nil, newThetaException(theta.TErrorCode_EInvalidArgument, "ApproveToken must be specified")
+	}
+	from, err := r.signerAddress(approve.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+	if approve.Spender == "" {
+		return nil, newThetaException(theta.TErrorCode_EInvalidArgument, "Spender must be specified")
+	}
+	amount, err := parseWei("amount", approve.Amount, nil)
+	if err != nil {
+		return nil, err
+	}
+	data, err := tnt20.PackApprove(common.HexToAddress(approve.Spender), amount)
+	if err != nil {
+		return nil, newThetaException(theta.TErrorCode_EInvalidArgument, "%v", err)
+	}
+	return r.sendTokenTx(from, approve.ContractAddress, data, approve.GasLimit, approve.GasPrice)
+}
+
+func (r *RpcHandler) TransferTokenFrom(ctx context.Context, transfer *theta.TransferTokenFrom) (*theta.BroadcastRawTransactionAsync, error) {
+	if transfer == nil {
+		return nil, newThetaException(theta.TErrorCode_EInvalidArgument, "TransferTokenFrom must be specified")
+	}
+	from, err := r.signerAddress(transfer.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+	if transfer.Owner == "" {
+		return nil, newThetaException(theta.TErrorCode_EInvalidArgument, "Owner must be specified")
+	}
+	if transfer.To == "" {
+		return nil, newThetaException(theta.TErrorCode_EInvalidArgument, "The to address cannot be empty")
+	}
+	amount, err := parseTokenAmount(transfer.Amount)
+	if err != nil {
+		return nil, err
+	}
+	data, err := tnt20.PackTransferFrom(common.HexToAddress(transfer.Owner), common.HexToAddress(transfer.To), amount)
+	if err != nil {
+		return nil, newThetaException(theta.TErrorCode_EInvalidArgument, "%v", err)
+	}
+	return r.sendTokenTx(from, transfer.ContractAddress, data, transfer.GasLimit, transfer.GasPrice)
+}
+
+// sendTokenTx signs and broadcasts the smart contract transaction calling the TNT-20 contract.
+func (r *RpcHandler) sendTokenTx(from common.Address, contractAddress string, data []byte,
+	gasLimitStr, gasPriceStr string) (*theta.BroadcastRawTransactionAsync, error) {
+	if contractAddress == "" {
+		return nil, newThetaException(theta.TErrorCode_EInvalidArgument, "Contract address must be specified")
+	}
+	gasLimit, err := parseGasLimit(gasLimitStr)
+	if err != nil {
+		return nil, err
+	}
+	gasPrice, err := parseWei("gas price", gasPriceStr, big.NewInt(defaultGasPrice))
+	if err != nil {
+		return nil, err
+	}
+	sequence, err := r.nextSequence(from.Hex())
+	if err != nil {
+		return nil, err
+	}
+
+	smartContractTx := &types.SmartContractTx{
+		From: types.TxInput{
+			Address:  from,
+			Coins:    types.NewCoins(0, 0),
+			Sequence: sequence,
+		},
+		To: types.TxOutput{
+			Address: common.HexToAddress(contractAddress),
+		},
+		GasLimit: gasLimit,
+		GasPrice: gasPrice,
+		Data:     data,
+	}
+
+	sig, err := r.sign(from, smartContractTx.SignBytes(r.chainID))
+	if err != nil {
+		return nil, err
+	}
+	smartContractTx.SetSignature(from, sig)
+
+	return r.broadcastTx(smartContractTx)
+}
+
+func parseTokenAmount(amount string) (*big.Int, error) {
+	value, err := parseWei("amount", amount, nil)
+	if err != nil {
+		return nil, err
+	}
+	if value.Sign() == 0 {
+		return nil, newThetaException(theta.TErrorCode_EInvalidArgument, "Amount must be positive")
+	}
+	return value, nil
+}
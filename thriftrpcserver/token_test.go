@@ -0,0 +1,143 @@
+package thriftrpcserver
+
+import (
+	"context"
+	"encoding/hex"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/apache/thrift/lib/go/thrift"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"theta/common"
+	"theta/ledger/types"
+	"theta/thrift/gen-go/rpc/theta"
+	sw "theta/wallet/softwallet"
+)
+
+func TestRpcHandlerToken(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	dir, err := ioutil.TempDir("", "theta-thrift-test")
+	require.Nil(err)
+	defer os.RemoveAll(dir)
+
+	wallet, err := sw.NewSoftWallet(dir, sw.KeystoreTypeEncrypted)
+	require.Nil(err)
+	from, err := wallet.NewKey("qwertyuiop")
+	require.Nil(err)
+
+	amount, _ := new(big.Int).SetString("100000000000000000000000", 10)
+	service := &mockService{
+		accounts: map[string]*types.Account{from.Hex(): types.NewAccount(from)},
+		balances: map[string]*big.Int{"0x01": amount, "0x04": big.NewInt(1000)},
+	}
+	handler := NewRpcHandler(service, wallet, "privatenet")
+
+	balance, err := handler.GetTokenBalanceString(context.Background(), "0x01", "0x02")
+	require.Nil(err)
+	assert.Equal("100000000000000000000000", balance)
+
+	_, err = handler.GetTokenBalanceString(context.Background(), "0x03", "0x02")
+	require.NotNil(err)
+	assert.Equal(theta.TErrorCode_EUnknown, err.(*theta.TThetaException).Code)
+
+	// The legacy query only returns the balances that fit into i64
+	legacyBalance, err := handler.GetTokenBalance(context.Background(), "0x04", "0x02", "")
+	require.Nil(err)
+	assert.Equal(int64(1000), legacyBalance)
+
+	_, err = handler.GetTokenBalance(context.Background(), "0x01", "0x02", "")
+	require.NotNil(err)
+	assert.Equal(theta.TErrorCode_EInvalidArgument, err.(*theta.TThetaException).Code)
+
+	contract := "0x413682f3ec6504695ef2d70cda502c0489ce86af"
+	send := &theta.SendToken{
+		FromAddress:     from.Hex(),
+		To:              "0x0d2fd67d573c8ecb4161510fc00754d64b401f86",
+		Amount:          amount.String(),
+		ContractAddress: contract,
+	}
+	_, err = handler.SendToken(context.Background(), send)
+	require.Nil(err)
+	require.Equal(1, len(service.txBytes))
+	raw, err := hex.DecodeString(service.txBytes[0])
+	require.Nil(err)
+	tx, err := types.TxFromBytes(raw)
+	require.Nil(err)
+	sctx := tx.(*types.SmartContractTx)
+	assert.Equal(common.HexToAddress(contract), sctx.To.Address)
+	assert.Equal("a9059cbb"+
+		"0000000000000000000000000d2fd67d573c8ecb4161510fc00754d64b401f86"+
+		"00000000000000000000000000000000000000000000152d02c7e14af6800000", hex.EncodeToString(sctx.Data))
+	assert.True(sctx.From.Signature.Verify(sctx.SignBytes("privatenet"), from))
+
+	send.Amount = "0"
+	_, err = handler.SendToken(context.Background(), send)
+	require.NotNil(err)
+	assert.Equal(theta.TErrorCode_EInvalidArgument, err.(*theta.TThetaException).Code)
+
+	deprecatedAmount := int32(1)
+	send.Amount = "1"
+	send.DeprecatedAmount = &deprecatedAmount
+	_, err = handler.SendToken(context.Background(), send)
+	require.NotNil(err)
+	assert.Equal(theta.TErrorCode_EInvalidArgument, err.(*theta.TThetaException).Code)
+
+	send.DeprecatedAmount = nil
+	send.ContractAddress = ""
+	_, err = handler.SendToken(context.Background(), send)
+	require.NotNil(err)
+	assert.Equal(theta.TErrorCode_EInvalidArgument, err.(*theta.TThetaException).Code)
+
+	approve := &theta.ApproveToken{
+		FromAddress:     from.Hex(),
+		Spender:         "0x0d2fd67d573c8ecb4161510fc00754d64b401f86",
+		Amount:          "0",
+		ContractAddress: contract,
+	}
+	_, err = handler.ApproveToken(context.Background(), approve)
+	require.Nil(err)
+	require.Equal(2, len(service.txBytes))
+	raw, err = hex.DecodeString(service.txBytes[1])
+	require.Nil(err)
+	tx, err = types.TxFromBytes(raw)
+	require.Nil(err)
+	assert.Equal("095ea7b3", hex.EncodeToString(tx.(*types.SmartContractTx).Data[:4]))
+}
+
+func TestSendTokenLegacyEncoding(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	// Encode a SendToken request the way the legacy clients do, i.e. with the private key
+	// as field 1 and an i32 amount as field 3
+	buf := thrift.NewTMemoryBuffer()
+	oprot := thrift.NewTBinaryProtocolTransport(buf)
+	require.Nil(oprot.WriteStructBegin("SendToken"))
+	require.Nil(oprot.WriteFieldBegin("private_key", thrift.STRING, 1))
+	require.Nil(oprot.WriteString("2e3c3a3e1f0d8a3d0c6b0e4c8bb2d1ad3b9f5d39c6ad6bd1f8e4c9b5f1b3a7c9"))
+	require.Nil(oprot.WriteFieldEnd())
+	require.Nil(oprot.WriteFieldBegin("to", thrift.STRING, 2))
+	require.Nil(oprot.WriteString("0x0d2fd67d573c8ecb4161510fc00754d64b401f86"))
+	require.Nil(oprot.WriteFieldEnd())
+	require.Nil(oprot.WriteFieldBegin("amount", thrift.I32, 3))
+	require.Nil(oprot.WriteI32(100))
+	require.Nil(oprot.WriteFieldEnd())
+	require.Nil(oprot.WriteFieldStop())
+	require.Nil(oprot.WriteStructEnd())
+
+	send := theta.NewSendToken()
+	require.Nil(send.Read(thrift.NewTBinaryProtocolTransport(buf)))
+	assert.True(send.IsSetPrivateKey())
+	assert.Equal(int32(100), send.GetDeprecatedAmount())
+	assert.Equal("", send.FromAddress)
+	assert.Equal("", send.Amount)
+
+	handler := NewRpcHandler(&mockService{}, nil, "privatenet")
+	_, err := handler.SendToken(context.Background(), send)
+	assert.Equal(errPrivateKeyNotAccepted, err)
+}